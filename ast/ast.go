@@ -21,7 +21,6 @@ import (
 	"iter"
 	"strings"
 
-	"ballerina-lang-go/common"
 	"ballerina-lang-go/context"
 	"ballerina-lang-go/model"
 	"ballerina-lang-go/parser/tree"
@@ -72,15 +71,19 @@ type (
 		Name                            *BLangIdentifier
 		AnnAttachments                  []BLangAnnotationAttachment
 		MarkdownDocumentationAttachment *BLangMarkdownDocumentation
+		AttachPoints                    []AttachPoint
 		typeDescriptor                  TypeDescriptor
-		attachPoints                    common.UnorderedSet[AttachPoint]
+		symbol                          model.SymbolRef
+		flags                           model.Flag
 	}
 
 	BLangAnnotationAttachment struct {
 		bLangNodeBase
+		// Expr is nil when the annotation is attached without a value.
 		Expr           BLangExpression
 		AnnotationName *BLangIdentifier
 		PkgAlias       *BLangIdentifier
+		symbol         model.SymbolRef
 	}
 
 	bLangFunctionBodyBase struct {
@@ -232,6 +235,43 @@ type (
 		Name *BLangIdentifier
 	}
 
+	// BLangTupleVariable is a variable declared with a list binding pattern.
+	// Members and RestVariable are the variables of the nested binding
+	// patterns; a wildcard is a simple variable named "_".
+	BLangTupleVariable struct {
+		BLangVariableBase
+		Members      []VariableNode
+		RestVariable *BLangSimpleVariable
+	}
+
+	// BLangRecordVariable is a variable declared with a mapping binding pattern.
+	BLangRecordVariable struct {
+		BLangVariableBase
+		Fields       []BLangRecordVariableKeyValue
+		RestVariable *BLangSimpleVariable
+	}
+
+	BLangRecordVariableKeyValue struct {
+		Key   *BLangIdentifier
+		Value VariableNode
+	}
+
+	// BLangErrorVariable is a variable declared with an error binding pattern.
+	// ErrorTypeReference is set when the binding pattern names an error type.
+	BLangErrorVariable struct {
+		BLangVariableBase
+		ErrorTypeReference *BLangUserDefinedType
+		Message            *BLangSimpleVariable
+		Cause              VariableNode
+		Detail             []BLangErrorDetailEntry
+		RestDetail         *BLangSimpleVariable
+	}
+
+	BLangErrorDetailEntry struct {
+		Key   *BLangIdentifier
+		Value VariableNode
+	}
+
 	ClosureVarSymbol struct {
 		DiagnosticLocation diagnostics.Location
 	}
@@ -245,6 +285,7 @@ type (
 		RequiredParams                  []BLangSimpleVariable
 		RestParam                       SimpleVariableNode
 		returnTypeDescriptor            TypeDescriptor
+		ReturnTypeAnnAttachments        []BLangAnnotationAttachment
 		Body                            FunctionBodyNode
 		flags                           model.Flag
 		scope                           model.Scope
@@ -291,6 +332,8 @@ func (b *bLangInvokableNodeBase) IsInterface() bool     { return b.flags.Has(mod
 func (b *bLangInvokableNodeBase) IsNative() bool        { return b.flags.Has(model.FlagNative) }
 func (b *bLangInvokableNodeBase) IsAnonymous() bool     { return b.flags.Has(model.FlagLambda) }
 func (b *bLangInvokableNodeBase) IsAttached() bool      { return b.flags.Has(model.FlagAttached) }
+func (b *bLangInvokableNodeBase) IsWorker() bool        { return b.flags.Has(model.FlagWorker) }
+func (b *bLangInvokableNodeBase) IsInferred() bool      { return b.flags.Has(model.FlagInfer) }
 
 func (b *bLangInvokableNodeBase) SetPublic()        { b.flags |= model.FlagPublic }
 func (b *bLangInvokableNodeBase) SetRemote()        { b.flags |= model.FlagRemote }
@@ -301,16 +344,26 @@ func (b *bLangInvokableNodeBase) SetInterface()     { b.flags |= model.FlagInter
 func (b *bLangInvokableNodeBase) SetNative()        { b.flags |= model.FlagNative }
 func (b *bLangInvokableNodeBase) SetAnonymous()     { b.flags |= model.FlagLambda | model.FlagAnonymous }
 func (b *bLangInvokableNodeBase) SetAttached()      { b.flags |= model.FlagAttached }
+func (b *bLangInvokableNodeBase) SetWorker()        { b.flags |= model.FlagWorker }
+func (b *bLangInvokableNodeBase) SetInferred()      { b.flags |= model.FlagInfer }
 func (b *bLangInvokableNodeBase) Flags() model.Flag { return b.flags }
 
 func (b *bLangInvokableNodeBase) FuncSymbolFlags() model.FuncSymbolFlags {
-	return model.FuncSymbolFlags(b.flags)
+	var flags model.FuncSymbolFlags
+	if b.IsIsolated() {
+		flags |= model.FuncSymbolFlagIsolated
+	}
+	if b.IsTransactional() {
+		flags |= model.FuncSymbolFlagTransactional
+	}
+	return flags
 }
 
 // BLangVariableBase flag methods
 func (b *BLangVariableBase) IsPublic() bool           { return b.flags.Has(model.FlagPublic) }
 func (b *BLangVariableBase) IsFinal() bool            { return b.flags.Has(model.FlagFinal) }
 func (b *BLangVariableBase) IsConfigurable() bool     { return b.flags.Has(model.FlagConfigurable) }
+func (b *BLangVariableBase) IsRequired() bool         { return b.flags.Has(model.FlagRequired) }
 func (b *BLangVariableBase) IsDefaultableParam() bool { return b.flags.Has(model.FlagDefaultableParam) }
 func (b *BLangVariableBase) IsRequiredParam() bool    { return b.flags.Has(model.FlagRequiredParam) }
 func (b *BLangVariableBase) IsRestParam() bool        { return b.flags.Has(model.FlagRestParam) }
@@ -322,6 +375,7 @@ func (b *BLangVariableBase) SetPublic()              { b.flags |= model.FlagPubl
 func (b *BLangVariableBase) SetPrivate()             { b.flags &^= model.FlagPublic }
 func (b *BLangVariableBase) SetFinal()               { b.flags |= model.FlagFinal }
 func (b *BLangVariableBase) SetConfigurable()        { b.flags |= model.FlagConfigurable }
+func (b *BLangVariableBase) SetRequired()            { b.flags |= model.FlagRequired }
 func (b *BLangVariableBase) SetIsolated()            { b.flags |= model.FlagIsolated }
 func (b *BLangVariableBase) SetDefaultableParam()    { b.flags |= model.FlagDefaultableParam }
 func (b *BLangVariableBase) SetRequiredParam()       { b.flags |= model.FlagRequiredParam }
@@ -357,8 +411,13 @@ func (b *BLangTypeDefinition) SetPublic()        { b.flags |= model.FlagPublic }
 func (b *BLangTypeDefinition) SetAnonymous()     { b.flags |= model.FlagAnonymous }
 func (b *BLangTypeDefinition) SetDistinct()      { b.flags |= model.FlagDistinct }
 
+// BLangAnnotation flag methods
+func (b *BLangAnnotation) IsPublic() bool { return b.flags.Has(model.FlagPublic) }
+func (b *BLangAnnotation) IsConst() bool  { return b.flags.Has(model.FlagConstant) }
+func (b *BLangAnnotation) SetPublic()     { b.flags |= model.FlagPublic }
+func (b *BLangAnnotation) SetConst()      { b.flags |= model.FlagConstant }
+
 // Stub IsPublic for types with no flags
-func (b *BLangAnnotation) IsPublic() bool     { return false }
 func (b *BLangMemberTypeDesc) IsPublic() bool { return false }
 
 func (b *bLangNodeBase) SetDeterminedType(ty semtypes.SemType) {
@@ -417,6 +476,22 @@ func (n *bLangInvokableNodeBase) SetSymbol(symbolRef model.SymbolRef) {
 	n.symbol = symbolRef
 }
 
+func (n *BLangAnnotation) Symbol() model.SymbolRef {
+	return n.symbol
+}
+
+func (n *BLangAnnotation) SetSymbol(symbolRef model.SymbolRef) {
+	n.symbol = symbolRef
+}
+
+func (n *BLangAnnotationAttachment) Symbol() model.SymbolRef {
+	return n.symbol
+}
+
+func (n *BLangAnnotationAttachment) SetSymbol(symbolRef model.SymbolRef) {
+	n.symbol = symbolRef
+}
+
 func (n *BLangTypeDefinition) Symbol() model.SymbolRef {
 	return n.symbol
 }
@@ -425,6 +500,14 @@ func (n *BLangTypeDefinition) SetSymbol(symbolRef model.SymbolRef) {
 	n.symbol = symbolRef
 }
 
+func (n *BLangWorker) Symbol() model.SymbolRef {
+	return n.symbol
+}
+
+func (n *BLangWorker) SetSymbol(symbolRef model.SymbolRef) {
+	n.symbol = symbolRef
+}
+
 var (
 	_ AnnotationAttachmentNode                    = &BLangAnnotationAttachment{}
 	_ ImportPackageNode                           = &BLangImportPackage{}
@@ -438,6 +521,9 @@ var (
 	_ CompilationUnitNode                         = &BLangCompilationUnit{}
 	_ ConstantNode                                = &BLangConstant{}
 	_ SimpleVariableNode                          = &BLangSimpleVariable{}
+	_ VariableNode                                = &BLangTupleVariable{}
+	_ VariableNode                                = &BLangRecordVariable{}
+	_ VariableNode                                = &BLangErrorVariable{}
 	_ MarkdownDocumentationNode                   = &BLangMarkdownDocumentation{}
 	_ MarkdownDocumentationReferenceAttributeNode = &BLangMarkdownReferenceDocumentation{}
 	_ ExprFunctionBodyNode                        = &BLangExprFunctionBody{}
@@ -449,6 +535,9 @@ func (*BLangImportPackage) isTopLevel()   {}
 func (*BLangXMLNS) isTopLevel()           {}
 func (*BLangAnnotation) isTopLevel()      {}
 func (*BLangSimpleVariable) isTopLevel()  {}
+func (*BLangTupleVariable) isTopLevel()   {}
+func (*BLangRecordVariable) isTopLevel()  {}
+func (*BLangErrorVariable) isTopLevel()   {}
 func (*BLangFunction) isTopLevel()        {}
 func (*BLangClassDefinition) isTopLevel() {}
 func (*BLangService) isTopLevel()         {}
@@ -472,6 +561,9 @@ var (
 	_ BLangNode = &BLangMarkdownReferenceDocumentation{}
 	_ BLangNode = &BLangConstant{}
 	_ BLangNode = &BLangSimpleVariable{}
+	_ BLangNode = &BLangTupleVariable{}
+	_ BLangNode = &BLangRecordVariable{}
+	_ BLangNode = &BLangErrorVariable{}
 	_ BLangNode = &BLangFunction{}
 	_ BLangNode = &BLangTypeDefinition{}
 )
@@ -483,6 +575,8 @@ var (
 	_ BNodeWithSymbol = &BLangSimpleVariable{}
 	_ BNodeWithSymbol = &BLangFunction{}
 	_ BNodeWithSymbol = &BLangTypeDefinition{}
+	_ BNodeWithSymbol = &BLangAnnotation{}
+	_ BNodeWithSymbol = &BLangAnnotationAttachment{}
 )
 
 func (b *BLangAnnotationAttachment) GetPackageAlias() *BLangIdentifier {
@@ -809,6 +903,97 @@ func (b *BLangSimpleVariable) SetName(name *BLangIdentifier) {
 	b.Name = name
 }
 
+// BindingVariables returns the simple variables declared by variable in
+// binding pattern order, including those of wildcard binding patterns.
+func BindingVariables(variable VariableNode) []*BLangSimpleVariable {
+	var result []*BLangSimpleVariable
+	var collect func(VariableNode)
+	collect = func(v VariableNode) {
+		switch v := v.(type) {
+		case *BLangSimpleVariable:
+			result = append(result, v)
+		case *BLangTupleVariable:
+			for _, member := range v.Members {
+				collect(member)
+			}
+			if v.RestVariable != nil {
+				collect(v.RestVariable)
+			}
+		case *BLangRecordVariable:
+			for _, field := range v.Fields {
+				collect(field.Value)
+			}
+			if v.RestVariable != nil {
+				collect(v.RestVariable)
+			}
+		case *BLangErrorVariable:
+			if v.Message != nil {
+				collect(v.Message)
+			}
+			if v.Cause != nil {
+				collect(v.Cause)
+			}
+			for _, entry := range v.Detail {
+				collect(entry.Value)
+			}
+			if v.RestDetail != nil {
+				collect(v.RestDetail)
+			}
+		}
+	}
+	collect(variable)
+	return result
+}
+
+// IsWildcardVariable reports whether variable was declared by a wildcard
+// binding pattern.
+func IsWildcardVariable(variable *BLangSimpleVariable) bool {
+	return variable.Name != nil && variable.Name.Value == string(model.IGNORE)
+}
+
+// BindingVarRefs returns the variable references and wildcards assigned by
+// the binding pattern ref of a destructuring assignment, in binding pattern
+// order. A ref that is not a binding pattern is returned as is.
+func BindingVarRefs(ref LExpr) []LExpr {
+	var result []LExpr
+	var collect func(LExpr)
+	collect = func(r LExpr) {
+		switch r := r.(type) {
+		case *BLangTupleVarRef:
+			for _, member := range r.Expressions {
+				collect(member)
+			}
+			if r.RestParam != nil {
+				collect(r.RestParam)
+			}
+		case *BLangRecordVarRef:
+			for _, field := range r.RecordRefFields {
+				collect(field.VariableReference)
+			}
+			if r.RestParam != nil {
+				collect(r.RestParam)
+			}
+		case *BLangErrorVarRef:
+			if r.Message != nil {
+				collect(r.Message)
+			}
+			if r.Cause != nil {
+				collect(r.Cause)
+			}
+			for _, entry := range r.Detail {
+				collect(entry.Expr.(LExpr))
+			}
+			if r.RestVar != nil {
+				collect(r.RestVar)
+			}
+		default:
+			result = append(result, r)
+		}
+	}
+	collect(ref)
+	return result
+}
+
 func (b *BLangMarkdownDocumentation) GetDocumentationLines() []MarkdownDocumentationTextAttributeNode {
 	result := make([]MarkdownDocumentationTextAttributeNode, len(b.DocumentationLines))
 	for i := range b.DocumentationLines {
@@ -1073,6 +1258,18 @@ func (b *bLangInvokableNodeBase) GetReturnTypeDescriptor() TypeDescriptor {
 	return b.returnTypeDescriptor
 }
 
+func (b *bLangInvokableNodeBase) GetReturnTypeAnnotationAttachments() []AnnotationAttachmentNode {
+	result := make([]AnnotationAttachmentNode, len(b.ReturnTypeAnnAttachments))
+	for i := range b.ReturnTypeAnnAttachments {
+		result[i] = &b.ReturnTypeAnnAttachments[i]
+	}
+	return result
+}
+
+func (b *bLangInvokableNodeBase) AddReturnTypeAnnotationAttachment(annAttachment AnnotationAttachmentNode) {
+	b.ReturnTypeAnnAttachments = append(b.ReturnTypeAnnAttachments, *annAttachment.(*BLangAnnotationAttachment))
+}
+
 func (b *bLangInvokableNodeBase) SetReturnTypeDescriptor(typeDescriptor TypeDescriptor) {
 	if typeDescriptor == nil {
 		b.returnTypeDescriptor = nil
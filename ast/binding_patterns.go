@@ -71,6 +71,27 @@ type (
 	BLangWildCardBindingPattern struct {
 		BLangBindingPatternBase
 	}
+
+	BLangListBindingPattern struct {
+		BLangBindingPatternBase
+		BindingPatterns    []BindingPatternNode
+		RestBindingPattern *BLangRestBindingPattern
+	}
+
+	BLangMappingBindingPattern struct {
+		BLangBindingPatternBase
+		FieldBindingPatterns []BLangFieldBindingPattern
+		RestBindingPattern   *BLangRestBindingPattern
+	}
+
+	// BLangFieldBindingPattern is a field of a mapping binding pattern. For
+	// the `{x}` shorthand BindingPattern is a capture binding pattern named
+	// after the field.
+	BLangFieldBindingPattern struct {
+		BLangBindingPatternBase
+		FieldName      *BLangIdentifier
+		BindingPattern BindingPatternNode
+	}
 )
 
 func (*BLangWildCardBindingPattern) isWildCardBindingPattern() {}
@@ -85,6 +106,9 @@ var (
 	_ NamedArgBindingPatternNode     = &BLangNamedArgBindingPattern{}
 	_ RestBindingPatternNode         = &BLangRestBindingPattern{}
 	_ WildCardBindingPatternNode     = &BLangWildCardBindingPattern{}
+	_ BindingPatternNode             = &BLangListBindingPattern{}
+	_ BindingPatternNode             = &BLangMappingBindingPattern{}
+	_ BindingPatternNode             = &BLangFieldBindingPattern{}
 )
 
 var (
@@ -97,6 +121,9 @@ var (
 	_ BLangNode = &BLangNamedArgBindingPattern{}
 	_ BLangNode = &BLangRestBindingPattern{}
 	_ BLangNode = &BLangWildCardBindingPattern{}
+	_ BLangNode = &BLangListBindingPattern{}
+	_ BLangNode = &BLangMappingBindingPattern{}
+	_ BLangNode = &BLangFieldBindingPattern{}
 )
 
 func (b *BLangCaptureBindingPattern) GetIdentifier() *BLangIdentifier {
@@ -18,6 +18,7 @@ package ast
 
 import (
 	"ballerina-lang-go/common"
+	"ballerina-lang-go/semtypes"
 )
 
 type TypeParamEntry struct {
@@ -32,6 +33,10 @@ type (
 		// PR-TODO: can this be nil?
 		VariableDefinitionNode *BLangSimpleVariableDef
 		IsDeclaredWithVarFlag  bool
+		// CompletionType is the error type with which iterating Collection
+		// can complete; NEVER if iteration cannot fail. Set by the type
+		// resolver.
+		CompletionType semtypes.SemType
 	}
 	BLangFromClause struct {
 		BLangInputClause
@@ -43,7 +48,10 @@ type (
 	}
 	BLangLetClause struct {
 		bLangNodeBase
-		LetVarDeclarations []BLangSimpleVariableDef
+		// LetVarDeclarations holds simple variable definitions and, for
+		// list, mapping and error binding patterns, the definitions of
+		// the variables they declare.
+		LetVarDeclarations []VariableDefinitionNode
 	}
 	BLangOnClause struct {
 		bLangNodeBase
@@ -106,6 +106,7 @@ type ResourceAccessSegmentKind uint8
 const (
 	ResourceAccessSegmentName ResourceAccessSegmentKind = iota
 	ResourceAccessSegmentComputed
+	ResourceAccessSegmentRest
 )
 
 type MappingKeyKind uint8
@@ -124,11 +125,27 @@ const (
 	TemplateExprKindString TemplateExprKind = iota
 	TemplateExprKindXML
 	TemplateExprKindRaw
+	TemplateExprKindRegExp
 )
 
 const (
 	XMLTemplateInsertionKindContent XMLTemplateInsertionKind = iota
 	XMLTemplateInsertionKindAttribute
+	XMLTemplateInsertionKindComment
+	XMLTemplateInsertionKindPI
+	XMLTemplateInsertionKindCDATA
+)
+
+// XMLStepKind is the step start of an xml step expression.
+type XMLStepKind uint8
+
+const (
+	// XMLStepKindChildren is `x/*`: all the children of each element.
+	XMLStepKindChildren XMLStepKind = iota
+	// XMLStepKindChildElements is `x/<p>`: the child elements matching the name pattern.
+	XMLStepKindChildElements
+	// XMLStepKindDescendants is `x/**/<p>`: the descendant elements matching the name pattern.
+	XMLStepKindDescendants
 )
 
 type (
@@ -158,13 +175,16 @@ type (
 	BLangFieldBaseAccess struct {
 		bLangAccessExpressionBase
 		Field BLangIdentifier
+		// OptionalFieldAccess is set for `x?.f`, which evaluates to nil instead of failing when x is nil or has no
+		// field f.
+		OptionalFieldAccess bool
 		// I think this need a symbol to got to the field definition in type but Expr could be non atomic and
 		// this should still work
 	}
 
 	BLangAlternateWorkerReceive struct {
 		bLangExpressionBase
-		workerReceives []BLangWorkerReceive
+		WorkerReceives []BLangWorkerReceive
 	}
 
 	BLangAnnotAccessExpr struct {
@@ -172,6 +192,7 @@ type (
 		Expr           BLangExpression
 		PkgAlias       *BLangIdentifier
 		AnnotationName *BLangIdentifier
+		symbol         model.SymbolRef
 	}
 
 	BLangArrowFunction struct {
@@ -193,10 +214,15 @@ type (
 		RhsExpr BLangExpression
 		OpKind  model.OperatorKind
 	}
+	// BLangQueryExpr is a query expression or, when the last clause is a
+	// BLangDoClause, a query action.
 	BLangQueryExpr struct {
 		bLangExpressionBase
 		QueryClauseList    []BLangNode
 		QueryConstructType TypeKind
+		// TableKeySpecifier is the key specifier of a table query construct
+		// type, nil if there is none.
+		TableKeySpecifier *BLangTableKeySpecifier
 	}
 
 	BLangCheckedExpr struct {
@@ -213,9 +239,21 @@ type (
 		Expr BLangExpression
 	}
 
+	BLangLetExpr struct {
+		bLangExpressionBase
+		// LetVarDeclarations holds simple variable definitions and, for
+		// list, mapping and error binding patterns, the definitions of
+		// the variables they declare.
+		LetVarDeclarations []VariableDefinitionNode
+		Expr               BLangExpression
+	}
+
 	BLangCommitExpr struct {
 		bLangExpressionBase
 	}
+	BLangTransactionalExpr struct {
+		bLangExpressionBase
+	}
 	BLangVariableReferenceBase struct {
 		BLangValueExpressionBase
 		symbol model.SymbolRef
@@ -231,6 +269,39 @@ type (
 		BLangSimpleVarRef
 	}
 
+	// BLangTupleVarRef is the list binding pattern on the left-hand side of a
+	// destructuring assignment. Members are simple variable references,
+	// wildcards or nested binding patterns.
+	BLangTupleVarRef struct {
+		BLangValueExpressionBase
+		Expressions []LExpr
+		RestParam   LExpr
+	}
+
+	// BLangRecordVarRef is the mapping binding pattern on the left-hand side
+	// of a destructuring assignment.
+	BLangRecordVarRef struct {
+		BLangValueExpressionBase
+		RecordRefFields []BLangRecordVarRefKeyValue
+		RestParam       LExpr
+	}
+
+	BLangRecordVarRefKeyValue struct {
+		VariableName      *BLangIdentifier
+		VariableReference LExpr
+	}
+
+	// BLangErrorVarRef is the error binding pattern on the left-hand side of a
+	// destructuring assignment. The Expr of each Detail entry is an LExpr.
+	BLangErrorVarRef struct {
+		BLangValueExpressionBase
+		ErrorTypeReference *BLangUserDefinedType
+		Message            LExpr
+		Cause              LExpr
+		Detail             []BLangNamedArgsExpression
+		RestVar            LExpr
+	}
+
 	BLangConstRef struct {
 		BLangSimpleVarRef
 		Value         any
@@ -253,27 +324,89 @@ type (
 		LhsExpr BLangExpression
 		RhsExpr BLangExpression
 	}
+	BLangTernaryExpr struct {
+		bLangExpressionBase
+		Expr     BLangExpression
+		ThenExpr BLangExpression
+		ElseExpr BLangExpression
+	}
 
 	BLangWorkerSendReceiveExprBase struct {
 		bLangExpressionBase
-		WorkerType       BType
 		WorkerIdentifier *BLangIdentifier
-		Channel          *Channel
+		// Channel is the message channel this interaction belongs to. Set by
+		// the worker analyzer once the send has been paired with its receive.
+		Channel *Channel
+		// PeerSymbol is the function symbol of the peer worker. For the
+		// default worker this is the symbol of the enclosing function.
+		PeerSymbol model.SymbolRef
+		// GroupSymbol is the hidden variable holding the worker group of the
+		// enclosing function. Set by desugar.
+		GroupSymbol model.SymbolRef
 	}
 
 	BLangWorkerReceive struct {
 		BLangWorkerSendReceiveExprBase
-		Send               WorkerSendExpressionNode
-		MatchingSendsError BType
+		Send WorkerSendExpressionNode
 	}
 
 	BLangWorkerSendExprBase struct {
 		BLangWorkerSendReceiveExprBase
-		Expr                     BLangExpression
-		Receive                  *BLangWorkerReceive
-		SendType                 BType
-		SendTypeWithNoMsgIgnored BType
-		NoMessagePossible        bool
+		Expr    BLangExpression
+		Receive *BLangWorkerReceive
+		// NoMessagePossible is true when the sender may terminate without
+		// executing the send, in which case the receive must also accept an
+		// error.
+		NoMessagePossible bool
+	}
+
+	// BLangWorkerAsyncSendExpr is `expr -> peer`.
+	BLangWorkerAsyncSendExpr struct {
+		BLangWorkerSendExprBase
+	}
+
+	// BLangWorkerSyncSendExpr is `expr ->> peer`.
+	BLangWorkerSyncSendExpr struct {
+		BLangWorkerSendExprBase
+	}
+
+	// BLangWaitExpr is `wait f` or the alternate wait `wait f1|f2`.
+	BLangWaitExpr struct {
+		bLangExpressionBase
+		Exprs []BLangExpression
+	}
+
+	// BLangWaitForAllExpr is the multiple wait `wait { k1: f1, k2 }`.
+	BLangWaitForAllExpr struct {
+		bLangExpressionBase
+		KeyValuePairs []BLangWaitKeyValue
+	}
+
+	BLangWaitKeyValue struct {
+		Key       BLangIdentifier
+		ValueExpr BLangExpression
+	}
+
+	// BLangMultipleWorkerReceive is `<- { k1: w1, k2: w2 }`.
+	BLangMultipleWorkerReceive struct {
+		bLangExpressionBase
+		ReceiveFields []BLangReceiveField
+	}
+
+	BLangReceiveField struct {
+		Key     BLangIdentifier
+		Receive BLangWorkerReceive
+	}
+
+	// BLangWorkerFlushExpr is `flush` or `flush peer`. Receivers holds the
+	// names of all workers the flushing worker sends to when no peer is given.
+	BLangWorkerFlushExpr struct {
+		bLangExpressionBase
+		WorkerIdentifier *BLangIdentifier
+		Sender           string
+		Receivers        []string
+		PeerSymbols      []model.SymbolRef
+		GroupSymbol      model.SymbolRef
 	}
 
 	bLangInvocationBase struct {
@@ -285,13 +418,17 @@ type (
 		ArgExprs     []BLangExpression
 		RequiredArgs []BLangExpression
 		RestArgs     []BLangExpression
+		// Async is set for calls made by a start action. The call then evaluates to a future for its result.
+		Async bool
+		// paramsSymbol is set for calls through a function value whose type is a single function type. It is the
+		// function symbol describing the parameters of that type, used to match named arguments and supply defaults.
+		paramsSymbol model.SymbolRef
 	}
 
 	BLangInvocation struct {
 		bLangExpressionBase
 		bLangInvocationBase
 		PkgAlias *BLangIdentifier
-		Async    bool
 	}
 
 	BLangRemoteMethodCallAction struct {
@@ -376,6 +513,11 @@ type (
 		Readonly  bool
 	}
 
+	BLangMappingSpreadField struct {
+		bLangNodeBase
+		Expr BLangExpression
+	}
+
 	BLangMappingConstructorExpr struct {
 		bLangExpressionBase
 		Fields        []MappingField
@@ -383,6 +525,12 @@ type (
 		FieldDefaults []model.FieldDefault
 	}
 
+	BLangTableConstructorExpr struct {
+		bLangExpressionBase
+		TableKeySpecifier *BLangTableKeySpecifier
+		RecordLiteralList []*BLangMappingConstructorExpr
+	}
+
 	BLangNamedArgsExpression struct {
 		bLangExpressionBase
 		Name BLangIdentifier
@@ -390,6 +538,16 @@ type (
 		// JBallerina has symbols for these as well. Need to think if we need them as well (for go to definition)
 	}
 
+	// BLangRestArgsExpression is a rest argument `...expr` of a call; its members are
+	// passed as separate arguments.
+	BLangRestArgsExpression struct {
+		bLangExpressionBase
+		Expr BLangExpression
+		// ParamMembers is the number of leading members passed to required parameters
+		// rather than to the rest parameter; set by the type resolver.
+		ParamMembers int
+	}
+
 	BLangNewExpression struct {
 		bLangExpressionBase
 		AtomicType     *semtypes.MappingAtomicType
@@ -453,6 +611,38 @@ type (
 		bLangExpressionBase
 		Body string
 	}
+
+	// BLangXMLElementFilter is a single alternative of an xml name pattern. Name is "*" for a wildcard and Prefix
+	// is empty when the pattern has no prefix.
+	BLangXMLElementFilter struct {
+		bLangNodeBase
+		Prefix string
+		Name   string
+	}
+
+	// BLangXMLFilterExpr is `x.<p>`, the elements of x matching any of Filters.
+	BLangXMLFilterExpr struct {
+		bLangExpressionBase
+		Expr    BLangExpression
+		Filters []BLangXMLElementFilter
+	}
+
+	// BLangXMLStepExpr is `x/*`, `x/<p>` or `x/**/<p>` followed by the optional step extensions. The extensions are
+	// built over Item, which stands for the result of the step on a single element of Expr; Extension is nil when
+	// there are none.
+	BLangXMLStepExpr struct {
+		bLangExpressionBase
+		Expr      BLangExpression
+		Kind      XMLStepKind
+		Filters   []BLangXMLElementFilter
+		Item      *BLangXMLStepItem
+		Extension BLangExpression
+	}
+
+	// BLangXMLStepItem is the placeholder operand of the extensions of an xml step expression.
+	BLangXMLStepItem struct {
+		bLangExpressionBase
+	}
 )
 
 var (
@@ -465,12 +655,15 @@ var (
 	_ BLangExpression                                        = &BLangLiteral{}
 	_ MappingVarNameFieldNode                                = &BLangConstRef{}
 	_ ElvisExpressionNode                                    = &BLangElvisExpr{}
+	_ TernaryExpressionNode                                  = &BLangTernaryExpr{}
 	_ MarkdownDocumentationTextAttributeNode                 = &BLangMarkdownDocumentationLine{}
 	_ MarkdownDocumentationParameterAttributeNode            = &BLangMarkdownParameterDocumentation{}
 	_ MarkdownDocumentationReturnParameterAttributeNode      = &BLangMarkdownReturnParameterDocumentation{}
 	_ MarkDownDocumentationDeprecationAttributeNode          = &BLangMarkDownDeprecationDocumentation{}
 	_ MarkDownDocumentationDeprecatedParametersAttributeNode = &BLangMarkDownDeprecatedParametersDocumentation{}
 	_ WorkerReceiveNode                                      = &BLangWorkerReceive{}
+	_ WorkerSendExpressionNode                               = &BLangWorkerAsyncSendExpr{}
+	_ WorkerSendExpressionNode                               = &BLangWorkerSyncSendExpr{}
 	_ LambdaFunctionNode                                     = &BLangLambdaFunction{}
 	_ InvocationNode                                         = &BLangInvocation{}
 	_ BLangExpression                                        = &BLangInvocation{}
@@ -494,10 +687,14 @@ var (
 	_ MappingKeyValueFieldNode                               = &BLangMappingKeyValueField{}
 	_ BLangExpression                                        = &BLangMappingConstructorExpr{}
 	_ BLangNode                                              = &BLangMappingConstructorExpr{}
+	_ BLangExpression                                        = &BLangTableConstructorExpr{}
 	_ BLangExpression                                        = &BLangNamedArgsExpression{}
 	_ NamedArgNode                                           = &BLangNamedArgsExpression{}
+	_ MappingField                                           = &BLangMappingSpreadField{}
+	_ BLangExpression                                        = &BLangRestArgsExpression{}
 	_ TrapNode                                               = &BLangTrapExpr{}
 	_ BLangExpression                                        = &BLangTrapExpr{}
+	_ BLangExpression                                        = &BLangLetExpr{}
 	_ BLangExpression                                        = &BLangNewExpression{}
 )
 
@@ -513,13 +710,29 @@ var (
 	_ BLangNode       = &BLangCheckedExpr{}
 	_ BLangNode       = &BLangCheckPanickedExpr{}
 	_ BLangNode       = &BLangCommitExpr{}
+	_ BLangNode       = &BLangTransactionalExpr{}
 	_ BLangNode       = &BLangSimpleVarRef{}
 	_ BLangNode       = &BLangLocalVarRef{}
+	_ BLangNode       = &BLangTupleVarRef{}
+	_ BLangNode       = &BLangRecordVarRef{}
+	_ BLangNode       = &BLangErrorVarRef{}
 	_ BLangNode       = &BLangConstRef{}
 	_ BLangNode       = &BLangLiteral{}
 	_ BLangNode       = &BLangNumericLiteral{}
 	_ BLangNode       = &BLangElvisExpr{}
+	_ BLangNode       = &BLangTernaryExpr{}
 	_ BLangNode       = &BLangWorkerReceive{}
+	_ BLangNode       = &BLangWorkerAsyncSendExpr{}
+	_ BLangNode       = &BLangWorkerSyncSendExpr{}
+	_ BLangNode       = &BLangMultipleWorkerReceive{}
+	_ BLangNode       = &BLangWorkerFlushExpr{}
+	_ BLangNode       = &BLangWaitExpr{}
+	_ BLangNode       = &BLangWaitForAllExpr{}
+	_ ActionNode      = &BLangWaitExpr{}
+	_ ActionNode      = &BLangWaitForAllExpr{}
+	_ ActionNode      = &BLangAlternateWorkerReceive{}
+	_ ActionNode      = &BLangMultipleWorkerReceive{}
+	_ ActionNode      = &BLangWorkerFlushExpr{}
 	_ BLangNode       = &BLangInvocation{}
 	_ BLangNode       = &BLangMarkdownDocumentationLine{}
 	_ BLangNode       = &BLangMarkdownParameterDocumentation{}
@@ -535,7 +748,10 @@ var (
 	_ BLangNode       = &BLangTypeConversionExpr{}
 	_ BLangNode       = &BLangMappingConstructorExpr{}
 	_ BLangNode       = &BLangMappingKeyValueField{}
+	_ BLangNode       = &BLangMappingSpreadField{}
+	_ BLangNode       = &BLangTableConstructorExpr{}
 	_ BLangNode       = &BLangTrapExpr{}
+	_ BLangNode       = &BLangLetExpr{}
 	_ BLangNode       = &BLangNewExpression{}
 )
 
@@ -552,10 +768,19 @@ var (
 func (*BLangVariableReferenceBase) isVariableReference() {}
 
 func (*BLangSimpleVarRef) isLExpr()         {}
+func (*BLangTupleVarRef) isLExpr()          {}
+func (*BLangRecordVarRef) isLExpr()         {}
+func (*BLangErrorVarRef) isLExpr()          {}
 func (*bLangAccessExpressionBase) isLExpr() {}
 
-func (*BLangCommitExpr) isAction()    {}
-func (*BLangWorkerReceive) isAction() {}
+func (*BLangCommitExpr) isAction()             {}
+func (*BLangWorkerReceive) isAction()          {}
+func (*BLangWorkerSendExprBase) isAction()     {}
+func (*BLangAlternateWorkerReceive) isAction() {}
+func (*BLangMultipleWorkerReceive) isAction()  {}
+func (*BLangWorkerFlushExpr) isAction()        {}
+func (*BLangWaitExpr) isAction()               {}
+func (*BLangWaitForAllExpr) isAction()         {}
 
 func (n *BLangVariableReferenceBase) Symbol() model.SymbolRef {
 	return n.symbol
@@ -565,6 +790,14 @@ func (n *BLangVariableReferenceBase) SetSymbol(symbolRef model.SymbolRef) {
 	n.symbol = symbolRef
 }
 
+func (n *BLangAnnotAccessExpr) Symbol() model.SymbolRef {
+	return n.symbol
+}
+
+func (n *BLangAnnotAccessExpr) SetSymbol(symbolRef model.SymbolRef) {
+	n.symbol = symbolRef
+}
+
 // Symbol returns the resolved SymbolRef for this invocation.
 // Panics if the symbol has not been resolved yet (i.e. is a deferred method symbol).
 // Only call this after type resolution.
@@ -629,7 +862,11 @@ func (b *BLangLambdaFunction) SetFunctionNode(functionNode FunctionNode) {
 }
 
 func (b *BLangAlternateWorkerReceive) ToActionString() string {
-	panic("Not implemented")
+	names := make([]string, len(b.WorkerReceives))
+	for i := range b.WorkerReceives {
+		names[i] = b.WorkerReceives[i].WorkerIdentifier.Value
+	}
+	return " <- " + strings.Join(names, " | ")
 }
 
 func (b *BLangWorkerReceive) GetWorkerName() *BLangIdentifier {
@@ -757,6 +994,18 @@ func (b *BLangElvisExpr) GetRightExpression() BLangExpression {
 	return b.RhsExpr
 }
 
+func (b *BLangTernaryExpr) GetCondition() BLangExpression {
+	return b.Expr
+}
+
+func (b *BLangTernaryExpr) GetThenExpression() BLangExpression {
+	return b.ThenExpr
+}
+
+func (b *BLangTernaryExpr) GetElseExpression() BLangExpression {
+	return b.ElseExpr
+}
+
 func (b *BLangMarkdownDocumentationLine) GetText() string {
 	return b.Text
 }
@@ -833,7 +1082,7 @@ func (b *BLangMarkDownDeprecatedParametersDocumentation) GetParameters() []Markd
 	return result
 }
 
-func (b *BLangWorkerSendExprBase) GetExpr() BLangExpression {
+func (b *BLangWorkerSendExprBase) GetExpression() BLangExpression {
 	return b.Expr
 }
 
@@ -877,6 +1126,9 @@ func (n *bLangInvocationBase) Receiver() BLangExpression             { return n.
 func (n *bLangInvocationBase) SetReceiver(expr BLangExpression)      { n.Expr = expr }
 func (n *bLangInvocationBase) CallArgs() []BLangExpression           { return n.ArgExprs }
 func (n *bLangInvocationBase) SetCallArgs(args []BLangExpression)    { n.ArgExprs = args }
+func (n *bLangInvocationBase) IsAsync() bool                         { return n.Async }
+func (n *bLangInvocationBase) ParamsSymbol() model.SymbolRef         { return n.paramsSymbol }
+func (n *bLangInvocationBase) SetParamsSymbol(ref model.SymbolRef)   { n.paramsSymbol = ref }
 
 func (b *BLangInvocation) GetPackageAlias() IdentifierNode {
 	return b.PkgAlias
@@ -941,6 +1193,10 @@ func (b *BLangFieldBaseAccess) GetFieldName() *BLangIdentifier {
 	return &b.Field
 }
 
+func (b *BLangFieldBaseAccess) IsOptionalFieldAccess() bool {
+	return b.OptionalFieldAccess
+}
+
 func (b *BLangListConstructorExpr) GetExpressions() []BLangExpression {
 	result := make([]BLangExpression, len(b.Exprs))
 	copy(result, b.Exprs)
@@ -1008,6 +1264,10 @@ func (b *BLangMappingKeyValueField) IsKeyValueField() bool {
 	return true
 }
 
+func (b *BLangMappingSpreadField) IsKeyValueField() bool {
+	return false
+}
+
 func (b *BLangMappingConstructorExpr) GetFields() []MappingField {
 	return b.Fields
 }
@@ -1028,6 +1288,10 @@ func (b *BLangNamedArgsExpression) SetExpression(expr BLangExpression) {
 	b.Expr = expr
 }
 
+func (b *BLangRestArgsExpression) GetExpression() BLangExpression {
+	return b.Expr
+}
+
 func (b *BLangTrapExpr) GetExpression() BLangExpression {
 	return b.Expr
 }
@@ -1060,6 +1324,9 @@ var (
 	_ BLangExpression = &BLangXMLPILiteral{}
 	_ BLangExpression = &BLangXMLCommentLiteral{}
 	_ BLangExpression = &BLangXMLTextLiteral{}
+	_ BLangExpression = &BLangXMLFilterExpr{}
+	_ BLangExpression = &BLangXMLStepExpr{}
+	_ BLangExpression = &BLangXMLStepItem{}
 	_ BLangNode       = &BLangXMLSequenceLiteral{}
 	_ BLangNode       = &BLangTemplateExpr{}
 	_ BLangNode       = &BLangXMLTemplateExpr{}
@@ -1068,4 +1335,8 @@ var (
 	_ BLangNode       = &BLangXMLPILiteral{}
 	_ BLangNode       = &BLangXMLCommentLiteral{}
 	_ BLangNode       = &BLangXMLTextLiteral{}
+	_ BLangNode       = &BLangXMLElementFilter{}
+	_ BLangNode       = &BLangXMLFilterExpr{}
+	_ BLangNode       = &BLangXMLStepExpr{}
+	_ BLangNode       = &BLangXMLStepItem{}
 )
@@ -167,6 +167,8 @@ type InvokableNode interface {
 	AddParameter(param SimpleVariableNode)
 	GetReturnTypeDescriptor() TypeDescriptor
 	SetReturnTypeDescriptor(typeDescriptor TypeDescriptor)
+	GetReturnTypeAnnotationAttachments() []AnnotationAttachmentNode
+	AddReturnTypeAnnotationAttachment(annAttachment AnnotationAttachmentNode)
 	GetBody() FunctionBodyNode
 	SetBody(body FunctionBodyNode)
 	HasBody() bool
@@ -327,6 +329,7 @@ type FieldBasedAccessNode interface {
 	BLangExpression
 	GetExpression() BLangExpression
 	GetFieldName() *BLangIdentifier
+	IsOptionalFieldAccess() bool
 }
 
 type ListConstructorExprNode interface {
@@ -371,6 +374,12 @@ type ElvisExpressionNode interface {
 	GetRightExpression() BLangExpression
 }
 
+type TernaryExpressionNode interface {
+	GetCondition() BLangExpression
+	GetThenExpression() BLangExpression
+	GetElseExpression() BLangExpression
+}
+
 type MappingField interface {
 	Node
 	IsKeyValueField() bool
@@ -436,9 +445,6 @@ type WorkerReceiveNode interface {
 	SetWorkerName(identifierNode *BLangIdentifier)
 }
 
-// WorkerSendExpressionNode currently has no concrete implementations; it
-// exists as a placeholder for BLangWorkerReceive.Send until worker-send
-// expressions are modeled.
 type WorkerSendExpressionNode interface {
 	BLangExpression
 	ActionNode
@@ -17,6 +17,7 @@
 package ast
 
 import (
+	"ballerina-lang-go/model"
 	"ballerina-lang-go/semtypes"
 )
 
@@ -28,6 +29,7 @@ type BLangMatchPattern interface {
 type (
 	BLangMatchClause struct {
 		bLangNodeBase
+		scope        model.Scope
 		Guard        BLangExpression
 		Body         BLangBlockStmt
 		Patterns     []BLangMatchPattern
@@ -46,6 +48,58 @@ type (
 	BLangWildCardMatchPattern struct {
 		bLangMatchPatternBase
 	}
+
+	// BLangCaptureMatchPattern is a `var x` match pattern. A `var` match
+	// pattern with a list, mapping or error binding pattern is built as the
+	// equivalent list, mapping or error match pattern of capture patterns.
+	BLangCaptureMatchPattern struct {
+		bLangMatchPatternBase
+		Variable *BLangSimpleVariable
+	}
+
+	BLangListMatchPattern struct {
+		bLangMatchPatternBase
+		MatchPatterns    []BLangMatchPattern
+		RestMatchPattern *BLangRestMatchPattern
+	}
+
+	// BLangRestMatchPattern is a `...var x` match pattern, which binds the
+	// members of a list, or the fields of a mapping or error detail, that are
+	// not matched by the other match patterns.
+	BLangRestMatchPattern struct {
+		bLangNodeBase
+		Variable *BLangSimpleVariable
+	}
+
+	BLangMappingMatchPattern struct {
+		bLangMatchPatternBase
+		FieldMatchPatterns []BLangFieldMatchPattern
+		RestMatchPattern   *BLangRestMatchPattern
+	}
+
+	BLangFieldMatchPattern struct {
+		bLangNodeBase
+		FieldName    *BLangIdentifier
+		MatchPattern BLangMatchPattern
+	}
+
+	// BLangErrorMatchPattern is an error match pattern. ErrorTypeReference is
+	// set when the match pattern names an error type, and MessageMatchPattern
+	// and CauseMatchPattern when it has positional arguments.
+	BLangErrorMatchPattern struct {
+		bLangMatchPatternBase
+		ErrorTypeReference  *BLangUserDefinedType
+		MessageMatchPattern BLangMatchPattern
+		CauseMatchPattern   BLangMatchPattern
+		FieldMatchPatterns  []BLangNamedArgMatchPattern
+		RestMatchPattern    *BLangRestMatchPattern
+	}
+
+	BLangNamedArgMatchPattern struct {
+		bLangNodeBase
+		ArgName      *BLangIdentifier
+		MatchPattern BLangMatchPattern
+	}
 )
 
 var (
@@ -53,12 +107,23 @@ var (
 	_ MatchClause       = &BLangMatchClause{}
 	_ BLangMatchPattern = &BLangConstPattern{}
 	_ BLangMatchPattern = &BLangWildCardMatchPattern{}
+	_ BLangMatchPattern = &BLangCaptureMatchPattern{}
+	_ BLangMatchPattern = &BLangListMatchPattern{}
+	_ BLangMatchPattern = &BLangMappingMatchPattern{}
+	_ BLangMatchPattern = &BLangErrorMatchPattern{}
 )
 
 var (
 	_ BLangNode = &BLangConstPattern{}
 	_ BLangNode = &BLangMatchClause{}
 	_ BLangNode = &BLangWildCardMatchPattern{}
+	_ BLangNode = &BLangCaptureMatchPattern{}
+	_ BLangNode = &BLangListMatchPattern{}
+	_ BLangNode = &BLangRestMatchPattern{}
+	_ BLangNode = &BLangMappingMatchPattern{}
+	_ BLangNode = &BLangFieldMatchPattern{}
+	_ BLangNode = &BLangErrorMatchPattern{}
+	_ BLangNode = &BLangNamedArgMatchPattern{}
 )
 
 func (b *BLangConstPattern) GetExpression() BLangExpression {
@@ -69,6 +134,14 @@ func (b *BLangConstPattern) SetExpression(expression BLangExpression) {
 	b.Expr = expression
 }
 
+func (b *BLangMatchClause) Scope() model.Scope {
+	return b.scope
+}
+
+func (b *BLangMatchClause) SetScope(scope model.Scope) {
+	b.scope = scope
+}
+
 func (b *BLangMatchClause) GetMatchGuard() BLangExpression {
 	return b.Guard
 }
@@ -108,3 +181,55 @@ func (b *bLangMatchPatternBase) GetAcceptedType() semtypes.SemType {
 func (b *bLangMatchPatternBase) SetAcceptedType(t semtypes.SemType) {
 	b.AcceptedType = t
 }
+
+// MatchPatternVariables returns the variables bound by the capture and rest
+// match patterns of pattern, in match pattern order.
+func MatchPatternVariables(pattern BLangMatchPattern) []*BLangSimpleVariable {
+	var result []*BLangSimpleVariable
+	var collect func(BLangMatchPattern)
+	collectRest := func(rest *BLangRestMatchPattern) {
+		if rest != nil {
+			result = append(result, rest.Variable)
+		}
+	}
+	collect = func(p BLangMatchPattern) {
+		switch p := p.(type) {
+		case *BLangCaptureMatchPattern:
+			result = append(result, p.Variable)
+		case *BLangListMatchPattern:
+			for _, member := range p.MatchPatterns {
+				collect(member)
+			}
+			collectRest(p.RestMatchPattern)
+		case *BLangMappingMatchPattern:
+			for _, field := range p.FieldMatchPatterns {
+				collect(field.MatchPattern)
+			}
+			collectRest(p.RestMatchPattern)
+		case *BLangErrorMatchPattern:
+			if p.MessageMatchPattern != nil {
+				collect(p.MessageMatchPattern)
+			}
+			if p.CauseMatchPattern != nil {
+				collect(p.CauseMatchPattern)
+			}
+			for _, field := range p.FieldMatchPatterns {
+				collect(field.MatchPattern)
+			}
+			collectRest(p.RestMatchPattern)
+		}
+	}
+	collect(pattern)
+	return result
+}
+
+// IsStructuralMatchPattern reports whether pattern is a match pattern other
+// than a const or wildcard match pattern.
+func IsStructuralMatchPattern(pattern BLangMatchPattern) bool {
+	switch pattern.(type) {
+	case *BLangConstPattern, *BLangWildCardMatchPattern:
+		return false
+	default:
+		return true
+	}
+}
@@ -17,6 +17,8 @@
 package ast
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"iter"
 	"math"
@@ -24,6 +26,7 @@ import (
 	"strconv"
 	"strings"
 
+	"ballerina-lang-go/common/bregexp"
 	"ballerina-lang-go/context"
 	"ballerina-lang-go/model"
 	"ballerina-lang-go/parser/common"
@@ -34,24 +37,26 @@ import (
 )
 
 type typeTable struct {
-	booleanType *BTypeBasic
-	intType     *BTypeBasic
-	nilType     *BTypeBasic
-	stringType  *BTypeBasic
-	floatType   *BTypeBasic
-	decimalType *BTypeBasic
-	byteType    *BTypeBasic
+	booleanType   *BTypeBasic
+	intType       *BTypeBasic
+	nilType       *BTypeBasic
+	stringType    *BTypeBasic
+	floatType     *BTypeBasic
+	decimalType   *BTypeBasic
+	byteType      *BTypeBasic
+	byteArrayType *BTypeBasic
 }
 
 func newTypeTable() typeTable {
 	return typeTable{
-		booleanType: &BTypeBasic{tag: TypeTags_BOOLEAN, flags: model.FlagReadonly},
-		intType:     &BTypeBasic{tag: TypeTags_INT, flags: model.FlagReadonly},
-		nilType:     &BTypeBasic{tag: TypeTags_NIL, flags: model.FlagReadonly},
-		stringType:  &BTypeBasic{tag: TypeTags_STRING, flags: model.FlagReadonly},
-		floatType:   &BTypeBasic{tag: TypeTags_FLOAT, flags: model.FlagReadonly},
-		decimalType: &BTypeBasic{tag: TypeTags_DECIMAL, flags: model.FlagReadonly},
-		byteType:    &BTypeBasic{tag: TypeTags_BYTE, flags: model.FlagReadonly},
+		booleanType:   &BTypeBasic{tag: TypeTags_BOOLEAN, flags: model.FlagReadonly},
+		intType:       &BTypeBasic{tag: TypeTags_INT, flags: model.FlagReadonly},
+		nilType:       &BTypeBasic{tag: TypeTags_NIL, flags: model.FlagReadonly},
+		stringType:    &BTypeBasic{tag: TypeTags_STRING, flags: model.FlagReadonly},
+		floatType:     &BTypeBasic{tag: TypeTags_FLOAT, flags: model.FlagReadonly},
+		decimalType:   &BTypeBasic{tag: TypeTags_DECIMAL, flags: model.FlagReadonly},
+		byteType:      &BTypeBasic{tag: TypeTags_BYTE, flags: model.FlagReadonly},
+		byteArrayType: &BTypeBasic{tag: TypeTags_BYTE_ARRAY, flags: model.FlagReadonly},
 	}
 }
 
@@ -71,6 +76,8 @@ func (t *typeTable) getTypeFromTag(tag TypeTags) TypeDescriptor {
 		return t.decimalType
 	case TypeTags_BYTE:
 		return t.byteType
+	case TypeTags_BYTE_ARRAY:
+		return t.byteArrayType
 	default:
 		panic("not implemented")
 	}
@@ -84,6 +91,7 @@ type NodeBuilder struct {
 	CurrentCompUnitName  string
 	isInLocalContext     bool
 	isInFiniteContext    bool
+	bindingVarCounter    int               // Counter for hidden variables bound in place of binding patterns
 	constantSet          map[string]string // Track declared constants to detect redeclarations
 	cx                   *context.CompilerContext
 	types                typeTable
@@ -900,10 +908,7 @@ func (n *NodeBuilder) createSimpleVarInner(name tree.Token, typeName tree.Node,
 		bLSimpleVar.SetInitialExpression(n.createExpression(initializer))
 	}
 
-	if annotations.Size() > 0 {
-		// Panic instead of processing annotations (not yet implemented)
-		panic("annotations not yet supported")
-	}
+	n.addAnnotationList(annotations, bLSimpleVar)
 
 	return bLSimpleVar
 }
@@ -1002,12 +1007,8 @@ func (n *NodeBuilder) isFunctionCallAsync(functionCallBLangExpression *tree.Func
 
 // createBLangInvocation creates a BLangInvocation from a name node and arguments
 func (n *NodeBuilder) createBLangInvocation(nameNode tree.Node, arguments tree.NodeList[tree.FunctionArgumentNode], position diagnostics.Location, isAsync bool) *BLangInvocation {
-	var bLInvocation BLangInvocation
-	if isAsync {
-		panic("unimplemented")
-	} else {
-		bLInvocation = BLangInvocation{}
-	}
+	bLInvocation := BLangInvocation{}
+	bLInvocation.Async = isAsync
 
 	nameReference := n.createBLangNameReference(nameNode)
 	bLInvocation.PkgAlias = &nameReference[0]
@@ -1426,8 +1427,8 @@ func (n *NodeBuilder) populateFuncSignatureOnBase(bLFunction *bLangInvokableNode
 		// Pop "return" from the stack
 		n.anonTypeNameSuffixes = n.anonTypeNameSuffixes[:len(n.anonTypeNameSuffixes)-1]
 		annots := retTypeDescNode.Annotations()
-		if annots.Size() > 0 {
-			panic("unimplemented")
+		for i := 0; i < annots.Size(); i++ {
+			bLFunction.AddReturnTypeAnnotationAttachment(n.TransformAnnotation(annots.Get(i)).(*BLangAnnotationAttachment))
 		}
 	} else {
 		// Default return type is nil when not specified
@@ -1451,7 +1452,7 @@ func (n *NodeBuilder) TransformFunctionDefinition(funcDefNode *tree.FunctionDefi
 
 	metadata := funcDefNode.Metadata()
 	if metadata != nil && !metadata.IsMissing() {
-		// TODO: Handle annotations
+		n.addAnnotationAttachments(metadata, bLFunction)
 		docString := getDocumentationString(metadata)
 		bLFunction.MarkdownDocumentationAttachment = n.createMarkdownDocumentationAttachment(docString)
 	}
@@ -1602,30 +1603,23 @@ func (n *NodeBuilder) TransformListenerDeclaration(listenerDeclarationNode *tree
 }
 
 func (n *NodeBuilder) TransformTypeDefinition(typeDefinitionNode *tree.TypeDefinitionNode) BLangNode {
+	typeDef := NewBLangTypeDefinition()
 	metadata := typeDefinitionNode.Metadata()
 	if metadata != nil && !metadata.IsMissing() {
-		panic("TransformTypeDefinition: metadata not yet supported")
+		n.addAnnotationAttachments(metadata, typeDef)
+		typeDef.SetMarkdownDocumentationAttachment(n.createMarkdownDocumentationAttachment(getDocumentationString(metadata)))
 	}
 
-	typeDef := NewBLangTypeDefinition()
-
 	identifierNode := createIdentifierFromToken(getPosition(n.de(), typeDefinitionNode.TypeName()), typeDefinitionNode.TypeName())
 	typeDef.Name = &identifierNode
 
 	n.anonTypeNameSuffixes = append(n.anonTypeNameSuffixes, typeDef.Name.GetValue())
 
 	typeDescriptorNode := typeDefinitionNode.TypeDescriptor()
+	// A top level `distinct` belongs to the type definition itself, so its
+	// type-id is tied to the definition's symbol rather than to the descriptor.
 	if distinctTypeDescriptorNode, ok := typeDescriptorNode.(*tree.DistinctTypeDescriptorNode); ok {
-		innerTypeDescriptorNode := distinctTypeDescriptorNode.TypeDescriptor()
-		if innerTypeDescriptorNode == nil || innerTypeDescriptorNode.Kind() != common.OBJECT_TYPE_DESC {
-			n.cx.Unimplemented("distinct types are only supported for object types", getPosition(n.de(), distinctTypeDescriptorNode))
-			neverType := &BLangValueType{TypeKind: TypeKind_NEVER}
-			neverType.pos = getPosition(n.de(), distinctTypeDescriptorNode)
-			typeDef.SetTypeData(TypeData{TypeDescriptor: neverType})
-			n.anonTypeNameSuffixes = n.anonTypeNameSuffixes[:len(n.anonTypeNameSuffixes)-1]
-			return typeDef
-		}
-		typeDescriptorNode = innerTypeDescriptorNode
+		typeDescriptorNode = distinctTypeDescriptorNode.TypeDescriptor()
 		typeDef.SetDistinct()
 	}
 	typeData := TypeData{
@@ -1774,6 +1768,7 @@ func (n *NodeBuilder) addCollectedMethod(members *classDefnMembers, funcDef *tre
 	bLFunction := n.createFunctionNode(funcDef.FunctionName(), funcDef.QualifierList(), funcDef.FunctionSignature(), funcDef.FunctionBody())
 	bLFunction.pos = getPositionWithoutMetadata(n.de(), funcDef)
 	bLFunction.SetAttached()
+	n.addAnnotationAttachments(funcDef.Metadata(), bLFunction)
 
 	funcName := bLFunction.Name.Value
 	if model.Name(funcName) == model.USER_DEFINED_INIT_SUFFIX {
@@ -1799,7 +1794,12 @@ func (n *NodeBuilder) TransformAssignmentStatement(assignmentStatementNode *tree
 	lhsKind := assignmentStatementNode.VarRef().Kind()
 	switch lhsKind {
 	case common.LIST_BINDING_PATTERN, common.MAPPING_BINDING_PATTERN, common.ERROR_BINDING_PATTERN:
-		panic("unimplemented")
+		bLAssignment := &BLangAssignment{}
+		bLAssignment.pos = getPosition(n.de(), assignmentStatementNode)
+		bindingPattern := n.TransformSyntaxNode(assignmentStatementNode.VarRef()).(BindingPatternNode)
+		bLAssignment.VarRef = n.createBindingPatternVarRef(bindingPattern)
+		bLAssignment.SetActionOrExpression(n.createActionOrExpression(assignmentStatementNode.Expression()))
+		return bLAssignment
 	default:
 		break
 	}
@@ -1843,9 +1843,13 @@ func (n *NodeBuilder) TransformVariableDeclaration(variableDeclarationNode *tree
 		variableDeclarationNode.Initializer(),
 		variableDeclarationNode.FinalKeyword(),
 	)
-	annotations := variableDeclarationNode.Annotations()
-	if annotations.Size() > 0 {
-		panic("annotations not yet supported")
+	if annotations := variableDeclarationNode.Annotations(); annotations.Size() > 0 {
+		if varDef, ok := varNode.(*BLangSimpleVariableDef); ok {
+			n.addAnnotationList(annotations, varDef.Var)
+		} else {
+			n.cx.Unimplemented("annotations on a variable with a list, mapping or error binding pattern are not yet supported",
+				getPosition(n.de(), annotations.Get(0)))
+		}
 	}
 
 	return varNode.(BLangNode)
@@ -1891,18 +1895,72 @@ func (n *NodeBuilder) createBLangVarDef(location diagnostics.Location, typedBind
 
 		return bLVarDef
 
-	case common.MAPPING_BINDING_PATTERN:
-		panic("MAPPING_BINDING_PATTERN unimplemented")
+	case common.MAPPING_BINDING_PATTERN, common.LIST_BINDING_PATTERN, common.ERROR_BINDING_PATTERN:
+		var expr BLangActionOrExpression
+		if initializer != nil {
+			expr = n.createActionOrExpression(initializer)
+		}
+		return n.createBindingPatternVarDefWithType(location, variable, typedBindingPattern.TypeDescriptor(), expr,
+			finalKeyword != nil)
 
-	case common.LIST_BINDING_PATTERN:
-		panic("LIST_BINDING_PATTERN unimplemented")
+	default:
+		panic("Syntax kind is not a valid binding pattern")
+	}
+}
 
-	case common.ERROR_BINDING_PATTERN:
-		panic("ERROR_BINDING_PATTERN unimplemented")
+// createBindingPatternVarDefWithType creates the definition of a variable
+// declared by a list, mapping or error binding pattern. The type descriptor
+// applies to the binding pattern as a whole, while finality applies to each
+// variable it binds.
+func (n *NodeBuilder) createBindingPatternVarDefWithType(location diagnostics.Location, variable VariableNode, typeDesc tree.Node, expr BLangActionOrExpression, isFinal bool) VariableDefinitionNode {
+	varDef := createBindingPatternVarDef(variable, location)
+	base := bindingPatternVariableBase(variable)
+	base.pos = location
+	base.SetInitialExpression(expr)
+	if isFinal {
+		for _, each := range BindingVariables(variable) {
+			each.SetFinal()
+		}
+	}
+	isDeclaredWithVar := isDeclaredWithVar(typeDesc)
+	base.SetIsDeclaredWithVar(isDeclaredWithVar)
+	if !isDeclaredWithVar {
+		base.SetTypeNode(n.createTypeNode(typeDesc).(BType))
+	}
+	return varDef
+}
 
+// createIterationVarDef creates the definition of the variable bound by the
+// typed binding pattern of a foreach statement or a query input clause. For a
+// list, mapping or error binding pattern each member is bound to a hidden
+// variable instead, and patternDef destructures that variable with the
+// binding pattern.
+func (n *NodeBuilder) createIterationVarDef(typedBindingPattern *tree.TypedBindingPatternNode) (varDef *BLangSimpleVariableDef, patternDef VariableDefinitionNode) {
+	pos := getPosition(n.de(), typedBindingPattern)
+	bindingPattern := typedBindingPattern.BindingPattern()
+	switch bindingPattern.Kind() {
+	case common.MAPPING_BINDING_PATTERN, common.LIST_BINDING_PATTERN, common.ERROR_BINDING_PATTERN:
 	default:
-		panic("Syntax kind is not a valid binding pattern")
+		return n.createBLangVarDef(pos, typedBindingPattern, nil, nil).(*BLangSimpleVariableDef), nil
 	}
+	name := fmt.Sprintf("$binding$%d", n.bindingVarCounter)
+	n.bindingVarCounter++
+	hiddenVar := createBindingSimpleVariable(createIdentifier(pos, &name, &name), pos)
+	typeDesc := typedBindingPattern.TypeDescriptor()
+	if isDeclaredWithVar(typeDesc) {
+		hiddenVar.SetIsDeclaredWithVar(true)
+	} else {
+		hiddenVar.SetTypeNode(n.createTypeNode(typeDesc).(BType))
+	}
+	varDef = &BLangSimpleVariableDef{}
+	varDef.pos = pos
+	varDef.SetVariable(hiddenVar)
+
+	variable := n.getBLangVariableNode(bindingPattern, pos)
+	hiddenVarRef := createBindingVarRef(createIdentifier(pos, &name, &name), pos)
+	// Like the variable of a simple binding pattern, the variables of the pattern can't be assigned to.
+	patternDef = n.createBindingPatternVarDefWithType(pos, variable, nil, hiddenVarRef, true)
+	return varDef, patternDef
 }
 
 func (n *NodeBuilder) TransformBlockStatement(blockStatementNode *tree.BlockStatementNode) BLangNode {
@@ -1970,7 +2028,10 @@ func (n *NodeBuilder) TransformBreakStatement(breakStatementNode *tree.BreakStat
 }
 
 func (n *NodeBuilder) TransformFailStatement(failStatementNode *tree.FailStatementNode) BLangNode {
-	panic("TransformFailStatement unimplemented")
+	bLFail := &BLangFail{}
+	bLFail.pos = getPosition(n.de(), failStatementNode)
+	bLFail.Expr = n.createExpression(failStatementNode.Expression())
+	return bLFail
 }
 
 func (n *NodeBuilder) TransformExpressionStatement(expressionStatement *tree.ExpressionStatementNode) BLangNode {
@@ -2107,31 +2168,32 @@ func (n *NodeBuilder) TransformLocalTypeDefinitionStatement(localTypeDefinitionS
 }
 
 func (n *NodeBuilder) TransformLockStatement(lockStatementNode *tree.LockStatementNode) BLangNode {
-	if lockStatementNode.OnFailClause() != nil {
-		n.cx.Unimplemented("on-fail clause on lock is not yet supported", getPosition(n.de(), lockStatementNode.OnFailClause()))
-	}
 	bLLock := &BLangLock{}
 	bLLock.pos = getPosition(n.de(), lockStatementNode)
 	bLBlockStmt := n.TransformBlockStatement(lockStatementNode.BlockStatement()).(*BLangBlockStmt)
 	bLBlockStmt.pos = getPosition(n.de(), lockStatementNode.BlockStatement())
 	bLLock.Body = *bLBlockStmt
+	if lockStatementNode.OnFailClause() != nil {
+		bLLock.OnFailClause = n.TransformOnFailClause(lockStatementNode.OnFailClause()).(*BLangOnFailClause)
+	}
 	return bLLock
 }
 
 func (n *NodeBuilder) TransformForkStatement(forkStatementNode *tree.ForkStatementNode) BLangNode {
-	panic("TransformForkStatement unimplemented")
+	bLFork := &BLangFork{}
+	bLFork.pos = getPosition(n.de(), forkStatementNode)
+	workers := forkStatementNode.NamedWorkerDeclarations()
+	for worker := range workers.Iterator() {
+		bLFork.Workers = append(bLFork.Workers, *n.TransformNamedWorkerDeclaration(worker).(*BLangWorker))
+	}
+	return bLFork
 }
 
 func (n *NodeBuilder) TransformForEachStatement(forEachStatementNode *tree.ForEachStatementNode) BLangNode {
 	bLForeach := &BLangForeach{}
 	bLForeach.pos = getPosition(n.de(), forEachStatementNode)
 
-	varDef := n.createBLangVarDef(
-		getPosition(n.de(), forEachStatementNode.TypedBindingPattern()),
-		forEachStatementNode.TypedBindingPattern(),
-		nil,
-		nil,
-	).(*BLangSimpleVariableDef)
+	varDef, patternDef := n.createIterationVarDef(forEachStatementNode.TypedBindingPattern())
 	bLForeach.VariableDef = varDef
 	bLForeach.IsDeclaredWithVar = varDef.Var.IsDeclaredWithVar
 
@@ -2139,6 +2201,9 @@ func (n *NodeBuilder) TransformForEachStatement(forEachStatementNode *tree.ForEa
 
 	body := n.TransformBlockStatement(forEachStatementNode.BlockStatement()).(*BLangBlockStmt)
 	body.pos = getPosition(n.de(), forEachStatementNode.BlockStatement())
+	if patternDef != nil {
+		body.Stmts = append([]StatementNode{patternDef}, body.Stmts...)
+	}
 	bLForeach.Body = *body
 
 	if forEachStatementNode.OnFailClause() != nil {
@@ -2151,7 +2216,11 @@ func (n *NodeBuilder) TransformForEachStatement(forEachStatementNode *tree.ForEa
 
 func (n *NodeBuilder) TransformBinaryExpression(binaryBLangExpression *tree.BinaryExpressionNode) BLangNode {
 	if binaryBLangExpression.Operator().Kind() == common.ELVIS_TOKEN {
-		panic("TransformBinaryExpression: elvis operator not supported")
+		elvisExpr := &BLangElvisExpr{}
+		elvisExpr.pos = getPosition(n.de(), binaryBLangExpression)
+		elvisExpr.LhsExpr = n.createExpression(binaryBLangExpression.LhsExpr())
+		elvisExpr.RhsExpr = n.createExpression(binaryBLangExpression.RhsExpr())
+		return elvisExpr
 	}
 
 	bLBinaryExpr := BLangBinaryExpr{}
@@ -2234,7 +2303,7 @@ func (n *NodeBuilder) TransformMappingConstructorExpression(mappingConstructorBL
 		field := fields.Get(i)
 		switch field.Kind() {
 		case common.SPREAD_FIELD:
-			panic("mapping constructor spread field not implemented")
+			mappingConstructor.Fields = append(mappingConstructor.Fields, n.TransformSpreadField(field.(*tree.SpreadFieldNode)).(*BLangMappingSpreadField))
 		case common.COMPUTED_NAME_FIELD:
 			computedNameField := field.(*tree.ComputedNameFieldNode)
 			keyExpr := n.createExpression(computedNameField.FieldNameExpr())
@@ -2290,8 +2359,9 @@ func (n *NodeBuilder) TransformIndexedExpression(indexedBLangExpression *tree.In
 	} else {
 		listConstructorExpr := &BLangListConstructorExpr{}
 		listConstructorExpr.pos = getPositionRange(n.de(), keys.Get(0), keys.Get(keys.Size()-1))
-		exprs := make([]BLangExpression, 0, keys.Size())
-		for i := 0; i < keys.Size(); i++ {
+		// keys is a separated list: key expressions at even indices, commas in between
+		exprs := make([]BLangExpression, 0, (keys.Size()+1)/2)
+		for i := 0; i < keys.Size(); i += 2 {
 			exprs = append(exprs, n.createExpression(keys.Get(i)))
 		}
 		listConstructorExpr.Exprs = exprs
@@ -2370,11 +2440,9 @@ func (n *NodeBuilder) TransformConstantDeclaration(constantDeclarationNode *tree
 		constantNode.SetTypeNode(n.createTypeNode(typeDescriptor).(BType))
 	}
 
-	// Lines 950-952: Skip annotations
-
 	metadata := constantDeclarationNode.Metadata()
 	if metadata != nil && !metadata.IsMissing() {
-		// TODO: Handle annotations
+		n.addAnnotationAttachments(metadata, constantNode)
 		docString := getDocumentationString(metadata)
 		constantNode.MarkdownDocumentationAttachment = n.createMarkdownDocumentationAttachment(docString)
 	}
@@ -2520,7 +2588,10 @@ func (n *NodeBuilder) TransformSpecificField(specificFieldNode *tree.SpecificFie
 }
 
 func (n *NodeBuilder) TransformSpreadField(spreadFieldNode *tree.SpreadFieldNode) BLangNode {
-	panic("TransformSpreadField unimplemented")
+	spreadField := &BLangMappingSpreadField{}
+	spreadField.pos = getPosition(n.de(), spreadFieldNode)
+	spreadField.Expr = n.createExpression(spreadFieldNode.ValueExpr())
+	return spreadField
 }
 
 func (n *NodeBuilder) TransformNamedArgument(namedArgumentNode *tree.NamedArgumentNode) BLangNode {
@@ -2536,7 +2607,10 @@ func (n *NodeBuilder) TransformPositionalArgument(positionalArgumentNode *tree.P
 }
 
 func (n *NodeBuilder) TransformRestArgument(restArgumentNode *tree.RestArgumentNode) BLangNode {
-	panic("TransformRestArgument unimplemented")
+	restArgs := &BLangRestArgsExpression{}
+	restArgs.pos = getPosition(n.de(), restArgumentNode)
+	restArgs.Expr = n.createExpression(restArgumentNode.Expression())
+	return restArgs
 }
 
 func (n *NodeBuilder) TransformInferredTypedescDefault(inferredTypedescDefaultNode *tree.InferredTypedescDefaultNode) BLangNode {
@@ -2559,8 +2633,7 @@ func (n *NodeBuilder) TransformObjectTypeDescriptor(objectTypeDescriptorNode *tr
 		case common.ISOLATED_KEYWORD:
 			objectType.Isolated = true
 		case common.READONLY_KEYWORD:
-			// https://github.com/ballerina-platform/ballerina-lang-go/issues/537",
-			n.cx.Unimplemented("readonly object type descriptors are not implemented", getPosition(n.de(), q))
+			objectType.Readonly = true
 		}
 	}
 
@@ -2749,7 +2822,30 @@ func (n *NodeBuilder) TransformTypeReference(typeReferenceNode *tree.TypeReferen
 }
 
 func (n *NodeBuilder) TransformAnnotation(annotationNode *tree.AnnotationNode) BLangNode {
-	panic("TransformAnnotation unimplemented")
+	bLAnnotAttachment := &BLangAnnotationAttachment{}
+	if annotValue := annotationNode.AnnotValue(); annotValue != nil {
+		bLAnnotAttachment.Expr = n.createExpression(annotValue)
+	}
+	nameReference := n.createBLangNameReference(annotationNode.AnnotReference())
+	bLAnnotAttachment.PkgAlias = &nameReference[0]
+	bLAnnotAttachment.AnnotationName = &nameReference[1]
+	bLAnnotAttachment.pos = getPosition(n.de(), annotationNode)
+	return bLAnnotAttachment
+}
+
+// addAnnotationAttachments adds the annotations of metadata, if any, to node.
+func (n *NodeBuilder) addAnnotationAttachments(metadata *tree.MetadataNode, node AnnotatableNode) {
+	if metadata == nil || metadata.IsMissing() {
+		return
+	}
+	n.addAnnotationList(metadata.Annotations(), node)
+}
+
+// addAnnotationList adds each of annotations to node.
+func (n *NodeBuilder) addAnnotationList(annotations tree.NodeList[*tree.AnnotationNode], node AnnotatableNode) {
+	for i := 0; i < annotations.Size(); i++ {
+		node.AddAnnotationAttachment(n.TransformAnnotation(annotations.Get(i)).(*BLangAnnotationAttachment))
+	}
 }
 
 func (n *NodeBuilder) TransformMetadata(metadataNode *tree.MetadataNode) BLangNode {
@@ -2762,7 +2858,12 @@ func (n *NodeBuilder) TransformModuleVariableDeclaration(moduleVariableDeclarati
 	pos := getPositionWithoutMetadata(n.de(), moduleVariableDeclarationNode)
 
 	variable := n.getBLangVariableNode(bindingPattern, pos)
-	simpleVar := variable.(*BLangSimpleVariable)
+	simpleVar, ok := variable.(*BLangSimpleVariable)
+	if !ok {
+		n.cx.Unimplemented("module variable with a list, mapping or error binding pattern is not supported yet",
+			getPosition(n.de(), bindingPattern))
+		simpleVar = createBindingSimpleVariable(createIgnoreIdentifier(n.de(), bindingPattern), pos)
+	}
 
 	typeDesc := typedBindingPattern.TypeDescriptor()
 	if typeDesc != nil {
@@ -2773,18 +2874,27 @@ func (n *NodeBuilder) TransformModuleVariableDeclaration(moduleVariableDeclarati
 		}
 	}
 
+	n.populateModuleVariableVisibilityAndQualifiers(moduleVariableDeclarationNode, simpleVar)
+	n.addAnnotationAttachments(moduleVariableDeclarationNode.Metadata(), simpleVar)
+
 	initializer := moduleVariableDeclarationNode.Initializer()
-	if initializer != nil {
+	if initializer != nil && initializer.Kind() == common.REQUIRED_EXPRESSION {
+		// The parser only accepts `?` as the initializer of a configurable variable; its value must be configured.
+		simpleVar.SetRequired()
+	} else if initializer != nil {
 		simpleVar.SetInitialExpression(n.createExpression(initializer))
 	}
 
+	if simpleVar.IsConfigurable() && simpleVar.IsDeclaredWithVar {
+		n.cx.SyntaxError("configurable variable cannot be declared with 'var'", pos)
+		return simpleVar
+	}
+
 	if simpleVar.IsDeclaredWithVar && simpleVar.TypeNode() == nil && simpleVar.Expr == nil {
 		n.cx.SyntaxError("var-declared module variable must have an initializer expression for type inference", pos)
 		return simpleVar
 	}
 
-	n.populateModuleVariableVisibilityAndQualifiers(moduleVariableDeclarationNode, simpleVar)
-
 	simpleVar.pos = pos
 	return simpleVar
 }
@@ -2804,7 +2914,9 @@ func (n *NodeBuilder) populateModuleVariableVisibilityAndQualifiers(node *tree.M
 		case common.ISOLATED_KEYWORD:
 			simpleVar.SetIsolated()
 		case common.CONFIGURABLE_KEYWORD:
-			n.cx.Unimplemented("configurable module variables are not supported yet", simpleVar.pos)
+			// A configurable variable is implicitly final.
+			simpleVar.SetConfigurable()
+			simpleVar.SetFinal()
 		}
 	}
 }
@@ -2854,11 +2966,56 @@ func (n *NodeBuilder) TransformNilLiteral(nilLiteralNode *tree.NilLiteralNode) B
 }
 
 func (n *NodeBuilder) TransformAnnotationDeclaration(annotationDeclarationNode *tree.AnnotationDeclarationNode) BLangNode {
-	panic("TransformAnnotationDeclaration unimplemented")
+	annotationDecl := &BLangAnnotation{}
+	annotationDecl.pos = getPositionWithoutMetadata(n.de(), annotationDeclarationNode)
+
+	annotTag := annotationDeclarationNode.AnnotationTag()
+	name := createIdentifierFromToken(getPosition(n.de(), annotTag), annotTag)
+	annotationDecl.SetName(&name)
+
+	visibilityQualifier := annotationDeclarationNode.VisibilityQualifier()
+	if visibilityQualifier != nil && visibilityQualifier.Kind() == common.PUBLIC_KEYWORD {
+		annotationDecl.SetPublic()
+	}
+	if annotationDeclarationNode.ConstKeyword() != nil {
+		annotationDecl.SetConst()
+	}
+
+	if typeDesc := annotationDeclarationNode.TypeDescriptor(); typeDesc != nil {
+		n.anonTypeNameSuffixes = append(n.anonTypeNameSuffixes, name.Value)
+		annotationDecl.SetTypeDescriptor(n.createTypeNode(typeDesc))
+		n.anonTypeNameSuffixes = n.anonTypeNameSuffixes[:len(n.anonTypeNameSuffixes)-1]
+	}
+
+	attachPoints := annotationDeclarationNode.AttachPoints()
+	for i := 0; i < attachPoints.Size(); i++ {
+		attachPointNode, ok := attachPoints.Get(i).(*tree.AnnotationAttachPointNode)
+		if !ok {
+			continue
+		}
+		// A multi-word attach point such as `object function` is matched by the concatenation of its words.
+		var point strings.Builder
+		identifiers := attachPointNode.Identifiers()
+		for j := 0; j < identifiers.Size(); j++ {
+			point.WriteString(identifiers.Get(j).Text())
+		}
+		annotationDecl.AttachPoints = append(annotationDecl.AttachPoints, AttachPoint{
+			Point:  Point(point.String()),
+			Source: attachPointNode.SourceKeyword() != nil,
+		})
+	}
+
+	metadata := annotationDeclarationNode.Metadata()
+	if metadata != nil && !metadata.IsMissing() {
+		n.addAnnotationAttachments(metadata, annotationDecl)
+		docString := getDocumentationString(metadata)
+		annotationDecl.MarkdownDocumentationAttachment = n.createMarkdownDocumentationAttachment(docString)
+	}
+	return annotationDecl
 }
 
 func (n *NodeBuilder) TransformAnnotationAttachPoint(annotationAttachPointNode *tree.AnnotationAttachPointNode) BLangNode {
-	panic("TransformAnnotationAttachPoint unimplemented")
+	panic("TransformAnnotationAttachPoint: attach points are transformed as part of the annotation declaration")
 }
 
 type xmlNamespaceDeclarationNode interface {
@@ -2900,7 +3057,13 @@ func (n *NodeBuilder) TransformFunctionBodyBlock(functionBodyBlockNode *tree.Fun
 	stmtList := statements
 	namedWorkerDeclarator := functionBodyBlockNode.NamedWorkerDeclarator()
 	if namedWorkerDeclarator != nil {
-		panic("unimplemented")
+		// Worker init statements run before any of the named workers are started.
+		n.generateAndAddBLangStatements(namedWorkerDeclarator.WorkerInitStatements(), &stmtList, 0, namedWorkerDeclarator)
+		workers := namedWorkerDeclarator.NamedWorkerDeclarations()
+		for worker := range workers.Iterator() {
+			stmtList = append(stmtList, n.TransformNamedWorkerDeclaration(worker).(StatementNode))
+		}
+		n.isInLocalContext = true
 	}
 
 	n.generateAndAddBLangStatements(functionBodyBlockNode.Statements(), &stmtList, 0, functionBodyBlockNode)
@@ -2912,11 +3075,61 @@ func (n *NodeBuilder) TransformFunctionBodyBlock(functionBodyBlockNode *tree.Fun
 }
 
 func (n *NodeBuilder) generateForkStatements(statements *[]StatementNode, forkStatementNode *tree.ForkStatementNode) {
-	panic("generateForkStatements unimplemented")
+	*statements = append(*statements, n.TransformForkStatement(forkStatementNode).(StatementNode))
 }
 
+// TransformNamedWorkerDeclaration creates a worker whose body is an anonymous function flagged as a worker.
 func (n *NodeBuilder) TransformNamedWorkerDeclaration(namedWorkerDeclarationNode *tree.NamedWorkerDeclarationNode) BLangNode {
-	panic("TransformNamedWorkerDeclaration unimplemented")
+	pos := getPosition(n.de(), namedWorkerDeclarationNode)
+	annots := namedWorkerDeclarationNode.Annotations()
+	if annots.Size() > 0 {
+		n.cx.Unimplemented("annotations on workers are not yet supported", pos)
+	}
+	if namedWorkerDeclarationNode.TransactionalKeyword() != nil {
+		n.cx.Unimplemented("transactional workers are not yet supported", pos)
+	}
+	if namedWorkerDeclarationNode.OnFailClause() != nil {
+		n.cx.Unimplemented("on-fail clause on worker is not yet supported", getPosition(n.de(), namedWorkerDeclarationNode.OnFailClause()))
+	}
+
+	bLFunction := &BLangFunction{}
+	name := n.cx.GetNextAnonymousFunctionKey(n.PackageID)
+	bLFunction.Name = createIdentifier(diagnostics.NewBuiltinLocation(), &name, &name)
+	if retTypeDesc, ok := namedWorkerDeclarationNode.ReturnTypeDesc().(*tree.ReturnTypeDescriptorNode); ok {
+		n.anonTypeNameSuffixes = append(n.anonTypeNameSuffixes, "return")
+		bLFunction.SetReturnTypeDescriptor(n.createTypeNode(retTypeDesc.Type()))
+		n.anonTypeNameSuffixes = n.anonTypeNameSuffixes[:len(n.anonTypeNameSuffixes)-1]
+		retAnnots := retTypeDesc.Annotations()
+		if retAnnots.Size() > 0 {
+			n.cx.Unimplemented("annotations on worker return types are not yet supported", getPosition(n.de(), retTypeDesc))
+		}
+	} else {
+		nilReturnType := &BLangValueType{TypeKind: TypeKind_NIL}
+		nilReturnType.pos = diagnostics.NewBuiltinLocation()
+		bLFunction.SetReturnTypeDescriptor(nilReturnType)
+	}
+
+	workerBody := namedWorkerDeclarationNode.WorkerBody()
+	block := n.TransformBlockStatement(workerBody).(*BLangBlockStmt)
+	// Worker declarations only appear in local context; TransformBlockStatement resets it.
+	n.isInLocalContext = true
+	body := &BLangBlockFunctionBody{Stmts: block.Stmts}
+	body.pos = block.pos
+	bLFunction.Body = body
+	bLFunction.pos = pos
+	bLFunction.SetAnonymous()
+	bLFunction.SetWorker()
+
+	lambdaFunc := &BLangLambdaFunction{Function: bLFunction}
+	lambdaFunc.pos = pos
+
+	workerName := namedWorkerDeclarationNode.WorkerName()
+	bLWorker := &BLangWorker{
+		Name:   createIdentifierFromToken(getPosition(n.de(), workerName), workerName),
+		Lambda: lambdaFunc,
+	}
+	bLWorker.pos = pos
+	return bLWorker
 }
 
 func (n *NodeBuilder) TransformNamedWorkerDeclarator(namedWorkerDeclarator *tree.NamedWorkerDeclarator) BLangNode {
@@ -3012,11 +3225,34 @@ func (n *NodeBuilder) TransformUnionTypeDescriptor(unionTypeDescriptorNode *tree
 }
 
 func (n *NodeBuilder) TransformTableConstructorExpression(tableConstructorBLangExpression *tree.TableConstructorExpressionNode) BLangNode {
-	panic("TransformTableConstructorExpression unimplemented")
+	tableConstructor := &BLangTableConstructorExpr{}
+	tableConstructor.pos = getPosition(n.de(), tableConstructorBLangExpression)
+	if keySpecifier := tableConstructorBLangExpression.KeySpecifier(); keySpecifier != nil {
+		tableConstructor.TableKeySpecifier = n.TransformKeySpecifier(keySpecifier).(*BLangTableKeySpecifier)
+	}
+	rows := tableConstructorBLangExpression.Rows()
+	for i := 0; i < rows.Size(); i += 2 {
+		row := rows.Get(i)
+		mappingConstructor, ok := n.createExpression(row).(*BLangMappingConstructorExpr)
+		if !ok {
+			n.cx.InternalError("table constructor row must be a mapping constructor", getPosition(n.de(), row))
+			return nil
+		}
+		tableConstructor.RecordLiteralList = append(tableConstructor.RecordLiteralList, mappingConstructor)
+	}
+	return tableConstructor
 }
 
 func (n *NodeBuilder) TransformKeySpecifier(keySpecifierNode *tree.KeySpecifierNode) BLangNode {
-	panic("TransformKeySpecifier unimplemented")
+	keySpecifier := &BLangTableKeySpecifier{}
+	keySpecifier.pos = getPosition(n.de(), keySpecifierNode)
+	fieldNames := keySpecifierNode.FieldNames()
+	for i := 0; i < fieldNames.Size(); i += 2 {
+		fieldName := fieldNames.Get(i)
+		keySpecifier.FieldNameIdentifierList = append(keySpecifier.FieldNameIdentifierList,
+			createIdentifierFromToken(getPosition(n.de(), fieldName), fieldName))
+	}
+	return keySpecifier
 }
 
 func (n *NodeBuilder) TransformStreamTypeDescriptor(streamTypeDescriptorNode *tree.StreamTypeDescriptorNode) BLangNode {
@@ -3036,13 +3272,22 @@ func (n *NodeBuilder) TransformStreamTypeDescriptor(streamTypeDescriptorNode *tr
 	}
 	valueDesc := params.LeftTypeDescNode()
 	completionDesc := params.RightTypeDescNode()
-	if valueDesc == nil || completionDesc == nil {
-		n.cx.InternalError("stream<...> requires both value and completion type parameters", position)
+	if valueDesc == nil {
+		n.cx.InternalError("stream<...> requires a value type parameter", position)
 		return nil
 	}
+	// stream<T> is stream<T, ()>
+	var completionType TypeDescriptor
+	if completionDesc != nil {
+		completionType = n.createTypeNode(completionDesc)
+	} else {
+		nilType := &BLangValueType{TypeKind: TypeKind_NIL}
+		nilType.pos = position
+		completionType = nilType
+	}
 	streamType := NewBLangStreamType(
 		TypeData{TypeDescriptor: n.createTypeNode(valueDesc)},
-		TypeData{TypeDescriptor: n.createTypeNode(completionDesc)},
+		TypeData{TypeDescriptor: completionType},
 	)
 	streamType.SetPosition(position)
 	return streamType
@@ -3053,7 +3298,16 @@ func (n *NodeBuilder) TransformStreamTypeParams(streamTypeParamsNode *tree.Strea
 }
 
 func (n *NodeBuilder) TransformLetExpression(letBLangExpression *tree.LetExpressionNode) BLangNode {
-	panic("TransformLetExpression unimplemented")
+	letExpr := &BLangLetExpr{}
+	letExpr.pos = getPosition(n.de(), letBLangExpression)
+	letVarDeclarations := letBLangExpression.LetVarDeclarations()
+	letExpr.LetVarDeclarations = make([]VariableDefinitionNode, 0, letVarDeclarations.Size())
+	for letVar := range letVarDeclarations.Iterator() {
+		varDef := n.TransformLetVariableDeclaration(letVar).(VariableDefinitionNode)
+		letExpr.LetVarDeclarations = append(letExpr.LetVarDeclarations, varDef)
+	}
+	letExpr.Expr = n.createExpression(letBLangExpression.Expression())
+	return letExpr
 }
 
 func (n *NodeBuilder) TransformLetVariableDeclaration(letVariableDeclarationNode *tree.LetVariableDeclarationNode) BLangNode {
@@ -3067,6 +3321,10 @@ func (n *NodeBuilder) TransformLetVariableDeclaration(letVariableDeclarationNode
 	if annotations.Size() > 0 {
 		panic("annotations not yet supported")
 	}
+	// Variables declared by let are implicitly final.
+	for _, each := range BindingVariables(varDef.GetVariable()) {
+		each.SetFinal()
+	}
 	return varDef.(BLangNode)
 }
 
@@ -3074,14 +3332,15 @@ func (n *NodeBuilder) TransformTemplateExpression(templateBLangExpression *tree.
 	typeToken := templateBLangExpression.Type()
 	pos := getPosition(n.de(), templateBLangExpression)
 	if typeToken == nil {
-		n.cx.Unimplemented("raw templates not supported", pos)
-		return nil
+		return n.buildRawTemplateExpr(templateBLangExpression, pos)
 	}
 	switch typeToken.Text() {
 	case "string":
 		return n.buildStringTemplateExpr(templateBLangExpression, pos)
 	case "xml":
 		return n.buildXMLTemplateExpr(templateBLangExpression, pos)
+	case "re":
+		return n.buildRegExpTemplateExpr(templateBLangExpression, pos)
 	default:
 		n.cx.Unimplemented("unsupported template expression kind", pos)
 		return nil
@@ -3336,29 +3595,17 @@ func (n *NodeBuilder) flattenXMLTemplateNodeWithNamespace(
 	case *tree.XMLEmptyElementNode:
 		return n.flattenXMLTemplateEmptyElement(x, namespaceInsertion, yield)
 	case *tree.XMLComment:
-		if ins := firstXMLInterpolation(x); ins != nil {
-			return yield(xmlTemplateToken{}, &xmlTemplateDiagnostic{
-				Message:  "interpolation is not allowed in xml comment",
-				Position: getPosition(n.de(), ins),
-			})
+		if firstXMLInterpolation(x) == nil {
+			return yield(newXMLTemplateTextToken(tree.ToSourceCode(x.InternalNode())), nil)
 		}
-		return yield(newXMLTemplateTextToken(tree.ToSourceCode(x.InternalNode())), nil)
+		return n.flattenXMLTemplateDelimited("<!--", x.Content(), "-->", XMLTemplateInsertionKindComment, yield)
 	case *tree.XMLProcessingInstruction:
-		if ins := firstXMLInterpolation(x); ins != nil {
-			return yield(xmlTemplateToken{}, &xmlTemplateDiagnostic{
-				Message:  "interpolation is not allowed in xml processing instruction",
-				Position: getPosition(n.de(), ins),
-			})
-		}
 		return n.flattenXMLTemplatePI(x, yield)
 	case *tree.XMLCDATANode:
-		if ins := firstXMLInterpolation(x); ins != nil {
-			return yield(xmlTemplateToken{}, &xmlTemplateDiagnostic{
-				Message:  "interpolation is not allowed in xml CDATA section",
-				Position: getPosition(n.de(), ins),
-			})
+		if firstXMLInterpolation(x) == nil {
+			return yield(newXMLTemplateTextToken(tree.ToSourceCode(x.InternalNode())), nil)
 		}
-		return yield(newXMLTemplateTextToken(tree.ToSourceCode(x.InternalNode())), nil)
+		return n.flattenXMLTemplateDelimited("<![CDATA[", x.Content(), "]]>", XMLTemplateInsertionKindCDATA, yield)
 	default:
 		return yield(newXMLTemplateTextToken(tree.ToSourceCode(node.InternalNode())), nil)
 	}
@@ -3430,6 +3677,26 @@ func (n *NodeBuilder) flattenXMLTemplateEmptyElement(
 	return yield(newXMLTemplateTextToken("/>"), nil)
 }
 
+// flattenXMLTemplateDelimited flattens the content of a comment or CDATA section, whose interpolations are inserted
+// as strings.
+func (n *NodeBuilder) flattenXMLTemplateDelimited(
+	start string,
+	content tree.NodeList[tree.Node],
+	end string,
+	kind XMLTemplateInsertionKind,
+	yield func(xmlTemplateToken, *xmlTemplateDiagnostic) bool,
+) bool {
+	if !yield(newXMLTemplateTextToken(start), nil) {
+		return false
+	}
+	for child := range content.Iterator() {
+		if !n.flattenXMLTemplateNodeWithNamespace(child, kind, nil, yield) {
+			return false
+		}
+	}
+	return yield(newXMLTemplateTextToken(end), nil)
+}
+
 func (n *NodeBuilder) flattenXMLTemplatePI(x *tree.XMLProcessingInstruction, yield func(xmlTemplateToken, *xmlTemplateDiagnostic) bool) bool {
 	if !yield(newXMLTemplateTextToken("<?"), nil) {
 		return false
@@ -3437,8 +3704,37 @@ func (n *NodeBuilder) flattenXMLTemplatePI(x *tree.XMLProcessingInstruction, yie
 	if !yield(newXMLTemplateTextToken(n.xmlNameToString(x.Target())), nil) {
 		return false
 	}
-	var dataText strings.Builder
 	data := x.Data()
+	if firstXMLInterpolation(x) != nil {
+		if !yield(newXMLTemplateTextToken(" "), nil) {
+			return false
+		}
+		// Only the whitespace separating the data from the target and the closing `?>` is dropped.
+		last := data.Size() - 1
+		for i := 0; i <= last; i++ {
+			child := data.Get(i)
+			tok, ok := child.(tree.Token)
+			if !ok {
+				if !n.flattenXMLTemplateNodeWithNamespace(child, XMLTemplateInsertionKindPI, nil, yield) {
+					return false
+				}
+				continue
+			}
+			// Whitespace following an interpolation is lexed as minutiae of the next data token.
+			text := tree.ToSourceCode(tok.InternalNode())
+			if i == 0 {
+				text = strings.TrimLeft(text, " \t\r\n")
+			}
+			if i == last {
+				text = strings.TrimRight(text, " \t\r\n")
+			}
+			if !yield(newXMLTemplateTextToken(text), nil) {
+				return false
+			}
+		}
+		return yield(newXMLTemplateTextToken("?>"), nil)
+	}
+	var dataText strings.Builder
 	for child := range data.Iterator() {
 		if tok, ok := child.(tree.Token); ok {
 			dataText.WriteString(tok.Text())
@@ -3630,6 +3926,45 @@ func isXMLTemplateXMLNSName(name string) bool {
 }
 
 func (n *NodeBuilder) buildStringTemplateExpr(node *tree.TemplateExpressionNode, pos diagnostics.Location) BLangNode {
+	strs, insertions, ok := n.flattenTemplateContent(node, "string")
+	if !ok {
+		return nil
+	}
+	tpl := &BLangTemplateExpr{Kind: TemplateExprKindString, Strings: strs, Insertions: insertions}
+	tpl.SetPosition(pos)
+	return tpl
+}
+
+func (n *NodeBuilder) buildRawTemplateExpr(node *tree.TemplateExpressionNode, pos diagnostics.Location) BLangNode {
+	strs, insertions, ok := n.flattenTemplateContent(node, "raw")
+	if !ok {
+		return nil
+	}
+	tpl := &BLangTemplateExpr{Kind: TemplateExprKindRaw, Strings: strs, Insertions: insertions}
+	tpl.SetPosition(pos)
+	return tpl
+}
+
+// buildRegExpTemplateExpr builds a regexp template. The regexp syntax of the
+// literal parts is validated here, with each interpolation standing for an
+// atom; the complete pattern is validated again at runtime once the
+// interpolations are evaluated.
+func (n *NodeBuilder) buildRegExpTemplateExpr(node *tree.TemplateExpressionNode, pos diagnostics.Location) BLangNode {
+	strs, insertions, ok := n.flattenTemplateContent(node, "regexp")
+	if !ok {
+		return nil
+	}
+	if err := bregexp.Check(strs); err != nil {
+		n.cx.SyntaxError(fmt.Sprintf("invalid regular expression: %s", err.Error()), pos)
+	}
+	tpl := &BLangTemplateExpr{Kind: TemplateExprKindRegExp, Strings: strs, Insertions: insertions}
+	tpl.SetPosition(pos)
+	return tpl
+}
+
+// flattenTemplateContent splits the content of a template into its literal
+// strings and interpolated expressions.
+func (n *NodeBuilder) flattenTemplateContent(node *tree.TemplateExpressionNode, templateKind string) ([]string, []BLangExpression, bool) {
 	// We maintain fallowing 2 invariants
 	// 1. First and last elements are always strings
 	// 2. Between any two expressions there is a string
@@ -3642,7 +3977,7 @@ func (n *NodeBuilder) buildStringTemplateExpr(node *tree.TemplateExpressionNode,
 		switch c := child.(type) {
 		case tree.Token:
 			if c.Kind() != common.TEMPLATE_STRING {
-				n.cx.InternalError(fmt.Sprintf("unexpected token kind in string template: %v", c.Kind()), getPosition(n.de(), c))
+				n.cx.InternalError(fmt.Sprintf("unexpected token kind in %s template: %v", templateKind, c.Kind()), getPosition(n.de(), c))
 				continue
 			}
 			strs = append(strs, c.Text())
@@ -3655,20 +3990,18 @@ func (n *NodeBuilder) buildStringTemplateExpr(node *tree.TemplateExpressionNode,
 			be, ok := expr.(BLangExpression)
 			if !ok {
 				n.cx.InternalError("interpolation did not produce BLangExpression", getPosition(n.de(), c))
-				return nil
+				return nil, nil, false
 			}
 			insertions = append(insertions, be)
 			lastStr = false
 		default:
-			n.cx.InternalError(fmt.Sprintf("unexpected node in string template: %T", c), getPosition(n.de(), child))
+			n.cx.InternalError(fmt.Sprintf("unexpected node in %s template: %T", templateKind, c), getPosition(n.de(), child))
 		}
 	}
 	if !lastStr {
 		strs = append(strs, "")
 	}
-	tpl := &BLangTemplateExpr{Kind: TemplateExprKindString, Strings: strs, Insertions: insertions}
-	tpl.SetPosition(pos)
-	return tpl
+	return strs, insertions, true
 }
 
 func (n *NodeBuilder) xmlNameToString(name tree.XMLNameNode) string {
@@ -3769,7 +4102,7 @@ func (n *NodeBuilder) TransformXMLEmptyElement(xMLEmptyElementNode *tree.XMLEmpt
 }
 
 func (n *NodeBuilder) TransformInterpolation(interpolationNode *tree.InterpolationNode) BLangNode {
-	n.cx.Unimplemented("xml interpolation not yet supported", getPosition(n.de(), interpolationNode))
+	n.cx.InternalError("xml interpolation must be handled by the enclosing xml template", getPosition(n.de(), interpolationNode))
 	return nil
 }
 
@@ -3802,7 +4135,7 @@ func (n *NodeBuilder) TransformXMLAttributeValue(xMLAttributeValue *tree.XMLAttr
 	for child := range items.Iterator() {
 		tok, ok := child.(tree.Token)
 		if !ok {
-			n.cx.Unimplemented("xml attribute value interpolation not yet supported", getPosition(n.de(), child))
+			n.cx.InternalError("xml interpolation must be handled by the enclosing xml template", getPosition(n.de(), child))
 			return nil
 		}
 		b.WriteString(tok.Text())
@@ -3824,7 +4157,7 @@ func (n *NodeBuilder) TransformXMLComment(xMLComment *tree.XMLComment) BLangNode
 	for child := range content.Iterator() {
 		tok, ok := child.(tree.Token)
 		if !ok {
-			n.cx.Unimplemented("xml interpolation in comment not yet supported", getPosition(n.de(), child))
+			n.cx.InternalError("xml interpolation must be handled by the enclosing xml template", getPosition(n.de(), child))
 			continue
 		}
 		b.WriteString(tok.Text())
@@ -3833,9 +4166,22 @@ func (n *NodeBuilder) TransformXMLComment(xMLComment *tree.XMLComment) BLangNode
 	return c
 }
 
+// TransformXMLCDATA turns a CDATA section into an xml text item holding its content as is.
 func (n *NodeBuilder) TransformXMLCDATA(xMLCDATANode *tree.XMLCDATANode) BLangNode {
-	n.cx.Unimplemented("xml CDATA not yet supported", getPosition(n.de(), xMLCDATANode))
-	return nil
+	text := &BLangXMLTextLiteral{}
+	text.pos = getPosition(n.de(), xMLCDATANode)
+	var b strings.Builder
+	content := xMLCDATANode.Content()
+	for child := range content.Iterator() {
+		tok, ok := child.(tree.Token)
+		if !ok {
+			n.cx.InternalError("xml interpolation must be handled by the enclosing xml template", getPosition(n.de(), child))
+			continue
+		}
+		b.WriteString(tok.Text())
+	}
+	text.Body = b.String()
+	return text
 }
 
 func (n *NodeBuilder) TransformXMLProcessingInstruction(xMLProcessingInstruction *tree.XMLProcessingInstruction) BLangNode {
@@ -3847,7 +4193,7 @@ func (n *NodeBuilder) TransformXMLProcessingInstruction(xMLProcessingInstruction
 	for child := range data.Iterator() {
 		tok, ok := child.(tree.Token)
 		if !ok {
-			n.cx.Unimplemented("xml interpolation in processing instruction not yet supported", getPosition(n.de(), child))
+			n.cx.InternalError("xml interpolation must be handled by the enclosing xml template", getPosition(n.de(), child))
 			continue
 		}
 		b.WriteString(tok.Text())
@@ -3857,7 +4203,27 @@ func (n *NodeBuilder) TransformXMLProcessingInstruction(xMLProcessingInstruction
 }
 
 func (n *NodeBuilder) TransformTableTypeDescriptor(tableTypeDescriptorNode *tree.TableTypeDescriptorNode) BLangNode {
-	panic("TransformTableTypeDescriptor unimplemented")
+	position := getPosition(n.de(), tableTypeDescriptorNode)
+	rowTypeParam, ok := tableTypeDescriptorNode.RowTypeParameterNode().(*tree.TypeParameterNode)
+	if !ok || rowTypeParam.TypeNode() == nil {
+		n.cx.InternalError("table type requires a row type parameter", position)
+		return nil
+	}
+	tableType := &BLangTableType{
+		Constraint: TypeData{TypeDescriptor: n.createTypeNode(rowTypeParam.TypeNode())},
+	}
+	tableType.SetPosition(position)
+	switch keyConstraint := tableTypeDescriptorNode.KeyConstraintNode().(type) {
+	case nil:
+	case *tree.KeySpecifierNode:
+		tableType.KeySpecifier = n.TransformKeySpecifier(keyConstraint).(*BLangTableKeySpecifier)
+	case *tree.KeyTypeConstraintNode:
+		tableType.KeyTypeConstraint = n.TransformKeyTypeConstraint(keyConstraint).(*BLangTableKeyTypeConstraint)
+	default:
+		n.cx.InternalError(fmt.Sprintf("unexpected table key constraint kind: %v", keyConstraint.Kind()), position)
+		return nil
+	}
+	return tableType
 }
 
 func (n *NodeBuilder) TransformTypeParameter(typeParameterNode *tree.TypeParameterNode) BLangNode {
@@ -3865,7 +4231,17 @@ func (n *NodeBuilder) TransformTypeParameter(typeParameterNode *tree.TypeParamet
 }
 
 func (n *NodeBuilder) TransformKeyTypeConstraint(keyTypeConstraintNode *tree.KeyTypeConstraintNode) BLangNode {
-	panic("TransformKeyTypeConstraint unimplemented")
+	position := getPosition(n.de(), keyTypeConstraintNode)
+	typeParam, ok := keyTypeConstraintNode.TypeParameterNode().(*tree.TypeParameterNode)
+	if !ok || typeParam.TypeNode() == nil {
+		n.cx.InternalError("table key constraint requires a type parameter", position)
+		return nil
+	}
+	keyTypeConstraint := &BLangTableKeyTypeConstraint{
+		KeyType: TypeData{TypeDescriptor: n.createTypeNode(typeParam.TypeNode())},
+	}
+	keyTypeConstraint.pos = position
+	return keyTypeConstraint
 }
 
 func (n *NodeBuilder) TransformFunctionTypeDescriptor(functionTypeDescriptorNode *tree.FunctionTypeDescriptorNode) BLangNode {
@@ -4044,14 +4420,36 @@ func (n *NodeBuilder) TransformQueryConstructType(queryConstructTypeNode *tree.Q
 }
 
 func (n *NodeBuilder) TransformFromClause(fromClauseNode *tree.FromClauseNode) BLangNode {
+	fromClause, _ := n.createFromClause(fromClauseNode)
+	return fromClause
+}
+
+// createFromClause creates the from clause for fromClauseNode. When the from
+// clause has a list, mapping or error binding pattern, it also returns a let
+// clause destructuring the hidden variable bound by the from clause.
+func (n *NodeBuilder) createFromClause(fromClauseNode *tree.FromClauseNode) (*BLangFromClause, *BLangLetClause) {
 	fromClause := &BLangFromClause{}
 	fromClause.pos = getPosition(n.de(), fromClauseNode)
 	fromClause.SetCollection(n.createExpression(fromClauseNode.Expression()))
 	bindingPatternNode := fromClauseNode.TypedBindingPattern()
-	fromClause.SetVariableDefinitionNode(n.createBLangVarDef(getPosition(n.de(), bindingPatternNode), bindingPatternNode,
-		nil, nil))
+	varDef, patternDef := n.createIterationVarDef(bindingPatternNode)
+	fromClause.SetVariableDefinitionNode(varDef)
 	fromClause.IsDeclaredWithVarFlag = isDeclaredWithVar(bindingPatternNode.TypeDescriptor())
-	return fromClause
+	if patternDef == nil {
+		return fromClause, nil
+	}
+	letClause := &BLangLetClause{}
+	letClause.pos = getPosition(n.de(), bindingPatternNode)
+	letClause.LetVarDeclarations = []VariableDefinitionNode{patternDef}
+	return fromClause, letClause
+}
+
+func (n *NodeBuilder) addFromClause(queryExpr *BLangQueryExpr, fromClauseNode *tree.FromClauseNode) {
+	fromClause, letClause := n.createFromClause(fromClauseNode)
+	queryExpr.AddQueryClause(fromClause)
+	if letClause != nil {
+		queryExpr.AddQueryClause(letClause)
+	}
 }
 
 func (n *NodeBuilder) TransformWhereClause(whereClauseNode *tree.WhereClauseNode) BLangNode {
@@ -4065,10 +4463,10 @@ func (n *NodeBuilder) TransformLetClause(letClauseNode *tree.LetClauseNode) BLan
 	letClause := &BLangLetClause{}
 	letClause.pos = getPosition(n.de(), letClauseNode)
 	letVarDeclarations := letClauseNode.LetVarDeclarations()
-	letClause.LetVarDeclarations = make([]BLangSimpleVariableDef, 0, letVarDeclarations.Size())
+	letClause.LetVarDeclarations = make([]VariableDefinitionNode, 0, letVarDeclarations.Size())
 	for letVar := range letVarDeclarations.Iterator() {
-		varDef := n.TransformLetVariableDeclaration(letVar).(*BLangSimpleVariableDef)
-		letClause.LetVarDeclarations = append(letClause.LetVarDeclarations, *varDef)
+		varDef := n.TransformLetVariableDeclaration(letVar).(VariableDefinitionNode)
+		letClause.LetVarDeclarations = append(letClause.LetVarDeclarations, varDef)
 	}
 	return letClause
 }
@@ -4078,9 +4476,12 @@ func (n *NodeBuilder) TransformJoinClause(joinClauseNode *tree.JoinClauseNode) B
 	joinClause.pos = getPosition(n.de(), joinClauseNode)
 	joinClause.SetCollection(n.createExpression(joinClauseNode.Expression()))
 	bindingPatternNode := joinClauseNode.TypedBindingPattern()
-	joinClause.SetVariableDefinitionNode(
-		n.createBLangVarDef(getPosition(n.de(), bindingPatternNode), bindingPatternNode, nil, nil),
-	)
+	varDef, patternDef := n.createIterationVarDef(bindingPatternNode)
+	if patternDef != nil {
+		n.cx.Unimplemented("join clause with a list, mapping or error binding pattern is not supported yet",
+			getPosition(n.de(), bindingPatternNode))
+	}
+	joinClause.SetVariableDefinitionNode(varDef)
 	joinClause.IsDeclaredWithVarFlag = isDeclaredWithVar(bindingPatternNode.TypeDescriptor())
 	joinClause.IsOuterJoinFlag = joinClauseNode.OuterKeyword() != nil
 	if onClauseNode := joinClauseNode.JoinOnCondition(); onClauseNode != nil {
@@ -4111,8 +4512,31 @@ func (n *NodeBuilder) TransformOnConflictClause(onConflictClauseNode *tree.OnCon
 	return onConflictClause
 }
 
+// TransformQueryPipeline creates a query expression holding the clauses of
+// queryPipelineNode. The caller adds the result clause.
 func (n *NodeBuilder) TransformQueryPipeline(queryPipelineNode *tree.QueryPipelineNode) BLangNode {
-	panic("TransformQueryPipeline unimplemented")
+	queryExpr := &BLangQueryExpr{}
+	queryExpr.pos = getPosition(n.de(), queryPipelineNode)
+	if queryPipelineNode.FromClause() == nil {
+		return queryExpr
+	}
+
+	n.addFromClause(queryExpr, queryPipelineNode.FromClause())
+
+	intermediateClauses := queryPipelineNode.IntermediateClauses()
+	for i := 0; i < intermediateClauses.Size(); i++ {
+		clause := intermediateClauses.Get(i)
+		switch clause.Kind() {
+		case common.FROM_CLAUSE:
+			n.addFromClause(queryExpr, clause.(*tree.FromClauseNode))
+		case common.JOIN_CLAUSE, common.LET_CLAUSE, common.WHERE_CLAUSE,
+			common.GROUP_BY_CLAUSE, common.LIMIT_CLAUSE, common.ORDER_BY_CLAUSE:
+			queryExpr.AddQueryClause(n.TransformSyntaxNode(clause))
+		default:
+			n.cx.Unimplemented("only from + join + let + where + group by + order by + limit + select/collect query clauses are supported for now", getPosition(n.de(), clause))
+		}
+	}
+	return queryExpr
 }
 
 func (n *NodeBuilder) TransformSelectClause(selectClauseNode *tree.SelectClauseNode) BLangNode {
@@ -4133,37 +4557,29 @@ func (n *NodeBuilder) TransformCollectClause(collectClauseNode *tree.CollectClau
 
 func (n *NodeBuilder) TransformQueryExpression(queryBLangExpression *tree.QueryExpressionNode) BLangNode {
 	queryExpr := &BLangQueryExpr{}
+	if queryPipeline := queryBLangExpression.QueryPipeline(); queryPipeline != nil {
+		queryExpr = n.TransformQueryPipeline(queryPipeline).(*BLangQueryExpr)
+	}
 	queryExpr.pos = getPosition(n.de(), queryBLangExpression)
 
 	if constructType := queryBLangExpression.QueryConstructType(); constructType != nil {
-		switch constructType.Keyword().Text() {
-		case string(TypeKind_MAP):
-			queryExpr.QueryConstructType = TypeKind_MAP
+		switch TypeKind(constructType.Keyword().Text()) {
+		case TypeKind_MAP, TypeKind_STREAM:
+			queryExpr.QueryConstructType = TypeKind(constructType.Keyword().Text())
+		case TypeKind_TABLE:
+			queryExpr.QueryConstructType = TypeKind_TABLE
+			if keySpecifier := constructType.KeySpecifier(); keySpecifier != nil {
+				queryExpr.TableKeySpecifier = n.TransformKeySpecifier(keySpecifier).(*BLangTableKeySpecifier)
+			}
 		default:
-			n.cx.Unimplemented("only map query construct type is supported for now", getPosition(n.de(), constructType))
+			n.cx.Unimplemented("query construct type is not supported", getPosition(n.de(), constructType))
 		}
 	}
 
-	queryPipeline := queryBLangExpression.QueryPipeline()
-	if queryPipeline == nil || queryPipeline.FromClause() == nil {
+	if len(queryExpr.QueryClauseList) == 0 {
 		return queryExpr
 	}
 
-	fromClause := n.TransformSyntaxNode(queryPipeline.FromClause())
-	queryExpr.AddQueryClause(fromClause)
-
-	intermediateClauses := queryPipeline.IntermediateClauses()
-	for i := 0; i < intermediateClauses.Size(); i++ {
-		clause := intermediateClauses.Get(i)
-		switch clause.Kind() {
-		case common.FROM_CLAUSE, common.JOIN_CLAUSE, common.LET_CLAUSE, common.WHERE_CLAUSE,
-			common.GROUP_BY_CLAUSE, common.LIMIT_CLAUSE, common.ORDER_BY_CLAUSE:
-			queryExpr.AddQueryClause(n.TransformSyntaxNode(clause))
-		default:
-			n.cx.Unimplemented("only from + join + let + where + group by + order by + limit + select/collect query clauses are supported for now", getPosition(n.de(), clause))
-		}
-	}
-
 	resultClause := queryBLangExpression.ResultClause()
 	if resultClause != nil && (resultClause.Kind() == common.SELECT_CLAUSE || resultClause.Kind() == common.COLLECT_CLAUSE) {
 		queryExpr.AddQueryClause(n.TransformSyntaxNode(resultClause))
@@ -4178,13 +4594,33 @@ func (n *NodeBuilder) TransformQueryExpression(queryBLangExpression *tree.QueryE
 	return queryExpr
 }
 
+// TransformQueryAction creates a query expression whose last clause is a do
+// clause holding the block of queryActionNode.
 func (n *NodeBuilder) TransformQueryAction(queryActionNode *tree.QueryActionNode) BLangNode {
-	panic("TransformQueryAction unimplemented")
+	queryExpr := n.TransformQueryPipeline(queryActionNode.QueryPipeline()).(*BLangQueryExpr)
+	queryExpr.pos = getPosition(n.de(), queryActionNode)
+	doClause := &BLangDoClause{}
+	doClause.pos = getPosition(n.de(), queryActionNode.BlockStatement())
+	isInLocalContext := n.isInLocalContext
+	doClause.Body = n.TransformBlockStatement(queryActionNode.BlockStatement()).(*BLangBlockStmt)
+	n.isInLocalContext = isInLocalContext
+	queryExpr.AddQueryClause(doClause)
+	return queryExpr
 }
 
 func (n *NodeBuilder) TransformIntersectionTypeDescriptor(intersectionTypeDescriptorNode *tree.IntersectionTypeDescriptorNode) BLangNode {
 	lhs := intersectionTypeDescriptorNode.LeftTypeDesc()
 	rhs := intersectionTypeDescriptorNode.RightTypeDesc()
+	// `readonly & object {...}` is the readonly object type, which can be included like any other object type.
+	if lhs.Kind() == common.OBJECT_TYPE_DESC && rhs.Kind() == common.READONLY_TYPE_DESC {
+		lhs, rhs = rhs, lhs
+	}
+	if lhs.Kind() == common.READONLY_TYPE_DESC && rhs.Kind() == common.OBJECT_TYPE_DESC {
+		objectType := n.createTypeNode(rhs).(*BLangObjectType)
+		objectType.Readonly = true
+		objectType.pos = getPosition(n.de(), intersectionTypeDescriptorNode)
+		return objectType
+	}
 	bLIntersectionType := &BLangIntersectionTypeNode{
 		lhs: TypeData{
 			TypeDescriptor: n.createTypeNode(lhs),
@@ -4198,19 +4634,83 @@ func (n *NodeBuilder) TransformIntersectionTypeDescriptor(intersectionTypeDescri
 }
 
 func (n *NodeBuilder) TransformImplicitAnonymousFunctionParameters(implicitAnonymousFunctionParameters *tree.ImplicitAnonymousFunctionParameters) BLangNode {
-	panic("TransformImplicitAnonymousFunctionParameters unimplemented")
+	panic("TransformImplicitAnonymousFunctionParameters: parameters are transformed as part of the implicit anonymous function expression")
 }
 
+// TransformImplicitAnonymousFunctionExpression creates a lambda whose parameters have no type descriptors and
+// whose body is the given expression. The signature is inferred from the contextually expected function type
+// during type resolution.
 func (n *NodeBuilder) TransformImplicitAnonymousFunctionExpression(implicitAnonymousFunctionBLangExpression *tree.ImplicitAnonymousFunctionExpressionNode) BLangNode {
-	panic("TransformImplicitAnonymousFunctionExpression unimplemented")
+	bLFunction := &BLangFunction{}
+	name := n.cx.GetNextAnonymousFunctionKey(n.PackageID)
+	ident := createIdentifier(diagnostics.NewBuiltinLocation(), &name, &name)
+	bLFunction.Name = ident
+
+	switch params := implicitAnonymousFunctionBLangExpression.Params().(type) {
+	case *tree.SimpleNameReferenceNode:
+		bLFunction.AddParameter(n.createImplicitAnonFunctionParam(params))
+	case *tree.ImplicitAnonymousFunctionParameters:
+		paramList := params.Parameters()
+		for param := range paramList.Iterator() {
+			bLFunction.AddParameter(n.createImplicitAnonFunctionParam(param))
+		}
+	default:
+		panic("TransformImplicitAnonymousFunctionExpression: unexpected parameter node")
+	}
+
+	exprNode := implicitAnonymousFunctionBLangExpression.Expression()
+	body := &BLangExprFunctionBody{Expr: n.createExpression(exprNode)}
+	body.pos = getPosition(n.de(), exprNode)
+	bLFunction.Body = body
+	bLFunction.pos = getPosition(n.de(), implicitAnonymousFunctionBLangExpression)
+	bLFunction.SetAnonymous()
+	bLFunction.SetInferred()
+
+	lambdaFunc := &BLangLambdaFunction{Function: bLFunction}
+	lambdaFunc.pos = bLFunction.pos
+	return lambdaFunc
+}
+
+func (n *NodeBuilder) createImplicitAnonFunctionParam(nameRef *tree.SimpleNameReferenceNode) *BLangSimpleVariable {
+	simpleVar := createSimpleVariableNode()
+	nameToken := nameRef.Name()
+	identifier := createIdentifierFromToken(getPosition(n.de(), nameToken), nameToken)
+	simpleVar.SetName(&identifier)
+	simpleVar.pos = getPosition(n.de(), nameRef)
+	simpleVar.SetRequiredParam()
+	return simpleVar
 }
 
+// TransformStartAction marks the call made by the start action as async. The call itself is created as usual.
 func (n *NodeBuilder) TransformStartAction(startActionNode *tree.StartActionNode) BLangNode {
-	panic("TransformStartAction unimplemented")
+	pos := getPosition(n.de(), startActionNode)
+	annots := startActionNode.Annotations()
+	if annots.Size() > 0 {
+		n.cx.Unimplemented("annotations on start actions are not yet supported", pos)
+	}
+	call := n.createActionOrExpression(startActionNode.Expression())
+	switch call := call.(type) {
+	case *BLangInvocation:
+		call.Async = true
+	case *BLangRemoteMethodCallAction:
+		call.Async = true
+	case *BLangClientResourceAccessAction:
+		call.Async = true
+	default:
+		n.cx.SemanticError("start action requires a function or method call", pos)
+	}
+	return call
 }
 
 func (n *NodeBuilder) TransformFlushAction(flushActionNode *tree.FlushActionNode) BLangNode {
-	panic("TransformFlushAction unimplemented")
+	bLFlush := &BLangWorkerFlushExpr{}
+	bLFlush.pos = getPosition(n.de(), flushActionNode)
+	if peer, ok := flushActionNode.PeerWorker().(*tree.SimpleNameReferenceNode); ok {
+		bLFlush.WorkerIdentifier = n.createPeerWorkerIdentifier(peer)
+	} else if flushActionNode.PeerWorker() != nil {
+		n.cx.Unimplemented("qualified peer worker names are not supported", getPosition(n.de(), flushActionNode.PeerWorker()))
+	}
+	return bLFlush
 }
 
 func (n *NodeBuilder) TransformSingletonTypeDescriptor(singletonTypeDescriptorNode *tree.SingletonTypeDescriptorNode) BLangNode {
@@ -4229,7 +4729,11 @@ func (n *NodeBuilder) TransformTypedBindingPattern(typedBindingPatternNode *tree
 }
 
 func (n *NodeBuilder) TransformCaptureBindingPattern(captureBindingPatternNode *tree.CaptureBindingPatternNode) BLangNode {
-	panic("TransformCaptureBindingPattern unimplemented")
+	bLCaptureBindingPattern := &BLangCaptureBindingPattern{}
+	bLCaptureBindingPattern.pos = getPosition(n.de(), captureBindingPatternNode)
+	varName := captureBindingPatternNode.VariableName()
+	bLCaptureBindingPattern.Identifier = createIdentifierFromToken(getPosition(n.de(), varName), varName)
+	return bLCaptureBindingPattern
 }
 
 func (n *NodeBuilder) TransformWildcardBindingPattern(wildcardBindingPatternNode *tree.WildcardBindingPatternNode) BLangNode {
@@ -4239,51 +4743,223 @@ func (n *NodeBuilder) TransformWildcardBindingPattern(wildcardBindingPatternNode
 }
 
 func (n *NodeBuilder) TransformListBindingPattern(listBindingPatternNode *tree.ListBindingPatternNode) BLangNode {
-	panic("TransformListBindingPattern unimplemented")
+	bLListBindingPattern := &BLangListBindingPattern{}
+	bLListBindingPattern.pos = getPosition(n.de(), listBindingPatternNode)
+	bindingPatterns := listBindingPatternNode.BindingPatterns()
+	for bindingPattern := range bindingPatterns.Iterator() {
+		if bindingPattern.Kind() == common.REST_BINDING_PATTERN {
+			bLListBindingPattern.RestBindingPattern = n.TransformSyntaxNode(bindingPattern).(*BLangRestBindingPattern)
+			continue
+		}
+		bLListBindingPattern.BindingPatterns = append(bLListBindingPattern.BindingPatterns,
+			n.TransformSyntaxNode(bindingPattern).(BindingPatternNode))
+	}
+	return bLListBindingPattern
 }
 
 func (n *NodeBuilder) TransformMappingBindingPattern(mappingBindingPatternNode *tree.MappingBindingPatternNode) BLangNode {
-	panic("TransformMappingBindingPattern unimplemented")
+	bLMappingBindingPattern := &BLangMappingBindingPattern{}
+	bLMappingBindingPattern.pos = getPosition(n.de(), mappingBindingPatternNode)
+	fieldBindingPatterns := mappingBindingPatternNode.FieldBindingPatterns()
+	for bindingPattern := range fieldBindingPatterns.Iterator() {
+		if bindingPattern.Kind() == common.REST_BINDING_PATTERN {
+			bLMappingBindingPattern.RestBindingPattern = n.TransformSyntaxNode(bindingPattern).(*BLangRestBindingPattern)
+			continue
+		}
+		fieldBindingPattern := n.TransformSyntaxNode(bindingPattern).(*BLangFieldBindingPattern)
+		for _, each := range bLMappingBindingPattern.FieldBindingPatterns {
+			if each.FieldName.Value == fieldBindingPattern.FieldName.Value {
+				n.cx.SemanticError("duplicate field '"+each.FieldName.Value+"' in mapping binding pattern", fieldBindingPattern.pos)
+			}
+		}
+		bLMappingBindingPattern.FieldBindingPatterns = append(bLMappingBindingPattern.FieldBindingPatterns, *fieldBindingPattern)
+	}
+	return bLMappingBindingPattern
 }
 
 func (n *NodeBuilder) TransformFieldBindingPatternFull(fieldBindingPatternFullNode *tree.FieldBindingPatternFullNode) BLangNode {
-	panic("TransformFieldBindingPatternFull unimplemented")
+	bLFieldBindingPattern := &BLangFieldBindingPattern{}
+	bLFieldBindingPattern.pos = getPosition(n.de(), fieldBindingPatternFullNode)
+	fieldName := fieldBindingPatternFullNode.VariableName().Name()
+	bLFieldBindingPattern.FieldName = new(createIdentifierFromToken(getPosition(n.de(), fieldName), fieldName))
+	bLFieldBindingPattern.BindingPattern = n.TransformSyntaxNode(fieldBindingPatternFullNode.BindingPattern()).(BindingPatternNode)
+	return bLFieldBindingPattern
 }
 
 func (n *NodeBuilder) TransformFieldBindingPatternVarname(fieldBindingPatternVarnameNode *tree.FieldBindingPatternVarnameNode) BLangNode {
-	panic("TransformFieldBindingPatternVarname unimplemented")
+	pos := getPosition(n.de(), fieldBindingPatternVarnameNode)
+	fieldName := fieldBindingPatternVarnameNode.VariableName().Name()
+	fieldNamePos := getPosition(n.de(), fieldName)
+	bLCaptureBindingPattern := &BLangCaptureBindingPattern{}
+	bLCaptureBindingPattern.pos = pos
+	bLCaptureBindingPattern.Identifier = createIdentifierFromToken(fieldNamePos, fieldName)
+	bLFieldBindingPattern := &BLangFieldBindingPattern{}
+	bLFieldBindingPattern.pos = pos
+	bLFieldBindingPattern.FieldName = new(createIdentifierFromToken(fieldNamePos, fieldName))
+	bLFieldBindingPattern.BindingPattern = bLCaptureBindingPattern
+	return bLFieldBindingPattern
 }
 
 func (n *NodeBuilder) TransformRestBindingPattern(restBindingPatternNode *tree.RestBindingPatternNode) BLangNode {
-	panic("TransformRestBindingPattern unimplemented")
+	bLRestBindingPattern := &BLangRestBindingPattern{}
+	bLRestBindingPattern.pos = getPosition(n.de(), restBindingPatternNode)
+	varName := restBindingPatternNode.VariableName().Name()
+	bLRestBindingPattern.VariableName = new(createIdentifierFromToken(getPosition(n.de(), varName), varName))
+	return bLRestBindingPattern
 }
 
 func (n *NodeBuilder) TransformErrorBindingPattern(errorBindingPatternNode *tree.ErrorBindingPatternNode) BLangNode {
-	panic("TransformErrorBindingPattern unimplemented")
+	bLErrorBindingPattern := &BLangErrorBindingPattern{}
+	bLErrorBindingPattern.pos = getPosition(n.de(), errorBindingPatternNode)
+	if typeRef := errorBindingPatternNode.TypeReference(); typeRef != nil {
+		bLErrorBindingPattern.ErrorTypeReference = n.createTypeNode(typeRef).(*BLangUserDefinedType)
+	}
+	argListBindingPatterns := errorBindingPatternNode.ArgListBindingPatterns()
+	position := 0
+	for bindingPattern := range argListBindingPatterns.Iterator() {
+		pos := getPosition(n.de(), bindingPattern)
+		switch bindingPattern.Kind() {
+		case common.NAMED_ARG_BINDING_PATTERN, common.REST_BINDING_PATTERN:
+			if bLErrorBindingPattern.ErrorFieldBindingPatterns == nil {
+				bLErrorBindingPattern.ErrorFieldBindingPatterns = &BLangErrorFieldBindingPatterns{}
+				bLErrorBindingPattern.ErrorFieldBindingPatterns.pos = pos
+			}
+			fieldBindingPatterns := bLErrorBindingPattern.ErrorFieldBindingPatterns
+			if bindingPattern.Kind() == common.REST_BINDING_PATTERN {
+				fieldBindingPatterns.RestBindingPattern = n.TransformSyntaxNode(bindingPattern).(*BLangRestBindingPattern)
+			} else {
+				fieldBindingPatterns.AddNamedArgBindingPattern(n.TransformSyntaxNode(bindingPattern).(*BLangNamedArgBindingPattern))
+			}
+			continue
+		}
+		switch position {
+		case 0:
+			messageBindingPattern := &BLangErrorMessageBindingPattern{}
+			messageBindingPattern.pos = pos
+			messageBindingPattern.SimpleBindingPattern = n.createSimpleBindingPattern(bindingPattern)
+			bLErrorBindingPattern.ErrorMessageBindingPattern = messageBindingPattern
+		case 1:
+			causeBindingPattern := &BLangErrorCauseBindingPattern{}
+			causeBindingPattern.pos = pos
+			if bindingPattern.Kind() == common.ERROR_BINDING_PATTERN {
+				causeBindingPattern.ErrorBindingPattern = n.TransformSyntaxNode(bindingPattern).(*BLangErrorBindingPattern)
+			} else {
+				causeBindingPattern.SimpleBindingPattern = n.createSimpleBindingPattern(bindingPattern)
+			}
+			bLErrorBindingPattern.ErrorCauseBindingPattern = causeBindingPattern
+		default:
+			n.cx.SyntaxError("unexpected positional argument in error binding pattern", pos)
+		}
+		position++
+	}
+	return bLErrorBindingPattern
+}
+
+func (n *NodeBuilder) createSimpleBindingPattern(bindingPattern tree.Node) *BLangSimpleBindingPattern {
+	bLSimpleBindingPattern := &BLangSimpleBindingPattern{}
+	bLSimpleBindingPattern.pos = getPosition(n.de(), bindingPattern)
+	switch bp := n.TransformSyntaxNode(bindingPattern).(type) {
+	case *BLangCaptureBindingPattern:
+		bLSimpleBindingPattern.CaptureBindingPattern = bp
+	case *BLangWildCardBindingPattern:
+		bLSimpleBindingPattern.WildCardBindingPattern = bp
+	default:
+		n.cx.SyntaxError("error message and cause must be bound by a variable name or '_'", bLSimpleBindingPattern.pos)
+	}
+	return bLSimpleBindingPattern
 }
 
 func (n *NodeBuilder) TransformNamedArgBindingPattern(namedArgBindingPatternNode *tree.NamedArgBindingPatternNode) BLangNode {
-	panic("TransformNamedArgBindingPattern unimplemented")
+	bLNamedArgBindingPattern := &BLangNamedArgBindingPattern{}
+	bLNamedArgBindingPattern.pos = getPosition(n.de(), namedArgBindingPatternNode)
+	argName := namedArgBindingPatternNode.ArgName()
+	bLNamedArgBindingPattern.ArgName = new(createIdentifierFromToken(getPosition(n.de(), argName), argName))
+	bLNamedArgBindingPattern.BindingPattern = n.TransformSyntaxNode(namedArgBindingPatternNode.BindingPattern()).(BindingPatternNode)
+	return bLNamedArgBindingPattern
 }
 
 func (n *NodeBuilder) TransformAsyncSendAction(asyncSendActionNode *tree.AsyncSendActionNode) BLangNode {
-	panic("TransformAsyncSendAction unimplemented")
+	bLSend := &BLangWorkerAsyncSendExpr{}
+	bLSend.pos = getPosition(n.de(), asyncSendActionNode)
+	bLSend.Expr = n.createExpression(asyncSendActionNode.Expression())
+	bLSend.WorkerIdentifier = n.createPeerWorkerIdentifier(asyncSendActionNode.PeerWorker())
+	return bLSend
 }
 
 func (n *NodeBuilder) TransformSyncSendAction(syncSendActionNode *tree.SyncSendActionNode) BLangNode {
-	panic("TransformSyncSendAction unimplemented")
+	bLSend := &BLangWorkerSyncSendExpr{}
+	bLSend.pos = getPosition(n.de(), syncSendActionNode)
+	bLSend.Expr = n.createExpression(syncSendActionNode.Expression())
+	bLSend.WorkerIdentifier = n.createPeerWorkerIdentifier(syncSendActionNode.PeerWorker())
+	return bLSend
 }
 
 func (n *NodeBuilder) TransformReceiveAction(receiveActionNode *tree.ReceiveActionNode) BLangNode {
-	panic("TransformReceiveAction unimplemented")
+	pos := getPosition(n.de(), receiveActionNode)
+	switch workers := receiveActionNode.ReceiveWorkers().(type) {
+	case *tree.SimpleNameReferenceNode:
+		bLReceive := n.createWorkerReceive(workers)
+		bLReceive.pos = pos
+		return bLReceive
+	case *tree.AlternateReceiveNode:
+		bLReceive := n.TransformAlternateReceive(workers).(*BLangAlternateWorkerReceive)
+		bLReceive.pos = pos
+		return bLReceive
+	case *tree.ReceiveFieldsNode:
+		bLReceive := n.TransformReceiveFields(workers).(*BLangMultipleWorkerReceive)
+		bLReceive.pos = pos
+		return bLReceive
+	default:
+		panic("unexpected receive workers node")
+	}
 }
 
 func (n *NodeBuilder) TransformReceiveFields(receiveFieldsNode *tree.ReceiveFieldsNode) BLangNode {
-	panic("TransformReceiveFields unimplemented")
+	bLReceive := &BLangMultipleWorkerReceive{}
+	bLReceive.pos = getPosition(n.de(), receiveFieldsNode)
+	fields := receiveFieldsNode.ReceiveFields()
+	for field := range fields.Iterator() {
+		switch field := field.(type) {
+		case *tree.ReceiveFieldNode:
+			fieldName := field.FieldName().Name()
+			bLReceive.ReceiveFields = append(bLReceive.ReceiveFields, BLangReceiveField{
+				Key:     createIdentifierFromToken(getPosition(n.de(), fieldName), fieldName),
+				Receive: *n.createWorkerReceive(field.PeerWorker()),
+			})
+		case *tree.SimpleNameReferenceNode:
+			// `{w1}` is shorthand for `{w1: w1}`
+			receive := n.createWorkerReceive(field)
+			bLReceive.ReceiveFields = append(bLReceive.ReceiveFields, BLangReceiveField{
+				Key:     *receive.WorkerIdentifier,
+				Receive: *receive,
+			})
+		}
+	}
+	return bLReceive
 }
 
 func (n *NodeBuilder) TransformAlternateReceive(alternateReceiveNode *tree.AlternateReceiveNode) BLangNode {
-	panic("TransformAlternateReceive unimplemented")
+	bLReceive := &BLangAlternateWorkerReceive{}
+	bLReceive.pos = getPosition(n.de(), alternateReceiveNode)
+	workers := alternateReceiveNode.Workers()
+	for worker := range workers.Iterator() {
+		bLReceive.WorkerReceives = append(bLReceive.WorkerReceives, *n.createWorkerReceive(worker))
+	}
+	return bLReceive
+}
+
+func (n *NodeBuilder) createWorkerReceive(peerWorker *tree.SimpleNameReferenceNode) *BLangWorkerReceive {
+	bLReceive := &BLangWorkerReceive{}
+	bLReceive.pos = getPosition(n.de(), peerWorker)
+	bLReceive.WorkerIdentifier = n.createPeerWorkerIdentifier(peerWorker)
+	return bLReceive
+}
+
+// createPeerWorkerIdentifier creates the identifier for a peer worker. The default worker is referred to
+// using the `function` keyword, so its name is always "function".
+func (n *NodeBuilder) createPeerWorkerIdentifier(peerWorker *tree.SimpleNameReferenceNode) *BLangIdentifier {
+	ident := createIdentifierFromToken(getPosition(n.de(), peerWorker), peerWorker.Name())
+	return &ident
 }
 
 func (n *NodeBuilder) TransformRestDescriptor(restDescriptorNode *tree.RestDescriptorNode) BLangNode {
@@ -4299,11 +4975,50 @@ func (n *NodeBuilder) TransformTrippleGTToken(trippleGTTokenNode *tree.TrippleGT
 }
 
 func (n *NodeBuilder) TransformWaitAction(waitActionNode *tree.WaitActionNode) BLangNode {
-	panic("TransformWaitAction unimplemented")
+	pos := getPosition(n.de(), waitActionNode)
+	if fields, ok := waitActionNode.WaitFutureExpr().(*tree.WaitFieldsListNode); ok {
+		bLWait := n.TransformWaitFieldsList(fields).(*BLangWaitForAllExpr)
+		bLWait.pos = pos
+		return bLWait
+	}
+	bLWait := &BLangWaitExpr{}
+	bLWait.pos = pos
+	bLWait.Exprs = n.collectAlternateWaitExprs(waitActionNode.WaitFutureExpr(), nil)
+	return bLWait
+}
+
+// collectAlternateWaitExprs flattens the future expressions of an alternate wait. The parser represents
+// `wait f1|f2|f3` as a bitwise or of the futures.
+func (n *NodeBuilder) collectAlternateWaitExprs(node tree.Node, exprs []BLangExpression) []BLangExpression {
+	if binExpr, ok := node.(*tree.BinaryExpressionNode); ok && binExpr.Operator().Kind() == common.PIPE_TOKEN {
+		exprs = n.collectAlternateWaitExprs(binExpr.LhsExpr(), exprs)
+		return n.collectAlternateWaitExprs(binExpr.RhsExpr(), exprs)
+	}
+	return append(exprs, n.createExpression(node))
 }
 
 func (n *NodeBuilder) TransformWaitFieldsList(waitFieldsListNode *tree.WaitFieldsListNode) BLangNode {
-	panic("TransformWaitFieldsList unimplemented")
+	bLWait := &BLangWaitForAllExpr{}
+	bLWait.pos = getPosition(n.de(), waitFieldsListNode)
+	fields := waitFieldsListNode.WaitFields()
+	for field := range fields.Iterator() {
+		switch field := field.(type) {
+		case *tree.WaitFieldNode:
+			fieldName := field.FieldName().Name()
+			bLWait.KeyValuePairs = append(bLWait.KeyValuePairs, BLangWaitKeyValue{
+				Key:       createIdentifierFromToken(getPosition(n.de(), fieldName), fieldName),
+				ValueExpr: n.createExpression(field.WaitFutureExpr()),
+			})
+		case *tree.SimpleNameReferenceNode:
+			// `{f1}` is shorthand for `{f1: f1}`
+			fieldName := field.Name()
+			bLWait.KeyValuePairs = append(bLWait.KeyValuePairs, BLangWaitKeyValue{
+				Key:       createIdentifierFromToken(getPosition(n.de(), fieldName), fieldName),
+				ValueExpr: n.createExpression(field),
+			})
+		}
+	}
+	return bLWait
 }
 
 func (n *NodeBuilder) TransformWaitField(waitFieldNode *tree.WaitFieldNode) BLangNode {
@@ -4311,15 +5026,44 @@ func (n *NodeBuilder) TransformWaitField(waitFieldNode *tree.WaitFieldNode) BLan
 }
 
 func (n *NodeBuilder) TransformAnnotAccessExpression(annotAccessBLangExpression *tree.AnnotAccessExpressionNode) BLangNode {
-	panic("TransformAnnotAccessExpression unimplemented")
+	annotAccessExpr := &BLangAnnotAccessExpr{}
+	annotAccessExpr.Expr = n.createExpression(annotAccessBLangExpression.Expression())
+	nameReference := n.createBLangNameReference(annotAccessBLangExpression.AnnotTagReference())
+	annotAccessExpr.PkgAlias = &nameReference[0]
+	annotAccessExpr.AnnotationName = &nameReference[1]
+	annotAccessExpr.pos = getPosition(n.de(), annotAccessBLangExpression)
+	return annotAccessExpr
 }
 
 func (n *NodeBuilder) TransformOptionalFieldAccessExpression(optionalFieldAccessBLangExpression *tree.OptionalFieldAccessExpressionNode) BLangNode {
-	panic("TransformOptionalFieldAccessExpression unimplemented")
+	fieldName := optionalFieldAccessBLangExpression.FieldName()
+	if fieldName.Kind() == common.QUALIFIED_NAME_REFERENCE {
+		panic("TransformOptionalFieldAccessExpression: QUALIFIED_NAME_REFERENCE unsupported")
+	}
+
+	bLFieldBasedAccess := &BLangFieldBaseAccess{OptionalFieldAccess: true}
+	simpleNameRef := fieldName.(*tree.SimpleNameReferenceNode)
+	bLFieldBasedAccess.Field = createIdentifierFromToken(getPosition(n.de(), fieldName), simpleNameRef.Name())
+
+	containerExpr := optionalFieldAccessBLangExpression.Expression()
+	if containerExpr.Kind() == common.BRACED_EXPRESSION {
+		bracedExpr := containerExpr.(*tree.BracedExpressionNode)
+		bLFieldBasedAccess.Expr = n.createExpression(bracedExpr.Expression())
+	} else {
+		bLFieldBasedAccess.Expr = n.createExpression(containerExpr)
+	}
+
+	bLFieldBasedAccess.pos = getPosition(n.de(), optionalFieldAccessBLangExpression)
+	return bLFieldBasedAccess
 }
 
 func (n *NodeBuilder) TransformConditionalExpression(conditionalBLangExpression *tree.ConditionalExpressionNode) BLangNode {
-	panic("TransformConditionalExpression unimplemented")
+	ternaryExpr := &BLangTernaryExpr{}
+	ternaryExpr.pos = getPosition(n.de(), conditionalBLangExpression)
+	ternaryExpr.Expr = n.createExpression(conditionalBLangExpression.LhsExpression())
+	ternaryExpr.ThenExpr = n.createExpression(conditionalBLangExpression.MiddleExpression())
+	ternaryExpr.ElseExpr = n.createExpression(conditionalBLangExpression.EndExpression())
+	return ternaryExpr
 }
 
 func (n *NodeBuilder) TransformEnumDeclaration(enumDeclarationNode *tree.EnumDeclarationNode) BLangNode {
@@ -4463,51 +5207,215 @@ func (n *NodeBuilder) TransformArrayDimension(arrayDimensionNode *tree.ArrayDime
 }
 
 func (n *NodeBuilder) TransformTransactionStatement(transactionStatementNode *tree.TransactionStatementNode) BLangNode {
-	panic("TransformTransactionStatement unimplemented")
+	bLTransaction := &BLangTransaction{}
+	bLTransaction.pos = getPosition(n.de(), transactionStatementNode)
+	bLBlockStmt := n.TransformBlockStatement(transactionStatementNode.BlockStatement()).(*BLangBlockStmt)
+	bLBlockStmt.pos = getPosition(n.de(), transactionStatementNode.BlockStatement())
+	bLTransaction.Body = *bLBlockStmt
+	if transactionStatementNode.OnFailClause() != nil {
+		bLTransaction.OnFailClause = n.TransformOnFailClause(transactionStatementNode.OnFailClause()).(*BLangOnFailClause)
+	}
+	return bLTransaction
 }
 
 func (n *NodeBuilder) TransformRollbackStatement(rollbackStatementNode *tree.RollbackStatementNode) BLangNode {
-	panic("TransformRollbackStatement unimplemented")
+	bLRollback := &BLangRollback{}
+	bLRollback.pos = getPosition(n.de(), rollbackStatementNode)
+	if expr := rollbackStatementNode.Expression(); expr != nil {
+		bLRollback.Expr = n.createExpression(expr)
+	}
+	return bLRollback
 }
 
 func (n *NodeBuilder) TransformRetryStatement(retryStatementNode *tree.RetryStatementNode) BLangNode {
-	panic("TransformRetryStatement unimplemented")
+	bLRetry := &BLangRetry{}
+	bLRetry.pos = getPosition(n.de(), retryStatementNode)
+	bLRetry.RetrySpec.pos = bLRetry.pos
+
+	var args []BLangExpression
+	if argList := retryStatementNode.Arguments(); argList != nil {
+		argNodes := argList.Arguments()
+		for arg := range argNodes.Iterator() {
+			args = append(args, n.createExpression(arg))
+		}
+	}
+	if typeParam := retryStatementNode.TypeParameter(); typeParam != nil {
+		retryManager := &BLangNewExpression{}
+		retryManager.pos = getPosition(n.de(), typeParam)
+		retryManager.TypeDescriptor = n.createTypeNode(typeParam.TypeNode()).(BType)
+		retryManager.ArgsExprs = args
+		bLRetry.RetrySpec.RetryManager = retryManager
+	} else {
+		bLRetry.RetrySpec.ArgExprs = args
+	}
+
+	switch body := retryStatementNode.RetryBody().(type) {
+	case *tree.TransactionStatementNode:
+		bLRetry.Transaction = n.TransformTransactionStatement(body).(*BLangTransaction)
+		// The on-fail clause of `retry transaction { ... } on fail { ... }` is parsed as part of the transaction
+		// statement, but it handles the failure of the last attempt, like the on-fail clause of a retry statement.
+		bLRetry.OnFailClause = bLRetry.Transaction.OnFailClause
+		bLRetry.Transaction.OnFailClause = nil
+	case *tree.BlockStatementNode:
+		bLBlockStmt := n.TransformBlockStatement(body).(*BLangBlockStmt)
+		bLRetry.Body = *bLBlockStmt
+	default:
+		n.cx.InternalError("unexpected retry body", getPosition(n.de(), retryStatementNode))
+	}
+	if retryStatementNode.OnFailClause() != nil {
+		if bLRetry.OnFailClause != nil {
+			n.cx.SyntaxError("retry statement cannot have more than one on-fail clause", getPosition(n.de(), retryStatementNode.OnFailClause()))
+		}
+		bLRetry.OnFailClause = n.TransformOnFailClause(retryStatementNode.OnFailClause()).(*BLangOnFailClause)
+	}
+	return bLRetry
 }
 
 func (n *NodeBuilder) TransformCommitAction(commitActionNode *tree.CommitActionNode) BLangNode {
-	panic("TransformCommitAction unimplemented")
+	bLCommit := &BLangCommitExpr{}
+	bLCommit.pos = getPosition(n.de(), commitActionNode)
+	return bLCommit
 }
 
 func (n *NodeBuilder) TransformTransactionalExpression(transactionalBLangExpression *tree.TransactionalExpressionNode) BLangNode {
-	panic("TransformTransactionalExpression unimplemented")
+	bLTransactional := &BLangTransactionalExpr{}
+	bLTransactional.pos = getPosition(n.de(), transactionalBLangExpression)
+	return bLTransactional
 }
 
 func (n *NodeBuilder) TransformByteArrayLiteral(byteArrayLiteralNode *tree.ByteArrayLiteralNode) BLangNode {
-	panic("TransformByteArrayLiteral unimplemented")
+	literal := &BLangLiteral{}
+	literal.pos = getPosition(n.de(), byteArrayLiteralNode)
+	literal.SetValueType(n.types.getTypeFromTag(TypeTags_BYTE_ARRAY).(BType))
+	encoding := byteArrayLiteralNode.Type().Text()
+	content := ""
+	if contentToken := byteArrayLiteralNode.Content(); contentToken != nil {
+		content = contentToken.Text()
+	}
+	literal.SetValue(decodeByteArrayContent(encoding, content))
+	literal.SetOriginalValue(encoding + " `" + content + "`")
+	return literal
+}
+
+// decodeByteArrayContent returns the bytes denoted by the content of a base16 or base64 byte array literal,
+// ignoring whitespace. The parser has already reported invalid content, for which this returns no bytes.
+func decodeByteArrayContent(encoding, content string) []byte {
+	content = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\n', '\r':
+			return -1
+		default:
+			return r
+		}
+	}, content)
+	var bytes []byte
+	var err error
+	if encoding == "base16" {
+		bytes, err = hex.DecodeString(content)
+	} else {
+		bytes, err = base64.StdEncoding.DecodeString(content)
+	}
+	if err != nil {
+		return []byte{}
+	}
+	return bytes
 }
 
 func (n *NodeBuilder) TransformXMLFilterExpression(xMLFilterBLangExpression *tree.XMLFilterExpressionNode) BLangNode {
-	panic("TransformXMLFilterExpression unimplemented")
+	filterExpr := &BLangXMLFilterExpr{}
+	filterExpr.pos = getPosition(n.de(), xMLFilterBLangExpression)
+	filterExpr.Expr = n.createExpression(xMLFilterBLangExpression.Expression())
+	filterExpr.Filters = n.xmlElementFilters(xMLFilterBLangExpression.XmlPatternChain())
+	return filterExpr
 }
 
 func (n *NodeBuilder) TransformXMLStepExpression(xMLStepBLangExpression *tree.XMLStepExpressionNode) BLangNode {
-	panic("TransformXMLStepExpression unimplemented")
+	stepExpr := &BLangXMLStepExpr{}
+	stepExpr.pos = getPosition(n.de(), xMLStepBLangExpression)
+	stepExpr.Expr = n.createExpression(xMLStepBLangExpression.Expression())
+	switch start := xMLStepBLangExpression.XmlStepStart().(type) {
+	case *tree.XMLNamePatternChainingNode:
+		stepExpr.Kind = XMLStepKindChildElements
+		if start.StartToken().Kind() == common.DOUBLE_SLASH_DOUBLE_ASTERISK_LT_TOKEN {
+			stepExpr.Kind = XMLStepKindDescendants
+		}
+		stepExpr.Filters = n.xmlElementFilters(start)
+	default:
+		stepExpr.Kind = XMLStepKindChildren
+	}
+	extends := xMLStepBLangExpression.XmlStepExtend()
+	if extends.Size() == 0 {
+		return stepExpr
+	}
+	item := &BLangXMLStepItem{}
+	item.pos = getPosition(n.de(), xMLStepBLangExpression.XmlStepStart())
+	stepExpr.Item = item
+	var extension BLangExpression = item
+	for extend := range extends.Iterator() {
+		pos := getPosition(n.de(), extend)
+		switch x := extend.(type) {
+		case *tree.XMLNamePatternChainingNode:
+			filterExpr := &BLangXMLFilterExpr{Expr: extension, Filters: n.xmlElementFilters(x)}
+			filterExpr.pos = pos
+			extension = filterExpr
+		case *tree.XMLStepIndexedExtendNode:
+			indexBasedAccess := &BLangIndexBasedAccess{}
+			indexBasedAccess.pos = pos
+			indexBasedAccess.Expr = extension
+			indexBasedAccess.IndexExpr = n.createExpression(x.Expression())
+			extension = indexBasedAccess
+		case *tree.XMLStepMethodCallExtendNode:
+			invocation := n.createBLangInvocation(x.MethodName(), x.ParenthesizedArgList().Arguments(), pos, false)
+			invocation.Expr = extension
+			extension = invocation
+		default:
+			panic(fmt.Sprintf("unexpected xml step extend kind: %v", extend.Kind()))
+		}
+	}
+	stepExpr.Extension = extension
+	return stepExpr
+}
+
+// xmlElementFilters returns the alternatives of the name pattern of a `.<...>`, `/<...>` or `/**/<...>` chain.
+func (n *NodeBuilder) xmlElementFilters(chain *tree.XMLNamePatternChainingNode) []BLangXMLElementFilter {
+	var filters []BLangXMLElementFilter
+	patterns := chain.XmlNamePattern()
+	for pattern := range patterns.Iterator() {
+		filter := BLangXMLElementFilter{}
+		filter.pos = getPosition(n.de(), pattern)
+		switch x := pattern.(type) {
+		case *tree.XMLAtomicNamePatternNode:
+			filter.Prefix = x.Prefix().Text()
+			filter.Name = x.Name().Text()
+		case *tree.SimpleNameReferenceNode:
+			filter.Name = x.Name().Text()
+		case tree.Token:
+			if x.Kind() == common.PIPE_TOKEN {
+				continue
+			}
+			filter.Name = x.Text()
+		default:
+			panic(fmt.Sprintf("unexpected xml name pattern kind: %v", pattern.Kind()))
+		}
+		filters = append(filters, filter)
+	}
+	return filters
 }
 
 func (n *NodeBuilder) TransformXMLNamePatternChaining(xMLNamePatternChainingNode *tree.XMLNamePatternChainingNode) BLangNode {
-	panic("TransformXMLNamePatternChaining unimplemented")
+	panic("TransformXMLNamePatternChaining: name pattern chains are transformed as part of the enclosing xml filter or step expression")
 }
 
 func (n *NodeBuilder) TransformXMLStepIndexedExtend(xMLStepIndexedExtendNode *tree.XMLStepIndexedExtendNode) BLangNode {
-	panic("TransformXMLStepIndexedExtend unimplemented")
+	panic("TransformXMLStepIndexedExtend: step extensions are transformed as part of the enclosing xml step expression")
 }
 
 func (n *NodeBuilder) TransformXMLStepMethodCallExtend(xMLStepMethodCallExtendNode *tree.XMLStepMethodCallExtendNode) BLangNode {
-	panic("TransformXMLStepMethodCallExtend unimplemented")
+	panic("TransformXMLStepMethodCallExtend: step extensions are transformed as part of the enclosing xml step expression")
 }
 
 func (n *NodeBuilder) TransformXMLAtomicNamePattern(xMLAtomicNamePatternNode *tree.XMLAtomicNamePatternNode) BLangNode {
-	panic("TransformXMLAtomicNamePattern unimplemented")
+	panic("TransformXMLAtomicNamePattern: name patterns are transformed as part of the enclosing xml name pattern")
 }
 
 func (n *NodeBuilder) TransformTypeReferenceTypeDesc(typeReferenceTypeDescNode *tree.TypeReferenceTypeDescNode) BLangNode {
@@ -4526,8 +5434,7 @@ func (n *NodeBuilder) TransformMatchStatement(matchStatementNode *tree.MatchStat
 
 		// Handle match guard
 		if matchClauseNode.MatchGuard() != nil {
-			matchGuardNode := matchClauseNode.MatchGuard()
-			bLangMatchClause.Guard = n.createExpression(matchGuardNode.Expression())
+			bLangMatchClause.Guard = n.TransformMatchGuard(matchClauseNode.MatchGuard()).(BLangExpression)
 		}
 
 		// Handle match patterns
@@ -4590,6 +5497,16 @@ func (n *NodeBuilder) transformMatchPattern(matchPattern tree.Node, matchStmtExp
 		bLangConstPattern.pos = matchPatternPos
 		return bLangConstPattern
 
+	case common.TYPED_BINDING_PATTERN:
+		typedBindingPattern := matchPattern.(*tree.TypedBindingPatternNode)
+		bindingPattern := n.TransformSyntaxNode(typedBindingPattern.BindingPattern()).(BindingPatternNode)
+		return n.createMatchPatternFromBindingPattern(bindingPattern)
+
+	case common.LIST_MATCH_PATTERN,
+		common.MAPPING_MATCH_PATTERN,
+		common.ERROR_MATCH_PATTERN:
+		return n.TransformSyntaxNode(matchPattern).(BLangMatchPattern)
+
 	case common.PIPE_TOKEN, common.COMMA_TOKEN:
 		// Skip separator tokens in match pattern lists
 		return nil
@@ -4605,38 +5522,223 @@ func (n *NodeBuilder) TransformMatchClause(matchClauseNode *tree.MatchClauseNode
 }
 
 func (n *NodeBuilder) TransformMatchGuard(matchGuardNode *tree.MatchGuardNode) BLangNode {
-	panic("TransformMatchGuard unimplemented")
+	return n.createExpression(matchGuardNode.Expression())
 }
 
 func (n *NodeBuilder) TransformDistinctTypeDescriptor(distinctTypeDescriptorNode *tree.DistinctTypeDescriptorNode) BLangNode {
-	n.cx.Unimplemented("inline distinct object type definitions are not supported", getPosition(n.de(), distinctTypeDescriptorNode))
-	neverType := &BLangValueType{TypeKind: TypeKind_NEVER}
-	neverType.pos = getPosition(n.de(), distinctTypeDescriptorNode)
-	return neverType
+	pos := getPosition(n.de(), distinctTypeDescriptorNode)
+	typeNode := n.createTypeNode(distinctTypeDescriptorNode.TypeDescriptor())
+	switch typeNode := typeNode.(type) {
+	case *BLangErrorTypeNode:
+		typeNode.SetDistinct()
+	case *BLangObjectType:
+		typeNode.SetDistinct()
+	case *BLangUserDefinedType:
+		typeNode.SetDistinct()
+	default:
+		n.cx.SemanticError("distinct type descriptor must be an error or object type", pos)
+		neverType := &BLangValueType{TypeKind: TypeKind_NEVER}
+		neverType.pos = pos
+		return neverType
+	}
+	typeNode.(BLangNode).SetPosition(pos)
+	return typeNode.(BLangNode)
 }
 
 func (n *NodeBuilder) TransformListMatchPattern(listMatchPatternNode *tree.ListMatchPatternNode) BLangNode {
-	panic("TransformListMatchPattern unimplemented")
+	bLListMatchPattern := &BLangListMatchPattern{}
+	bLListMatchPattern.pos = getPosition(n.de(), listMatchPatternNode)
+	matchPatterns := listMatchPatternNode.MatchPatterns()
+	for matchPattern := range matchPatterns.Iterator() {
+		if matchPattern.Kind() == common.REST_MATCH_PATTERN {
+			bLListMatchPattern.RestMatchPattern = n.TransformSyntaxNode(matchPattern).(*BLangRestMatchPattern)
+			continue
+		}
+		if member := n.transformMatchPattern(matchPattern, nil); member != nil {
+			bLListMatchPattern.MatchPatterns = append(bLListMatchPattern.MatchPatterns, member)
+		}
+	}
+	return bLListMatchPattern
 }
 
 func (n *NodeBuilder) TransformRestMatchPattern(restMatchPatternNode *tree.RestMatchPatternNode) BLangNode {
-	panic("TransformRestMatchPattern unimplemented")
+	bLRestMatchPattern := &BLangRestMatchPattern{}
+	bLRestMatchPattern.pos = getPosition(n.de(), restMatchPatternNode)
+	varName := restMatchPatternNode.VariableName().Name()
+	bLRestMatchPattern.Variable = createBindingSimpleVariable(createIdentifierFromToken(getPosition(n.de(), varName), varName), bLRestMatchPattern.pos)
+	return bLRestMatchPattern
 }
 
 func (n *NodeBuilder) TransformMappingMatchPattern(mappingMatchPatternNode *tree.MappingMatchPatternNode) BLangNode {
-	panic("TransformMappingMatchPattern unimplemented")
+	bLMappingMatchPattern := &BLangMappingMatchPattern{}
+	bLMappingMatchPattern.pos = getPosition(n.de(), mappingMatchPatternNode)
+	fieldMatchPatterns := mappingMatchPatternNode.FieldMatchPatterns()
+	for matchPattern := range fieldMatchPatterns.Iterator() {
+		switch matchPattern.Kind() {
+		case common.REST_MATCH_PATTERN:
+			bLMappingMatchPattern.RestMatchPattern = n.TransformSyntaxNode(matchPattern).(*BLangRestMatchPattern)
+		case common.FIELD_MATCH_PATTERN:
+			n.addFieldMatchPattern(bLMappingMatchPattern, n.TransformSyntaxNode(matchPattern).(*BLangFieldMatchPattern))
+		}
+	}
+	return bLMappingMatchPattern
+}
+
+func (n *NodeBuilder) addFieldMatchPattern(mappingMatchPattern *BLangMappingMatchPattern, fieldMatchPattern *BLangFieldMatchPattern) {
+	for _, each := range mappingMatchPattern.FieldMatchPatterns {
+		if each.FieldName.Value == fieldMatchPattern.FieldName.Value {
+			n.cx.SemanticError("duplicate field '"+each.FieldName.Value+"' in mapping match pattern", fieldMatchPattern.pos)
+		}
+	}
+	mappingMatchPattern.FieldMatchPatterns = append(mappingMatchPattern.FieldMatchPatterns, *fieldMatchPattern)
 }
 
 func (n *NodeBuilder) TransformFieldMatchPattern(fieldMatchPatternNode *tree.FieldMatchPatternNode) BLangNode {
-	panic("TransformFieldMatchPattern unimplemented")
+	bLFieldMatchPattern := &BLangFieldMatchPattern{}
+	bLFieldMatchPattern.pos = getPosition(n.de(), fieldMatchPatternNode)
+	fieldName := fieldMatchPatternNode.FieldNameNode()
+	bLFieldMatchPattern.FieldName = new(createIdentifierFromToken(getPosition(n.de(), fieldName), fieldName))
+	bLFieldMatchPattern.MatchPattern = n.transformMatchPattern(fieldMatchPatternNode.MatchPattern(), nil)
+	return bLFieldMatchPattern
 }
 
 func (n *NodeBuilder) TransformErrorMatchPattern(errorMatchPatternNode *tree.ErrorMatchPatternNode) BLangNode {
-	panic("TransformErrorMatchPattern unimplemented")
+	bLErrorMatchPattern := &BLangErrorMatchPattern{}
+	bLErrorMatchPattern.pos = getPosition(n.de(), errorMatchPatternNode)
+	if typeRef := errorMatchPatternNode.TypeReference(); typeRef != nil {
+		bLErrorMatchPattern.ErrorTypeReference = n.createTypeNode(typeRef).(*BLangUserDefinedType)
+	}
+	position := 0
+	argListMatchPatterns := errorMatchPatternNode.ArgListMatchPatternNode()
+	for matchPattern := range argListMatchPatterns.Iterator() {
+		pos := getPosition(n.de(), matchPattern)
+		switch matchPattern.Kind() {
+		case common.COMMA_TOKEN:
+			continue
+		case common.NAMED_ARG_MATCH_PATTERN:
+			fieldMatchPattern := n.TransformSyntaxNode(matchPattern).(*BLangNamedArgMatchPattern)
+			for _, each := range bLErrorMatchPattern.FieldMatchPatterns {
+				if each.ArgName.Value == fieldMatchPattern.ArgName.Value {
+					n.cx.SemanticError("duplicate field '"+each.ArgName.Value+"' in error match pattern", pos)
+				}
+			}
+			bLErrorMatchPattern.FieldMatchPatterns = append(bLErrorMatchPattern.FieldMatchPatterns, *fieldMatchPattern)
+			continue
+		case common.REST_MATCH_PATTERN:
+			bLErrorMatchPattern.RestMatchPattern = n.TransformSyntaxNode(matchPattern).(*BLangRestMatchPattern)
+			continue
+		}
+		switch position {
+		case 0:
+			bLErrorMatchPattern.MessageMatchPattern = n.transformMatchPattern(matchPattern, nil)
+		case 1:
+			bLErrorMatchPattern.CauseMatchPattern = n.transformMatchPattern(matchPattern, nil)
+		default:
+			n.cx.SyntaxError("unexpected positional argument in error match pattern", pos)
+		}
+		position++
+	}
+	return bLErrorMatchPattern
 }
 
 func (n *NodeBuilder) TransformNamedArgMatchPattern(namedArgMatchPatternNode *tree.NamedArgMatchPatternNode) BLangNode {
-	panic("TransformNamedArgMatchPattern unimplemented")
+	bLNamedArgMatchPattern := &BLangNamedArgMatchPattern{}
+	bLNamedArgMatchPattern.pos = getPosition(n.de(), namedArgMatchPatternNode)
+	argName := namedArgMatchPatternNode.Identifier()
+	bLNamedArgMatchPattern.ArgName = new(createIdentifierFromToken(getPosition(n.de(), argName), argName))
+	bLNamedArgMatchPattern.MatchPattern = n.transformMatchPattern(namedArgMatchPatternNode.MatchPattern(), nil)
+	return bLNamedArgMatchPattern
+}
+
+// createMatchPatternFromBindingPattern creates the match pattern of a `var`
+// match pattern: `var [a, {b}]` matches the same values as
+// `[var a, {b: var b}]`.
+func (n *NodeBuilder) createMatchPatternFromBindingPattern(bindingPattern BindingPatternNode) BLangMatchPattern {
+	switch bp := bindingPattern.(type) {
+	case *BLangCaptureBindingPattern:
+		if bp.Identifier.Value == string(model.IGNORE) {
+			wildcard := &BLangWildCardMatchPattern{}
+			wildcard.pos = bp.pos
+			return wildcard
+		}
+		capture := &BLangCaptureMatchPattern{}
+		capture.pos = bp.pos
+		capture.Variable = createBindingSimpleVariable(bp.Identifier, bp.pos)
+		return capture
+	case *BLangWildCardBindingPattern:
+		wildcard := &BLangWildCardMatchPattern{}
+		wildcard.pos = bp.pos
+		return wildcard
+	case *BLangSimpleBindingPattern:
+		if bp.CaptureBindingPattern != nil {
+			return n.createMatchPatternFromBindingPattern(bp.CaptureBindingPattern)
+		}
+		wildcard := &BLangWildCardMatchPattern{}
+		wildcard.pos = bp.pos
+		return wildcard
+	case *BLangListBindingPattern:
+		listMatchPattern := &BLangListMatchPattern{}
+		listMatchPattern.pos = bp.pos
+		for _, member := range bp.BindingPatterns {
+			listMatchPattern.MatchPatterns = append(listMatchPattern.MatchPatterns, n.createMatchPatternFromBindingPattern(member))
+		}
+		listMatchPattern.RestMatchPattern = createRestMatchPatternFromBindingPattern(bp.RestBindingPattern)
+		return listMatchPattern
+	case *BLangMappingBindingPattern:
+		mappingMatchPattern := &BLangMappingMatchPattern{}
+		mappingMatchPattern.pos = bp.pos
+		for i := range bp.FieldBindingPatterns {
+			field := &bp.FieldBindingPatterns[i]
+			fieldMatchPattern := BLangFieldMatchPattern{
+				FieldName:    field.FieldName,
+				MatchPattern: n.createMatchPatternFromBindingPattern(field.BindingPattern),
+			}
+			fieldMatchPattern.pos = field.pos
+			mappingMatchPattern.FieldMatchPatterns = append(mappingMatchPattern.FieldMatchPatterns, fieldMatchPattern)
+		}
+		mappingMatchPattern.RestMatchPattern = createRestMatchPatternFromBindingPattern(bp.RestBindingPattern)
+		return mappingMatchPattern
+	case *BLangErrorBindingPattern:
+		errorMatchPattern := &BLangErrorMatchPattern{}
+		errorMatchPattern.pos = bp.pos
+		errorMatchPattern.ErrorTypeReference = bp.ErrorTypeReference
+		if bp.ErrorMessageBindingPattern != nil {
+			errorMatchPattern.MessageMatchPattern = n.createMatchPatternFromBindingPattern(bp.ErrorMessageBindingPattern.SimpleBindingPattern)
+		}
+		if cause := bp.ErrorCauseBindingPattern; cause != nil {
+			if cause.ErrorBindingPattern != nil {
+				errorMatchPattern.CauseMatchPattern = n.createMatchPatternFromBindingPattern(cause.ErrorBindingPattern)
+			} else {
+				errorMatchPattern.CauseMatchPattern = n.createMatchPatternFromBindingPattern(cause.SimpleBindingPattern)
+			}
+		}
+		if fields := bp.ErrorFieldBindingPatterns; fields != nil {
+			for i := range fields.NamedArgBindingPatterns {
+				namedArg := &fields.NamedArgBindingPatterns[i]
+				fieldMatchPattern := BLangNamedArgMatchPattern{
+					ArgName:      namedArg.ArgName,
+					MatchPattern: n.createMatchPatternFromBindingPattern(namedArg.BindingPattern),
+				}
+				fieldMatchPattern.pos = namedArg.pos
+				errorMatchPattern.FieldMatchPatterns = append(errorMatchPattern.FieldMatchPatterns, fieldMatchPattern)
+			}
+			errorMatchPattern.RestMatchPattern = createRestMatchPatternFromBindingPattern(fields.RestBindingPattern)
+		}
+		return errorMatchPattern
+	default:
+		n.cx.InternalError(fmt.Sprintf("unexpected binding pattern %T in match pattern", bindingPattern), bindingPattern.GetPosition())
+		return nil
+	}
+}
+
+func createRestMatchPatternFromBindingPattern(restBindingPattern *BLangRestBindingPattern) *BLangRestMatchPattern {
+	if restBindingPattern == nil {
+		return nil
+	}
+	restMatchPattern := &BLangRestMatchPattern{}
+	restMatchPattern.pos = restBindingPattern.pos
+	restMatchPattern.Variable = createBindingSimpleVariable(*restBindingPattern.VariableName, restBindingPattern.pos)
+	return restMatchPattern
 }
 
 // Helper functions for markdown documentation transformation
@@ -4954,11 +6056,35 @@ func (n *NodeBuilder) TransformGroupingKeyVarDeclaration(groupingKeyVarDeclarati
 }
 
 func (n *NodeBuilder) TransformOnFailClause(onFailClauseNode *tree.OnFailClauseNode) BLangNode {
-	panic("TransformOnFailClause unimplemented")
+	bLOnFailClause := &BLangOnFailClause{}
+	bLOnFailClause.pos = getPosition(n.de(), onFailClauseNode)
+	body := n.TransformBlockStatement(onFailClauseNode.BlockStatement()).(*BLangBlockStmt)
+	if typedBindingPattern := onFailClauseNode.TypedBindingPattern(); typedBindingPattern != nil {
+		varDef, patternDef := n.createIterationVarDef(typedBindingPattern)
+		if varDef.Var.IsDeclaredWithVar {
+			bLOnFailClause.SetDeclaredWithVar()
+		}
+		bLOnFailClause.SetVariableDefinitionNode(varDef)
+		if patternDef != nil {
+			body.Stmts = append([]StatementNode{patternDef}, body.Stmts...)
+		}
+	}
+	bLOnFailClause.SetBody(body)
+	return bLOnFailClause
 }
 
 func (n *NodeBuilder) TransformDoStatement(doStatementNode *tree.DoStatementNode) BLangNode {
-	panic("TransformDoStatement unimplemented")
+	bLDo := &BLangDo{}
+	bLDo.pos = getPosition(n.de(), doStatementNode)
+	bLBlockStmt := n.TransformBlockStatement(doStatementNode.BlockStatement()).(*BLangBlockStmt)
+	bLBlockStmt.pos = getPosition(n.de(), doStatementNode.BlockStatement())
+	bLDo.SetBody(bLBlockStmt)
+	if doStatementNode.OnFailClause() != nil {
+		bLDo.SetOnFailClause(n.TransformOnFailClause(doStatementNode.OnFailClause()).(*BLangOnFailClause))
+	} else {
+		bLDo.OnFailClause.pos = diagnostics.NewBuiltinLocation()
+	}
+	return bLDo
 }
 
 func (n *NodeBuilder) TransformClassDefinition(classDefinitionNode *tree.ClassDefinitionNode) BLangNode {
@@ -4967,7 +6093,7 @@ func (n *NodeBuilder) TransformClassDefinition(classDefinitionNode *tree.ClassDe
 
 	metadata := classDefinitionNode.Metadata()
 	if metadata != nil && !metadata.IsMissing() {
-		// TODO: Handle annotations
+		n.addAnnotationAttachments(metadata, &blangClass)
 		docString := getDocumentationString(metadata)
 		blangClass.MarkdownDocumentationAttachment = n.createMarkdownDocumentationAttachment(docString)
 	}
@@ -5164,8 +6290,28 @@ func (n *NodeBuilder) TransformParameterizedTypeDescriptor(parameterizedTypeDesc
 		return n.transformTypedescTypeDescriptor(parameterizedTypeDescriptorNode)
 	case common.XML_TYPE_DESC:
 		return n.transformXMLTypeDescriptor(parameterizedTypeDescriptorNode)
+	case common.FUTURE_TYPE_DESC:
+		return n.transformFutureTypeDescriptor(parameterizedTypeDescriptorNode)
 	}
-	panic("TransformParameterizedTypeDescriptor supported only for error, typedesc and xml type descriptors")
+	panic("TransformParameterizedTypeDescriptor supported only for error, typedesc, xml and future type descriptors")
+}
+
+func (n *NodeBuilder) transformFutureTypeDescriptor(node *tree.ParameterizedTypeDescriptorNode) BLangNode {
+	pos := getPosition(n.de(), node)
+	refType := &BLangBuiltInRefTypeNode{
+		TypeKind: TypeKind_FUTURE,
+	}
+	refType.SetPosition(pos)
+	typeParamNode := node.TypeParamNode()
+	if typeParamNode == nil {
+		return refType
+	}
+	constrainedType := &BLangConstrainedType{
+		Type:       TypeData{TypeDescriptor: refType},
+		Constraint: TypeData{TypeDescriptor: n.createTypeNode(typeParamNode.TypeNode())},
+	}
+	constrainedType.SetPosition(pos)
+	return constrainedType
 }
 
 func (n *NodeBuilder) transformTypedescTypeDescriptor(node *tree.ParameterizedTypeDescriptorNode) BLangNode {
@@ -5225,12 +6371,6 @@ func (n *NodeBuilder) transformErrorTypeDescriptor(errorTypeDescriptorNode *tree
 		}
 	}
 
-	// Check if this is a distinct error type
-	parent := errorTypeDescriptorNode.Parent()
-	if parent.Kind() == common.DISTINCT_TYPE_DESC {
-		errorType.SetDistinct()
-	}
-
 	return errorType
 }
 
@@ -5281,7 +6421,8 @@ func (n *NodeBuilder) createResourceAccessSegments(pathNodes tree.NodeList[tree.
 			computed := node.(*tree.ComputedResourceAccessSegmentNode)
 			segments = append(segments, *n.TransformComputedResourceAccessSegment(computed).(*BLangResourceAccessSegment))
 		case common.RESOURCE_ACCESS_REST_SEGMENT:
-			n.cx.Unimplemented("resource access rest segments are not yet supported", getPosition(n.de(), node))
+			rest := node.(*tree.ResourceAccessRestSegmentNode)
+			segments = append(segments, *n.TransformResourceAccessRestSegment(rest).(*BLangResourceAccessSegment))
 		default:
 			n.cx.InternalError(fmt.Sprintf("unexpected resource access segment kind: %v", node.Kind()), getPosition(n.de(), node))
 		}
@@ -5296,96 +6437,108 @@ func (n *NodeBuilder) TransformComputedResourceAccessSegment(node *tree.Computed
 	return seg
 }
 
-func (n *NodeBuilder) TransformResourceAccessRestSegment(resourceAccessRestSegmentNode *tree.ResourceAccessRestSegmentNode) BLangNode {
-	panic("TransformResourceAccessRestSegment unimplemented")
+func (n *NodeBuilder) TransformResourceAccessRestSegment(node *tree.ResourceAccessRestSegmentNode) BLangNode {
+	seg := &BLangResourceAccessSegment{Kind: ResourceAccessSegmentRest}
+	seg.pos = getPosition(n.de(), node)
+	seg.Expr = n.createExpression(node.Expression())
+	return seg
+}
+
+// unexpectedRegExpNode reports a regexp syntax node reaching the node builder.
+// The parser keeps the content of a regexp template as template strings and
+// interpolations (see buildRegExpTemplateExpr), so these nodes never appear in
+// the syntax tree given to the builder.
+func (n *NodeBuilder) unexpectedRegExpNode(node tree.Node) BLangNode {
+	n.cx.InternalError(fmt.Sprintf("unexpected regexp syntax node %T", node), getPosition(n.de(), node))
+	return nil
 }
 
 func (n *NodeBuilder) TransformReSequence(reSequenceNode *tree.ReSequenceNode) BLangNode {
-	panic("TransformReSequence unimplemented")
+	return n.unexpectedRegExpNode(reSequenceNode)
 }
 
 func (n *NodeBuilder) TransformReAtomQuantifier(reAtomQuantifierNode *tree.ReAtomQuantifierNode) BLangNode {
-	panic("TransformReAtomQuantifier unimplemented")
+	return n.unexpectedRegExpNode(reAtomQuantifierNode)
 }
 
 func (n *NodeBuilder) TransformReAtomCharOrEscape(reAtomCharOrEscapeNode *tree.ReAtomCharOrEscapeNode) BLangNode {
-	panic("TransformReAtomCharOrEscape unimplemented")
+	return n.unexpectedRegExpNode(reAtomCharOrEscapeNode)
 }
 
 func (n *NodeBuilder) TransformReQuoteEscape(reQuoteEscapeNode *tree.ReQuoteEscapeNode) BLangNode {
-	panic("TransformReQuoteEscape unimplemented")
+	return n.unexpectedRegExpNode(reQuoteEscapeNode)
 }
 
 func (n *NodeBuilder) TransformReSimpleCharClassEscape(reSimpleCharClassEscapeNode *tree.ReSimpleCharClassEscapeNode) BLangNode {
-	panic("TransformReSimpleCharClassEscape unimplemented")
+	return n.unexpectedRegExpNode(reSimpleCharClassEscapeNode)
 }
 
 func (n *NodeBuilder) TransformReUnicodePropertyEscape(reUnicodePropertyEscapeNode *tree.ReUnicodePropertyEscapeNode) BLangNode {
-	panic("TransformReUnicodePropertyEscape unimplemented")
+	return n.unexpectedRegExpNode(reUnicodePropertyEscapeNode)
 }
 
 func (n *NodeBuilder) TransformReUnicodeScript(reUnicodeScriptNode *tree.ReUnicodeScriptNode) BLangNode {
-	panic("TransformReUnicodeScript unimplemented")
+	return n.unexpectedRegExpNode(reUnicodeScriptNode)
 }
 
 func (n *NodeBuilder) TransformReUnicodeGeneralCategory(reUnicodeGeneralCategoryNode *tree.ReUnicodeGeneralCategoryNode) BLangNode {
-	panic("TransformReUnicodeGeneralCategory unimplemented")
+	return n.unexpectedRegExpNode(reUnicodeGeneralCategoryNode)
 }
 
 func (n *NodeBuilder) TransformReCharacterClass(reCharacterClassNode *tree.ReCharacterClassNode) BLangNode {
-	panic("TransformReCharacterClass unimplemented")
+	return n.unexpectedRegExpNode(reCharacterClassNode)
 }
 
 func (n *NodeBuilder) TransformReCharSetRangeWithReCharSet(reCharSetRangeWithReCharSetNode *tree.ReCharSetRangeWithReCharSetNode) BLangNode {
-	panic("TransformReCharSetRangeWithReCharSet unimplemented")
+	return n.unexpectedRegExpNode(reCharSetRangeWithReCharSetNode)
 }
 
 func (n *NodeBuilder) TransformReCharSetRange(reCharSetRangeNode *tree.ReCharSetRangeNode) BLangNode {
-	panic("TransformReCharSetRange unimplemented")
+	return n.unexpectedRegExpNode(reCharSetRangeNode)
 }
 
 func (n *NodeBuilder) TransformReCharSetAtomWithReCharSetNoDash(reCharSetAtomWithReCharSetNoDashNode *tree.ReCharSetAtomWithReCharSetNoDashNode) BLangNode {
-	panic("TransformReCharSetAtomWithReCharSetNoDash unimplemented")
+	return n.unexpectedRegExpNode(reCharSetAtomWithReCharSetNoDashNode)
 }
 
 func (n *NodeBuilder) TransformReCharSetRangeNoDashWithReCharSet(reCharSetRangeNoDashWithReCharSetNode *tree.ReCharSetRangeNoDashWithReCharSetNode) BLangNode {
-	panic("TransformReCharSetRangeNoDashWithReCharSet unimplemented")
+	return n.unexpectedRegExpNode(reCharSetRangeNoDashWithReCharSetNode)
 }
 
 func (n *NodeBuilder) TransformReCharSetRangeNoDash(reCharSetRangeNoDashNode *tree.ReCharSetRangeNoDashNode) BLangNode {
-	panic("TransformReCharSetRangeNoDash unimplemented")
+	return n.unexpectedRegExpNode(reCharSetRangeNoDashNode)
 }
 
 func (n *NodeBuilder) TransformReCharSetAtomNoDashWithReCharSetNoDash(reCharSetAtomNoDashWithReCharSetNoDashNode *tree.ReCharSetAtomNoDashWithReCharSetNoDashNode) BLangNode {
-	panic("TransformReCharSetAtomNoDashWithReCharSetNoDash unimplemented")
+	return n.unexpectedRegExpNode(reCharSetAtomNoDashWithReCharSetNoDashNode)
 }
 
 func (n *NodeBuilder) TransformReCapturingGroups(reCapturingGroupsNode *tree.ReCapturingGroupsNode) BLangNode {
-	panic("TransformReCapturingGroups unimplemented")
+	return n.unexpectedRegExpNode(reCapturingGroupsNode)
 }
 
 func (n *NodeBuilder) TransformReFlagExpression(reFlagBLangExpression *tree.ReFlagExpressionNode) BLangNode {
-	panic("TransformReFlagExpression unimplemented")
+	return n.unexpectedRegExpNode(reFlagBLangExpression)
 }
 
 func (n *NodeBuilder) TransformReFlagsOnOff(reFlagsOnOffNode *tree.ReFlagsOnOffNode) BLangNode {
-	panic("TransformReFlagsOnOff unimplemented")
+	return n.unexpectedRegExpNode(reFlagsOnOffNode)
 }
 
 func (n *NodeBuilder) TransformReFlags(reFlagsNode *tree.ReFlagsNode) BLangNode {
-	panic("TransformReFlags unimplemented")
+	return n.unexpectedRegExpNode(reFlagsNode)
 }
 
 func (n *NodeBuilder) TransformReAssertion(reAssertionNode *tree.ReAssertionNode) BLangNode {
-	panic("TransformReAssertion unimplemented")
+	return n.unexpectedRegExpNode(reAssertionNode)
 }
 
 func (n *NodeBuilder) TransformReQuantifier(reQuantifierNode *tree.ReQuantifierNode) BLangNode {
-	panic("TransformReQuantifier unimplemented")
+	return n.unexpectedRegExpNode(reQuantifierNode)
 }
 
 func (n *NodeBuilder) TransformReBracedQuantifier(reBracedQuantifierNode *tree.ReBracedQuantifierNode) BLangNode {
-	panic("TransformReBracedQuantifier unimplemented")
+	return n.unexpectedRegExpNode(reBracedQuantifierNode)
 }
 
 func (n *NodeBuilder) TransformMemberTypeDescriptor(memberTypeDescriptorNode *tree.MemberTypeDescriptorNode) BLangNode {
@@ -5505,7 +6658,11 @@ func (n *NodeBuilder) getBLangVariableNode(bindingPattern tree.BindingPatternNod
 		simpleVar.SetName(&ignore)
 		simpleVar.pos = varPos
 		return simpleVar
-	case common.MAPPING_BINDING_PATTERN, common.LIST_BINDING_PATTERN, common.ERROR_BINDING_PATTERN, common.REST_BINDING_PATTERN:
+	case common.MAPPING_BINDING_PATTERN, common.LIST_BINDING_PATTERN, common.ERROR_BINDING_PATTERN:
+		variable := n.createBindingPatternVariable(n.TransformSyntaxNode(bindingPattern).(BindingPatternNode))
+		variable.(BLangNode).SetPosition(varPos)
+		return variable
+	case common.REST_BINDING_PATTERN:
 		panic("unimplemented")
 	case common.CAPTURE_BINDING_PATTERN:
 		fallthrough
@@ -5517,6 +6674,203 @@ func (n *NodeBuilder) getBLangVariableNode(bindingPattern tree.BindingPatternNod
 	return createSimpleVariableNodeWithLocationTokenLocation(varPos, varName, getPosition(n.de(), varName))
 }
 
+// createBindingPatternVariable creates the variable declared by a binding
+// pattern. A wildcard binding pattern declares a simple variable named "_".
+func (n *NodeBuilder) createBindingPatternVariable(bindingPattern BindingPatternNode) VariableNode {
+	switch bp := bindingPattern.(type) {
+	case *BLangCaptureBindingPattern:
+		return createBindingSimpleVariable(bp.Identifier, bp.pos)
+	case *BLangWildCardBindingPattern:
+		ignoreValue := string(model.IGNORE)
+		return createBindingSimpleVariable(createIdentifier(bp.pos, &ignoreValue, &ignoreValue), bp.pos)
+	case *BLangSimpleBindingPattern:
+		if bp.CaptureBindingPattern != nil {
+			return n.createBindingPatternVariable(bp.CaptureBindingPattern)
+		}
+		if bp.WildCardBindingPattern != nil {
+			return n.createBindingPatternVariable(bp.WildCardBindingPattern)
+		}
+		// The syntax error has already been reported.
+		wildcard := &BLangWildCardBindingPattern{}
+		wildcard.pos = bp.pos
+		return n.createBindingPatternVariable(wildcard)
+	case *BLangListBindingPattern:
+		tupleVar := &BLangTupleVariable{}
+		tupleVar.pos = bp.pos
+		for _, member := range bp.BindingPatterns {
+			tupleVar.Members = append(tupleVar.Members, n.createBindingPatternVariable(member))
+		}
+		if bp.RestBindingPattern != nil {
+			tupleVar.RestVariable = createBindingSimpleVariable(*bp.RestBindingPattern.VariableName, bp.RestBindingPattern.pos)
+		}
+		return tupleVar
+	case *BLangMappingBindingPattern:
+		recordVar := &BLangRecordVariable{}
+		recordVar.pos = bp.pos
+		for i := range bp.FieldBindingPatterns {
+			field := &bp.FieldBindingPatterns[i]
+			recordVar.Fields = append(recordVar.Fields, BLangRecordVariableKeyValue{
+				Key:   field.FieldName,
+				Value: n.createBindingPatternVariable(field.BindingPattern),
+			})
+		}
+		if bp.RestBindingPattern != nil {
+			recordVar.RestVariable = createBindingSimpleVariable(*bp.RestBindingPattern.VariableName, bp.RestBindingPattern.pos)
+		}
+		return recordVar
+	case *BLangErrorBindingPattern:
+		errorVar := &BLangErrorVariable{}
+		errorVar.pos = bp.pos
+		errorVar.ErrorTypeReference = bp.ErrorTypeReference
+		if bp.ErrorMessageBindingPattern != nil {
+			errorVar.Message = n.createBindingPatternVariable(bp.ErrorMessageBindingPattern.SimpleBindingPattern).(*BLangSimpleVariable)
+		}
+		if cause := bp.ErrorCauseBindingPattern; cause != nil {
+			if cause.ErrorBindingPattern != nil {
+				errorVar.Cause = n.createBindingPatternVariable(cause.ErrorBindingPattern)
+			} else {
+				errorVar.Cause = n.createBindingPatternVariable(cause.SimpleBindingPattern)
+			}
+		}
+		if fields := bp.ErrorFieldBindingPatterns; fields != nil {
+			for i := range fields.NamedArgBindingPatterns {
+				arg := &fields.NamedArgBindingPatterns[i]
+				errorVar.Detail = append(errorVar.Detail, BLangErrorDetailEntry{
+					Key:   arg.ArgName,
+					Value: n.createBindingPatternVariable(arg.BindingPattern),
+				})
+			}
+			if fields.RestBindingPattern != nil {
+				errorVar.RestDetail = createBindingSimpleVariable(*fields.RestBindingPattern.VariableName, fields.RestBindingPattern.pos)
+			}
+		}
+		return errorVar
+	default:
+		panic(fmt.Sprintf("unexpected binding pattern %T", bindingPattern))
+	}
+}
+
+func createBindingSimpleVariable(name BLangIdentifier, pos diagnostics.Location) *BLangSimpleVariable {
+	simpleVar := createSimpleVariableNode()
+	simpleVar.SetName(&name)
+	simpleVar.pos = pos
+	return simpleVar
+}
+
+// createBindingPatternVarRef creates the left-hand side of a destructuring
+// assignment from a binding pattern. A wildcard binding pattern is kept as is.
+func (n *NodeBuilder) createBindingPatternVarRef(bindingPattern BindingPatternNode) LExpr {
+	switch bp := bindingPattern.(type) {
+	case *BLangCaptureBindingPattern:
+		return createBindingVarRef(bp.Identifier, bp.pos)
+	case *BLangWildCardBindingPattern:
+		return bp
+	case *BLangSimpleBindingPattern:
+		if bp.CaptureBindingPattern != nil {
+			return n.createBindingPatternVarRef(bp.CaptureBindingPattern)
+		}
+		if bp.WildCardBindingPattern != nil {
+			return bp.WildCardBindingPattern
+		}
+		// The syntax error has already been reported.
+		wildcard := &BLangWildCardBindingPattern{}
+		wildcard.pos = bp.pos
+		return wildcard
+	case *BLangListBindingPattern:
+		tupleVarRef := &BLangTupleVarRef{}
+		tupleVarRef.pos = bp.pos
+		for _, member := range bp.BindingPatterns {
+			tupleVarRef.Expressions = append(tupleVarRef.Expressions, n.createBindingPatternVarRef(member))
+		}
+		if bp.RestBindingPattern != nil {
+			tupleVarRef.RestParam = createBindingVarRef(*bp.RestBindingPattern.VariableName, bp.RestBindingPattern.pos)
+		}
+		return tupleVarRef
+	case *BLangMappingBindingPattern:
+		recordVarRef := &BLangRecordVarRef{}
+		recordVarRef.pos = bp.pos
+		for i := range bp.FieldBindingPatterns {
+			field := &bp.FieldBindingPatterns[i]
+			recordVarRef.RecordRefFields = append(recordVarRef.RecordRefFields, BLangRecordVarRefKeyValue{
+				VariableName:      field.FieldName,
+				VariableReference: n.createBindingPatternVarRef(field.BindingPattern),
+			})
+		}
+		if bp.RestBindingPattern != nil {
+			recordVarRef.RestParam = createBindingVarRef(*bp.RestBindingPattern.VariableName, bp.RestBindingPattern.pos)
+		}
+		return recordVarRef
+	case *BLangErrorBindingPattern:
+		errorVarRef := &BLangErrorVarRef{}
+		errorVarRef.pos = bp.pos
+		errorVarRef.ErrorTypeReference = bp.ErrorTypeReference
+		if bp.ErrorMessageBindingPattern != nil {
+			errorVarRef.Message = n.createBindingPatternVarRef(bp.ErrorMessageBindingPattern.SimpleBindingPattern)
+		}
+		if cause := bp.ErrorCauseBindingPattern; cause != nil {
+			if cause.ErrorBindingPattern != nil {
+				errorVarRef.Cause = n.createBindingPatternVarRef(cause.ErrorBindingPattern)
+			} else {
+				errorVarRef.Cause = n.createBindingPatternVarRef(cause.SimpleBindingPattern)
+			}
+		}
+		if fields := bp.ErrorFieldBindingPatterns; fields != nil {
+			for i := range fields.NamedArgBindingPatterns {
+				arg := &fields.NamedArgBindingPatterns[i]
+				detail := BLangNamedArgsExpression{Name: *arg.ArgName, Expr: n.createBindingPatternVarRef(arg.BindingPattern)}
+				detail.pos = arg.pos
+				errorVarRef.Detail = append(errorVarRef.Detail, detail)
+			}
+			if fields.RestBindingPattern != nil {
+				errorVarRef.RestVar = createBindingVarRef(*fields.RestBindingPattern.VariableName, fields.RestBindingPattern.pos)
+			}
+		}
+		return errorVarRef
+	default:
+		panic(fmt.Sprintf("unexpected binding pattern %T", bindingPattern))
+	}
+}
+
+func bindingPatternVariableBase(variable VariableNode) *BLangVariableBase {
+	switch variable := variable.(type) {
+	case *BLangTupleVariable:
+		return &variable.BLangVariableBase
+	case *BLangRecordVariable:
+		return &variable.BLangVariableBase
+	case *BLangErrorVariable:
+		return &variable.BLangVariableBase
+	default:
+		panic(fmt.Sprintf("unexpected binding pattern variable %T", variable))
+	}
+}
+
+func createBindingVarRef(name BLangIdentifier, pos diagnostics.Location) *BLangSimpleVarRef {
+	emptyStr := ""
+	varRef := &BLangSimpleVarRef{}
+	varRef.pos = pos
+	varRef.PkgAlias = new(createIdentifier(diagnostics.NewBuiltinLocation(), &emptyStr, &emptyStr))
+	varRef.VariableName = &name
+	return varRef
+}
+
+// createBindingPatternVarDef wraps a variable declared by a list, mapping or
+// error binding pattern in the matching variable definition.
+func createBindingPatternVarDef(variable VariableNode, pos diagnostics.Location) VariableDefinitionNode {
+	var varDef VariableDefinitionNode
+	switch variable := variable.(type) {
+	case *BLangTupleVariable:
+		varDef = &BLangTupleVariableDef{Var: variable}
+	case *BLangRecordVariable:
+		varDef = &BLangRecordVariableDef{Var: variable}
+	case *BLangErrorVariable:
+		varDef = &BLangErrorVariableDef{Var: variable}
+	default:
+		panic(fmt.Sprintf("unexpected binding pattern variable %T", variable))
+	}
+	varDef.(BLangNode).SetPosition(pos)
+	return varDef
+}
+
 func (n *NodeBuilder) reportSyntaxDiagnostics(node tree.Node) {
 	diagnostics := innermostDiagnosticNodes(node)
 	if len(diagnostics) == 0 {
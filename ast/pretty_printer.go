@@ -57,6 +57,8 @@ func (p *PrettyPrinter) PrintInner(node BLangNode) {
 		p.printResourceMethod(t)
 	case *BLangBlockFunctionBody:
 		p.printBlockFunctionBody(t)
+	case *BLangExprFunctionBody:
+		p.printExprFunctionBody(t)
 	case *BLangSimpleVariable:
 		p.printSimpleVariable(t)
 	case *BLangIf:
@@ -83,6 +85,8 @@ func (p *PrettyPrinter) PrintInner(node BLangNode) {
 		p.printClientResourceAccessAction(t)
 	case *BLangNamedArgsExpression:
 		p.printNamedArgsExpression(t)
+	case *BLangRestArgsExpression:
+		p.printRestArgsExpression(t)
 	case *BLangValueType:
 		p.printValueType(t)
 	case *BLangBuiltInRefTypeNode:
@@ -91,12 +95,72 @@ func (p *PrettyPrinter) PrintInner(node BLangNode) {
 		p.printUnaryExpr(t)
 	case *BLangSimpleVariableDef:
 		p.printSimpleVariableDef(t)
+	case *BLangTupleVariableDef:
+		p.printStructuredVariableDef("tuple-var-def", t.Var)
+	case *BLangRecordVariableDef:
+		p.printStructuredVariableDef("record-var-def", t.Var)
+	case *BLangErrorVariableDef:
+		p.printStructuredVariableDef("error-var-def", t.Var)
+	case *BLangTupleVariable:
+		p.printTupleVariable(t)
+	case *BLangRecordVariable:
+		p.printRecordVariable(t)
+	case *BLangErrorVariable:
+		p.printErrorVariable(t)
+	case *BLangTupleVarRef:
+		p.printTupleVarRef(t)
+	case *BLangRecordVarRef:
+		p.printRecordVarRef(t)
+	case *BLangErrorVarRef:
+		p.printErrorVarRef(t)
 	case *BLangGroupExpr:
 		p.printGroupExpr(t)
+	case *BLangElvisExpr:
+		p.printElvisExpr(t)
+	case *BLangTernaryExpr:
+		p.printTernaryExpr(t)
 	case *BLangWhile:
 		p.printWhile(t)
 	case *BLangLock:
 		p.printLock(t)
+	case *BLangWorker:
+		p.printWorker(t)
+	case *BLangFork:
+		p.printFork(t)
+	case *BLangTransaction:
+		p.printTransaction(t)
+	case *BLangRetry:
+		p.printRetry(t)
+	case *BLangRetrySpec:
+		p.printRetrySpec(t)
+	case *BLangRollback:
+		p.printRollback(t)
+	case *BLangCommitExpr:
+		p.printCommitExpr(t)
+	case *BLangTransactionalExpr:
+		p.printTransactionalExpr(t)
+	case *BLangDo:
+		p.printDo(t)
+	case *BLangFail:
+		p.printFail(t)
+	case *BLangOnFailClause:
+		p.printOnFailClause(t)
+	case *BLangWorkerAsyncSendExpr:
+		p.printWorkerSend("worker-async-send", &t.BLangWorkerSendExprBase)
+	case *BLangWorkerSyncSendExpr:
+		p.printWorkerSend("worker-sync-send", &t.BLangWorkerSendExprBase)
+	case *BLangWorkerReceive:
+		p.printWorkerReceive(t)
+	case *BLangAlternateWorkerReceive:
+		p.printAlternateWorkerReceive(t)
+	case *BLangMultipleWorkerReceive:
+		p.printMultipleWorkerReceive(t)
+	case *BLangWaitExpr:
+		p.printWaitExpr(t)
+	case *BLangWaitForAllExpr:
+		p.printWaitForAllExpr(t)
+	case *BLangWorkerFlushExpr:
+		p.printWorkerFlush(t)
 	case *BLangForeach:
 		p.printForeach(t)
 	case *BLangArrayType:
@@ -125,6 +189,12 @@ func (p *PrettyPrinter) PrintInner(node BLangNode) {
 		p.printConstrainedType(t)
 	case *BLangStreamType:
 		p.printStreamType(t)
+	case *BLangTableType:
+		p.printTableType(t)
+	case *BLangTableKeySpecifier:
+		p.printTableKeySpecifier(t)
+	case *BLangTableKeyTypeConstraint:
+		p.printTableKeyTypeConstraint(t)
 	case *BLangTypeDefinition:
 		p.printTypeDefinition(t)
 	case *BLangUserDefinedType:
@@ -135,10 +205,14 @@ func (p *PrettyPrinter) PrintInner(node BLangNode) {
 		p.printListConstructorExpr(t)
 	case *BLangMappingConstructorExpr:
 		p.printMappingConstructor(t)
+	case *BLangTableConstructorExpr:
+		p.printTableConstructorExpr(t)
 	case *BLangTypeConversionExpr:
 		p.printTypeConversionExpr(t)
 	case *BLangTypeTestExpr:
 		p.printTypeTestExpr(t)
+	case *BLangTypedescExpr:
+		p.printTypedescExpr(t)
 	case *BLangTupleTypeNode:
 		p.printTupleTypeNode(t)
 	case *BLangRecordType:
@@ -187,12 +261,16 @@ func (p *PrettyPrinter) PrintInner(node BLangNode) {
 		p.printOnConflictClause(t)
 	case *BLangCollectClause:
 		p.printCollectClause(t)
+	case *BLangDoClause:
+		p.printDoClause(t)
 	case *BLangCheckedExpr:
 		p.printCheckedExpr(t)
 	case *BLangCheckPanickedExpr:
 		p.printCheckPanickedExpr(t)
 	case *BLangTrapExpr:
 		p.printTrapExpr(t)
+	case *BLangLetExpr:
+		p.printLetExpr(t)
 	case *BLangPanic:
 		p.printPanic(t)
 	case *BLangMatchStatement:
@@ -201,6 +279,14 @@ func (p *PrettyPrinter) PrintInner(node BLangNode) {
 		p.printConstPattern(t)
 	case *BLangWildCardMatchPattern:
 		p.printWildCardMatchPattern(t)
+	case *BLangCaptureMatchPattern:
+		p.printCaptureMatchPattern(t)
+	case *BLangListMatchPattern:
+		p.printListMatchPattern(t)
+	case *BLangMappingMatchPattern:
+		p.printMappingMatchPattern(t)
+	case *BLangErrorMatchPattern:
+		p.printErrorMatchPattern(t)
 	case *BLangMatchClause:
 		p.printMatchClause(t)
 	case *BLangFunctionType:
@@ -239,8 +325,22 @@ func (p *PrettyPrinter) PrintInner(node BLangNode) {
 		p.printXMLCommentLiteral(t)
 	case *BLangXMLTextLiteral:
 		p.printXMLTextLiteral(t)
+	case *BLangXMLFilterExpr:
+		p.printXMLFilterExpr(t)
+	case *BLangXMLStepExpr:
+		p.printXMLStepExpr(t)
+	case *BLangXMLStepItem:
+		p.StartNode()
+		p.PrintString("xml-step-item")
+		p.EndNode()
 	case *BLangXMLNS:
 		p.printXMLNS(t)
+	case *BLangAnnotation:
+		p.printAnnotation(t)
+	case *BLangAnnotationAttachment:
+		p.printAnnotationAttachment(t)
+	case *BLangAnnotAccessExpr:
+		p.printAnnotAccessExpr(t)
 	default:
 		if p.Fallback != nil {
 			p.Fallback(p, node)
@@ -251,6 +351,71 @@ func (p *PrettyPrinter) PrintInner(node BLangNode) {
 	}
 }
 
+func (p *PrettyPrinter) printAnnotation(node *BLangAnnotation) {
+	p.StartNode()
+	p.PrintString("annotation")
+	if node.IsPublic() {
+		p.PrintString("public")
+	}
+	if node.IsConst() {
+		p.PrintString("const")
+	}
+	p.PrintString(node.Name.Value)
+	p.PrintString("(on")
+	for _, attachPoint := range node.AttachPoints {
+		if attachPoint.Source {
+			p.PrintString("source")
+		}
+		p.PrintString(string(attachPoint.Point))
+	}
+	p.printSticky(")")
+	p.indentLevel++
+	if node.typeDescriptor != nil {
+		p.PrintInner(node.typeDescriptor.(BLangNode))
+	}
+	p.printAnnotationAttachments(node.GetAnnotationAttachments())
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printAnnotationAttachment(node *BLangAnnotationAttachment) {
+	p.StartNode()
+	p.PrintString("annotation-attachment")
+	p.printAnnotationName(node.PkgAlias, node.AnnotationName)
+	if node.Expr != nil {
+		p.indentLevel++
+		p.PrintInner(node.Expr.(BLangNode))
+		p.indentLevel--
+	}
+	p.EndNode()
+}
+
+// printAnnotationAttachments prints nothing when there are no attachments, so that unannotated declarations print
+// as they did before annotations were supported.
+func (p *PrettyPrinter) printAnnotationAttachments(attachments []AnnotationAttachmentNode) {
+	for _, attachment := range attachments {
+		p.PrintInner(attachment.(BLangNode))
+	}
+}
+
+func (p *PrettyPrinter) printAnnotAccessExpr(node *BLangAnnotAccessExpr) {
+	p.StartNode()
+	p.PrintString("annot-access-expr")
+	p.printAnnotationName(node.PkgAlias, node.AnnotationName)
+	p.indentLevel++
+	p.PrintInner(node.Expr.(BLangNode))
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printAnnotationName(pkgAlias, name *BLangIdentifier) {
+	if pkgAlias != nil && pkgAlias.Value != "" {
+		p.PrintString(pkgAlias.Value + ":" + name.Value)
+		return
+	}
+	p.PrintString(name.Value)
+}
+
 func (p *PrettyPrinter) printXMLNS(node *BLangXMLNS) {
 	p.StartNode()
 	p.PrintString("xmlns")
@@ -351,6 +516,9 @@ func (p *PrettyPrinter) printPackage(node *BLangPackage) {
 	for i := range node.Functions {
 		p.printFunction(&node.Functions[i])
 	}
+	for i := range node.Annotations {
+		p.printAnnotation(&node.Annotations[i])
+	}
 	p.indentLevel--
 	p.EndNode()
 }
@@ -417,6 +585,10 @@ func (p *PrettyPrinter) printTemplateExpr(node *BLangTemplateExpr) {
 		p.PrintString("string-template-literal")
 	case TemplateExprKindXML:
 		p.PrintString("xml-template-literal")
+	case TemplateExprKindRegExp:
+		p.PrintString("regexp-template-literal")
+	case TemplateExprKindRaw:
+		p.PrintString("raw-template-literal")
 	default:
 		panic("unsupported template expr kind")
 	}
@@ -448,6 +620,12 @@ func (p *PrettyPrinter) printXMLTemplateExpr(node *BLangXMLTemplateExpr) {
 			switch node.InsertionKinds[i] {
 			case XMLTemplateInsertionKindAttribute:
 				p.PrintString("xml-template-attribute-insertion")
+			case XMLTemplateInsertionKindComment:
+				p.PrintString("xml-template-comment-insertion")
+			case XMLTemplateInsertionKindPI:
+				p.PrintString("xml-template-pi-insertion")
+			case XMLTemplateInsertionKindCDATA:
+				p.PrintString("xml-template-cdata-insertion")
 			default:
 				p.PrintString("xml-template-content-insertion")
 			}
@@ -521,6 +699,47 @@ func (p *PrettyPrinter) printXMLTextLiteral(node *BLangXMLTextLiteral) {
 	p.EndNode()
 }
 
+func (p *PrettyPrinter) printXMLFilterExpr(node *BLangXMLFilterExpr) {
+	p.StartNode()
+	p.PrintString("xml-filter-expr")
+	p.printXMLElementFilters(node.Filters)
+	p.indentLevel++
+	p.PrintInner(node.Expr.(BLangNode))
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printXMLStepExpr(node *BLangXMLStepExpr) {
+	p.StartNode()
+	p.PrintString("xml-step-expr")
+	switch node.Kind {
+	case XMLStepKindChildren:
+		p.PrintString("children")
+	case XMLStepKindChildElements:
+		p.PrintString("child-elements")
+	case XMLStepKindDescendants:
+		p.PrintString("descendants")
+	}
+	p.printXMLElementFilters(node.Filters)
+	p.indentLevel++
+	p.PrintInner(node.Expr.(BLangNode))
+	if node.Extension != nil {
+		p.PrintInner(node.Extension.(BLangNode))
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printXMLElementFilters(filters []BLangXMLElementFilter) {
+	for _, filter := range filters {
+		if filter.Prefix != "" {
+			p.PrintString(filter.Prefix + ":" + filter.Name)
+		} else {
+			p.PrintString(filter.Name)
+		}
+	}
+}
+
 // Literal and basic expression printers
 func (p *PrettyPrinter) printLiteral(node *BLangLiteral) {
 	p.StartNode()
@@ -562,6 +781,9 @@ func (p *PrettyPrinter) printBinaryExpr(node *BLangBinaryExpr) {
 func (p *PrettyPrinter) printInvocation(node *BLangInvocation) {
 	p.StartNode()
 	p.PrintString("invocation")
+	if node.Async {
+		p.PrintString("start")
+	}
 
 	// Print function name with optional package alias
 	if node.PkgAlias != nil && node.PkgAlias.Value != "" {
@@ -636,6 +858,9 @@ func (p *PrettyPrinter) printResourceMethod(node *BLangResourceMethod) {
 func (p *PrettyPrinter) printClientResourceAccessAction(node *BLangClientResourceAccessAction) {
 	p.StartNode()
 	p.PrintString("client-resource-access")
+	if node.Async {
+		p.PrintString("start")
+	}
 	p.PrintString(node.MethodName)
 	p.indentLevel++
 	if node.Expr != nil {
@@ -650,6 +875,9 @@ func (p *PrettyPrinter) printClientResourceAccessAction(node *BLangClientResourc
 		case ResourceAccessSegmentComputed:
 			p.PrintString("computed:")
 			p.PrintInner(seg.Expr)
+		case ResourceAccessSegmentRest:
+			p.PrintString("rest:")
+			p.PrintInner(seg.Expr)
 		}
 	}
 	for _, arg := range node.ArgExprs {
@@ -662,6 +890,9 @@ func (p *PrettyPrinter) printClientResourceAccessAction(node *BLangClientResourc
 func (p *PrettyPrinter) printRemoteMethodCallAction(node *BLangRemoteMethodCallAction) {
 	p.StartNode()
 	p.PrintString("remote-method-call")
+	if node.Async {
+		p.PrintString("start")
+	}
 	p.PrintString(node.Name.Value)
 
 	if node.Expr != nil {
@@ -684,6 +915,15 @@ func (p *PrettyPrinter) printRemoteMethodCallAction(node *BLangRemoteMethodCallA
 	p.EndNode()
 }
 
+func (p *PrettyPrinter) printRestArgsExpression(node *BLangRestArgsExpression) {
+	p.StartNode()
+	p.PrintString("rest-arg")
+	p.indentLevel++
+	p.PrintInner(node.Expr.(BLangNode))
+	p.indentLevel--
+	p.EndNode()
+}
+
 func (p *PrettyPrinter) printNamedArgsExpression(node *BLangNamedArgsExpression) {
 	p.StartNode()
 	p.PrintString("named-arg")
@@ -770,6 +1010,9 @@ func (p *PrettyPrinter) printSimpleVariable(node *BLangSimpleVariable) {
 	p.StartNode()
 	p.PrintString("variable")
 	p.PrintString(node.Name.Value)
+	p.indentLevel++
+	p.printAnnotationAttachments(node.GetAnnotationAttachments())
+	p.indentLevel--
 	if node.TypeNode() != nil {
 		p.PrintString("(type")
 		p.indentLevel++
@@ -798,6 +1041,15 @@ func (p *PrettyPrinter) printBlockFunctionBody(node *BLangBlockFunctionBody) {
 	p.EndNode()
 }
 
+func (p *PrettyPrinter) printExprFunctionBody(node *BLangExprFunctionBody) {
+	p.StartNode()
+	p.PrintString("expr-function-body")
+	p.indentLevel++
+	p.PrintInner(node.Expr.(BLangNode))
+	p.indentLevel--
+	p.EndNode()
+}
+
 // Function printer
 func (p *PrettyPrinter) printFunction(node *BLangFunction) {
 	p.StartNode()
@@ -813,6 +1065,10 @@ func (p *PrettyPrinter) printFunction(node *BLangFunction) {
 		p.indentLevel--
 	}
 
+	p.indentLevel++
+	p.printAnnotationAttachments(node.GetAnnotationAttachments())
+	p.indentLevel--
+
 	// Print parameters
 	p.PrintString("(")
 	p.indentLevel++
@@ -827,6 +1083,7 @@ func (p *PrettyPrinter) printFunction(node *BLangFunction) {
 	if node.GetReturnTypeDescriptor() != nil {
 		p.indentLevel++
 		p.PrintInner(node.GetReturnTypeDescriptor().(BLangNode))
+		p.printAnnotationAttachments(node.GetReturnTypeAnnotationAttachments())
 		p.indentLevel--
 	}
 	p.printSticky(")")
@@ -862,6 +1119,140 @@ func (p *PrettyPrinter) printSimpleVariableDef(node *BLangSimpleVariableDef) {
 	p.EndNode()
 }
 
+func (p *PrettyPrinter) printStructuredVariableDef(label string, variable BLangNode) {
+	p.StartNode()
+	p.PrintString(label)
+	p.indentLevel++
+	p.PrintInner(variable)
+	p.indentLevel--
+	p.EndNode()
+}
+
+// printBindingPart prints a labeled part of a binding pattern variable or
+// variable reference, such as its rest binding.
+func (p *PrettyPrinter) printBindingPart(label string, node BLangNode) {
+	p.StartNode()
+	p.PrintString(label)
+	p.indentLevel++
+	p.PrintInner(node)
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printStructuredVariableTypeAndExpr(node *BLangVariableBase) {
+	if node.TypeNode() != nil {
+		p.printBindingPart("type", node.TypeNode().(BLangNode))
+	}
+	if node.Expr != nil {
+		p.printBindingPart("expr", node.Expr.(BLangNode))
+	}
+}
+
+func (p *PrettyPrinter) printTupleVariable(node *BLangTupleVariable) {
+	p.StartNode()
+	p.PrintString("tuple-variable")
+	p.indentLevel++
+	for _, member := range node.Members {
+		p.PrintInner(member.(BLangNode))
+	}
+	if node.RestVariable != nil {
+		p.printBindingPart("rest", node.RestVariable)
+	}
+	p.printStructuredVariableTypeAndExpr(&node.BLangVariableBase)
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printRecordVariable(node *BLangRecordVariable) {
+	p.StartNode()
+	p.PrintString("record-variable")
+	p.indentLevel++
+	for _, field := range node.Fields {
+		p.printBindingPart("field "+field.Key.Value, field.Value.(BLangNode))
+	}
+	if node.RestVariable != nil {
+		p.printBindingPart("rest", node.RestVariable)
+	}
+	p.printStructuredVariableTypeAndExpr(&node.BLangVariableBase)
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printErrorVariable(node *BLangErrorVariable) {
+	p.StartNode()
+	p.PrintString("error-variable")
+	p.indentLevel++
+	if node.ErrorTypeReference != nil {
+		p.PrintInner(node.ErrorTypeReference)
+	}
+	if node.Message != nil {
+		p.printBindingPart("message", node.Message)
+	}
+	if node.Cause != nil {
+		p.printBindingPart("cause", node.Cause.(BLangNode))
+	}
+	for _, entry := range node.Detail {
+		p.printBindingPart("detail "+entry.Key.Value, entry.Value.(BLangNode))
+	}
+	if node.RestDetail != nil {
+		p.printBindingPart("rest", node.RestDetail)
+	}
+	p.printStructuredVariableTypeAndExpr(&node.BLangVariableBase)
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printTupleVarRef(node *BLangTupleVarRef) {
+	p.StartNode()
+	p.PrintString("tuple-var-ref")
+	p.indentLevel++
+	for _, expr := range node.Expressions {
+		p.PrintInner(expr)
+	}
+	if node.RestParam != nil {
+		p.printBindingPart("rest", node.RestParam)
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printRecordVarRef(node *BLangRecordVarRef) {
+	p.StartNode()
+	p.PrintString("record-var-ref")
+	p.indentLevel++
+	for _, field := range node.RecordRefFields {
+		p.printBindingPart("field "+field.VariableName.Value, field.VariableReference)
+	}
+	if node.RestParam != nil {
+		p.printBindingPart("rest", node.RestParam)
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printErrorVarRef(node *BLangErrorVarRef) {
+	p.StartNode()
+	p.PrintString("error-var-ref")
+	p.indentLevel++
+	if node.ErrorTypeReference != nil {
+		p.PrintInner(node.ErrorTypeReference)
+	}
+	if node.Message != nil {
+		p.printBindingPart("message", node.Message)
+	}
+	if node.Cause != nil {
+		p.printBindingPart("cause", node.Cause)
+	}
+	for i := range node.Detail {
+		p.PrintInner(&node.Detail[i])
+	}
+	if node.RestVar != nil {
+		p.printBindingPart("rest", node.RestVar)
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
 // Grouped expression printer
 func (p *PrettyPrinter) printGroupExpr(node *BLangGroupExpr) {
 	p.StartNode()
@@ -872,6 +1263,27 @@ func (p *PrettyPrinter) printGroupExpr(node *BLangGroupExpr) {
 	p.EndNode()
 }
 
+func (p *PrettyPrinter) printElvisExpr(node *BLangElvisExpr) {
+	p.StartNode()
+	p.PrintString("elvis-expr")
+	p.indentLevel++
+	p.PrintInner(node.LhsExpr.(BLangNode))
+	p.PrintInner(node.RhsExpr.(BLangNode))
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printTernaryExpr(node *BLangTernaryExpr) {
+	p.StartNode()
+	p.PrintString("ternary-expr")
+	p.indentLevel++
+	p.PrintInner(node.Expr.(BLangNode))
+	p.PrintInner(node.ThenExpr.(BLangNode))
+	p.PrintInner(node.ElseExpr.(BLangNode))
+	p.indentLevel--
+	p.EndNode()
+}
+
 func (p *PrettyPrinter) printTypeConversionExpr(node *BLangTypeConversionExpr) {
 	p.StartNode()
 	p.PrintString("type-conversion-expr")
@@ -900,10 +1312,24 @@ func (p *PrettyPrinter) printTypeTestExpr(node *BLangTypeTestExpr) {
 	p.EndNode()
 }
 
+func (p *PrettyPrinter) printTypedescExpr(node *BLangTypedescExpr) {
+	p.StartNode()
+	p.PrintString("typedesc-expr")
+	if node.typeDescriptor != nil {
+		p.indentLevel++
+		p.PrintInner(node.typeDescriptor.(BLangNode))
+		p.indentLevel--
+	}
+	p.EndNode()
+}
+
 func (p *PrettyPrinter) printQueryExpr(node *BLangQueryExpr) {
 	p.StartNode()
 	p.PrintString("query-expr")
 	p.indentLevel++
+	if node.TableKeySpecifier != nil {
+		p.PrintInner(node.TableKeySpecifier)
+	}
 	for i := range node.QueryClauseList {
 		p.PrintInner(node.QueryClauseList[i])
 	}
@@ -950,8 +1376,8 @@ func (p *PrettyPrinter) printLetClause(node *BLangLetClause) {
 	p.StartNode()
 	p.PrintString("let-clause")
 	p.indentLevel++
-	for i := range node.LetVarDeclarations {
-		p.PrintInner(&node.LetVarDeclarations[i])
+	for _, varDef := range node.LetVarDeclarations {
+		p.PrintInner(varDef.(BLangNode))
 	}
 	p.indentLevel--
 	p.EndNode()
@@ -1075,6 +1501,15 @@ func (p *PrettyPrinter) printCollectClause(node *BLangCollectClause) {
 	p.EndNode()
 }
 
+func (p *PrettyPrinter) printDoClause(node *BLangDoClause) {
+	p.StartNode()
+	p.PrintString("do-clause")
+	p.indentLevel++
+	p.PrintInner(node.Body)
+	p.indentLevel--
+	p.EndNode()
+}
+
 // While loop printer
 func (p *PrettyPrinter) printWhile(node *BLangWhile) {
 	p.StartNode()
@@ -1082,7 +1517,9 @@ func (p *PrettyPrinter) printWhile(node *BLangWhile) {
 	p.indentLevel++
 	p.PrintInner(node.Expr.(BLangNode))
 	p.PrintInner(&node.Body)
-	// OnFailClause handling can be added if needed in the future
+	if node.OnFailClause.Body != nil {
+		p.PrintInner(&node.OnFailClause)
+	}
 	p.indentLevel--
 	p.EndNode()
 }
@@ -1092,10 +1529,215 @@ func (p *PrettyPrinter) printLock(node *BLangLock) {
 	p.PrintString("lock")
 	p.indentLevel++
 	p.PrintInner(&node.Body)
+	if node.OnFailClause != nil {
+		p.PrintInner(node.OnFailClause)
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printWorker(node *BLangWorker) {
+	p.StartNode()
+	p.PrintString("worker")
+	p.PrintString(node.Name.Value)
+	p.indentLevel++
+	p.PrintInner(node.Lambda)
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printFork(node *BLangFork) {
+	p.StartNode()
+	p.PrintString("fork")
+	p.indentLevel++
+	for i := range node.Workers {
+		p.PrintInner(&node.Workers[i])
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printTransaction(node *BLangTransaction) {
+	p.StartNode()
+	p.PrintString("transaction")
+	p.indentLevel++
+	p.PrintInner(&node.Body)
+	if node.OnFailClause != nil {
+		p.PrintInner(node.OnFailClause)
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printRetry(node *BLangRetry) {
+	p.StartNode()
+	p.PrintString("retry")
+	p.indentLevel++
+	p.PrintInner(&node.RetrySpec)
+	if node.Transaction != nil {
+		p.PrintInner(node.Transaction)
+	} else {
+		p.PrintInner(&node.Body)
+	}
+	if node.OnFailClause != nil {
+		p.PrintInner(node.OnFailClause)
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printRetrySpec(node *BLangRetrySpec) {
+	p.StartNode()
+	p.PrintString("retry-spec")
+	p.indentLevel++
+	if node.RetryManager != nil {
+		p.PrintInner(node.RetryManager)
+	}
+	for _, arg := range node.ArgExprs {
+		p.PrintInner(arg.(BLangNode))
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printRollback(node *BLangRollback) {
+	p.StartNode()
+	p.PrintString("rollback")
+	if node.Expr != nil {
+		p.indentLevel++
+		p.PrintInner(node.Expr.(BLangNode))
+		p.indentLevel--
+	}
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printCommitExpr(node *BLangCommitExpr) {
+	p.StartNode()
+	p.PrintString("commit")
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printTransactionalExpr(node *BLangTransactionalExpr) {
+	p.StartNode()
+	p.PrintString("transactional")
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printDo(node *BLangDo) {
+	p.StartNode()
+	p.PrintString("do")
+	p.indentLevel++
+	p.PrintInner(&node.Body)
+	if node.OnFailClause.Body != nil {
+		p.PrintInner(&node.OnFailClause)
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printOnFailClause(node *BLangOnFailClause) {
+	p.StartNode()
+	p.PrintString("on-fail")
+	p.indentLevel++
+	if node.VariableDefinitionNode != nil {
+		p.PrintInner(node.VariableDefinitionNode)
+	}
+	p.PrintInner(node.Body)
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printFail(node *BLangFail) {
+	p.StartNode()
+	p.PrintString("fail")
+	p.indentLevel++
+	p.PrintInner(node.Expr.(BLangNode))
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printWorkerSend(kind string, node *BLangWorkerSendExprBase) {
+	p.StartNode()
+	p.PrintString(kind)
+	p.PrintString(node.WorkerIdentifier.Value)
+	p.indentLevel++
+	p.PrintInner(node.Expr)
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printWorkerReceive(node *BLangWorkerReceive) {
+	p.StartNode()
+	p.PrintString("worker-receive")
+	p.PrintString(node.WorkerIdentifier.Value)
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printAlternateWorkerReceive(node *BLangAlternateWorkerReceive) {
+	p.StartNode()
+	p.PrintString("alternate-worker-receive")
+	p.indentLevel++
+	for i := range node.WorkerReceives {
+		p.PrintInner(&node.WorkerReceives[i])
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printMultipleWorkerReceive(node *BLangMultipleWorkerReceive) {
+	p.StartNode()
+	p.PrintString("multiple-worker-receive")
+	p.indentLevel++
+	for i := range node.ReceiveFields {
+		field := &node.ReceiveFields[i]
+		p.StartNode()
+		p.PrintString(field.Key.Value)
+		p.indentLevel++
+		p.PrintInner(&field.Receive)
+		p.indentLevel--
+		p.EndNode()
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printWaitExpr(node *BLangWaitExpr) {
+	p.StartNode()
+	p.PrintString("wait")
+	p.indentLevel++
+	for _, expr := range node.Exprs {
+		p.PrintInner(expr)
+	}
 	p.indentLevel--
 	p.EndNode()
 }
 
+func (p *PrettyPrinter) printWaitForAllExpr(node *BLangWaitForAllExpr) {
+	p.StartNode()
+	p.PrintString("wait-for-all")
+	p.indentLevel++
+	for i := range node.KeyValuePairs {
+		field := &node.KeyValuePairs[i]
+		p.StartNode()
+		p.PrintString(field.Key.Value)
+		p.indentLevel++
+		p.PrintInner(field.ValueExpr)
+		p.indentLevel--
+		p.EndNode()
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printWorkerFlush(node *BLangWorkerFlushExpr) {
+	p.StartNode()
+	p.PrintString("worker-flush")
+	if node.WorkerIdentifier != nil {
+		p.PrintString(node.WorkerIdentifier.Value)
+	}
+	p.EndNode()
+}
+
 func (p *PrettyPrinter) printForeach(node *BLangForeach) {
 	p.StartNode()
 	p.PrintString("foreach")
@@ -1107,6 +1749,9 @@ func (p *PrettyPrinter) printForeach(node *BLangForeach) {
 		p.PrintInner(node.Collection.(BLangNode))
 	}
 	p.PrintInner(&node.Body)
+	if node.OnFailClause != nil {
+		p.PrintInner(node.OnFailClause)
+	}
 	p.indentLevel--
 	p.EndNode()
 }
@@ -1150,6 +1795,12 @@ func (p *PrettyPrinter) printConstant(node *BLangConstant) {
 			p.addSpaceBeforeNode = true
 		}
 	}
+	if attachments := node.GetAnnotationAttachments(); len(attachments) > 0 {
+		p.indentLevel++
+		p.printAnnotationAttachments(attachments)
+		p.indentLevel--
+		p.addSpaceBeforeNode = true
+	}
 
 	p.PrintString("(")
 	if node.TypeNode() != nil {
@@ -1209,26 +1860,56 @@ func (p *PrettyPrinter) printListConstructorExpr(node *BLangListConstructorExpr)
 	p.StartNode()
 	p.PrintString("list-constructor-expr")
 	p.indentLevel++
-	for _, expr := range node.Exprs {
+	for i, expr := range node.Exprs {
+		if node.IsSpreadMember(i) {
+			p.printListSpreadMember(expr)
+			continue
+		}
 		p.PrintInner(expr.(BLangNode))
 	}
 	p.indentLevel--
 	p.EndNode()
 }
 
+func (p *PrettyPrinter) printListSpreadMember(expr BLangExpression) {
+	p.StartNode()
+	p.PrintString("spread-member")
+	p.indentLevel++
+	p.PrintInner(expr.(BLangNode))
+	p.indentLevel--
+	p.EndNode()
+}
+
 func (p *PrettyPrinter) printMappingConstructor(node *BLangMappingConstructorExpr) {
 	p.StartNode()
 	p.PrintString("mapping-constructor-expr")
 	p.indentLevel++
 	for _, f := range node.Fields {
-		if kv, ok := f.(*BLangMappingKeyValueField); ok {
-			p.printMappingKeyValueField(kv)
+		switch f := f.(type) {
+		case *BLangMappingKeyValueField:
+			p.printMappingKeyValueField(f)
+		case *BLangMappingSpreadField:
+			p.printMappingSpreadField(f)
 		}
 	}
 	p.indentLevel--
 	p.EndNode()
 }
 
+func (p *PrettyPrinter) printTableConstructorExpr(node *BLangTableConstructorExpr) {
+	p.StartNode()
+	p.PrintString("table-constructor-expr")
+	p.indentLevel++
+	if node.TableKeySpecifier != nil {
+		p.PrintInner(node.TableKeySpecifier)
+	}
+	for _, row := range node.RecordLiteralList {
+		p.PrintInner(row)
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
 // Mapping key-value field printer: prints as (key-value (key) (value))
 func (p *PrettyPrinter) printMappingKeyValueField(kv *BLangMappingKeyValueField) {
 	p.StartNode()
@@ -1244,6 +1925,15 @@ func (p *PrettyPrinter) printMappingKeyValueField(kv *BLangMappingKeyValueField)
 	p.EndNode()
 }
 
+func (p *PrettyPrinter) printMappingSpreadField(f *BLangMappingSpreadField) {
+	p.StartNode()
+	p.PrintString("spread-field")
+	p.indentLevel++
+	p.PrintInner(f.Expr.(BLangNode))
+	p.indentLevel--
+	p.EndNode()
+}
+
 // Wildcard binding pattern printer
 func (p *PrettyPrinter) printWildCardBindingPattern(node *BLangWildCardBindingPattern) {
 	p.StartNode()
@@ -1399,6 +2089,9 @@ func (p *PrettyPrinter) printMarkdownDocumentation(node *BLangMarkdownDocumentat
 func (p *PrettyPrinter) printErrorTypeNode(node *BLangErrorTypeNode) {
 	p.StartNode()
 	p.PrintString("error-type")
+	if node.IsDistinct() {
+		p.PrintString("distinct")
+	}
 	if !node.IsTop() {
 		p.indentLevel++
 		p.PrintInner(node.DetailType.TypeDescriptor.(BLangNode))
@@ -1627,6 +2320,43 @@ func (p *PrettyPrinter) printStreamType(node *BLangStreamType) {
 	p.EndNode()
 }
 
+func (p *PrettyPrinter) printTableType(node *BLangTableType) {
+	p.StartNode()
+	p.PrintString("table-type")
+	p.indentLevel++
+	if node.Constraint.TypeDescriptor != nil {
+		p.PrintInner(node.Constraint.TypeDescriptor.(BLangNode))
+	}
+	if node.KeySpecifier != nil {
+		p.PrintInner(node.KeySpecifier)
+	}
+	if node.KeyTypeConstraint != nil {
+		p.PrintInner(node.KeyTypeConstraint)
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printTableKeySpecifier(node *BLangTableKeySpecifier) {
+	p.StartNode()
+	p.PrintString("key-specifier")
+	for _, name := range node.FieldNameIdentifierList {
+		p.PrintString(name.Value)
+	}
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printTableKeyTypeConstraint(node *BLangTableKeyTypeConstraint) {
+	p.StartNode()
+	p.PrintString("key-type-constraint")
+	p.indentLevel++
+	if node.KeyType.TypeDescriptor != nil {
+		p.PrintInner(node.KeyType.TypeDescriptor.(BLangNode))
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
 func (p *PrettyPrinter) printConstrainedType(node *BLangConstrainedType) {
 	p.StartNode()
 	p.PrintString("constrained-type")
@@ -1645,9 +2375,15 @@ func (p *PrettyPrinter) printConstrainedType(node *BLangConstrainedType) {
 func (p *PrettyPrinter) printTypeDefinition(node *BLangTypeDefinition) {
 	p.StartNode()
 	p.PrintString("type-definition")
+	if node.IsDistinct() {
+		p.PrintString("distinct")
+	}
 	if node.Name != nil {
 		p.PrintString(node.Name.Value)
 	}
+	p.indentLevel++
+	p.printAnnotationAttachments(node.GetAnnotationAttachments())
+	p.indentLevel--
 	if node.GetTypeData().TypeDescriptor != nil {
 		p.indentLevel++
 		p.PrintInner(node.GetTypeData().TypeDescriptor.(BLangNode))
@@ -1712,9 +2448,15 @@ func (p *PrettyPrinter) printRecordType(node *BLangRecordType) {
 func (p *PrettyPrinter) printObjectType(node *BLangObjectType) {
 	p.StartNode()
 	p.PrintString("object-type")
+	if node.IsDistinct() {
+		p.PrintString("distinct")
+	}
 	if node.Isolated {
 		p.PrintString("isolated")
 	}
+	if node.Readonly {
+		p.PrintString("readonly")
+	}
 	switch node.NetworkQuals {
 	case ObjectNetworkQualsClient:
 		p.PrintString("client")
@@ -1783,6 +2525,9 @@ func (p *PrettyPrinter) printMethodDecl(node *BMethodDecl) {
 func (p *PrettyPrinter) printFieldBaseAccess(node *BLangFieldBaseAccess) {
 	p.StartNode()
 	p.PrintString("field-based-access")
+	if node.OptionalFieldAccess {
+		p.PrintString("optional")
+	}
 	p.PrintString(node.Field.Value)
 	p.indentLevel++
 	p.PrintInner(node.Expr.(BLangNode))
@@ -1855,6 +2600,18 @@ func (p *PrettyPrinter) printTrapExpr(node *BLangTrapExpr) {
 	p.EndNode()
 }
 
+func (p *PrettyPrinter) printLetExpr(node *BLangLetExpr) {
+	p.StartNode()
+	p.PrintString("let-expr")
+	p.indentLevel++
+	for _, varDef := range node.LetVarDeclarations {
+		p.PrintInner(varDef.(BLangNode))
+	}
+	p.PrintInner(node.Expr.(BLangNode))
+	p.indentLevel--
+	p.EndNode()
+}
+
 func (p *PrettyPrinter) printClassDefinition(node *BLangClassDefinition) {
 	p.StartNode()
 	p.PrintString("class-definition")
@@ -1866,6 +2623,7 @@ func (p *PrettyPrinter) printClassDefinition(node *BLangClassDefinition) {
 	}
 	p.PrintString(node.Name.Value)
 	p.indentLevel++
+	p.printAnnotationAttachments(node.GetAnnotationAttachments())
 	// Print fields
 	for _, field := range node.Fields {
 		p.PrintInner(field.(BLangNode))
@@ -2027,6 +2785,9 @@ func (p *PrettyPrinter) printFunctionTypeParam(node *BLangFunctionTypeParam) {
 func (p *PrettyPrinter) printUserDefinedType(node *BLangUserDefinedType) {
 	p.StartNode()
 	p.PrintString("user-defined-type")
+	if node.IsDistinct() {
+		p.PrintString("distinct")
+	}
 	if node.PkgAlias.Value != "" {
 		p.PrintString(node.PkgAlias.Value + " " + node.TypeName.Value)
 	} else {
@@ -2085,3 +2846,61 @@ func (p *PrettyPrinter) printWildCardMatchPattern(node *BLangWildCardMatchPatter
 	p.PrintString("wildcard-match-pattern")
 	p.EndNode()
 }
+
+func (p *PrettyPrinter) printCaptureMatchPattern(node *BLangCaptureMatchPattern) {
+	p.StartNode()
+	p.PrintString("capture-match-pattern")
+	p.PrintString(node.Variable.Name.Value)
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printListMatchPattern(node *BLangListMatchPattern) {
+	p.StartNode()
+	p.PrintString("list-match-pattern")
+	p.indentLevel++
+	for _, member := range node.MatchPatterns {
+		p.PrintInner(member.(BLangNode))
+	}
+	if node.RestMatchPattern != nil {
+		p.printBindingPart("rest", node.RestMatchPattern.Variable)
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printMappingMatchPattern(node *BLangMappingMatchPattern) {
+	p.StartNode()
+	p.PrintString("mapping-match-pattern")
+	p.indentLevel++
+	for _, field := range node.FieldMatchPatterns {
+		p.printBindingPart("field "+field.FieldName.Value, field.MatchPattern.(BLangNode))
+	}
+	if node.RestMatchPattern != nil {
+		p.printBindingPart("rest", node.RestMatchPattern.Variable)
+	}
+	p.indentLevel--
+	p.EndNode()
+}
+
+func (p *PrettyPrinter) printErrorMatchPattern(node *BLangErrorMatchPattern) {
+	p.StartNode()
+	p.PrintString("error-match-pattern")
+	p.indentLevel++
+	if node.ErrorTypeReference != nil {
+		p.PrintInner(node.ErrorTypeReference)
+	}
+	if node.MessageMatchPattern != nil {
+		p.printBindingPart("message", node.MessageMatchPattern.(BLangNode))
+	}
+	if node.CauseMatchPattern != nil {
+		p.printBindingPart("cause", node.CauseMatchPattern.(BLangNode))
+	}
+	for _, field := range node.FieldMatchPatterns {
+		p.printBindingPart("detail "+field.ArgName.Value, field.MatchPattern.(BLangNode))
+	}
+	if node.RestMatchPattern != nil {
+		p.printBindingPart("rest", node.RestMatchPattern.Variable)
+	}
+	p.indentLevel--
+	p.EndNode()
+}
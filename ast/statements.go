@@ -102,6 +102,21 @@ type (
 		Var *BLangSimpleVariable
 	}
 
+	BLangTupleVariableDef struct {
+		bLangStatementBase
+		Var *BLangTupleVariable
+	}
+
+	BLangRecordVariableDef struct {
+		bLangStatementBase
+		Var *BLangRecordVariable
+	}
+
+	BLangErrorVariableDef struct {
+		bLangStatementBase
+		Var *BLangErrorVariable
+	}
+
 	BLangReturn struct {
 		bLangStatementBase
 		Expr BLangActionOrExpression
@@ -121,7 +136,8 @@ type (
 
 	BLangLock struct {
 		bLangStatementBase
-		Body BLangBlockStmt
+		Body         BLangBlockStmt
+		OnFailClause *BLangOnFailClause
 		// LockKey is the content-addressed identifier of the restricted
 		// variable, set by the lock analyzer. For a module-level isolated
 		// variable it has the form "org/pkg:varName"; for a non-immutable
@@ -139,6 +155,61 @@ type (
 		// analysis purposes.
 		RestrictedSymbol model.SymbolRef
 	}
+
+	// BLangWorker declares a named worker. The worker body is modeled as an
+	// anonymous function flagged as a worker so that it closes over the
+	// enclosing function's variables like any other lambda. The worker name
+	// is also a variable of the enclosing function holding the future for
+	// the result of the worker.
+	BLangWorker struct {
+		bLangStatementBase
+		Name   BLangIdentifier
+		Lambda *BLangLambdaFunction
+		symbol model.SymbolRef
+		// GroupSymbol is the hidden variable holding the worker group of the
+		// enclosing function. Set by desugar.
+		GroupSymbol model.SymbolRef
+	}
+
+	BLangFork struct {
+		bLangStatementBase
+		Workers []BLangWorker
+	}
+
+	BLangTransaction struct {
+		bLangStatementBase
+		Body         BLangBlockStmt
+		OnFailClause *BLangOnFailClause
+	}
+
+	// BLangRetry is `retry<T>(args) { ... }`. For `retry transaction { ... }`
+	// Transaction is set and Body is empty.
+	BLangRetry struct {
+		bLangStatementBase
+		RetrySpec    BLangRetrySpec
+		Body         BLangBlockStmt
+		Transaction  *BLangTransaction
+		OnFailClause *BLangOnFailClause
+	}
+
+	BLangRetrySpec struct {
+		bLangNodeBase
+		// RetryManager constructs the retry manager given as the type parameter
+		// of the retry statement. Nil when the default retry manager is used, in
+		// which case ArgExprs are the arguments to the default retry manager.
+		RetryManager *BLangNewExpression
+		ArgExprs     []BLangExpression
+	}
+
+	BLangRollback struct {
+		bLangStatementBase
+		Expr BLangExpression
+	}
+
+	BLangFail struct {
+		bLangStatementBase
+		Expr BLangExpression
+	}
 )
 
 var (
@@ -152,6 +223,9 @@ var (
 	_ WhileNode               = &BLangWhile{}
 	_ ForeachNode             = &BLangForeach{}
 	_ VariableDefinitionNode  = &BLangSimpleVariableDef{}
+	_ VariableDefinitionNode  = &BLangTupleVariableDef{}
+	_ VariableDefinitionNode  = &BLangRecordVariableDef{}
+	_ VariableDefinitionNode  = &BLangErrorVariableDef{}
 	_ ReturnNode              = &BLangReturn{}
 	_ PanicNode               = &BLangPanic{}
 )
@@ -174,10 +248,20 @@ var (
 	_ BLangNode = &BLangWhile{}
 	_ BLangNode = &BLangForeach{}
 	_ BLangNode = &BLangSimpleVariableDef{}
+	_ BLangNode = &BLangTupleVariableDef{}
+	_ BLangNode = &BLangRecordVariableDef{}
+	_ BLangNode = &BLangErrorVariableDef{}
 	_ BLangNode = &BLangReturn{}
 	_ BLangNode = &BLangPanic{}
 	_ BLangNode = &BLangMatchStatement{}
 	_ BLangNode = &BLangLock{}
+	_ BLangNode = &BLangWorker{}
+	_ BLangNode = &BLangFork{}
+	_ BLangNode = &BLangTransaction{}
+	_ BLangNode = &BLangRetry{}
+	_ BLangNode = &BLangRetrySpec{}
+	_ BLangNode = &BLangRollback{}
+	_ BLangNode = &BLangFail{}
 )
 
 func (b *BLangAssignment) GetVariable() LExpr {
@@ -422,6 +506,42 @@ func (b *BLangSimpleVariableDef) SetVariable(variable VariableNode) {
 	}
 }
 
+func (b *BLangTupleVariableDef) GetVariable() VariableNode {
+	return b.Var
+}
+
+func (b *BLangTupleVariableDef) SetVariable(variable VariableNode) {
+	if v, ok := variable.(*BLangTupleVariable); ok {
+		b.Var = v
+	} else {
+		panic("variable is not a BLangTupleVariable")
+	}
+}
+
+func (b *BLangRecordVariableDef) GetVariable() VariableNode {
+	return b.Var
+}
+
+func (b *BLangRecordVariableDef) SetVariable(variable VariableNode) {
+	if v, ok := variable.(*BLangRecordVariable); ok {
+		b.Var = v
+	} else {
+		panic("variable is not a BLangRecordVariable")
+	}
+}
+
+func (b *BLangErrorVariableDef) GetVariable() VariableNode {
+	return b.Var
+}
+
+func (b *BLangErrorVariableDef) SetVariable(variable VariableNode) {
+	if v, ok := variable.(*BLangErrorVariable); ok {
+		b.Var = v
+	} else {
+		panic("variable is not a BLangErrorVariable")
+	}
+}
+
 func (b *BLangReturn) GetExpression() BLangActionOrExpression {
 	return b.Expr
 }
@@ -437,3 +557,15 @@ func (b *BLangPanic) GetExpression() BLangExpression {
 func (b *BLangLock) GetBody() BlockStatementNode {
 	return &b.Body
 }
+
+func (b *BLangTransaction) GetBody() BlockStatementNode {
+	return &b.Body
+}
+
+func (b *BLangRollback) GetExpression() BLangExpression {
+	return b.Expr
+}
+
+func (b *BLangFail) GetExpression() BLangExpression {
+	return b.Expr
+}
@@ -71,6 +71,10 @@ type (
 		tags    TypeTags
 		name    model.Name
 		flags   model.Flag
+		// distinctTypeID is the type-id allocated for an inline `distinct`
+		// occurrence of this type descriptor; valid only if hasDistinctTypeID.
+		distinctTypeID    int
+		hasDistinctTypeID bool
 	}
 
 	BTypeBasic struct {
@@ -148,6 +152,7 @@ type (
 		members              map[string]ObjectMember
 		Definition           semtypes.Definition
 		Isolated             bool
+		Readonly             bool
 		NetworkQuals         ObjectNetworkQuals
 	}
 
@@ -187,6 +192,23 @@ type (
 		Definition     semtypes.Definition
 	}
 
+	BLangTableType struct {
+		bLangTypeBase
+		Constraint        TypeData
+		KeySpecifier      *BLangTableKeySpecifier
+		KeyTypeConstraint *BLangTableKeyTypeConstraint
+	}
+
+	BLangTableKeySpecifier struct {
+		bLangNodeBase
+		FieldNameIdentifierList []BLangIdentifier
+	}
+
+	BLangTableKeyTypeConstraint struct {
+		bLangNodeBase
+		KeyType TypeData
+	}
+
 	BLangTupleTypeNode struct {
 		bLangTypeBase
 		Definition semtypes.Definition
@@ -224,6 +246,7 @@ type (
 		Name           *BLangIdentifier
 		TypeDesc       BType
 		InitExpr       BLangExpression
+		DefaultFnRef   model.SymbolRef
 		AnnAttachments []BLangAnnotationAttachment
 	}
 )
@@ -242,6 +265,11 @@ var (
 	_ BType                = &BLangStreamType{}
 	_ BLangNode            = &BLangStreamType{}
 	_ TypeDescriptor       = &BLangStreamType{}
+	_ BType                = &BLangTableType{}
+	_ BLangNode            = &BLangTableType{}
+	_ TypeDescriptor       = &BLangTableType{}
+	_ BLangNode            = &BLangTableKeySpecifier{}
+	_ BLangNode            = &BLangTableKeyTypeConstraint{}
 	_ TupleTypeNode        = &BLangTupleTypeNode{}
 	_ MemberTypeDesc       = &BLangMemberTypeDesc{}
 	_ RecordTypeNode       = &BLangRecordType{}
@@ -431,6 +459,23 @@ func (b *bLangTypeBase) bTypeSetFlags(flags model.Flag) {
 	b.flags = flags
 }
 
+func (b *bLangTypeBase) IsDistinct() bool {
+	return b.flags.Has(model.FlagDistinct)
+}
+
+func (b *bLangTypeBase) SetDistinct() {
+	b.flags |= model.FlagDistinct
+}
+
+func (b *bLangTypeBase) DistinctTypeID() (int, bool) {
+	return b.distinctTypeID, b.hasDistinctTypeID
+}
+
+func (b *bLangTypeBase) SetDistinctTypeID(id int) {
+	b.distinctTypeID = id
+	b.hasDistinctTypeID = true
+}
+
 func (b *BTypeBasic) SetDeterminedType(ty semtypes.SemType) {
 	b.ty.Type = ty
 }
@@ -541,14 +586,6 @@ func (b *BLangErrorTypeNode) IsTop() bool {
 	return b.DetailType.TypeDescriptor == nil
 }
 
-func (b *BLangErrorTypeNode) IsDistinct() bool {
-	return b.bTypeGetFlags().Has(model.FlagDistinct)
-}
-
-func (b *BLangErrorTypeNode) SetDistinct() {
-	b.bTypeSetFlags(b.bTypeGetFlags() | model.FlagDistinct)
-}
-
 func (b *BLangFunctionType) IsAnyFunction() bool {
 	return b.bTypeGetFlags().Has(model.FlagAnyFunction)
 }
@@ -601,6 +638,18 @@ func (b *BLangStreamType) GetTypeKind() TypeKind {
 	return TypeKind_STREAM
 }
 
+func (b *BLangTableType) GetTypeKind() TypeKind {
+	return TypeKind_TABLE
+}
+
+func (b *BLangTableKeySpecifier) GetFieldNames() []string {
+	names := make([]string, len(b.FieldNameIdentifierList))
+	for i := range b.FieldNameIdentifierList {
+		names[i] = b.FieldNameIdentifierList[i].Value
+	}
+	return names
+}
+
 func (b *BLangTupleTypeNode) GetMembers() []MemberTypeDesc {
 	members := make([]MemberTypeDesc, len(b.Members))
 	for i := range b.Members {
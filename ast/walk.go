@@ -153,7 +153,9 @@ func Walk(v Visitor, node BLangNode) {
 		walkTypeDescriptor(v, node.typeDescriptor)
 
 	case *BLangAnnotationAttachment:
-		Walk(v, node.Expr.(BLangNode))
+		if node.Expr != nil {
+			Walk(v, node.Expr.(BLangNode))
+		}
 		if node.AnnotationName != nil {
 			Walk(v, node.AnnotationName)
 		}
@@ -172,6 +174,7 @@ func Walk(v Visitor, node BLangNode) {
 		}
 
 	case *BLangConstant:
+		walkVariableAnnAttachments(v, &node.BLangVariableBase)
 		if node.Name != nil {
 			Walk(v, node.Name)
 		}
@@ -183,6 +186,7 @@ func Walk(v Visitor, node BLangNode) {
 		}
 
 	case *BLangSimpleVariable:
+		walkVariableAnnAttachments(v, &node.BLangVariableBase)
 		if node.Name != nil {
 			Walk(v, node.Name)
 		}
@@ -193,6 +197,57 @@ func Walk(v Visitor, node BLangNode) {
 			Walk(v, node.Expr.(BLangNode))
 		}
 
+	case *BLangTupleVariable:
+		if tn := node.TypeNode(); tn != nil {
+			Walk(v, tn.(BLangNode))
+		}
+		for _, member := range node.Members {
+			Walk(v, member.(BLangNode))
+		}
+		if node.RestVariable != nil {
+			Walk(v, node.RestVariable)
+		}
+		if node.Expr != nil {
+			Walk(v, node.Expr.(BLangNode))
+		}
+
+	case *BLangRecordVariable:
+		if tn := node.TypeNode(); tn != nil {
+			Walk(v, tn.(BLangNode))
+		}
+		for _, field := range node.Fields {
+			Walk(v, field.Value.(BLangNode))
+		}
+		if node.RestVariable != nil {
+			Walk(v, node.RestVariable)
+		}
+		if node.Expr != nil {
+			Walk(v, node.Expr.(BLangNode))
+		}
+
+	case *BLangErrorVariable:
+		if tn := node.TypeNode(); tn != nil {
+			Walk(v, tn.(BLangNode))
+		}
+		if node.ErrorTypeReference != nil {
+			Walk(v, node.ErrorTypeReference)
+		}
+		if node.Message != nil {
+			Walk(v, node.Message)
+		}
+		if node.Cause != nil {
+			Walk(v, node.Cause.(BLangNode))
+		}
+		for _, entry := range node.Detail {
+			Walk(v, entry.Value.(BLangNode))
+		}
+		if node.RestDetail != nil {
+			Walk(v, node.RestDetail)
+		}
+		if node.Expr != nil {
+			Walk(v, node.Expr.(BLangNode))
+		}
+
 	case *BLangXMLNS:
 		Walk(v, node.namespaceURI.(BLangNode))
 		if node.prefix != nil {
@@ -201,6 +256,9 @@ func Walk(v Visitor, node BLangNode) {
 
 	// Section 3: Function & Body
 	case *BLangFunction:
+		for i := range node.AnnAttachments {
+			Walk(v, &node.AnnAttachments[i])
+		}
 		Walk(v, &node.Name)
 		for i := range node.RequiredParams {
 			Walk(v, &node.RequiredParams[i])
@@ -211,6 +269,9 @@ func Walk(v Visitor, node BLangNode) {
 		if node.returnTypeDescriptor != nil {
 			walkTypeDescriptor(v, node.returnTypeDescriptor)
 		}
+		for i := range node.ReturnTypeAnnAttachments {
+			Walk(v, &node.ReturnTypeAnnAttachments[i])
+		}
 		if node.Body != nil {
 			Walk(v, node.Body.(BLangNode))
 		}
@@ -231,6 +292,9 @@ func Walk(v Visitor, node BLangNode) {
 		if node.returnTypeDescriptor != nil {
 			walkTypeDescriptor(v, node.returnTypeDescriptor)
 		}
+		for i := range node.ReturnTypeAnnAttachments {
+			Walk(v, &node.ReturnTypeAnnAttachments[i])
+		}
 		if node.Body != nil {
 			Walk(v, node.Body.(BLangNode))
 		}
@@ -309,6 +373,51 @@ func Walk(v Visitor, node BLangNode) {
 
 	case *BLangLock:
 		Walk(v, &node.Body)
+		if node.OnFailClause != nil {
+			Walk(v, node.OnFailClause)
+		}
+
+	case *BLangWorker:
+		Walk(v, &node.Name)
+		Walk(v, node.Lambda)
+
+	case *BLangFork:
+		for i := range node.Workers {
+			Walk(v, &node.Workers[i])
+		}
+
+	case *BLangTransaction:
+		Walk(v, &node.Body)
+		if node.OnFailClause != nil {
+			Walk(v, node.OnFailClause)
+		}
+
+	case *BLangRetry:
+		Walk(v, &node.RetrySpec)
+		if node.Transaction != nil {
+			Walk(v, node.Transaction)
+		} else {
+			Walk(v, &node.Body)
+		}
+		if node.OnFailClause != nil {
+			Walk(v, node.OnFailClause)
+		}
+
+	case *BLangRetrySpec:
+		if node.RetryManager != nil {
+			Walk(v, node.RetryManager)
+		}
+		for _, arg := range node.ArgExprs {
+			Walk(v, arg.(BLangNode))
+		}
+
+	case *BLangRollback:
+		if node.Expr != nil {
+			Walk(v, node.Expr.(BLangNode))
+		}
+
+	case *BLangFail:
+		Walk(v, node.Expr.(BLangNode))
 
 	case *BLangMatchStatement:
 		if node.Expr != nil {
@@ -330,6 +439,15 @@ func Walk(v Visitor, node BLangNode) {
 	case *BLangSimpleVariableDef:
 		Walk(v, node.Var)
 
+	case *BLangTupleVariableDef:
+		Walk(v, node.Var)
+
+	case *BLangRecordVariableDef:
+		Walk(v, node.Var)
+
+	case *BLangErrorVariableDef:
+		Walk(v, node.Var)
+
 	case *BLangReturn:
 		if node.Expr != nil {
 			Walk(v, node.Expr.(BLangNode))
@@ -373,6 +491,11 @@ func Walk(v Visitor, node BLangNode) {
 			Walk(v, node.RhsExpr.(BLangNode))
 		}
 
+	case *BLangTernaryExpr:
+		Walk(v, node.Expr.(BLangNode))
+		Walk(v, node.ThenExpr.(BLangNode))
+		Walk(v, node.ElseExpr.(BLangNode))
+
 	case *BLangCheckedExpr:
 		if node.Expr != nil {
 			Walk(v, node.Expr.(BLangNode))
@@ -388,6 +511,14 @@ func Walk(v Visitor, node BLangNode) {
 			Walk(v, node.Expr.(BLangNode))
 		}
 
+	case *BLangLetExpr:
+		for _, varDef := range node.LetVarDeclarations {
+			Walk(v, varDef.(BLangNode))
+		}
+		if node.Expr != nil {
+			Walk(v, node.Expr.(BLangNode))
+		}
+
 	case *BLangGroupExpr:
 		if node.Expression != nil {
 			Walk(v, node.Expression.(BLangNode))
@@ -419,8 +550,17 @@ func Walk(v Visitor, node BLangNode) {
 				if kv.ValueExpr != nil {
 					Walk(v, kv.ValueExpr.(BLangNode))
 				}
+			} else if spread, ok := f.(*BLangMappingSpreadField); ok {
+				Walk(v, spread.Expr.(BLangNode))
 			}
 		}
+	case *BLangTableConstructorExpr:
+		if node.TableKeySpecifier != nil {
+			Walk(v, node.TableKeySpecifier)
+		}
+		for _, row := range node.RecordLiteralList {
+			Walk(v, row)
+		}
 	case *BLangErrorConstructorExpr:
 		if node.ErrorTypeRef != nil {
 			Walk(v, node.ErrorTypeRef)
@@ -490,7 +630,10 @@ func Walk(v Visitor, node BLangNode) {
 		WalkTypeData(v, &node.Type)
 
 	case *BLangCommitExpr:
-		panic("unimplemented")
+		// No children
+
+	case *BLangTransactionalExpr:
+		// No children
 
 	// Section 6: Expressions - Variable Refs
 	case *BLangSimpleVarRef:
@@ -509,6 +652,39 @@ func Walk(v Visitor, node BLangNode) {
 			Walk(v, node.VariableName)
 		}
 
+	case *BLangTupleVarRef:
+		for _, expr := range node.Expressions {
+			Walk(v, expr)
+		}
+		if node.RestParam != nil {
+			Walk(v, node.RestParam)
+		}
+
+	case *BLangRecordVarRef:
+		for _, field := range node.RecordRefFields {
+			Walk(v, field.VariableReference)
+		}
+		if node.RestParam != nil {
+			Walk(v, node.RestParam)
+		}
+
+	case *BLangErrorVarRef:
+		if node.ErrorTypeReference != nil {
+			Walk(v, node.ErrorTypeReference)
+		}
+		if node.Message != nil {
+			Walk(v, node.Message)
+		}
+		if node.Cause != nil {
+			Walk(v, node.Cause)
+		}
+		for i := range node.Detail {
+			Walk(v, &node.Detail[i])
+		}
+		if node.RestVar != nil {
+			Walk(v, node.RestVar)
+		}
+
 	case *BLangConstRef:
 		if node.PkgAlias != nil {
 			Walk(v, node.PkgAlias)
@@ -560,6 +736,31 @@ func Walk(v Visitor, node BLangNode) {
 	case *BLangXMLTextLiteral:
 		// Leaf node
 
+	case *BLangXMLElementFilter:
+		// Leaf node
+
+	case *BLangXMLFilterExpr:
+		if node.Expr != nil {
+			Walk(v, node.Expr)
+		}
+		for i := range node.Filters {
+			Walk(v, &node.Filters[i])
+		}
+
+	case *BLangXMLStepExpr:
+		if node.Expr != nil {
+			Walk(v, node.Expr)
+		}
+		for i := range node.Filters {
+			Walk(v, &node.Filters[i])
+		}
+		if node.Extension != nil {
+			Walk(v, node.Extension)
+		}
+
+	case *BLangXMLStepItem:
+		// Leaf node
+
 	// Section 7: Expressions - Worker
 	case *BLangWorkerReceive:
 		if node.WorkerIdentifier != nil {
@@ -567,8 +768,36 @@ func Walk(v Visitor, node BLangNode) {
 		}
 
 	case *BLangAlternateWorkerReceive:
-		for i := range node.workerReceives {
-			Walk(v, &node.workerReceives[i])
+		for i := range node.WorkerReceives {
+			Walk(v, &node.WorkerReceives[i])
+		}
+
+	case *BLangMultipleWorkerReceive:
+		for i := range node.ReceiveFields {
+			Walk(v, &node.ReceiveFields[i].Key)
+			Walk(v, &node.ReceiveFields[i].Receive)
+		}
+
+	case *BLangWaitExpr:
+		for _, expr := range node.Exprs {
+			Walk(v, expr)
+		}
+
+	case *BLangWaitForAllExpr:
+		for i := range node.KeyValuePairs {
+			Walk(v, &node.KeyValuePairs[i].Key)
+			Walk(v, node.KeyValuePairs[i].ValueExpr)
+		}
+
+	case *BLangWorkerAsyncSendExpr:
+		walkWorkerSend(v, &node.BLangWorkerSendExprBase)
+
+	case *BLangWorkerSyncSendExpr:
+		walkWorkerSend(v, &node.BLangWorkerSendExprBase)
+
+	case *BLangWorkerFlushExpr:
+		if node.WorkerIdentifier != nil {
+			Walk(v, node.WorkerIdentifier)
 		}
 
 	// Section 8: Type Nodes
@@ -612,6 +841,20 @@ func Walk(v Visitor, node BLangNode) {
 	case *BLangStreamType:
 		WalkTypeData(v, &node.ValueType)
 		WalkTypeData(v, &node.CompletionType)
+	case *BLangTableType:
+		WalkTypeData(v, &node.Constraint)
+		if node.KeySpecifier != nil {
+			Walk(v, node.KeySpecifier)
+		}
+		if node.KeyTypeConstraint != nil {
+			Walk(v, node.KeyTypeConstraint)
+		}
+	case *BLangTableKeySpecifier:
+		for i := range node.FieldNameIdentifierList {
+			Walk(v, &node.FieldNameIdentifierList[i])
+		}
+	case *BLangTableKeyTypeConstraint:
+		WalkTypeData(v, &node.KeyType)
 	case *BLangTupleTypeNode:
 		for i := range node.Members {
 			Walk(v, node.Members[i].TypeDesc.(BLangNode))
@@ -735,6 +978,30 @@ func Walk(v Visitor, node BLangNode) {
 			Walk(v, node.VariableName)
 		}
 
+	case *BLangListBindingPattern:
+		for _, bindingPattern := range node.BindingPatterns {
+			Walk(v, bindingPattern.(BLangNode))
+		}
+		if node.RestBindingPattern != nil {
+			Walk(v, node.RestBindingPattern)
+		}
+
+	case *BLangMappingBindingPattern:
+		for i := range node.FieldBindingPatterns {
+			Walk(v, &node.FieldBindingPatterns[i])
+		}
+		if node.RestBindingPattern != nil {
+			Walk(v, node.RestBindingPattern)
+		}
+
+	case *BLangFieldBindingPattern:
+		if node.FieldName != nil {
+			Walk(v, node.FieldName)
+		}
+		if node.BindingPattern != nil {
+			Walk(v, node.BindingPattern.(BLangNode))
+		}
+
 	// Section 10: Clauses
 	case *BLangFromClause:
 		if node.Collection != nil {
@@ -764,8 +1031,8 @@ func Walk(v Visitor, node BLangNode) {
 		}
 
 	case *BLangLetClause:
-		for i := range node.LetVarDeclarations {
-			Walk(v, &node.LetVarDeclarations[i])
+		for _, varDef := range node.LetVarDeclarations {
+			Walk(v, varDef.(BLangNode))
 		}
 
 	case *BLangWhereClause:
@@ -812,12 +1079,12 @@ func Walk(v Visitor, node BLangNode) {
 		}
 
 	case *BLangOnFailClause:
-		if node.Body != nil {
-			Walk(v, node.Body)
-		}
 		if node.VariableDefinitionNode != nil {
 			Walk(v, node.VariableDefinitionNode)
 		}
+		if node.Body != nil {
+			Walk(v, node.Body)
+		}
 
 	case *BLangDoClause:
 		if node.Body != nil {
@@ -878,6 +1145,53 @@ func Walk(v Visitor, node BLangNode) {
 	case *BLangWildCardMatchPattern:
 		// Leaf node
 
+	case *BLangCaptureMatchPattern:
+		Walk(v, node.Variable)
+
+	case *BLangListMatchPattern:
+		for _, member := range node.MatchPatterns {
+			Walk(v, member.(BLangNode))
+		}
+		if node.RestMatchPattern != nil {
+			Walk(v, node.RestMatchPattern)
+		}
+
+	case *BLangRestMatchPattern:
+		Walk(v, node.Variable)
+
+	case *BLangMappingMatchPattern:
+		for i := range node.FieldMatchPatterns {
+			Walk(v, &node.FieldMatchPatterns[i])
+		}
+		if node.RestMatchPattern != nil {
+			Walk(v, node.RestMatchPattern)
+		}
+
+	case *BLangFieldMatchPattern:
+		Walk(v, node.FieldName)
+		Walk(v, node.MatchPattern.(BLangNode))
+
+	case *BLangErrorMatchPattern:
+		if node.ErrorTypeReference != nil {
+			Walk(v, node.ErrorTypeReference)
+		}
+		if node.MessageMatchPattern != nil {
+			Walk(v, node.MessageMatchPattern.(BLangNode))
+		}
+		if node.CauseMatchPattern != nil {
+			Walk(v, node.CauseMatchPattern.(BLangNode))
+		}
+		for i := range node.FieldMatchPatterns {
+			Walk(v, &node.FieldMatchPatterns[i])
+		}
+		if node.RestMatchPattern != nil {
+			Walk(v, node.RestMatchPattern)
+		}
+
+	case *BLangNamedArgMatchPattern:
+		Walk(v, node.ArgName)
+		Walk(v, node.MatchPattern.(BLangNode))
+
 	// Section 13: Misc Leaf
 	case *BLangIdentifier:
 		// Leaf node
@@ -889,6 +1203,9 @@ func Walk(v Visitor, node BLangNode) {
 		Walk(v, &node.Name)
 		Walk(v, node.Expr.(BLangNode))
 
+	case *BLangRestArgsExpression:
+		Walk(v, node.Expr.(BLangNode))
+
 	case *BLangNewExpression:
 		if node.TypeDescriptor != nil {
 			Walk(v, node.TypeDescriptor)
@@ -944,6 +1261,12 @@ func WalkTypeData(v Visitor, typeData *TypeData) {
 	v.Visit(nil)
 }
 
+func walkVariableAnnAttachments(v Visitor, b *BLangVariableBase) {
+	for _, attachment := range b.AnnAttachments {
+		Walk(v, attachment.(BLangNode))
+	}
+}
+
 func walkClassDefnBody(v Visitor, b *classDefnBase) {
 	for i := range b.AnnAttachments {
 		Walk(v, &b.AnnAttachments[i])
@@ -963,6 +1286,15 @@ func walkClassDefnBody(v Visitor, b *classDefnBase) {
 	WalkTypeData(v, &b.typeData)
 }
 
+func walkWorkerSend(v Visitor, b *BLangWorkerSendExprBase) {
+	if b.Expr != nil {
+		Walk(v, b.Expr)
+	}
+	if b.WorkerIdentifier != nil {
+		Walk(v, b.WorkerIdentifier)
+	}
+}
+
 func walkTypeDescriptor(v Visitor, td TypeDescriptor) {
 	if td == nil {
 		return
@@ -105,7 +105,7 @@ type context interface {
 
 // blockContext is the state every block shares, and is itself the node used
 // for an ordinary block (if branch / match clause / bare block). funcBlock,
-// loopBlock and lockBlock embed it.
+// loopBlock, lockBlock and failBlock embed it.
 type blockContext struct {
 	fn        *functionContext
 	enclosing context // lexical parent within this function; nil at the funcBlock
@@ -125,6 +125,15 @@ type lockBlock struct {
 	key string
 }
 
+// failBlock is the body of a do statement with an on-fail clause. A fail
+// statement within it stores the error in errOp, which lives in the frame of
+// the enclosing block, and jumps to onFailBB.
+type failBlock struct {
+	blockContext
+	onFailBB *BIRBasicBlock
+	errOp    *BIROperand
+}
+
 func newBlockContext(parent context) blockContext {
 	return blockContext{fn: parent.function(), enclosing: parent, vars: make(map[model.SymbolRef]*BIROperand)}
 }
@@ -556,6 +565,17 @@ func handleStatement(ctx context, curBB *BIRBasicBlock, stmt ast.StatementNode)
 		return statementEffect{block: curBB}
 	case *ast.BLangLock:
 		return lockStatement(ctx, curBB, stmt)
+	case *ast.BLangDo:
+		return doStatement(ctx, curBB, stmt)
+	case *ast.BLangFail:
+		return failStatement(ctx, curBB, stmt)
+	case *ast.BLangWorker:
+		return workerStatement(ctx, curBB, stmt)
+	case *ast.BLangFork:
+		for i := range stmt.Workers {
+			curBB = workerStatement(ctx, curBB, &stmt.Workers[i]).block
+		}
+		return statementEffect{block: curBB}
 	default:
 		panic("unexpected statement type")
 	}
@@ -578,6 +598,237 @@ func lockStatement(ctx context, bb *BIRBasicBlock, stmt *ast.BLangLock) statemen
 	return statementEffect{block: afterLock}
 }
 
+func doStatement(ctx context, bb *BIRBasicBlock, stmt *ast.BLangDo) statementEffect {
+	pos := ctx.function().loc(stmt.GetPosition())
+	clause := &stmt.OnFailClause
+	if clause.Body == nil {
+		return blockStatement(ctx, bb, &stmt.Body)
+	}
+	var errOp *BIROperand
+	if varDef := clause.VariableDefinitionNode; varDef != nil {
+		errOp = ctx.addLocalVar(model.Name(varDef.Var.Name.Value), varDef.Var.GetDeterminedType(), varDef.Var.Symbol())
+	} else {
+		errOp = ctx.addTempVar(semtypes.ERROR)
+	}
+	onFailBB := ctx.function().addBB()
+	fb := &failBlock{blockContext: newBlockContext(ctx), onFailBB: onFailBB, errOp: errOp}
+	bodyEffect := emitBlockBody(fb, bb, stmt.Body.Stmts, pos)
+	onFailEffect := blockStatement(ctx, onFailBB, clause.Body)
+	if bodyEffect.block == nil && onFailEffect.block == nil {
+		return statementEffect{}
+	}
+	afterDo := ctx.function().addBB()
+	if bodyEffect.block != nil {
+		bodyEffect.block.Terminator = NewGoto(afterDo, pos)
+	}
+	if onFailEffect.block != nil {
+		onFailEffect.block.Terminator = NewGoto(afterDo, pos)
+	}
+	return statementEffect{block: afterDo}
+}
+
+// failStatement transfers control to the on-fail clause of the nearest
+// enclosing do statement. Without one, the error is returned from the
+// function.
+func failStatement(ctx context, curBB *BIRBasicBlock, stmt *ast.BLangFail) statementEffect {
+	pos := ctx.function().loc(stmt.GetPosition())
+	errorEffect := handleActionOrExpression(ctx, curBB, stmt.Expr)
+	curBB = errorEffect.block
+	levelsUp := 0
+	for cur := ctx; cur.enclosingBlock() != nil; cur = cur.enclosingBlock() {
+		levelsUp++
+		fb, ok := cur.(*failBlock)
+		if !ok {
+			continue
+		}
+		errOp := &BIROperand{VariableDcl: fb.errOp.VariableDcl, Address: absoluteAddress(levelsUp, fb.errOp.Address.FrameIndex)}
+		curBB.Instructions = append(curBB.Instructions, NewMove(errorEffect.result, errOp, pos))
+		for {
+			curBB = unwindInner(ctx, curBB, pos)
+			if ctx == context(fb) {
+				break
+			}
+			ctx = ctx.enclosingBlock()
+		}
+		curBB.Terminator = NewGoto(fb.onFailBB, pos)
+		return statementEffect{}
+	}
+	curBB.Instructions = append(curBB.Instructions, NewMove(errorEffect.result, retVar(ctx), pos))
+	curBB = unwindFunction(ctx, curBB, pos)
+	curBB.Terminator = NewReturn(pos)
+	return statementEffect{}
+}
+
+// workerStatement starts a named worker. The worker body is lowered as a lambda function so that it can refer
+// to the variables of the enclosing function, including the worker group. The future for the result of the
+// worker is stored in the local variable named after the worker.
+func workerStatement(ctx context, curBB *BIRBasicBlock, stmt *ast.BLangWorker) statementEffect {
+	pos := ctx.function().loc(stmt.GetPosition())
+	fpEffect := lambdaFunction(ctx, curBB, stmt.Lambda)
+	curBB = fpEffect.block
+	groupOp := workerGroupOperand(ctx, stmt.GroupSymbol, stmt.GetPosition())
+	var lhsOp *BIROperand
+	if ast.SymbolIsSet(stmt) {
+		lhsOp = ctx.addLocalVar(model.Name(stmt.Name.Value), ctx.symbolType(stmt.Symbol()), stmt.Symbol())
+	}
+	thenBB := ctx.function().addBB()
+	curBB.Terminator = NewAsyncCall(fpEffect.result, groupOp, stmt.Name.Value, thenBB, lhsOp, pos)
+	return statementEffect{block: thenBB}
+}
+
+func workerGroupOperand(ctx context, symRef model.SymbolRef, pos diagnostics.Location) *BIROperand {
+	op, crossedFunction, ok := lookupVar(ctx, symRef)
+	if !ok {
+		ctx.internalError("worker group variable not found", pos)
+	}
+	ctx.function().isClosure = ctx.function().isClosure || crossedFunction
+	return op
+}
+
+func workerSend(ctx context, curBB *BIRBasicBlock, send *ast.BLangWorkerSendExprBase, isSync bool) expressionEffect {
+	pos := ctx.function().loc(send.GetPosition())
+	dataEffect := handleActionOrExpression(ctx, curBB, send.Expr)
+	curBB = dataEffect.block
+	resultOperand := ctx.addTempVar(send.GetDeterminedType())
+	thenBB := ctx.function().addBB()
+	curBB.Terminator = &WorkerSend{
+		BIRTerminatorBase: BIRTerminatorBase{
+			BIRInstructionBase: BIRInstructionBase{
+				BIRNodeBase: BIRNodeBase{Pos: pos},
+				LhsOp:       resultOperand,
+			},
+			ThenBB: thenBB,
+		},
+		GroupOp:   workerGroupOperand(ctx, send.GroupSymbol, send.GetPosition()),
+		DataOp:    dataEffect.result,
+		ChannelId: send.Channel.ChannelId(),
+		Sender:    send.Channel.Sender,
+		Receiver:  send.Channel.Receiver,
+		IsSync:    isSync,
+	}
+	return expressionEffect{result: resultOperand, block: thenBB}
+}
+
+func workerReceive(ctx context, curBB *BIRBasicBlock, receive *ast.BLangWorkerReceive) expressionEffect {
+	pos := ctx.function().loc(receive.GetPosition())
+	resultOperand := ctx.addTempVar(receive.GetDeterminedType())
+	thenBB := ctx.function().addBB()
+	curBB.Terminator = &WorkerReceive{
+		BIRTerminatorBase: BIRTerminatorBase{
+			BIRInstructionBase: BIRInstructionBase{
+				BIRNodeBase: BIRNodeBase{Pos: pos},
+				LhsOp:       resultOperand,
+			},
+			ThenBB: thenBB,
+		},
+		GroupOp:   workerGroupOperand(ctx, receive.GroupSymbol, receive.GetPosition()),
+		ChannelId: receive.Channel.ChannelId(),
+		Sender:    receive.Channel.Sender,
+	}
+	return expressionEffect{result: resultOperand, block: thenBB}
+}
+
+func alternateWorkerReceive(ctx context, curBB *BIRBasicBlock, expr *ast.BLangAlternateWorkerReceive) expressionEffect {
+	pos := ctx.function().loc(expr.GetPosition())
+	channels := make([]WorkerReceiveChannel, len(expr.WorkerReceives))
+	var groupOp *BIROperand
+	for i := range expr.WorkerReceives {
+		receive := &expr.WorkerReceives[i]
+		channels[i] = WorkerReceiveChannel{ChannelId: receive.Channel.ChannelId(), Sender: receive.Channel.Sender}
+		groupOp = workerGroupOperand(ctx, receive.GroupSymbol, receive.GetPosition())
+	}
+	resultOperand := ctx.addTempVar(expr.GetDeterminedType())
+	thenBB := ctx.function().addBB()
+	curBB.Terminator = &WorkerAlternateReceive{
+		BIRTerminatorBase: BIRTerminatorBase{
+			BIRInstructionBase: BIRInstructionBase{
+				BIRNodeBase: BIRNodeBase{Pos: pos},
+				LhsOp:       resultOperand,
+			},
+			ThenBB: thenBB,
+		},
+		GroupOp:  groupOp,
+		Channels: channels,
+	}
+	return expressionEffect{result: resultOperand, block: thenBB}
+}
+
+func multipleWorkerReceive(ctx context, curBB *BIRBasicBlock, expr *ast.BLangMultipleWorkerReceive) expressionEffect {
+	pos := ctx.function().loc(expr.GetPosition())
+	channels := make([]WorkerReceiveChannel, len(expr.ReceiveFields))
+	var groupOp *BIROperand
+	for i := range expr.ReceiveFields {
+		field := &expr.ReceiveFields[i]
+		channels[i] = WorkerReceiveChannel{Key: field.Key.Value, ChannelId: field.Receive.Channel.ChannelId(), Sender: field.Receive.Channel.Sender}
+		groupOp = workerGroupOperand(ctx, field.Receive.GroupSymbol, field.Receive.GetPosition())
+	}
+	resultTy := expr.GetDeterminedType()
+	resultOperand := ctx.addTempVar(resultTy)
+	thenBB := ctx.function().addBB()
+	curBB.Terminator = &WorkerMultipleReceive{
+		BIRTerminatorBase: BIRTerminatorBase{
+			BIRInstructionBase: BIRInstructionBase{
+				BIRNodeBase: BIRNodeBase{Pos: pos},
+				LhsOp:       resultOperand,
+			},
+			ThenBB: thenBB,
+		},
+		GroupOp:  groupOp,
+		Channels: channels,
+		Type:     semtypes.Diff(resultTy, semtypes.ERROR),
+	}
+	return expressionEffect{result: resultOperand, block: thenBB}
+}
+
+func waitExpression(ctx context, curBB *BIRBasicBlock, expr *ast.BLangWaitExpr) expressionEffect {
+	exprs := make([]BIROperand, len(expr.Exprs))
+	for i, future := range expr.Exprs {
+		effect := handleActionOrExpression(ctx, curBB, future)
+		curBB = effect.block
+		exprs[i] = *effect.result
+	}
+	resultOperand := ctx.addTempVar(expr.GetDeterminedType())
+	thenBB := ctx.function().addBB()
+	curBB.Terminator = NewWait(exprs, thenBB, resultOperand, ctx.function().loc(expr.GetPosition()))
+	return expressionEffect{result: resultOperand, block: thenBB}
+}
+
+func waitForAllExpression(ctx context, curBB *BIRBasicBlock, expr *ast.BLangWaitForAllExpr) expressionEffect {
+	keys := make([]string, len(expr.KeyValuePairs))
+	exprs := make([]BIROperand, len(expr.KeyValuePairs))
+	for i := range expr.KeyValuePairs {
+		field := &expr.KeyValuePairs[i]
+		effect := handleActionOrExpression(ctx, curBB, field.ValueExpr)
+		curBB = effect.block
+		keys[i] = field.Key.Value
+		exprs[i] = *effect.result
+	}
+	resultTy := expr.GetDeterminedType()
+	resultOperand := ctx.addTempVar(resultTy)
+	thenBB := ctx.function().addBB()
+	curBB.Terminator = NewWaitAll(keys, exprs, resultTy, thenBB, resultOperand, ctx.function().loc(expr.GetPosition()))
+	return expressionEffect{result: resultOperand, block: thenBB}
+}
+
+func workerFlush(ctx context, curBB *BIRBasicBlock, expr *ast.BLangWorkerFlushExpr) expressionEffect {
+	pos := ctx.function().loc(expr.GetPosition())
+	resultOperand := ctx.addTempVar(expr.GetDeterminedType())
+	thenBB := ctx.function().addBB()
+	curBB.Terminator = &Flush{
+		BIRTerminatorBase: BIRTerminatorBase{
+			BIRInstructionBase: BIRInstructionBase{
+				BIRNodeBase: BIRNodeBase{Pos: pos},
+				LhsOp:       resultOperand,
+			},
+			ThenBB: thenBB,
+		},
+		GroupOp:   workerGroupOperand(ctx, expr.GroupSymbol, expr.GetPosition()),
+		Sender:    expr.Sender,
+		Receivers: expr.Receivers,
+	}
+	return expressionEffect{result: resultOperand, block: thenBB}
+}
+
 func compoundAssignment(ctx context, curBB *BIRBasicBlock, stmt *ast.BLangCompoundAssignment) statementEffect {
 	pos := ctx.function().loc(stmt.GetPosition())
 	if indexRef, ok := stmt.VarRef.(*ast.BLangIndexBasedAccess); ok {
@@ -626,6 +877,8 @@ func memberAccessInstructionKinds(tyCtx semtypes.Context, containerType semtypes
 		return INSTRUCTION_KIND_ARRAY_LOAD, INSTRUCTION_KIND_ARRAY_STORE
 	case semtypes.IsSubtype(tyCtx, containerType, semtypes.OBJECT):
 		return INSTRUCTION_KIND_OBJECT_LOAD, INSTRUCTION_KIND_OBJECT_STORE
+	case semtypes.IsSubtype(tyCtx, containerType, semtypes.TABLE):
+		return INSTRUCTION_KIND_TABLE_LOAD, INSTRUCTION_KIND_TABLE_STORE
 	default:
 		return INSTRUCTION_KIND_MAP_LOAD, INSTRUCTION_KIND_MAP_STORE
 	}
@@ -917,11 +1170,9 @@ func handleExprFunctionBody(ctx context, body *ast.BLangExprFunctionBody) {
 	effect := handleActionOrExpression(ctx, curBB, body.Expr)
 	curBB = effect.block
 	if curBB != nil {
-		retAssign := &Move{}
-		retAssign.LhsOp = retVar(ctx)
-		retAssign.RhsOp = effect.result
-		curBB.Instructions = append(curBB.Instructions, retAssign)
-		curBB.Terminator = &Return{}
+		pos := ctx.function().loc(body.Expr.GetPosition())
+		curBB.Instructions = append(curBB.Instructions, NewMove(effect.result, retVar(ctx), pos))
+		curBB.Terminator = NewReturn(pos)
 	}
 }
 
@@ -994,6 +1245,8 @@ func handleActionOrExpression(ctx context, curBB *BIRBasicBlock, expr ast.BLangA
 		return typeTestExpression(ctx, curBB, expr)
 	case *ast.BLangMappingConstructorExpr:
 		return mappingConstructorExpression(ctx, curBB, expr)
+	case *ast.BLangTableConstructorExpr:
+		return tableConstructorExpression(ctx, curBB, expr)
 	case *ast.BLangErrorConstructorExpr:
 		return errorConstructorExpression(ctx, curBB, expr)
 	case *ast.BLangTrapExpr:
@@ -1022,6 +1275,22 @@ func handleActionOrExpression(ctx context, curBB *BIRBasicBlock, expr ast.BLangA
 		return xmlTextLiteral(ctx, curBB, expr)
 	case *ast.BLangTemplateExpr:
 		return templateExpression(ctx, curBB, expr)
+	case *ast.BLangWorkerAsyncSendExpr:
+		return workerSend(ctx, curBB, &expr.BLangWorkerSendExprBase, false)
+	case *ast.BLangWorkerSyncSendExpr:
+		return workerSend(ctx, curBB, &expr.BLangWorkerSendExprBase, true)
+	case *ast.BLangWorkerReceive:
+		return workerReceive(ctx, curBB, expr)
+	case *ast.BLangAlternateWorkerReceive:
+		return alternateWorkerReceive(ctx, curBB, expr)
+	case *ast.BLangMultipleWorkerReceive:
+		return multipleWorkerReceive(ctx, curBB, expr)
+	case *ast.BLangWorkerFlushExpr:
+		return workerFlush(ctx, curBB, expr)
+	case *ast.BLangWaitExpr:
+		return waitExpression(ctx, curBB, expr)
+	case *ast.BLangWaitForAllExpr:
+		return waitForAllExpression(ctx, curBB, expr)
 	default:
 		panic(fmt.Sprintf("unexpected expression type: %T", expr))
 	}
@@ -1154,6 +1423,8 @@ func templateExpression(ctx context, curBB *BIRBasicBlock, expr *ast.BLangTempla
 		kind = TemplateKindString
 	case ast.TemplateExprKindXML:
 		kind = TemplateKindXML
+	case ast.TemplateExprKindRegExp:
+		kind = TemplateKindRegExp
 	default:
 		panic(fmt.Sprintf("unsupported template expr kind: %d", expr.Kind))
 	}
@@ -1178,9 +1449,12 @@ func xmlSequenceLiteral(ctx context, curBB *BIRBasicBlock, expr *ast.BLangXMLSeq
 	return expressionEffect{result: resultOp, block: curBB}
 }
 
+// mappingField is a field of a mapping constructor; a spread field has no key and
+// its value is the mapping whose fields are copied.
 type mappingField struct {
-	key   string
-	value ast.BLangExpression
+	key    string
+	value  ast.BLangExpression
+	spread bool
 }
 
 func mappingConstructorExpression(ctx context, curBB *BIRBasicBlock, expr *ast.BLangMappingConstructorExpr) expressionEffect {
@@ -1190,6 +1464,8 @@ func mappingConstructorExpression(ctx context, curBB *BIRBasicBlock, expr *ast.B
 		case *ast.BLangMappingKeyValueField:
 			keyName := mappingKeyName(f.Key)
 			fields = append(fields, mappingField{key: keyName, value: f.ValueExpr})
+		case *ast.BLangMappingSpreadField:
+			fields = append(fields, mappingField{value: f.Expr, spread: true})
 		default:
 			ctx.unimplemented("non-key-value record field not implemented", expr.GetPosition())
 		}
@@ -1218,6 +1494,12 @@ func mappingKeyName(key *ast.BLangMappingKey) string {
 func mappingConstructorExpressionInner(ctx context, curBB *BIRBasicBlock, mapType semtypes.SemType, fields []mappingField, defaults []MappingConstructorDefaultEntry, pos Location) expressionEffect {
 	var entries []MappingConstructorEntry
 	for _, field := range fields {
+		if field.spread {
+			valueEffect := handleActionOrExpression(ctx, curBB, field.value)
+			curBB = valueEffect.block
+			entries = append(entries, NewMappingConstructorSpreadFieldEntry(valueEffect.result))
+			continue
+		}
 		keyOperand := ctx.addTempVar(semtypes.STRING)
 		keyLoad := NewConstantLoad(keyOperand, field.key, pos)
 		curBB.Instructions = append(curBB.Instructions, keyLoad)
@@ -1239,6 +1521,26 @@ func mappingConstructorExpressionInner(ctx context, curBB *BIRBasicBlock, mapTyp
 	}
 }
 
+func tableConstructorExpression(ctx context, curBB *BIRBasicBlock, expr *ast.BLangTableConstructorExpr) expressionEffect {
+	rows := make([]*BIROperand, len(expr.RecordLiteralList))
+	for i, row := range expr.RecordLiteralList {
+		rowEffect := mappingConstructorExpression(ctx, curBB, row)
+		curBB = rowEffect.block
+		rows[i] = rowEffect.result
+	}
+	tableType := expr.GetDeterminedType()
+	tyCtx := ctx.function().birCx.typeCtx
+	resultOperand := ctx.addTempVar(tableType)
+	keyFieldNames := semtypes.TableKeyFieldNames(tyCtx, tableType)
+	isReadonly := semtypes.IsSubtype(tyCtx, tableType, semtypes.VAL_READONLY)
+	newTable := NewTableConstructor(tableType, resultOperand, keyFieldNames, rows, isReadonly, ctx.function().loc(expr.GetPosition()))
+	curBB.Instructions = append(curBB.Instructions, newTable)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
 func errorConstructorExpression(ctx context, curBB *BIRBasicBlock, expr *ast.BLangErrorConstructorExpr) expressionEffect {
 	// Message is the first positional arg
 	msgEffect := handleActionOrExpression(ctx, curBB, expr.PositionalArgs[0])
@@ -1348,7 +1650,8 @@ func listConstructorExpression(ctx context, bb *BIRBasicBlock, expr *ast.BLangLi
 	lat := expr.AtomicType
 	exprPos := ctx.function().loc(expr.GetPosition())
 	tyCx := ctx.function().birCx.typeCtx
-	for i := len(expr.Exprs); i < lat.Members.FixedLength; i++ {
+	memberCount, variableLength := listConstructorMemberCount(tyCx, expr)
+	for i := memberCount; !variableLength && i < lat.Members.FixedLength; i++ {
 		ty := lat.MemberAtInnerVal(i)
 		filler, ok := semtypes.FillerValue(tyCx, ty)
 		if !ok {
@@ -1360,14 +1663,28 @@ func listConstructorExpression(ctx context, bb *BIRBasicBlock, expr *ast.BLangLi
 	}
 	restFiller, _ := values.FillerFactoryFor(tyCx, lat.Rest())
 
+	// Spread members are flattened at runtime, so the size only accounts for the
+	// remaining operands.
+	var spreadMembers []bool
+	size := len(initValues)
+	if expr.HasSpreadMembers() {
+		spreadMembers = make([]bool, len(initValues))
+		for i := range expr.Exprs {
+			if expr.IsSpreadMember(i) {
+				spreadMembers[i] = true
+				size--
+			}
+		}
+	}
 	sizeOperand := ctx.addTempVar(semtypes.INT)
-	constantLoad := NewConstantLoad(sizeOperand, int64(len(initValues)), exprPos)
+	constantLoad := NewConstantLoad(sizeOperand, int64(size), exprPos)
 	bb.Instructions = append(bb.Instructions, constantLoad)
 
 	resultOperand := ctx.addTempVar(semtypes.LIST)
 	listTy := expr.GetDeterminedType()
 	isReadonly := semtypes.IsSubtype(tyCx, listTy, semtypes.VAL_READONLY)
 	newArray := NewArrayConstructor(listTy, resultOperand, sizeOperand, initValues, restFiller, isReadonly, exprPos)
+	newArray.SpreadMembers = spreadMembers
 	bb.Instructions = append(bb.Instructions, newArray)
 	return expressionEffect{
 		result: resultOperand,
@@ -1375,6 +1692,24 @@ func listConstructorExpression(ctx context, bb *BIRBasicBlock, expr *ast.BLangLi
 	}
 }
 
+// listConstructorMemberCount returns the number of members of a list constructor known
+// statically, and whether a spread member of variable length contributes further members.
+func listConstructorMemberCount(tyCx semtypes.Context, expr *ast.BLangListConstructorExpr) (int, bool) {
+	count := 0
+	for i, memberExpr := range expr.Exprs {
+		if !expr.IsSpreadMember(i) {
+			count++
+			continue
+		}
+		lat := semtypes.ToListAtomicType(tyCx, memberExpr.GetDeterminedType())
+		if lat == nil || !semtypes.IsNever(lat.Rest()) {
+			return count, true
+		}
+		count += lat.Members.FixedLength
+	}
+	return count, false
+}
+
 // assignmentContainerReference produces the container reference for an indexed assignment LHS.
 // When the container is itself an index-based access on a list or mapping, the inner read
 // must be a filling load so that intermediate arrays grow (and fill) and absent map keys
@@ -1471,6 +1806,7 @@ type callable interface {
 	Receiver() ast.BLangExpression
 	CallArgs() []ast.BLangExpression
 	GetName() ast.IdentifierNode
+	IsAsync() bool
 }
 
 func generateResourceAccessCall(ctx context, bb *BIRBasicBlock, expr *ast.BLangClientResourceAccessAction) expressionEffect {
@@ -1481,6 +1817,7 @@ func generateResourceAccessCall(ctx context, bb *BIRBasicBlock, expr *ast.BLangC
 	receiver := *recvEffect.result
 	pos := ctx.function().loc(expr.GetPosition())
 	var pathSegments []BIROperand
+	hasRestSegment := false
 	for i := range expr.Path {
 		seg := &expr.Path[i]
 		switch seg.Kind {
@@ -1488,11 +1825,12 @@ func generateResourceAccessCall(ctx context, bb *BIRBasicBlock, expr *ast.BLangC
 			temp := ctx.addTempVar(semtypes.StringConst(seg.Name))
 			curBB.Instructions = append(curBB.Instructions, NewConstantLoad(temp, seg.Name, pos))
 			pathSegments = append(pathSegments, *temp)
-		case ast.ResourceAccessSegmentComputed:
+		case ast.ResourceAccessSegmentComputed, ast.ResourceAccessSegmentRest:
 			effect := handleActionOrExpression(ctx, curBB, seg.Expr)
 			effect = snapshotIfNeeded(ctx, effect, pos)
 			curBB = effect.block
 			pathSegments = append(pathSegments, *effect.result)
+			hasRestSegment = seg.Kind == ast.ResourceAccessSegmentRest
 		}
 	}
 	var args []BIROperand
@@ -1504,7 +1842,10 @@ func generateResourceAccessCall(ctx context, bb *BIRBasicBlock, expr *ast.BLangC
 	}
 	thenBB := ctx.function().addBB()
 	resultOperand := ctx.addTempVar(expr.GetDeterminedType())
-	curBB.Terminator = NewResourceFunctionCall(receiver, expr.MethodName, pathSegments, args, thenBB, resultOperand, pos)
+	call := NewResourceFunctionCall(receiver, expr.MethodName, pathSegments, args, thenBB, resultOperand, pos)
+	call.IsAsync = expr.IsAsync()
+	call.HasRestSegment = hasRestSegment
+	curBB.Terminator = call
 	return expressionEffect{result: resultOperand, block: thenBB}
 }
 
@@ -1523,8 +1864,10 @@ func generateCall(ctx context, bb *BIRBasicBlock, callable callable) expressionE
 		isMethodCall = true
 	}
 
+	hasRestArg := false
 	for _, arg := range callable.CallArgs() {
-		effect := handleActionOrExpression(ctx, curBB, arg)
+		var effect expressionEffect
+		effect, hasRestArg = callArgument(ctx, curBB, arg)
 		effect = snapshotIfNeeded(ctx, effect, ctx.function().loc(callable.GetPosition()))
 		curBB = effect.block
 		args = append(args, *effect.result)
@@ -1538,6 +1881,8 @@ func generateCall(ctx context, bb *BIRBasicBlock, callable callable) expressionE
 	}
 	call := NewCall(INSTRUCTION_KIND_CALL, args, model.Name(callName), thenBB, resultOperand, ctx.function().loc(callable.GetPosition()))
 	call.IsMethodCall = isMethodCall
+	call.IsAsync = callable.IsAsync()
+	call.HasRestArg = hasRestArg
 
 	if !isMethodCall {
 		symRef := callable.ResolvedSymbol()
@@ -1561,6 +1906,15 @@ func generateCall(ctx context, bb *BIRBasicBlock, callable callable) expressionE
 	}
 }
 
+// callArgument evaluates a call argument. For a rest argument it evaluates the list whose
+// members are passed as separate arguments, and reports that it did so.
+func callArgument(ctx context, curBB *BIRBasicBlock, arg ast.BLangExpression) (expressionEffect, bool) {
+	if restArg, ok := arg.(*ast.BLangRestArgsExpression); ok {
+		return handleActionOrExpression(ctx, curBB, restArg.Expr), true
+	}
+	return handleActionOrExpression(ctx, curBB, arg), false
+}
+
 func literal(ctx context, curBB *BIRBasicBlock, expr *ast.BLangLiteral) expressionEffect {
 	resultOperand := ctx.addTempVar(expr.GetDeterminedType())
 	constantLoad := NewConstantLoad(resultOperand, expr.Value, ctx.function().loc(expr.GetPosition()))
@@ -1983,8 +2337,10 @@ func emitObjectInit(ctx context, curBB *BIRBasicBlock, classLookupKey string, ob
 
 	var args []BIROperand
 	args = append(args, *object)
+	hasRestArg := false
 	for _, arg := range argExprs {
-		argEffect := handleActionOrExpression(ctx, curBB, arg)
+		var argEffect expressionEffect
+		argEffect, hasRestArg = callArgument(ctx, curBB, arg)
 		curBB = argEffect.block
 		args = append(args, *argEffect.result)
 	}
@@ -1994,6 +2350,7 @@ func emitObjectInit(ctx context, curBB *BIRBasicBlock, classLookupKey string, ob
 	initDoneBB := ctx.function().addBB()
 	call := NewCall(INSTRUCTION_KIND_CALL, args, model.Name("init"), initDoneBB, initResult, ctx.function().loc(pos))
 	call.IsMethodCall = true
+	call.HasRestArg = hasRestArg
 	call.CachedMethodLookupKey = initMethodLookupKey
 	curBB.Terminator = call
 
@@ -134,14 +134,29 @@ func (br *birReader) readTypePool() {
 }
 
 func (br *birReader) readType() semtypes.SemType {
+	return br.typeAt(br.readTypeIndex())
+}
+
+func (br *birReader) readTypeIndex() int32 {
 	var idx int32
 	br.read(&idx)
+	return idx
+}
+
+func (br *birReader) typeAt(idx int32) semtypes.SemType {
 	if idx == -1 {
 		return semtypes.SemType{}
 	}
 	return br.tp.Get(semtypes.TypePoolIndex(idx))
 }
 
+// localVarKey identifies a local variable in varMap. Variables of nested
+// scope frames reuse the names of the function's locals, so the type is part
+// of the key.
+func localVarKey(name model.Name, tyIdx int32) string {
+	return fmt.Sprintf("%s:%d", name.Value(), tyIdx)
+}
+
 func (br *birReader) readConstantPool() {
 	var cpSize int64
 	br.read(&cpSize)
@@ -350,13 +365,13 @@ func (br *birReader) readFunction() *bir.BIRFunction {
 	var returnVar *bir.BIRLocalVariableDcl
 	if hasReturnVar {
 		_ = br.readKind()
-		returnVarType := br.readType()
+		returnVarTyIdx := br.readTypeIndex()
 		returnVarName := br.readStringCPEntry()
 
 		returnVar = &bir.BIRLocalVariableDcl{}
 		returnVar.Name = returnVarName
-		returnVar.Type = returnVarType
-		varMap[returnVarName.Value()] = returnVar
+		returnVar.Type = br.typeAt(returnVarTyIdx)
+		varMap[localVarKey(returnVarName, returnVarTyIdx)] = returnVar
 	}
 
 	localVarCount := br.readLength()
@@ -408,6 +423,38 @@ func (br *birReader) readFunction() *bir.BIRFunction {
 				if target, ok := bbMap[t.ThenBB.Id.Value()]; ok {
 					t.ThenBB = target
 				}
+			case *bir.AsyncCall:
+				if target, ok := bbMap[t.ThenBB.Id.Value()]; ok {
+					t.ThenBB = target
+				}
+			case *bir.WorkerSend:
+				if target, ok := bbMap[t.ThenBB.Id.Value()]; ok {
+					t.ThenBB = target
+				}
+			case *bir.WorkerReceive:
+				if target, ok := bbMap[t.ThenBB.Id.Value()]; ok {
+					t.ThenBB = target
+				}
+			case *bir.WorkerAlternateReceive:
+				if target, ok := bbMap[t.ThenBB.Id.Value()]; ok {
+					t.ThenBB = target
+				}
+			case *bir.WorkerMultipleReceive:
+				if target, ok := bbMap[t.ThenBB.Id.Value()]; ok {
+					t.ThenBB = target
+				}
+			case *bir.Flush:
+				if target, ok := bbMap[t.ThenBB.Id.Value()]; ok {
+					t.ThenBB = target
+				}
+			case *bir.Wait:
+				if target, ok := bbMap[t.ThenBB.Id.Value()]; ok {
+					t.ThenBB = target
+				}
+			case *bir.WaitAll:
+				if target, ok := bbMap[t.ThenBB.Id.Value()]; ok {
+					t.ThenBB = target
+				}
 			}
 		}
 	}
@@ -457,14 +504,14 @@ func (br *birReader) readFunction() *bir.BIRFunction {
 
 func (br *birReader) readLocalVar(varMap map[string]bir.BIRVariableDcl) *bir.BIRLocalVariableDcl {
 	_ = br.readKind()
-	ty := br.readType()
+	tyIdx := br.readTypeIndex()
 	name := br.readStringCPEntry()
 
 	localVar := &bir.BIRLocalVariableDcl{}
 	localVar.Name = name
-	localVar.Type = ty
+	localVar.Type = br.typeAt(tyIdx)
 
-	varMap[name.Value()] = localVar
+	varMap[localVarKey(name, tyIdx)] = localVar
 	return localVar
 }
 
@@ -570,7 +617,8 @@ func (br *birReader) readInstruction(varMap map[string]bir.BIRVariableDcl) bir.B
 		bir.INSTRUCTION_KIND_ARRAY_STORE, bir.INSTRUCTION_KIND_ARRAY_LOAD,
 		bir.INSTRUCTION_KIND_ARRAY_FILLING_LOAD,
 		bir.INSTRUCTION_KIND_MAP_FILLING_LOAD,
-		bir.INSTRUCTION_KIND_OBJECT_STORE, bir.INSTRUCTION_KIND_OBJECT_LOAD:
+		bir.INSTRUCTION_KIND_OBJECT_STORE, bir.INSTRUCTION_KIND_OBJECT_LOAD,
+		bir.INSTRUCTION_KIND_TABLE_LOAD:
 		lhsOp := br.readOperand(varMap)
 		keyOp := br.readOperand(varMap)
 		rhsOp := br.readOperand(varMap)
@@ -603,16 +651,26 @@ func (br *birReader) readInstruction(varMap map[string]bir.BIRVariableDcl) bir.B
 		for k := 0; k < int(valuesCount); k++ {
 			arrayValues[k] = br.readOperand(varMap)
 		}
+		var hasSpreadMembers bool
+		br.read(&hasSpreadMembers)
+		var spreadMembers []bool
+		if hasSpreadMembers {
+			spreadMembers = make([]bool, valuesCount)
+			for k := range spreadMembers {
+				br.read(&spreadMembers[k])
+			}
+		}
 		return &bir.NewArray{
 			BIRInstructionBase: bir.BIRInstructionBase{
 				BIRNodeBase: bir.BIRNodeBase{Pos: pos},
 				LhsOp:       lhsOp,
 			},
-			Type:       ty,
-			SizeOp:     sizeOp,
-			Values:     arrayValues,
-			Filler:     br.restFillerFactoryForListType(ty),
-			IsReadonly: isReadonly,
+			Type:          ty,
+			SizeOp:        sizeOp,
+			Values:        arrayValues,
+			SpreadMembers: spreadMembers,
+			Filler:        br.restFillerFactoryForListType(ty),
+			IsReadonly:    isReadonly,
 		}
 	case bir.INSTRUCTION_KIND_TYPE_CAST:
 		lhsOp := br.readOperand(varMap)
@@ -653,7 +711,8 @@ func (br *birReader) readInstruction(varMap map[string]bir.BIRVariableDcl) bir.B
 			var isKeyValuePair bool
 			br.read(&isKeyValuePair)
 			if !isKeyValuePair {
-				panic("spread entries in mapping constructors are not supported")
+				values[k] = bir.NewMappingConstructorSpreadFieldEntry(br.readOperand(varMap))
+				continue
 			}
 			keyOp := br.readOperand(varMap)
 			valueOp := br.readOperand(varMap)
@@ -715,6 +774,22 @@ func (br *birReader) readInstruction(varMap map[string]bir.BIRVariableDcl) bir.B
 			},
 			ClassDefRef: classDefRef.Value(),
 		}
+	case bir.INSTRUCTION_KIND_NEW_TABLE:
+		ty := br.readType()
+		lhsOp := br.readOperand(varMap)
+		var isReadonly bool
+		br.read(&isReadonly)
+		keyCount := br.readLength()
+		keyFieldNames := make([]string, keyCount)
+		for k := 0; k < int(keyCount); k++ {
+			keyFieldNames[k] = string(br.readStringCPEntry())
+		}
+		rowCount := br.readLength()
+		rows := make([]*bir.BIROperand, rowCount)
+		for k := 0; k < int(rowCount); k++ {
+			rows[k] = br.readOperand(varMap)
+		}
+		return bir.NewTableConstructor(ty, lhsOp, keyFieldNames, rows, isReadonly, pos)
 	case bir.INSTRUCTION_KIND_NEW_STREAM:
 		streamTy := br.readType()
 		lhsOp := br.readOperand(varMap)
@@ -870,8 +945,10 @@ func (br *birReader) readTerminator(varMap map[string]bir.BIRVariableDcl) bir.BI
 			},
 		}
 	case bir.INSTRUCTION_KIND_CALL, bir.INSTRUCTION_KIND_FP_CALL:
-		var isMethodCall bool
+		var isMethodCall, isAsync, hasRestArg bool
 		br.read(&isMethodCall)
+		br.read(&isAsync)
+		br.read(&hasRestArg)
 
 		pkg := br.readPackageCPEntry()
 		name := br.readStringCPEntry()
@@ -902,6 +979,8 @@ func (br *birReader) readTerminator(varMap map[string]bir.BIRVariableDcl) bir.BI
 		return &bir.Call{
 			Kind:              termInstructionKind,
 			IsMethodCall:      isMethodCall,
+			IsAsync:           isAsync,
+			HasRestArg:        hasRestArg,
 			CalleePkg:         pkg,
 			Name:              name,
 			FunctionLookupKey: string(functionLookupKey),
@@ -940,6 +1019,10 @@ func (br *birReader) readTerminator(varMap map[string]bir.BIRVariableDcl) bir.BI
 			LockKey: string(key),
 		}
 	case bir.INSTRUCTION_KIND_RESOURCE_CALL:
+		var isAsync bool
+		br.read(&isAsync)
+		var hasRestSegment bool
+		br.read(&hasRestSegment)
 		receiver := br.readOperand(varMap)
 		methodNameN := br.readStringCPEntry()
 		methodName := methodNameN.Value()
@@ -968,10 +1051,12 @@ func (br *birReader) readTerminator(varMap map[string]bir.BIRVariableDcl) bir.BI
 				},
 				ThenBB: &bir.BIRBasicBlock{Id: thenBBId},
 			},
-			Receiver:     *receiver,
-			MethodName:   methodName,
-			PathSegments: pathSegments,
-			Args:         args,
+			Receiver:       *receiver,
+			MethodName:     methodName,
+			PathSegments:   pathSegments,
+			Args:           args,
+			IsAsync:        isAsync,
+			HasRestSegment: hasRestSegment,
 		}
 	case bir.INSTRUCTION_KIND_UNLOCK:
 		key := br.readStringCPEntry()
@@ -985,11 +1070,155 @@ func (br *birReader) readTerminator(varMap map[string]bir.BIRVariableDcl) bir.BI
 			},
 			LockKey: string(key),
 		}
+	case bir.INSTRUCTION_KIND_ASYNC_CALL:
+		fpOperand := br.readOperand(varMap)
+		groupOp := br.readOperand(varMap)
+		workerName := br.readStringCPEntry()
+		var lhsExists bool
+		br.read(&lhsExists)
+		var lhsOp *bir.BIROperand
+		if lhsExists {
+			lhsOp = br.readOperand(varMap)
+		}
+		thenBBId := br.readStringCPEntry()
+		return bir.NewAsyncCall(fpOperand, groupOp, string(workerName), &bir.BIRBasicBlock{Id: thenBBId}, lhsOp, pos)
+	case bir.INSTRUCTION_KIND_WK_SEND:
+		groupOp := br.readOperand(varMap)
+		dataOp := br.readOperand(varMap)
+		channelId := br.readStringCPEntry()
+		sender := br.readStringCPEntry()
+		receiver := br.readStringCPEntry()
+		var isSync bool
+		br.read(&isSync)
+		lhsOp := br.readOperand(varMap)
+		thenBBId := br.readStringCPEntry()
+		return &bir.WorkerSend{
+			BIRTerminatorBase: bir.BIRTerminatorBase{
+				BIRInstructionBase: bir.BIRInstructionBase{
+					BIRNodeBase: bir.BIRNodeBase{Pos: pos},
+					LhsOp:       lhsOp,
+				},
+				ThenBB: &bir.BIRBasicBlock{Id: thenBBId},
+			},
+			GroupOp:   groupOp,
+			DataOp:    dataOp,
+			ChannelId: string(channelId),
+			Sender:    string(sender),
+			Receiver:  string(receiver),
+			IsSync:    isSync,
+		}
+	case bir.INSTRUCTION_KIND_WK_RECEIVE:
+		groupOp := br.readOperand(varMap)
+		channelId := br.readStringCPEntry()
+		sender := br.readStringCPEntry()
+		lhsOp := br.readOperand(varMap)
+		thenBBId := br.readStringCPEntry()
+		return &bir.WorkerReceive{
+			BIRTerminatorBase: bir.BIRTerminatorBase{
+				BIRInstructionBase: bir.BIRInstructionBase{
+					BIRNodeBase: bir.BIRNodeBase{Pos: pos},
+					LhsOp:       lhsOp,
+				},
+				ThenBB: &bir.BIRBasicBlock{Id: thenBBId},
+			},
+			GroupOp:   groupOp,
+			ChannelId: string(channelId),
+			Sender:    string(sender),
+		}
+	case bir.INSTRUCTION_KIND_WK_ALT_RECEIVE:
+		groupOp := br.readOperand(varMap)
+		channels := br.readReceiveChannels()
+		lhsOp := br.readOperand(varMap)
+		thenBBId := br.readStringCPEntry()
+		return &bir.WorkerAlternateReceive{
+			BIRTerminatorBase: bir.BIRTerminatorBase{
+				BIRInstructionBase: bir.BIRInstructionBase{
+					BIRNodeBase: bir.BIRNodeBase{Pos: pos},
+					LhsOp:       lhsOp,
+				},
+				ThenBB: &bir.BIRBasicBlock{Id: thenBBId},
+			},
+			GroupOp:  groupOp,
+			Channels: channels,
+		}
+	case bir.INSTRUCTION_KIND_WK_MULTIPLE_RECEIVE:
+		groupOp := br.readOperand(varMap)
+		channels := br.readReceiveChannels()
+		ty := br.readType()
+		lhsOp := br.readOperand(varMap)
+		thenBBId := br.readStringCPEntry()
+		return &bir.WorkerMultipleReceive{
+			BIRTerminatorBase: bir.BIRTerminatorBase{
+				BIRInstructionBase: bir.BIRInstructionBase{
+					BIRNodeBase: bir.BIRNodeBase{Pos: pos},
+					LhsOp:       lhsOp,
+				},
+				ThenBB: &bir.BIRBasicBlock{Id: thenBBId},
+			},
+			GroupOp:  groupOp,
+			Channels: channels,
+			Type:     ty,
+		}
+	case bir.INSTRUCTION_KIND_FLUSH:
+		groupOp := br.readOperand(varMap)
+		sender := br.readStringCPEntry()
+		receiverCount := br.readLength()
+		receivers := make([]string, receiverCount)
+		for k := 0; k < int(receiverCount); k++ {
+			receivers[k] = string(br.readStringCPEntry())
+		}
+		lhsOp := br.readOperand(varMap)
+		thenBBId := br.readStringCPEntry()
+		return &bir.Flush{
+			BIRTerminatorBase: bir.BIRTerminatorBase{
+				BIRInstructionBase: bir.BIRInstructionBase{
+					BIRNodeBase: bir.BIRNodeBase{Pos: pos},
+					LhsOp:       lhsOp,
+				},
+				ThenBB: &bir.BIRBasicBlock{Id: thenBBId},
+			},
+			GroupOp:   groupOp,
+			Sender:    string(sender),
+			Receivers: receivers,
+		}
+	case bir.INSTRUCTION_KIND_WAIT:
+		exprCount := br.readLength()
+		exprs := make([]bir.BIROperand, exprCount)
+		for k := 0; k < int(exprCount); k++ {
+			exprs[k] = *br.readOperand(varMap)
+		}
+		lhsOp := br.readOperand(varMap)
+		thenBBId := br.readStringCPEntry()
+		return bir.NewWait(exprs, &bir.BIRBasicBlock{Id: thenBBId}, lhsOp, pos)
+	case bir.INSTRUCTION_KIND_WAIT_ALL:
+		exprCount := br.readLength()
+		keys := make([]string, exprCount)
+		exprs := make([]bir.BIROperand, exprCount)
+		for k := 0; k < int(exprCount); k++ {
+			keys[k] = string(br.readStringCPEntry())
+			exprs[k] = *br.readOperand(varMap)
+		}
+		ty := br.readType()
+		lhsOp := br.readOperand(varMap)
+		thenBBId := br.readStringCPEntry()
+		return bir.NewWaitAll(keys, exprs, ty, &bir.BIRBasicBlock{Id: thenBBId}, lhsOp, pos)
 	default:
 		panic(fmt.Sprintf("unsupported terminator kind: %d", termInstructionKind))
 	}
 }
 
+func (br *birReader) readReceiveChannels() []bir.WorkerReceiveChannel {
+	count := br.readLength()
+	channels := make([]bir.WorkerReceiveChannel, count)
+	for k := 0; k < int(count); k++ {
+		key := br.readStringCPEntry()
+		channelId := br.readStringCPEntry()
+		sender := br.readStringCPEntry()
+		channels[k] = bir.WorkerReceiveChannel{Key: string(key), ChannelId: string(channelId), Sender: string(sender)}
+	}
+	return channels
+}
+
 func (br *birReader) readOperand(varMap map[string]bir.BIRVariableDcl) *bir.BIROperand {
 	var ignoreVariable bool
 	br.read(&ignoreVariable)
@@ -1018,11 +1247,15 @@ func (br *birReader) readOperand(varMap map[string]bir.BIRVariableDcl) *bir.BIRO
 		return &bir.BIROperand{VariableDcl: gv}
 	}
 
-	varDcl, ok := varMap[name.Value()]
+	tyIdx := br.readTypeIndex()
+	key := localVarKey(name, tyIdx)
+	varDcl, ok := varMap[key]
 	if !ok {
-		varDcl = &bir.BIRLocalVariableDcl{}
-		varDcl.(*bir.BIRLocalVariableDcl).SetName(name)
-		varMap[name.Value()] = varDcl
+		localVar := &bir.BIRLocalVariableDcl{}
+		localVar.SetName(name)
+		localVar.Type = br.typeAt(tyIdx)
+		varDcl = localVar
+		varMap[key] = varDcl
 	}
 
 	var mode uint8
@@ -1090,6 +1323,8 @@ func (br *birReader) readConstValueByTag(tag typeTag) any {
 		var idx int32
 		br.read(&idx)
 		return nil
+	case typeTagTypedesc:
+		return &values.TypeDesc{Type: br.readType()}
 	default:
 		var idx int32
 		br.read(&idx)
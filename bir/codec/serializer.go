@@ -26,11 +26,12 @@ import (
 	"ballerina-lang-go/decimal"
 	"ballerina-lang-go/model"
 	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/values"
 )
 
 const (
 	BIR_MAGIC   = "\xba\x10\xc0\xde"
-	BIR_VERSION = 77
+	BIR_VERSION = 79
 )
 
 type birWriter struct {
@@ -290,6 +291,10 @@ func (bw *birWriter) writeInstruction(buf *bytes.Buffer, instr bir.BIRInstructio
 		for _, v := range instr.Values {
 			bw.writeOperand(buf, v)
 		}
+		write(buf, instr.SpreadMembers != nil)
+		for _, isSpread := range instr.SpreadMembers {
+			write(buf, isSpread)
+		}
 	case *bir.TypeCast:
 		bw.writeOperand(buf, instr.LhsOp)
 		bw.writeOperand(buf, instr.RhsOp)
@@ -311,6 +316,8 @@ func (bw *birWriter) writeInstruction(buf *bytes.Buffer, instr bir.BIRInstructio
 				kvEntry := entry.(*bir.MappingConstructorKeyValueEntry)
 				bw.writeOperand(buf, kvEntry.KeyOp())
 				bw.writeOperand(buf, kvEntry.ValueOp())
+			} else {
+				bw.writeOperand(buf, entry.ValueOp())
 			}
 		}
 		bw.writeLength(buf, len(instr.Defaults))
@@ -334,6 +341,18 @@ func (bw *birWriter) writeInstruction(buf *bytes.Buffer, instr bir.BIRInstructio
 	case *bir.NewObject:
 		bw.writeStringCPEntry(buf, instr.ClassDefRef)
 		bw.writeOperand(buf, instr.LhsOp)
+	case *bir.NewTable:
+		bw.writeType(buf, instr.Type)
+		bw.writeOperand(buf, instr.LhsOp)
+		write(buf, instr.IsReadonly)
+		bw.writeLength(buf, len(instr.KeyFieldNames))
+		for _, name := range instr.KeyFieldNames {
+			bw.writeStringCPEntry(buf, name)
+		}
+		bw.writeLength(buf, len(instr.Rows))
+		for _, row := range instr.Rows {
+			bw.writeOperand(buf, row)
+		}
 	case *bir.NewStream:
 		bw.writeType(buf, instr.StreamType)
 		bw.writeOperand(buf, instr.LhsOp)
@@ -411,6 +430,8 @@ func (bw *birWriter) writeTerminator(buf *bytes.Buffer, term bir.BIRTerminator)
 		bw.writeStringCPEntry(buf, term.FalseBB.Id.Value())
 	case *bir.Call:
 		write(buf, term.IsMethodCall)
+		write(buf, term.IsAsync)
+		write(buf, term.HasRestArg)
 		bw.writePackageCPEntry(buf, term.CalleePkg)
 		bw.writeStringCPEntry(buf, term.Name.Value())
 		bw.writeStringCPEntry(buf, term.FunctionLookupKey)
@@ -442,6 +463,8 @@ func (bw *birWriter) writeTerminator(buf *bytes.Buffer, term bir.BIRTerminator)
 		bw.writeStringCPEntry(buf, term.LockKey)
 		bw.writeStringCPEntry(buf, term.ThenBB.Id.Value())
 	case *bir.ResourceFunctionCall:
+		write(buf, term.IsAsync)
+		write(buf, term.HasRestSegment)
 		bw.writeOperand(buf, &term.Receiver)
 		bw.writeStringCPEntry(buf, term.MethodName)
 		bw.writeLength(buf, len(term.PathSegments))
@@ -459,11 +482,82 @@ func (bw *birWriter) writeTerminator(buf *bytes.Buffer, term bir.BIRTerminator)
 			write(buf, uint8(0))
 		}
 		bw.writeStringCPEntry(buf, term.ThenBB.Id.Value())
+	case *bir.AsyncCall:
+		bw.writeOperand(buf, term.FpOperand)
+		bw.writeOperand(buf, term.GroupOp)
+		bw.writeStringCPEntry(buf, term.WorkerName)
+		if term.LhsOp != nil {
+			write(buf, uint8(1))
+			bw.writeOperand(buf, term.LhsOp)
+		} else {
+			write(buf, uint8(0))
+		}
+		bw.writeStringCPEntry(buf, term.ThenBB.Id.Value())
+	case *bir.WorkerSend:
+		bw.writeOperand(buf, term.GroupOp)
+		bw.writeOperand(buf, term.DataOp)
+		bw.writeStringCPEntry(buf, term.ChannelId)
+		bw.writeStringCPEntry(buf, term.Sender)
+		bw.writeStringCPEntry(buf, term.Receiver)
+		write(buf, term.IsSync)
+		bw.writeOperand(buf, term.LhsOp)
+		bw.writeStringCPEntry(buf, term.ThenBB.Id.Value())
+	case *bir.WorkerReceive:
+		bw.writeOperand(buf, term.GroupOp)
+		bw.writeStringCPEntry(buf, term.ChannelId)
+		bw.writeStringCPEntry(buf, term.Sender)
+		bw.writeOperand(buf, term.LhsOp)
+		bw.writeStringCPEntry(buf, term.ThenBB.Id.Value())
+	case *bir.WorkerAlternateReceive:
+		bw.writeOperand(buf, term.GroupOp)
+		bw.writeReceiveChannels(buf, term.Channels)
+		bw.writeOperand(buf, term.LhsOp)
+		bw.writeStringCPEntry(buf, term.ThenBB.Id.Value())
+	case *bir.WorkerMultipleReceive:
+		bw.writeOperand(buf, term.GroupOp)
+		bw.writeReceiveChannels(buf, term.Channels)
+		bw.writeType(buf, term.Type)
+		bw.writeOperand(buf, term.LhsOp)
+		bw.writeStringCPEntry(buf, term.ThenBB.Id.Value())
+	case *bir.Flush:
+		bw.writeOperand(buf, term.GroupOp)
+		bw.writeStringCPEntry(buf, term.Sender)
+		bw.writeLength(buf, len(term.Receivers))
+		for _, receiver := range term.Receivers {
+			bw.writeStringCPEntry(buf, receiver)
+		}
+		bw.writeOperand(buf, term.LhsOp)
+		bw.writeStringCPEntry(buf, term.ThenBB.Id.Value())
+	case *bir.Wait:
+		bw.writeLength(buf, len(term.Exprs))
+		for i := range term.Exprs {
+			bw.writeOperand(buf, &term.Exprs[i])
+		}
+		bw.writeOperand(buf, term.LhsOp)
+		bw.writeStringCPEntry(buf, term.ThenBB.Id.Value())
+	case *bir.WaitAll:
+		bw.writeLength(buf, len(term.Exprs))
+		for i := range term.Exprs {
+			bw.writeStringCPEntry(buf, term.Keys[i])
+			bw.writeOperand(buf, &term.Exprs[i])
+		}
+		bw.writeType(buf, term.Type)
+		bw.writeOperand(buf, term.LhsOp)
+		bw.writeStringCPEntry(buf, term.ThenBB.Id.Value())
 	default:
 		panic(fmt.Sprintf("unsupported terminator type: %T", term))
 	}
 }
 
+func (bw *birWriter) writeReceiveChannels(buf *bytes.Buffer, channels []bir.WorkerReceiveChannel) {
+	bw.writeLength(buf, len(channels))
+	for _, ch := range channels {
+		bw.writeStringCPEntry(buf, ch.Key)
+		bw.writeStringCPEntry(buf, ch.ChannelId)
+		bw.writeStringCPEntry(buf, ch.Sender)
+	}
+}
+
 func (bw *birWriter) writeOperand(buf *bytes.Buffer, op *bir.BIROperand) {
 	if op == nil || op.VariableDcl == nil {
 		write(buf, false)
@@ -492,6 +586,7 @@ func (bw *birWriter) writeOperand(buf *bytes.Buffer, op *bir.BIROperand) {
 		bw.writeStringCPEntry(buf, gv.GlobalVarLookupKey)
 		bw.writePackageCPEntry(buf, gv.PkgId)
 	} else {
+		bw.writeType(buf, op.VariableDcl.GetType())
 		write(buf, uint8(op.Address.Mode))
 		write(buf, int32(op.Address.FrameIndex))
 		write(buf, int32(op.Address.BaseIndex))
@@ -581,6 +676,12 @@ func (bw *birWriter) writeConstValueByTag(buf *bytes.Buffer, tag typeTag, value
 		write(buf, val)
 	case typeTagNil:
 		write(buf, int32(-1))
+	case typeTagTypedesc:
+		td, ok := value.(*values.TypeDesc)
+		if !ok {
+			panic(fmt.Sprintf("expected typedesc for tag %v, got %T", tag, value))
+		}
+		bw.writeType(buf, td.Type)
 	default:
 		panic(fmt.Sprintf("unsupported tag for constant value: %v", tag))
 	}
@@ -605,6 +706,8 @@ func (bw *birWriter) inferTag(value any) (typeTag, error) {
 		return typeTagDecimal, nil
 	case nil:
 		return typeTagNil, nil
+	case *values.TypeDesc:
+		return typeTagTypedesc, nil
 	default:
 		return 0, fmt.Errorf("cannot infer tag for value %v (%T)", value, value)
 	}
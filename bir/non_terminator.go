@@ -68,11 +68,14 @@ type (
 
 	NewArray struct {
 		BIRInstructionBase
-		SizeOp     *BIROperand
-		Type       semtypes.SemType
-		Values     []*BIROperand
-		Filler     values.FillerFactory
-		IsReadonly bool
+		SizeOp *BIROperand
+		Type   semtypes.SemType
+		Values []*BIROperand
+		// SpreadMembers is either nil or parallel to Values; a true entry marks a
+		// spread member `...expr` whose list members are inlined at that position.
+		SpreadMembers []bool
+		Filler        values.FillerFactory
+		IsReadonly    bool
 	}
 
 	// JBallerina call this NewStruct but prints as NewMap
@@ -118,6 +121,14 @@ type (
 		ClassDefRef string
 	}
 
+	NewTable struct {
+		BIRInstructionBase
+		Type          semtypes.SemType
+		KeyFieldNames []string
+		Rows          []*BIROperand
+		IsReadonly    bool
+	}
+
 	NewStream struct {
 		BIRInstructionBase
 		StreamType semtypes.SemType
@@ -194,6 +205,7 @@ const (
 	TemplateKindString TemplateKind = iota
 	TemplateKindXML
 	TemplateKindRaw
+	TemplateKindRegExp
 )
 
 type (
@@ -201,6 +213,12 @@ type (
 		keyOp   *BIROperand
 		valueOp *BIROperand
 	}
+
+	// MappingConstructorSpreadFieldEntry is a spread field `...expr`; all fields of
+	// the mapping value are copied into the constructed mapping.
+	MappingConstructorSpreadFieldEntry struct {
+		valueOp *BIROperand
+	}
 )
 
 var (
@@ -215,6 +233,7 @@ var (
 	_ BIRInstruction          = &NewMap{}
 	_ BIRAssignInstruction    = &NewError{}
 	_ BIRAssignInstruction    = &NewObject{}
+	_ BIRAssignInstruction    = &NewTable{}
 	_ BIRAssignInstruction    = &NewStream{}
 	_ BIRAssignInstruction    = &StreamNext{}
 	_ BIRAssignInstruction    = &StreamClose{}
@@ -228,6 +247,7 @@ var (
 	_ BIRAssignInstruction    = &NewXMLSequence{}
 	_ BIRAssignInstruction    = &EvalTemplateExpr{}
 	_ MappingConstructorEntry = &MappingConstructorKeyValueEntry{}
+	_ MappingConstructorEntry = &MappingConstructorSpreadFieldEntry{}
 )
 
 func (m *Move) GetLhsOperand() *BIROperand {
@@ -343,6 +363,11 @@ func (n *NewArray) GetKind() InstructionKind {
 	return INSTRUCTION_KIND_NEW_ARRAY
 }
 
+// IsSpreadMember reports whether Values[i] is a spread member.
+func (n *NewArray) IsSpreadMember(i int) bool {
+	return n.SpreadMembers != nil && n.SpreadMembers[i]
+}
+
 func NewArrayConstructor(typ semtypes.SemType, lhsOp, sizeOp *BIROperand, values []*BIROperand, filler values.FillerFactory, isReadonly bool, pos Location) *NewArray {
 	return &NewArray{
 		BIRInstructionBase: BIRInstructionBase{
@@ -476,6 +501,27 @@ func NewObjectConstructor(classDefRef string, lhsOp *BIROperand, pos Location) *
 	}
 }
 
+func (n *NewTable) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_TABLE
+}
+
+func (n *NewTable) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func NewTableConstructor(typ semtypes.SemType, lhsOp *BIROperand, keyFieldNames []string, rows []*BIROperand, isReadonly bool, pos Location) *NewTable {
+	return &NewTable{
+		BIRInstructionBase: BIRInstructionBase{
+			BIRNodeBase: BIRNodeBase{Pos: pos},
+			LhsOp:       lhsOp,
+		},
+		Type:          typ,
+		KeyFieldNames: keyFieldNames,
+		Rows:          rows,
+		IsReadonly:    isReadonly,
+	}
+}
+
 func (n *NewStream) GetKind() InstructionKind {
 	return INSTRUCTION_KIND_NEW_STREAM
 }
@@ -579,6 +625,20 @@ func (m *MappingConstructorKeyValueEntry) KeyOp() *BIROperand {
 	return m.keyOp
 }
 
+func NewMappingConstructorSpreadFieldEntry(valueOp *BIROperand) *MappingConstructorSpreadFieldEntry {
+	return &MappingConstructorSpreadFieldEntry{
+		valueOp: valueOp,
+	}
+}
+
+func (m *MappingConstructorSpreadFieldEntry) IsKeyValuePair() bool {
+	return false
+}
+
+func (m *MappingConstructorSpreadFieldEntry) ValueOp() *BIROperand {
+	return m.valueOp
+}
+
 func (n *NewXMLElement) GetLhsOperand() *BIROperand { return n.LhsOp }
 func (n *NewXMLElement) GetKind() InstructionKind   { return INSTRUCTION_KIND_NEW_XML_ELEMENT }
 
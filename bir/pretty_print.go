@@ -194,8 +194,26 @@ func (p *PrettyPrinter) PrintInstruction(instruction BIRInstruction) string {
 		return p.PrintLockEnd(instruction)
 	case *ResourceFunctionCall:
 		return p.PrintResourceFunctionCall(instruction)
+	case *AsyncCall:
+		return p.PrintAsyncCall(instruction)
+	case *WorkerSend:
+		return p.PrintWorkerSend(instruction)
+	case *WorkerReceive:
+		return p.PrintWorkerReceive(instruction)
+	case *WorkerAlternateReceive:
+		return p.PrintWorkerAlternateReceive(instruction)
+	case *WorkerMultipleReceive:
+		return p.PrintWorkerMultipleReceive(instruction)
+	case *Flush:
+		return p.PrintFlush(instruction)
+	case *Wait:
+		return p.PrintWait(instruction)
+	case *WaitAll:
+		return p.PrintWaitAll(instruction)
 	case *NewObject:
 		return p.PrintNewObject(instruction)
+	case *NewTable:
+		return p.PrintNewTable(instruction)
 	case *NewStream:
 		return p.PrintNewStream(instruction)
 	case *StreamNext:
@@ -255,6 +273,9 @@ func (p *PrettyPrinter) PrintNewArray(array *NewArray) string {
 		if i > 0 {
 			values.WriteString(", ")
 		}
+		if array.IsSpreadMember(i) {
+			values.WriteString("...")
+		}
 		values.WriteString(p.PrintOperand(*v))
 	}
 	return fmt.Sprintf("%s = newArray %s[%s]{%s}", p.PrintOperand(*array.LhsOp), p.PrintSemType(array.Type), p.PrintOperand(*array.SizeOp), values.String())
@@ -272,6 +293,7 @@ func (p *PrettyPrinter) PrintNewMap(m *NewMap) string {
 			values.WriteString("=")
 			values.WriteString(p.PrintOperand(*kv.ValueOp()))
 		} else {
+			values.WriteString("...")
 			values.WriteString(p.PrintOperand(*entry.ValueOp()))
 		}
 	}
@@ -305,7 +327,7 @@ func (p *PrettyPrinter) PrintFieldAccess(access *FieldAccess) string {
 	switch access.Kind {
 	case INSTRUCTION_KIND_MAP_STORE, INSTRUCTION_KIND_ARRAY_STORE, INSTRUCTION_KIND_OBJECT_STORE:
 		return fmt.Sprintf("%s[%s] = %s;", p.PrintOperand(*access.LhsOp), p.PrintOperand(*access.KeyOp), p.PrintOperand(*access.RhsOp))
-	case INSTRUCTION_KIND_MAP_LOAD, INSTRUCTION_KIND_ARRAY_LOAD, INSTRUCTION_KIND_OBJECT_LOAD:
+	case INSTRUCTION_KIND_MAP_LOAD, INSTRUCTION_KIND_ARRAY_LOAD, INSTRUCTION_KIND_OBJECT_LOAD, INSTRUCTION_KIND_TABLE_LOAD:
 		return fmt.Sprintf("%s = %s[%s];", p.PrintOperand(*access.LhsOp), p.PrintOperand(*access.RhsOp), p.PrintOperand(*access.KeyOp))
 	case INSTRUCTION_KIND_ARRAY_FILLING_LOAD, INSTRUCTION_KIND_MAP_FILLING_LOAD:
 		return fmt.Sprintf("%s = %s[%s] (fill);", p.PrintOperand(*access.LhsOp), p.PrintOperand(*access.RhsOp), p.PrintOperand(*access.KeyOp))
@@ -318,6 +340,17 @@ func (p *PrettyPrinter) PrintNewObject(n *NewObject) string {
 	return fmt.Sprintf("%s = newObject %s", p.PrintOperand(*n.LhsOp), n.ClassDefRef)
 }
 
+func (p *PrettyPrinter) PrintNewTable(n *NewTable) string {
+	rows := strings.Builder{}
+	for i, row := range n.Rows {
+		if i > 0 {
+			rows.WriteString(", ")
+		}
+		rows.WriteString(p.PrintOperand(*row))
+	}
+	return fmt.Sprintf("%s = newTable %s key(%s) [%s]", p.PrintOperand(*n.LhsOp), p.PrintSemType(n.Type), strings.Join(n.KeyFieldNames, ", "), rows.String())
+}
+
 func (p *PrettyPrinter) PrintNewStream(n *NewStream) string {
 	return fmt.Sprintf("%s = newStream %s %s", p.PrintOperand(*n.LhsOp), p.PrintSemType(n.StreamType), p.PrintOperand(*n.ImplOp))
 }
@@ -425,6 +458,9 @@ func (p *PrettyPrinter) PrintResourceFunctionCall(call *ResourceFunctionCall) st
 		if i > 0 {
 			segs.WriteString(",")
 		}
+		if call.HasRestSegment && i == len(call.PathSegments)-1 {
+			segs.WriteString("...")
+		}
 		segs.WriteString(p.PrintOperand(seg))
 	}
 	args := strings.Builder{}
@@ -434,7 +470,62 @@ func (p *PrettyPrinter) PrintResourceFunctionCall(call *ResourceFunctionCall) st
 		}
 		args.WriteString(p.PrintOperand(arg))
 	}
-	return fmt.Sprintf("%s = %s->[%s].%s(%s) -> %s;", p.PrintOperand(*call.LhsOp), p.PrintOperand(call.Receiver), segs.String(), call.MethodName, args.String(), call.ThenBB.Id.Value())
+	return fmt.Sprintf("%s = %s%s->[%s].%s(%s) -> %s;", p.PrintOperand(*call.LhsOp), startPrefix(call.IsAsync), p.PrintOperand(call.Receiver), segs.String(), call.MethodName, args.String(), call.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintAsyncCall(call *AsyncCall) string {
+	if call.LhsOp != nil {
+		return fmt.Sprintf("%s = start-worker %s %s(%s) -> %s;", p.PrintOperand(*call.LhsOp), call.WorkerName, p.PrintOperand(*call.FpOperand), p.PrintOperand(*call.GroupOp), call.ThenBB.Id.Value())
+	}
+	return fmt.Sprintf("start-worker %s %s(%s) -> %s;", call.WorkerName, p.PrintOperand(*call.FpOperand), p.PrintOperand(*call.GroupOp), call.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintWorkerSend(send *WorkerSend) string {
+	kind := "async-send"
+	if send.IsSync {
+		kind = "sync-send"
+	}
+	return fmt.Sprintf("%s = %s %s %q(%s) -> %s;", p.PrintOperand(*send.LhsOp), kind, p.PrintOperand(*send.DataOp), send.ChannelId, p.PrintOperand(*send.GroupOp), send.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintWorkerReceive(receive *WorkerReceive) string {
+	return fmt.Sprintf("%s = receive %q(%s) -> %s;", p.PrintOperand(*receive.LhsOp), receive.ChannelId, p.PrintOperand(*receive.GroupOp), receive.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintWorkerAlternateReceive(receive *WorkerAlternateReceive) string {
+	channels := make([]string, len(receive.Channels))
+	for i, ch := range receive.Channels {
+		channels[i] = fmt.Sprintf("%q", ch.ChannelId)
+	}
+	return fmt.Sprintf("%s = receive %s(%s) -> %s;", p.PrintOperand(*receive.LhsOp), strings.Join(channels, " | "), p.PrintOperand(*receive.GroupOp), receive.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintWorkerMultipleReceive(receive *WorkerMultipleReceive) string {
+	channels := make([]string, len(receive.Channels))
+	for i, ch := range receive.Channels {
+		channels[i] = fmt.Sprintf("%s: %q", ch.Key, ch.ChannelId)
+	}
+	return fmt.Sprintf("%s = receive {%s}(%s) -> %s;", p.PrintOperand(*receive.LhsOp), strings.Join(channels, ", "), p.PrintOperand(*receive.GroupOp), receive.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintFlush(flush *Flush) string {
+	return fmt.Sprintf("%s = flush %s -> [%s](%s) -> %s;", p.PrintOperand(*flush.LhsOp), flush.Sender, strings.Join(flush.Receivers, ","), p.PrintOperand(*flush.GroupOp), flush.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintWait(wait *Wait) string {
+	exprs := make([]string, len(wait.Exprs))
+	for i, expr := range wait.Exprs {
+		exprs[i] = p.PrintOperand(expr)
+	}
+	return fmt.Sprintf("%s = wait %s -> %s;", p.PrintOperand(*wait.LhsOp), strings.Join(exprs, " | "), wait.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintWaitAll(wait *WaitAll) string {
+	fields := make([]string, len(wait.Exprs))
+	for i, expr := range wait.Exprs {
+		fields[i] = fmt.Sprintf("%s: %s", wait.Keys[i], p.PrintOperand(expr))
+	}
+	return fmt.Sprintf("%s = wait {%s} -> %s;", p.PrintOperand(*wait.LhsOp), strings.Join(fields, ", "), wait.ThenBB.Id.Value())
 }
 
 func (p *PrettyPrinter) PrintCall(call *Call) string {
@@ -443,9 +534,19 @@ func (p *PrettyPrinter) PrintCall(call *Call) string {
 		if i > 0 {
 			args.WriteString(",")
 		}
+		if call.HasRestArg && i == len(call.Args)-1 {
+			args.WriteString("...")
+		}
 		args.WriteString(p.PrintOperand(arg))
 	}
-	return fmt.Sprintf("%s = %s(%s) -> %s;", p.PrintOperand(*call.LhsOp), call.Name.Value(), args.String(), call.ThenBB.Id.Value())
+	return fmt.Sprintf("%s = %s%s(%s) -> %s;", p.PrintOperand(*call.LhsOp), startPrefix(call.IsAsync), call.Name.Value(), args.String(), call.ThenBB.Id.Value())
+}
+
+func startPrefix(isAsync bool) string {
+	if isAsync {
+		return "start "
+	}
+	return ""
 }
 
 func (p *PrettyPrinter) PrintOperand(operand BIROperand) string {
@@ -578,8 +679,11 @@ func (p *PrettyPrinter) PrintNewXMLText(n *NewXMLText) string {
 
 func (p *PrettyPrinter) PrintEvalTemplateExpr(n *EvalTemplateExpr) string {
 	kindStr := "string"
-	if n.Kind == TemplateKindXML {
+	switch n.Kind {
+	case TemplateKindXML:
 		kindStr = "xml"
+	case TemplateKindRegExp:
+		kindStr = "regexp"
 	}
 	parts := strings.Builder{}
 	for i, s := range n.Strings {
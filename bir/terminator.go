@@ -20,6 +20,7 @@ import (
 	"ballerina-lang-go/common"
 	"ballerina-lang-go/model"
 	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/semtypes"
 )
 
 type BIRTerminator = BIRInstruction
@@ -49,6 +50,11 @@ type (
 		CachedMethodLookupKey string
 		CachedNativeFunc      extern.NativeFunc
 		FpOperand             *BIROperand // For FP_CALL: the operand holding the function value
+		// IsAsync is set for calls made by a start action. The callee runs on a new strand and LhsOp holds a
+		// future for its result.
+		IsAsync bool
+		// HasRestArg is set when the last of Args is a list whose members are passed as separate arguments.
+		HasRestArg bool
 	}
 
 	Return struct {
@@ -83,9 +89,92 @@ type (
 		MethodName   string
 		PathSegments []BIROperand
 		Args         []BIROperand
+		// IsAsync is set for calls made by a start action (see Call.IsAsync).
+		IsAsync bool
+		// HasRestSegment is set when the last of PathSegments is a list whose members are passed as separate
+		// path segments.
+		HasRestSegment bool
+	}
+
+	// AsyncCall starts the function value in FpOperand as the named worker WorkerName on a new strand. GroupOp
+	// holds the worker group shared by the workers of the declaring function; it is created on the first start.
+	// LhsOp, if set, receives the future for the result of the worker.
+	AsyncCall struct {
+		BIRTerminatorBase
+		FpOperand  *BIROperand
+		GroupOp    *BIROperand
+		WorkerName string
+	}
+
+	// WorkerSend sends the value of DataOp on the channel ChannelId. A sync send waits until the message is
+	// received and stores nil or the receiver's error in LhsOp.
+	WorkerSend struct {
+		BIRTerminatorBase
+		GroupOp   *BIROperand
+		DataOp    *BIROperand
+		ChannelId string
+		Sender    string
+		Receiver  string
+		IsSync    bool
+	}
+
+	// WorkerReceive receives the message sent on the channel ChannelId by the worker Sender into LhsOp.
+	WorkerReceive struct {
+		BIRTerminatorBase
+		GroupOp   *BIROperand
+		ChannelId string
+		Sender    string
+	}
+
+	// WorkerAlternateReceive receives from whichever of Channels first results in a non-error value.
+	WorkerAlternateReceive struct {
+		BIRTerminatorBase
+		GroupOp  *BIROperand
+		Channels []WorkerReceiveChannel
+	}
+
+	// WorkerMultipleReceive receives from all of Channels and stores the messages in a record of type Type,
+	// keyed by the channel keys.
+	WorkerMultipleReceive struct {
+		BIRTerminatorBase
+		GroupOp  *BIROperand
+		Channels []WorkerReceiveChannel
+		Type     semtypes.SemType
+	}
+
+	// Wait waits on the futures in Exprs and stores the result of the first one to complete with a non-error
+	// value in LhsOp. If all of them complete with an error, the last error is stored instead.
+	Wait struct {
+		BIRTerminatorBase
+		Exprs []BIROperand
+	}
+
+	// WaitAll waits on all the futures in Exprs and stores their results in a record of type Type, keyed by
+	// Keys.
+	WaitAll struct {
+		BIRTerminatorBase
+		Keys  []string
+		Exprs []BIROperand
+		Type  semtypes.SemType
+	}
+
+	// Flush waits until every message sent by Sender to Receivers has been received.
+	Flush struct {
+		BIRTerminatorBase
+		GroupOp   *BIROperand
+		Sender    string
+		Receivers []string
 	}
 )
 
+// WorkerReceiveChannel is a single channel of an alternate or multiple receive. Key is only used by multiple
+// receives.
+type WorkerReceiveChannel struct {
+	Key       string
+	ChannelId string
+	Sender    string
+}
+
 var (
 	_ BIRTerminator        = &Goto{}
 	_ BIRAssignInstruction = &Call{}
@@ -95,6 +184,14 @@ var (
 	_ BIRTerminator        = &LockStart{}
 	_ BIRTerminator        = &LockEnd{}
 	_ BIRAssignInstruction = &ResourceFunctionCall{}
+	_ BIRTerminator        = &AsyncCall{}
+	_ BIRAssignInstruction = &WorkerSend{}
+	_ BIRAssignInstruction = &WorkerReceive{}
+	_ BIRAssignInstruction = &WorkerAlternateReceive{}
+	_ BIRAssignInstruction = &WorkerMultipleReceive{}
+	_ BIRAssignInstruction = &Flush{}
+	_ BIRAssignInstruction = &Wait{}
+	_ BIRAssignInstruction = &WaitAll{}
 )
 
 func (g *Goto) GetKind() InstructionKind {
@@ -247,3 +344,110 @@ func NewBranch(op *BIROperand, trueBB, falseBB *BIRBasicBlock, pos Location) *Br
 		FalseBB: falseBB,
 	}
 }
+
+func (a *AsyncCall) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_ASYNC_CALL
+}
+
+func (a *AsyncCall) GetLhsOperand() *BIROperand {
+	return a.LhsOp
+}
+
+func NewAsyncCall(fpOperand, groupOp *BIROperand, workerName string, thenBB *BIRBasicBlock, lhsOp *BIROperand, pos Location) *AsyncCall {
+	return &AsyncCall{
+		BIRTerminatorBase: BIRTerminatorBase{
+			BIRInstructionBase: BIRInstructionBase{
+				BIRNodeBase: BIRNodeBase{Pos: pos},
+				LhsOp:       lhsOp,
+			},
+			ThenBB: thenBB,
+		},
+		FpOperand:  fpOperand,
+		GroupOp:    groupOp,
+		WorkerName: workerName,
+	}
+}
+
+func (s *WorkerSend) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_WK_SEND
+}
+
+func (s *WorkerSend) GetLhsOperand() *BIROperand {
+	return s.LhsOp
+}
+
+func (r *WorkerReceive) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_WK_RECEIVE
+}
+
+func (r *WorkerReceive) GetLhsOperand() *BIROperand {
+	return r.LhsOp
+}
+
+func (r *WorkerAlternateReceive) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_WK_ALT_RECEIVE
+}
+
+func (r *WorkerAlternateReceive) GetLhsOperand() *BIROperand {
+	return r.LhsOp
+}
+
+func (r *WorkerMultipleReceive) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_WK_MULTIPLE_RECEIVE
+}
+
+func (r *WorkerMultipleReceive) GetLhsOperand() *BIROperand {
+	return r.LhsOp
+}
+
+func (f *Flush) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_FLUSH
+}
+
+func (f *Flush) GetLhsOperand() *BIROperand {
+	return f.LhsOp
+}
+
+func (w *Wait) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_WAIT
+}
+
+func (w *Wait) GetLhsOperand() *BIROperand {
+	return w.LhsOp
+}
+
+func NewWait(exprs []BIROperand, thenBB *BIRBasicBlock, lhsOp *BIROperand, pos Location) *Wait {
+	return &Wait{
+		BIRTerminatorBase: BIRTerminatorBase{
+			BIRInstructionBase: BIRInstructionBase{
+				BIRNodeBase: BIRNodeBase{Pos: pos},
+				LhsOp:       lhsOp,
+			},
+			ThenBB: thenBB,
+		},
+		Exprs: exprs,
+	}
+}
+
+func (w *WaitAll) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_WAIT_ALL
+}
+
+func (w *WaitAll) GetLhsOperand() *BIROperand {
+	return w.LhsOp
+}
+
+func NewWaitAll(keys []string, exprs []BIROperand, ty semtypes.SemType, thenBB *BIRBasicBlock, lhsOp *BIROperand, pos Location) *WaitAll {
+	return &WaitAll{
+		BIRTerminatorBase: BIRTerminatorBase{
+			BIRInstructionBase: BIRInstructionBase{
+				BIRNodeBase: BIRNodeBase{Pos: pos},
+				LhsOp:       lhsOp,
+			},
+			ThenBB: thenBB,
+		},
+		Keys:  keys,
+		Exprs: exprs,
+		Type:  ty,
+	}
+}
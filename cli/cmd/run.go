@@ -17,7 +17,9 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -34,6 +36,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	configTomlFile    = "Config.toml"
+	configFilesEnvVar = "BAL_CONFIG_FILES"
+	configDataEnvVar  = "BAL_CONFIG_DATA"
+)
+
 var runOpts struct {
 	dumpTokens    bool
 	dumpST        bool
@@ -143,6 +151,18 @@ func runBallerina(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Arguments after `--` are given to the program, which only accepts `-Ckey=value` configuration options.
+	var programArgs []string
+	if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+		programArgs = args[dash:]
+		args = args[:dash]
+	}
+	configArgs, err := parseConfigArgs(programArgs)
+	if err != nil {
+		printRunError(err)
+		return err
+	}
+
 	// Default to current directory if no path provided (bal run == bal run .)
 	path := "."
 	if len(args) > 0 {
@@ -281,6 +301,16 @@ func runBallerina(cmd *cobra.Command, args []string) error {
 	pal, cleanupSignals := palnative.NewPlatform()
 	defer cleanupSignals()
 	rt := runtime.NewRuntime(pal, tyEnv)
+	configTOMLs, err := readConfigTOMLs(absBaseDir)
+	if err != nil {
+		printRunError(err)
+		return err
+	}
+	rootPkgID := birPkgs[len(birPkgs)-1].PackageID
+	if err := rt.Configure(birPkgs, rootPkgID, runtime.ConfigSources{Args: configArgs, TOMLs: configTOMLs}); err != nil {
+		printRuntimeError(err)
+		return err
+	}
 	var initErr error
 	for _, birPkg := range birPkgs {
 		if err := rt.Init(*birPkg); err != nil {
@@ -304,6 +334,43 @@ func printRunError(err error) {
 	printError(err, "run [<source-file.bal> | <package-dir> | .]", false)
 }
 
+// parseConfigArgs returns the `key=value` parts of the `-Ckey=value` program arguments.
+func parseConfigArgs(programArgs []string) ([]string, error) {
+	configArgs := make([]string, 0, len(programArgs))
+	for _, arg := range programArgs {
+		option, ok := strings.CutPrefix(arg, "-C")
+		if !ok {
+			return nil, fmt.Errorf("unsupported program argument '%s': only '-Ckey=value' options are supported", arg)
+		}
+		configArgs = append(configArgs, option)
+	}
+	return configArgs, nil
+}
+
+// readConfigTOMLs reads the configuration files in order of precedence: the files listed in BAL_CONFIG_FILES, or
+// Config.toml of the package directory when it is not set, followed by the content of BAL_CONFIG_DATA. So a value
+// given in a configuration file takes precedence over one given in BAL_CONFIG_DATA.
+func readConfigTOMLs(packageDir string) ([]string, error) {
+	var tomls []string
+	if configFiles := os.Getenv(configFilesEnvVar); configFiles != "" {
+		for _, file := range filepath.SplitList(configFiles) {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read configuration file: %w", err)
+			}
+			tomls = append(tomls, string(content))
+		}
+	} else if content, err := os.ReadFile(filepath.Join(packageDir, configTomlFile)); err == nil {
+		tomls = append(tomls, string(content))
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read configuration file: %w", err)
+	}
+	if configData := os.Getenv(configDataEnvVar); configData != "" {
+		tomls = append(tomls, configData)
+	}
+	return tomls, nil
+}
+
 func getBallerinaEnvPath() (string, error) {
 	if balEnv := os.Getenv(projects.BallerinaEnvVar); balEnv != "" {
 		return balEnv, nil
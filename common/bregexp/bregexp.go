@@ -0,0 +1,636 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package bregexp parses Ballerina regular expressions
+// (https://ballerina.io/spec/lang/master/#section_10.1) and translates them to
+// the RE2 syntax accepted by the Go regexp package.
+//
+// The compiler uses Check to validate the literal parts of a regexp template,
+// and the runtime uses Translate to compile the final pattern once the
+// interpolations have been evaluated.
+package bregexp
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// SyntaxError describes a pattern that does not conform to the Ballerina
+// regexp grammar.
+type SyntaxError struct {
+	// Offset is the code point offset in the pattern at which the error was
+	// detected.
+	Offset int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return e.Msg
+}
+
+// Translate converts a Ballerina regular expression to an equivalent RE2
+// pattern. Capturing groups keep their numbering, so submatch indices of the
+// translated pattern correspond to the groups of the original one.
+func Translate(pattern string) (string, error) {
+	p := newParser([]string{pattern})
+	if err := p.parse(); err != nil {
+		return "", err
+	}
+	return p.out.String(), nil
+}
+
+// Check validates a regexp template. The template is given as the literal
+// strings surrounding its interpolations, so a template with n interpolations
+// has n+1 parts. Each interpolation is treated as an atom. When there are no
+// interpolations the translated pattern is also compiled, so that limits of
+// the underlying engine (such as repetition counts) are reported up front.
+func Check(parts []string) error {
+	p := newParser(parts)
+	if err := p.parse(); err != nil {
+		return err
+	}
+	if len(parts) > 1 {
+		return nil
+	}
+	if _, err := regexp.Compile(p.out.String()); err != nil {
+		return &SyntaxError{Msg: fmt.Sprintf("unsupported regular expression: %s", compileErrorMessage(err))}
+	}
+	return nil
+}
+
+func compileErrorMessage(err error) string {
+	if se, ok := err.(*syntax.Error); ok {
+		return string(se.Code)
+	}
+	return err.Error()
+}
+
+// parser is a recursive descent parser for the Ballerina regexp grammar. It
+// writes the RE2 translation to out as it goes.
+type parser struct {
+	src []rune
+	// hole[i] is true when src[i] stands for an interpolation.
+	hole []bool
+	pos  int
+	out  strings.Builder
+	// ignoreSpace is set while the `x` flag is in effect.
+	ignoreSpace bool
+}
+
+const holeRune = 0
+
+func newParser(parts []string) *parser {
+	p := &parser{}
+	for i, part := range parts {
+		if i > 0 {
+			p.src = append(p.src, holeRune)
+			p.hole = append(p.hole, true)
+		}
+		for _, r := range part {
+			p.src = append(p.src, r)
+			p.hole = append(p.hole, false)
+		}
+	}
+	return p
+}
+
+func (p *parser) parse() error {
+	if err := p.parseDisjunction(); err != nil {
+		return err
+	}
+	if !p.atEnd() {
+		// parseDisjunction only stops early on an unmatched ')'.
+		return p.errorf("unmatched ')'")
+	}
+	return nil
+}
+
+func (p *parser) atEnd() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) peek() (rune, bool) {
+	if p.atEnd() || p.hole[p.pos] {
+		return 0, false
+	}
+	return p.src[p.pos], true
+}
+
+func (p *parser) peekIs(r rune) bool {
+	c, ok := p.peek()
+	return ok && c == r
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return &SyntaxError{Offset: p.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) parseDisjunction() error {
+	if err := p.parseSequence(); err != nil {
+		return err
+	}
+	for p.peekIs('|') {
+		p.pos++
+		p.out.WriteByte('|')
+		if err := p.parseSequence(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *parser) parseSequence() error {
+	for !p.atEnd() {
+		if p.hole[p.pos] {
+			p.pos++
+			if err := p.parseQuantifier(); err != nil {
+				return err
+			}
+			continue
+		}
+		switch c := p.src[p.pos]; c {
+		case '|', ')':
+			return nil
+		case '^', '$':
+			p.pos++
+			p.out.WriteRune(c)
+		case '*', '+', '?', '{':
+			return p.errorf("quantifier '%c' does not follow an atom", c)
+		case ']', '}':
+			return p.errorf("unescaped '%c'", c)
+		default:
+			if p.ignoreSpace && isPatternSpace(c) {
+				p.pos++
+				continue
+			}
+			if err := p.parseAtom(); err != nil {
+				return err
+			}
+			if err := p.parseQuantifier(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func isPatternSpace(c rune) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\f', '\v':
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *parser) parseAtom() error {
+	c := p.src[p.pos]
+	switch c {
+	case '.':
+		p.pos++
+		p.out.WriteByte('.')
+		return nil
+	case '\\':
+		return p.parseEscape(false)
+	case '[':
+		return p.parseCharacterClass()
+	case '(':
+		return p.parseGroup()
+	default:
+		p.pos++
+		p.out.WriteString(regexp.QuoteMeta(string(c)))
+		return nil
+	}
+}
+
+func (p *parser) parseQuantifier() error {
+	c, ok := p.peek()
+	if !ok {
+		return nil
+	}
+	switch c {
+	case '*', '+', '?':
+		p.pos++
+		p.out.WriteRune(c)
+	case '{':
+		if err := p.parseBracedQuantifier(); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+	if p.peekIs('?') {
+		p.pos++
+		p.out.WriteByte('?')
+	}
+	return nil
+}
+
+func (p *parser) parseBracedQuantifier() error {
+	start := p.pos
+	p.pos++
+	least, ok := p.parseDigits()
+	if !ok {
+		p.pos = start
+		return p.errorf("invalid quantifier: expected a digit after '{'")
+	}
+	most := least
+	hasMost := true
+	if p.peekIs(',') {
+		p.pos++
+		most, hasMost = p.parseDigits()
+	}
+	if !p.peekIs('}') {
+		return p.errorf("invalid quantifier: missing '}'")
+	}
+	p.pos++
+	if hasMost && compareDigits(least, most) > 0 {
+		p.pos = start
+		return p.errorf("invalid quantifier: minimum %s is greater than maximum %s", least, most)
+	}
+	p.out.WriteString(string(p.src[start:p.pos]))
+	return nil
+}
+
+// compareDigits compares two non-empty decimal digit strings numerically
+// without converting them, so arbitrarily long counts are handled.
+func compareDigits(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+	return strings.Compare(a, b)
+}
+
+func (p *parser) parseDigits() (string, bool) {
+	start := p.pos
+	for {
+		c, ok := p.peek()
+		if !ok || c < '0' || c > '9' {
+			break
+		}
+		p.pos++
+	}
+	return string(p.src[start:p.pos]), p.pos > start
+}
+
+func (p *parser) parseGroup() error {
+	start := p.pos
+	p.pos++
+	if p.peekIs('?') {
+		p.pos++
+		on, off, err := p.parseFlagsOnOff()
+		if err != nil {
+			return err
+		}
+		saved := p.ignoreSpace
+		if strings.ContainsRune(on, 'x') {
+			p.ignoreSpace = true
+		} else if strings.ContainsRune(off, 'x') {
+			p.ignoreSpace = false
+		}
+		p.out.WriteString("(?")
+		goOn := strings.ReplaceAll(on, "x", "")
+		goOff := strings.ReplaceAll(off, "x", "")
+		p.out.WriteString(goOn)
+		if goOff != "" {
+			p.out.WriteByte('-')
+			p.out.WriteString(goOff)
+		}
+		p.out.WriteByte(':')
+		err = p.finishGroup(start)
+		p.ignoreSpace = saved
+		return err
+	}
+	p.out.WriteByte('(')
+	return p.finishGroup(start)
+}
+
+func (p *parser) finishGroup(start int) error {
+	if err := p.parseDisjunction(); err != nil {
+		return err
+	}
+	if !p.peekIs(')') {
+		p.pos = start
+		return p.errorf("missing ')'")
+	}
+	p.pos++
+	p.out.WriteByte(')')
+	return nil
+}
+
+// parseFlagsOnOff parses `ReFlags ["-" ReFlags] ":"` after "(?".
+func (p *parser) parseFlagsOnOff() (string, string, error) {
+	seen := make(map[rune]bool)
+	on, err := p.parseFlags(seen)
+	if err != nil {
+		return "", "", err
+	}
+	var off string
+	if p.peekIs('-') {
+		p.pos++
+		off, err = p.parseFlags(seen)
+		if err != nil {
+			return "", "", err
+		}
+		if off == "" {
+			return "", "", p.errorf("missing flag after '-'")
+		}
+	}
+	if !p.peekIs(':') {
+		if c, ok := p.peek(); ok {
+			return "", "", p.errorf("invalid flag '%c'", c)
+		}
+		return "", "", p.errorf("missing ':' after flags")
+	}
+	p.pos++
+	return on, off, nil
+}
+
+func (p *parser) parseFlags(seen map[rune]bool) (string, error) {
+	var sb strings.Builder
+	for {
+		c, ok := p.peek()
+		if !ok {
+			return sb.String(), nil
+		}
+		switch c {
+		case 'm', 's', 'i', 'x':
+			if seen[c] {
+				return "", p.errorf("duplicate flag '%c'", c)
+			}
+			seen[c] = true
+			p.pos++
+			sb.WriteRune(c)
+		default:
+			return sb.String(), nil
+		}
+	}
+}
+
+// classEscape is the result of parsing an escape. A single character escape
+// has a code point and can be used as a range bound; the others denote a set
+// of characters.
+type classEscape struct {
+	text   string
+	char   rune
+	isChar bool
+}
+
+func (p *parser) parseEscape(inClass bool) error {
+	esc, err := p.parseEscapeSeq(inClass)
+	if err != nil {
+		return err
+	}
+	p.out.WriteString(esc.text)
+	return nil
+}
+
+func (p *parser) parseEscapeSeq(inClass bool) (classEscape, error) {
+	start := p.pos
+	p.pos++
+	c, ok := p.peek()
+	if !ok {
+		p.pos = start
+		return classEscape{}, p.errorf("incomplete escape sequence")
+	}
+	p.pos++
+	switch c {
+	case 'n':
+		return classEscape{text: `\n`, char: '\n', isChar: true}, nil
+	case 'r':
+		return classEscape{text: `\r`, char: '\r', isChar: true}, nil
+	case 't':
+		return classEscape{text: `\t`, char: '\t', isChar: true}, nil
+	case 'd', 'D', 's', 'S', 'w', 'W':
+		return classEscape{text: `\` + string(c)}, nil
+	case 'u':
+		return p.parseNumericEscape(start)
+	case 'p', 'P':
+		text, err := p.parseUnicodeProperty(start, c == 'P', inClass)
+		return classEscape{text: text}, err
+	case '^', '$', '\\', '.', '*', '+', '?', '(', ')', '[', ']', '{', '}', '|':
+		return classEscape{text: `\` + string(c), char: c, isChar: true}, nil
+	case '-':
+		if inClass {
+			return classEscape{text: `\-`, char: '-', isChar: true}, nil
+		}
+	}
+	p.pos = start
+	return classEscape{}, p.errorf("invalid escape sequence '\\%c'", c)
+}
+
+func (p *parser) parseNumericEscape(start int) (classEscape, error) {
+	if !p.peekIs('{') {
+		p.pos = start
+		return classEscape{}, p.errorf("invalid unicode escape: expected '{' after '\\u'")
+	}
+	p.pos++
+	digitsStart := p.pos
+	for {
+		c, ok := p.peek()
+		if !ok || !isHexDigit(c) {
+			break
+		}
+		p.pos++
+	}
+	digits := string(p.src[digitsStart:p.pos])
+	if digits == "" || !p.peekIs('}') {
+		p.pos = start
+		return classEscape{}, p.errorf("invalid unicode escape: expected hex digits followed by '}'")
+	}
+	p.pos++
+	cp, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil || cp > unicode.MaxRune || cp >= 0xD800 && cp <= 0xDFFF {
+		p.pos = start
+		return classEscape{}, p.errorf("invalid unicode code point '%s'", digits)
+	}
+	return classEscape{text: fmt.Sprintf(`\x{%x}`, cp), char: rune(cp), isChar: true}, nil
+}
+
+func isHexDigit(c rune) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+// generalCategories are the general category names allowed by the grammar.
+var generalCategories = map[string]bool{
+	"L": true, "Lu": true, "Ll": true, "Lt": true, "Lm": true, "Lo": true,
+	"M": true, "Mn": true, "Mc": true, "Me": true,
+	"N": true, "Nd": true, "Nl": true, "No": true,
+	"P": true, "Pc": true, "Pd": true, "Ps": true, "Pe": true, "Pi": true, "Pf": true, "Po": true,
+	"S": true, "Sm": true, "Sc": true, "Sk": true, "So": true,
+	"Z": true, "Zs": true, "Zl": true, "Zp": true,
+	"C": true, "Cc": true, "Cf": true, "Co": true, "Cs": true, "Cn": true,
+}
+
+// unassignedComplement is the union of every general category other than Cn.
+// RE2 has no table for unassigned code points, so \p{Cn} is translated to its
+// complement.
+const unassignedComplement = `\p{L}\p{M}\p{N}\p{P}\p{S}\p{Z}\p{Cc}\p{Cf}\p{Co}\p{Cs}`
+
+func (p *parser) parseUnicodeProperty(start int, negated bool, inClass bool) (string, error) {
+	if !p.peekIs('{') {
+		p.pos = start
+		return "", p.errorf("invalid unicode property escape: expected '{'")
+	}
+	p.pos++
+	nameStart := p.pos
+	for !p.atEnd() && !p.peekIs('}') {
+		if p.hole[p.pos] {
+			break
+		}
+		p.pos++
+	}
+	if !p.peekIs('}') {
+		p.pos = start
+		return "", p.errorf("invalid unicode property escape: missing '}'")
+	}
+	name := string(p.src[nameStart:p.pos])
+	p.pos++
+	kind := `\p`
+	if negated {
+		kind = `\P`
+	}
+	if script, ok := strings.CutPrefix(name, "sc="); ok {
+		if _, ok := unicode.Scripts[script]; !ok {
+			p.pos = start
+			return "", p.errorf("invalid unicode script '%s'", script)
+		}
+		return kind + "{" + script + "}", nil
+	}
+	category := strings.TrimPrefix(name, "gc=")
+	if !generalCategories[category] {
+		p.pos = start
+		return "", p.errorf("invalid unicode general category '%s'", category)
+	}
+	if category != "Cn" {
+		return kind + "{" + category + "}", nil
+	}
+	if negated {
+		if inClass {
+			return unassignedComplement, nil
+		}
+		return "[" + unassignedComplement + "]", nil
+	}
+	if inClass {
+		// A negated class cannot be nested inside another class in RE2.
+		p.pos = start
+		return "", p.errorf("unsupported unicode property '\\p{Cn}' in a character class")
+	}
+	return "[^" + unassignedComplement + "]", nil
+}
+
+func (p *parser) parseCharacterClass() error {
+	start := p.pos
+	p.pos++
+	negated := false
+	if p.peekIs('^') {
+		p.pos++
+		negated = true
+	}
+	var body strings.Builder
+	first := true
+	for {
+		if p.atEnd() {
+			p.pos = start
+			return p.errorf("missing ']'")
+		}
+		c := p.src[p.pos]
+		if p.hole[p.pos] {
+			return p.errorf("interpolation is not allowed in a character class")
+		}
+		if c == ']' {
+			p.pos++
+			break
+		}
+		lo, err := p.parseClassAtom(first)
+		if err != nil {
+			return err
+		}
+		first = false
+		if !p.peekIs('-') || p.pos+1 >= len(p.src) || !p.hole[p.pos+1] && p.src[p.pos+1] == ']' {
+			body.WriteString(lo.text)
+			continue
+		}
+		rangeStart := p.pos
+		p.pos++
+		hi, err := p.parseClassAtom(false)
+		if err != nil {
+			return err
+		}
+		if !lo.isChar || !hi.isChar {
+			p.pos = rangeStart
+			return p.errorf("invalid character class range: bounds must be single characters")
+		}
+		if lo.char > hi.char {
+			p.pos = rangeStart
+			return p.errorf("character class range '%c-%c' is out of order", lo.char, hi.char)
+		}
+		body.WriteString(lo.text)
+		body.WriteByte('-')
+		body.WriteString(hi.text)
+	}
+	switch {
+	case body.Len() == 0 && negated:
+		p.out.WriteString(`[\x00-\x{10ffff}]`)
+	case body.Len() == 0:
+		p.out.WriteString(`[^\x00-\x{10ffff}]`)
+	case negated:
+		p.out.WriteString("[^" + body.String() + "]")
+	default:
+		p.out.WriteString("[" + body.String() + "]")
+	}
+	return nil
+}
+
+// parseClassAtom parses a single member of a character class. An unescaped
+// '-' is only a literal at the start of the class or right before its end.
+func (p *parser) parseClassAtom(first bool) (classEscape, error) {
+	if p.atEnd() || p.hole[p.pos] {
+		return classEscape{}, p.errorf("missing ']'")
+	}
+	c := p.src[p.pos]
+	switch c {
+	case '\\':
+		return p.parseEscapeSeq(true)
+	case '-':
+		if !first && (p.pos+1 >= len(p.src) || p.src[p.pos+1] != ']') {
+			return classEscape{}, p.errorf("unescaped '-' in character class")
+		}
+	}
+	p.pos++
+	return classEscape{text: quoteClassChar(c), char: c, isChar: true}, nil
+}
+
+func quoteClassChar(c rune) string {
+	switch c {
+	case '\\', '[', ']', '^', '-':
+		return `\` + string(c)
+	case '\n':
+		return `\n`
+	case '\r':
+		return `\r`
+	case '\t':
+		return `\t`
+	default:
+		return string(c)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bregexp
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{`abc`, `abc`},
+		{`a.b*c+?`, `a.b*c+?`},
+		{`^(\d{2,4})-(\w+)$`, `^(\d{2,4})-(\w+)$`},
+		{`a|b|`, `a|b|`},
+		{`[a-z\-_]`, `[a-z\-_]`},
+		{`[^\]\\]`, `[^\]\\]`},
+		{`[-a]`, `[\-a]`},
+		{`[a-]`, `[a\-]`},
+		{`[[^]`, `[\[\^]`},
+		{`[]`, `[^\x00-\x{10ffff}]`},
+		{`[^]`, `[\x00-\x{10ffff}]`},
+		{`\u{1F600}`, `\x{1f600}`},
+		{`\p{Lu}\P{gc=Nd}\p{sc=Latin}`, `\p{Lu}\P{Nd}\p{Latin}`},
+		{`(?i:ab)`, `(?i:ab)`},
+		{`(?i-m:ab)`, `(?i-m:ab)`},
+		{`(?x:a b c)`, `(?:abc)`},
+		{`\.\*\(\)\{\}`, `\.\*\(\)\{\}`},
+		{`a{3}`, `a{3}`},
+		{`a{3,}`, `a{3,}`},
+	}
+	for _, test := range tests {
+		got, err := Translate(test.pattern)
+		if err != nil {
+			t.Errorf("Translate(%q) returned error: %v", test.pattern, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Translate(%q) = %q, want %q", test.pattern, got, test.want)
+			continue
+		}
+		if _, err := regexp.Compile(got); err != nil {
+			t.Errorf("Translate(%q) produced invalid pattern %q: %v", test.pattern, got, err)
+		}
+	}
+}
+
+func TestTranslateErrors(t *testing.T) {
+	tests := []struct {
+		pattern string
+		msg     string
+	}{
+		{`(ab`, "missing ')'"},
+		{`ab)`, "unmatched ')'"},
+		{`*a`, "quantifier '*' does not follow an atom"},
+		{`a{2,1}`, "invalid quantifier: minimum 2 is greater than maximum 1"},
+		{`a{x}`, "invalid quantifier: expected a digit after '{'"},
+		{`a}`, "unescaped '}'"},
+		{`[z-a]`, "character class range 'z-a' is out of order"},
+		{`[\d-z]`, "invalid character class range: bounds must be single characters"},
+		{`[abc`, "missing ']'"},
+		{`\q`, "invalid escape sequence '\\q'"},
+		{`\-`, "invalid escape sequence '\\-'"},
+		{`\u{110000}`, "invalid unicode code point '110000'"},
+		{`\p{Foo}`, "invalid unicode general category 'Foo'"},
+		{`\p{sc=Foo}`, "invalid unicode script 'Foo'"},
+		{`(?ii:a)`, "duplicate flag 'i'"},
+		{`(?q:a)`, "invalid flag 'q'"},
+	}
+	for _, test := range tests {
+		_, err := Translate(test.pattern)
+		if err == nil {
+			t.Errorf("Translate(%q) succeeded, want error %q", test.pattern, test.msg)
+			continue
+		}
+		if err.Error() != test.msg {
+			t.Errorf("Translate(%q) error = %q, want %q", test.pattern, err.Error(), test.msg)
+		}
+	}
+}
+
+func TestCheckWithInterpolations(t *testing.T) {
+	if err := Check([]string{"a(", ")+b"}); err != nil {
+		t.Errorf("Check returned error: %v", err)
+	}
+	if err := Check([]string{"", "*"}); err != nil {
+		t.Errorf("interpolation should be quantifiable: %v", err)
+	}
+	if err := Check([]string{"(", ""}); err == nil {
+		t.Error("Check should report the unclosed group")
+	}
+	if err := Check([]string{"a{1001}"}); err == nil {
+		t.Error("Check should report a repeat count the engine does not support")
+	}
+}
@@ -108,6 +108,14 @@ func (c *CompilerContext) CreateFunctionSymbol(space *model.SymbolSpace, name st
 	return c.env.CreateFunctionSymbol(space, name, signature, fnTy)
 }
 
+func (c *CompilerContext) SetFunctionParamsSymbol(fat *semtypes.FunctionAtomicType, symbol model.SymbolRef) {
+	c.env.SetFunctionParamsSymbol(fat, symbol)
+}
+
+func (c *CompilerContext) FunctionParamsSymbol(fat *semtypes.FunctionAtomicType) (model.SymbolRef, bool) {
+	return c.env.FunctionParamsSymbol(fat)
+}
+
 func (c *CompilerContext) UnnarrowedSymbol(symbol model.SymbolRef) model.SymbolRef {
 	return c.env.UnnarrowedSymbol(symbol)
 }
@@ -136,6 +144,10 @@ func (c *CompilerContext) DistinctTypeID(symbol model.SymbolRef) int {
 	return c.env.DistinctTypeID(symbol)
 }
 
+func (c *CompilerContext) NewDistinctTypeID() int {
+	return c.env.NewDistinctTypeID()
+}
+
 func (c *CompilerContext) DistinctTypeSymbolRef(id int) (model.SymbolRef, bool) {
 	return c.env.DistinctTypeSymbolRef(id)
 }
@@ -52,6 +52,16 @@ func (t *distinctTypeTracker) id(symbol model.SymbolRef) int {
 	return id
 }
 
+// fresh allocates a type-id that is not tied to a symbol. It is used for
+// inline distinct type descriptors, which get a new type-id per occurrence.
+func (t *distinctTypeTracker) fresh() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id := t.nextID
+	t.nextID++
+	return id
+}
+
 func (t *distinctTypeTracker) symbolRef(id int) (model.SymbolRef, bool) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
@@ -61,13 +71,15 @@ func (t *distinctTypeTracker) symbolRef(id int) (model.SymbolRef, bool) {
 
 // CompilerEnvironment maintain the shared state of the frontend.
 type CompilerEnvironment struct {
-	anonTypeCount     map[*model.PackageID]int
-	anonFuncCount     map[*model.PackageID]int
-	packageInterner   *model.PackageIDInterner
-	symbolSpaces      []*model.SymbolSpace
-	symbolSpacesMu    sync.RWMutex // we need this because desugaring add new init functions concurrently we shouldn't need this if the spaces are scoped to the module, may be we should do that?
-	typeEnv           semtypes.Env
-	underlyingSymbol  sync.Map
+	anonTypeCount    map[*model.PackageID]int
+	anonFuncCount    map[*model.PackageID]int
+	packageInterner  *model.PackageIDInterner
+	symbolSpaces     []*model.SymbolSpace
+	symbolSpacesMu   sync.RWMutex // we need this because desugaring add new init functions concurrently we shouldn't need this if the spaces are scoped to the module, may be we should do that?
+	typeEnv          semtypes.Env
+	underlyingSymbol sync.Map
+	// functionParams maps the atom of a function type to the function symbol describing its parameters.
+	functionParams    sync.Map
 	distinctTypes     distinctTypeTracker
 	statsEnabled      bool
 	diagnosticContext *diagnostics.DiagnosticEnv
@@ -177,6 +189,19 @@ func (c *CompilerEnvironment) CreateFunctionSymbol(space *model.SymbolSpace, nam
 	return space.RefAt(symbolIndex)
 }
 
+// SetFunctionParamsSymbol records the function symbol describing the parameter names and defaults of the
+// function type with the given atom.
+func (c *CompilerEnvironment) SetFunctionParamsSymbol(fat *semtypes.FunctionAtomicType, symbol model.SymbolRef) {
+	c.functionParams.Store(fat, symbol)
+}
+
+func (c *CompilerEnvironment) FunctionParamsSymbol(fat *semtypes.FunctionAtomicType) (model.SymbolRef, bool) {
+	if symbol, ok := c.functionParams.Load(fat); ok {
+		return symbol.(model.SymbolRef), true
+	}
+	return model.SymbolRef{}, false
+}
+
 func (c *CompilerEnvironment) UnnarrowedSymbol(symbol model.SymbolRef) model.SymbolRef {
 	if underlying, ok := c.underlyingSymbol.Load(symbol); ok {
 		return underlying.(model.SymbolRef)
@@ -208,6 +233,10 @@ func (c *CompilerEnvironment) DistinctTypeID(symbol model.SymbolRef) int {
 	return c.distinctTypes.id(symbol)
 }
 
+func (c *CompilerEnvironment) NewDistinctTypeID() int {
+	return c.distinctTypes.fresh()
+}
+
 func (c *CompilerEnvironment) DistinctTypeSymbolRef(id int) (model.SymbolRef, bool) {
 	return c.distinctTypes.symbolRef(id)
 }
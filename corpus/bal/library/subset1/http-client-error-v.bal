@@ -0,0 +1,40 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/http;
+import ballerina/io;
+
+public function main() returns error? {
+    http:Client c = check new ("https://example.com");
+    http:Response r = check c->get("/path");
+
+    // Stub returns no headers, so the lookup fails with a HeaderNotFoundError
+    string|http:HeaderNotFoundError header = r.getHeader("x-absent");
+    io:println(header is http:HeaderNotFoundError); // @output true
+    io:println(header is http:ClientError);         // @output true
+    if header is error {
+        io:println(header.message());               // @output header not found: x-absent
+    }
+
+    // "test body" is not valid JSON
+    json|http:ClientError payload = r.getJsonPayload();
+    io:println(payload is http:GenericClientError); // @output true
+    io:println(payload is http:HeaderNotFoundError); // @output false
+
+    http:Client|http:ClientError bad = new ("https://example.com", {responseLimits: {maxHeaderSize: -1}});
+    io:println(bad is http:ClientError);            // @output true
+    return;
+}
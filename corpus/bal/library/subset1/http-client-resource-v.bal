@@ -0,0 +1,47 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/http;
+import ballerina/io;
+
+public function main() returns error? {
+    http:Client c = check new ("https://example.com");
+
+    http:Response r1 = check c->/users/[42];
+    io:println(r1.statusCode);          // @output 200
+
+    string[] segments = ["orders", "pending"];
+    http:Response r2 = check c->/[...segments].post({"key": "val"});
+    io:println(r2.statusCode);          // @output 200
+
+    http:Response r3 = check c->/items.put("body", mediaType = "text/plain");
+    io:println(r3.statusCode);          // @output 200
+
+    http:Response r4 = check c->/items/[1].patch("patch");
+    io:println(r4.statusCode);          // @output 200
+
+    http:Response r5 = check c->/items/[1].delete();
+    io:println(r5.statusCode);          // @output 200
+
+    http:Response r6 = check c->/.head();
+    io:println(r6.statusCode);          // @output 200
+
+    http:Response r7 = check c->/.options();
+    io:println(r7.statusCode);          // @output 200
+    io:println(check r7.getTextPayload()); // @output test body
+
+    return;
+}
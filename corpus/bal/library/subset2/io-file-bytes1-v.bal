@@ -25,7 +25,9 @@ public function main() returns error? {
     io:println(readBack.length());
     io:println(readBack[0]);
     io:println(readBack[4]);
+    io:println(readBack is readonly);
 }
 // @output 5
 // @output 72
 // @output 111
+// @output true
@@ -16,9 +16,9 @@
 
 // @productions panic-stmt error-constructor-expr string-literal function-call-expr
 public function main() {
-    p(error("help"));
+    p(error("help")); // @panic help
 }
 
 function p(error e) {
-    panic e; // @panic help
+    panic e;
 }
@@ -21,9 +21,9 @@ public function main() {
 }
 
 function doPanic() {
-    check checkpanic alwaysErr(); // @panic err
+    check checkpanic alwaysErr();
 }
 
 function alwaysErr() returns error? {
-    return error("err");
+    return error("err"); // @panic err
 }
@@ -17,10 +17,10 @@
 import ballerina/io;
 
 public function main() {
-    checkpanic newError(); // @panic failed successfully
+    checkpanic newError();
     io:println("may not reach");
 }
 
 function newError() returns error? {
-    return error("failed successfully");
+    return error("failed successfully"); // @panic failed successfully
 }
@@ -15,9 +15,9 @@
 // under the License.
 
 public function main() {
-    checkpanic newError(); // @panic failed successfully
+    checkpanic newError();
 }
 
 function newError() returns error {
-    return error("failed successfully");
+    return error("failed successfully"); // @panic failed successfully
 }
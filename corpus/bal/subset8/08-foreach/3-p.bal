@@ -21,7 +21,7 @@ class FailingIterator {
 
     public function next() returns record {|int value;|}|error? {
         if self.idx >= 2 {
-            return error("iterator failed");
+            return error("iterator failed"); // @panic iterator failed
         }
         int val = self.idx;
         self.idx += 1;
@@ -37,7 +37,7 @@ class FailingIterable {
 
 public function main() {
     FailingIterable f = new;
-    foreach int val in f { // @panic iterator failed
+    foreach int val in f {
         io:println(val); // @output 0
                          // @output 1
     }
@@ -14,5 +14,11 @@
 // specific language governing permissions and limitations
 // under the License.
 
-// Techinically this is also not an error but nothing in standard library uses this
-function withRest(int val, typedesc retTy = <>, int... rest) returns retTy = external; // @error
+function withRest(int val, typedesc retTy = <>, int... rest) returns retTy = external;
+
+function either(typedesc<int> a = <>, typedesc<string> b = <>) returns a|b = external;
+
+public function main() {
+    int _ = withRest(1, int, 2, "3"); // @error
+    int _ = either(); // @error
+}
@@ -34,7 +34,7 @@ public function main() {
 
     string[][] _ = from var person in people
         select from var dept in departments
-            join var owner in person.id
+            join var owner in person.id // @error
             on dept.ownerId equals owner.id
             select dept.name;
 }
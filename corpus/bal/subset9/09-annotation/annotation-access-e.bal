@@ -14,8 +14,9 @@
 // specific language governing permissions and limitations
 // under the License.
 
+annotation tag on type;
+
 public function main() {
-    distinct object { // @error
-        public string name;
-    } person;
+    int i = 1;
+    _ = i.@tag; // @error
 }
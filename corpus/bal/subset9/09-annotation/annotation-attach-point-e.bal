@@ -14,7 +14,10 @@
 // specific language governing permissions and limitations
 // under the License.
 
+annotation tag on function;
+
+@tag // @error
+type T int;
+
 public function main() {
-    string s = "x";
-    xml x = xml `<!-- hello ${s} -->`; // @error interpolation not allowed in xml comment
 }
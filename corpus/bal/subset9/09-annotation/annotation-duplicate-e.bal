@@ -14,7 +14,11 @@
 // specific language governing permissions and limitations
 // under the License.
 
+annotation tag on type;
+
+@tag
+@tag // @error
+type T int;
+
 public function main() {
-    string s = "x";
-    xml x = xml `<![CDATA[hello ${s}]]>`; // @error interpolation not allowed in xml CDATA section
 }
@@ -14,7 +14,14 @@
 // specific language governing permissions and limitations
 // under the License.
 
+type Info record {|
+    string name;
+|};
+
+annotation Info info on type;
+
+@info // @error
+type T int;
+
 public function main() {
-    string s = "x";
-    xml x = xml `<?target body ${s}?>`; // @error interpolation not allowed in xml processing instruction
 }
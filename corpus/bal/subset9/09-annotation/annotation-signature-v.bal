@@ -0,0 +1,47 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+type Range record {|
+    int min;
+    int max = 100;
+|};
+
+annotation Range range on parameter, return;
+annotation tag on parameter, return;
+const annotation checked on source var;
+
+function clamp(@range {min: 0} int x, @tag int... rest) returns @tag @range {min: 0, max: 10} int {
+    int sum = x + rest.length();
+    return sum > 10 ? 10 : sum;
+}
+
+class Box {
+    function put(@tag int n) returns @tag int {
+        return n;
+    }
+}
+
+public function main() {
+    @checked
+    int a = clamp(42);
+    @checked
+    string label = "clamped";
+    io:println(label, " ", a); // @output clamped 10
+    var f = function(@range {min: 1} int n) returns @tag int => n * 2;
+    io:println(f(3)); // @output 6
+    io:println(new Box().put(7)); // @output 7
+}
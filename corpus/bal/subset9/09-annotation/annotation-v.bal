@@ -0,0 +1,73 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+type Info record {|
+    string name;
+    int weight = 1;
+|};
+
+public const annotation Info info on type, class, function;
+annotation tag on type, class, function;
+annotation Info[] labels on type;
+const annotation checked on source var;
+
+typedesc<Counter> counterTd = Counter;
+
+@checked
+int count = 1;
+
+@info {name: "person"}
+@tag
+type Person record {|
+    string name;
+|};
+
+@tag
+@info {name: "counter", weight: 3}
+class Counter {
+    int n = 0;
+
+    @tag
+    function inc() {
+        self.n += 1;
+    }
+}
+
+@labels {name: "a"}
+@labels {name: "b", weight: 2}
+type Labelled int;
+
+type Plain int;
+
+@info {name: "main"}
+public function main() {
+    typedesc<Person> pt = Person;
+    Info? pi = pt.@info;
+    io:println(pi); // @output {"name":"person","weight":1}
+    io:println(pt.@tag); // @output true
+    io:println(pt.@labels is ()); // @output true
+    io:println(counterTd.@info); // @output {"name":"counter","weight":3}
+    io:println(counterTd === Counter); // @output true
+    Info[]? ls = Labelled.@labels;
+    io:println(ls); // @output [{"name":"a","weight":1},{"name":"b","weight":2}]
+    typedesc<Plain> plain = Plain;
+    io:println(plain.@tag is ()); // @output true
+    typedesc<int> it = int;
+    io:println(it.@info is ()); // @output true
+    io:println(count); // @output 1
+}
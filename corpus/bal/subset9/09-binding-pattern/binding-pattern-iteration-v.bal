@@ -0,0 +1,47 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+type Person record {|
+    string name;
+    int age;
+|};
+
+public function main() {
+    [int, string][] pairs = [[1, "one"], [2, "two"]];
+    foreach var [num, word] in pairs {
+        io:println(num, ":", word);
+    }
+    // @output 1:one
+    // @output 2:two
+
+    Person[] people = [{name: "Ann", age: 30}, {name: "Bob", age: 17}];
+    foreach Person {name, age} in people {
+        io:println(name, " ", age);
+    }
+    // @output Ann 30
+    // @output Bob 17
+
+    string[] adults = from var {name, age} in people
+        where age >= 18
+        select name;
+    io:println(adults); // @output ["Ann"]
+
+    int[] sums = from var [n, w] in pairs
+        let var [a, b] = [n, w.length()]
+        select a + b;
+    io:println(sums); // @output [4,5]
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+type Person record {|
+    string name;
+    int age;
+|};
+
+type Detail record {|
+    int code;
+|};
+
+function divide(int a, int b) returns [int, int] {
+    return [a / b, a % b];
+}
+
+public function main() {
+    int q;
+    int r;
+    [q, r] = divide(17, 5);
+    io:println(q, " ", r); // @output 3 2
+
+    [q, r] = [r, q];
+    io:println(q, " ", r); // @output 2 3
+
+    string name = "";
+    int age = 0;
+    {name, age} = <Person>{name: "Ann", age: 30};
+    io:println(name, " ", age); // @output Ann 30
+
+    [q, _] = [9, "ignored"];
+    io:println(q); // @output 9
+
+    string msg;
+    int code;
+    error<Detail> e = error("failed", code = 5);
+    error(msg, code = code) = e;
+    io:println(msg, " ", code); // @output failed 5
+
+    int[] rest = [];
+    [q, ...rest] = [1, 2, 3];
+    io:println(q, " ", rest); // @output 1 [2,3]
+
+    int|string v = 1;
+    [v, r] = ["one", 0];
+    io:println(v, " ", r); // @output one 0
+}
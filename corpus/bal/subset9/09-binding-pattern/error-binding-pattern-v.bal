@@ -0,0 +1,41 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+type Detail record {|
+    int code;
+    string reason?;
+|};
+
+type AppError error<Detail>;
+
+public function main() {
+    AppError e = error("boom", error("inner"), code = 42);
+    var error(msg, cause, code = c) = e;
+    io:println(msg, " ", cause, " ", c); // @output boom error("inner") 42
+
+    AppError e2 = error("bad", code = 7, reason = "r");
+    AppError error(m2, code = c2, ...details) = e2;
+    io:println(m2, " ", c2, " ", details); // @output bad 7 {"reason":"r"}
+
+    error plain = error("plain");
+    var error(plainMsg, plainCause) = plain;
+    io:println(plainMsg, " ", plainCause is ()); // @output plain true
+
+    [int, AppError] pair = [1, error("nested", code = 3)];
+    var [i, error(nestedMsg, code = nestedCode)] = pair;
+    io:println(i, " ", nestedMsg, " ", nestedCode); // @output 1 nested 3
+}
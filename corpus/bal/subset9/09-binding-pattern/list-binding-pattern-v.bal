@@ -0,0 +1,36 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+public function main() {
+    [int, string, boolean...] t = [1, "a", true, false];
+    var [a, b, ...rest] = t;
+    io:println(a, " ", b, " ", rest); // @output 1 a [true,false]
+
+    [int, [string, float]] nested = [1, ["x", 2.5]];
+    var [n, [s, f]] = nested;
+    io:println(n, " ", s, " ", f); // @output 1 x 2.5
+
+    [int, string] pair = [7, "seven"];
+    [int, string] [num, _] = pair;
+    io:println(num); // @output 7
+
+    [int, int...] numbers = [1, 2, 3];
+    var [head, ...tail] = numbers;
+    tail.push(4);
+    io:println(head, " ", tail); // @output 1 [2,3,4]
+    io:println(numbers); // @output [1,2,3]
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+type Person record {|
+    string name;
+    int age;
+    string city?;
+|};
+
+public function main() {
+    Person p = {name: "Ann", age: 30};
+    var {name, age, city} = p;
+    io:println(name, " ", age, " ", city is ()); // @output Ann 30 true
+
+    Person q = {name: "Bob", age: 40, city: "Colombo"};
+    Person {name: qName, city: qCity} = q;
+    io:println(qName, " ", qCity); // @output Bob Colombo
+
+    record {| string name; int...; |} r = {name: "x", "a": 1, "b": 2};
+    var {name: rName, ...others} = r;
+    io:println(rName, " ", others); // @output x {"a":1,"b":2}
+
+    map<int> counts = {a: 1, b: 2};
+    var {a: ca, ...restCounts} = counts;
+    io:println(ca, " ", restCounts); // @output 1 {"b":2}
+
+    record {| [int, int] point; record {| string k; |} inner; |} nested = {point: [1, 2], inner: {k: "v"}};
+    var {point: [x, y], inner: {k}} = nested;
+    io:println(x + y, " ", k); // @output 3 v
+}
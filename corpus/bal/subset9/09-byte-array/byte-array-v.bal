@@ -0,0 +1,39 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+const byte[] MAGIC = base16 `cafe`;
+
+public function main() {
+    byte[] a = base16 `aa ff 01`;
+    io:println(a); // @output [170,255,1]
+    byte[] b = base64 `aGVsbG8=`;
+    io:println(b, " ", b.length()); // @output [104,101,108,108,111] 5
+    byte[] empty = base16 ``;
+    io:println(empty); // @output []
+    var c = base64 `
+        AAEC
+        AwQF`;
+    io:println(c); // @output [0,1,2,3,4,5]
+    byte[3] fixed = base16 `010203`;
+    io:println(fixed); // @output [1,2,3]
+    int[] ints = base16 `0102`;
+    io:println(ints); // @output [1,2]
+    io:println(a is readonly, " ", MAGIC); // @output true [202,254]
+    io:println(base16 `0f`[0]); // @output 15
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+int calls = 0;
+
+function fallback(int n) returns int {
+    calls += 1;
+    return n;
+}
+
+function find(map<int> m, string key) returns int? {
+    return m[key];
+}
+
+public function main() {
+    map<int> m = {a: 1};
+    io:println(find(m, "a") ?: fallback(10)); // @output 1
+    io:println(calls); // @output 0
+    io:println(find(m, "b") ?: fallback(10)); // @output 10
+    io:println(calls); // @output 1
+
+    int? a = ();
+    int? b = ();
+    int c = a ?: b ?: 3;
+    io:println(c); // @output 3
+
+    string? s = "set";
+    string t = s ?: "unset";
+    io:println(t); // @output set
+}
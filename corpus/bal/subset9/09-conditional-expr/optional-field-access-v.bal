@@ -0,0 +1,55 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+type Address record {|
+    string city;
+|};
+
+type Person record {|
+    string name;
+    Address? address = ();
+    int age?;
+|};
+
+public function main() {
+    Person p = {name: "ann", address: {city: "Colombo"}, age: 30};
+    Person q = {name: "bob"};
+    io:println(p.address?.city); // @output Colombo
+    io:println(q.address?.city is ()); // @output true
+    io:println(p?.age); // @output 30
+    io:println(q?.age is ()); // @output true
+    io:println(q?.age ?: -1); // @output -1
+
+    Person? r = ();
+    io:println(r?.name is ()); // @output true
+
+    map<int> m = {a: 1};
+    io:println(m?.a); // @output 1
+    io:println(m?.b is ()); // @output true
+
+    json j = {a: {b: 2}};
+    json|error jb = j?.a?.b;
+    io:println(jb is json ? jb : -1); // @output 2
+    json k = 3;
+    json|error ka = k?.a;
+    io:println(ka is error); // @output true
+    json|error kab = k?.a?.b;
+    io:println(kab is error); // @output true
+    json n = ();
+    json|error na = n?.a;
+    io:println(na is ()); // @output true
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+int calls = 0;
+
+function count(int n) returns int {
+    calls += 1;
+    return n;
+}
+
+function label(int? n) returns string {
+    return n is int ? (n > 0 ? "positive" : n == 0 ? "zero" : "negative") : "none";
+}
+
+function max(int a, int b) returns int => a > b ? a : b;
+
+public function main() {
+    io:println(label(3)); // @output positive
+    io:println(label(0)); // @output zero
+    io:println(label(-2)); // @output negative
+    io:println(label(())); // @output none
+    io:println(max(4, 7)); // @output 7
+
+    int x = 5;
+    int y = x > 3 ? count(1) : count(2);
+    io:println(y); // @output 1
+    io:println(calls); // @output 1
+
+    string|int v = "hello";
+    int len = v is string ? v.length() : v;
+    io:println(len); // @output 5
+
+    boolean flag = false;
+    float f = flag ? 1.5 : 2.5;
+    io:println(f); // @output 2.5
+}
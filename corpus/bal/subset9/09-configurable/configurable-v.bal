@@ -0,0 +1,41 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+type Endpoint record {|
+    string host;
+    int port;
+|};
+
+configurable int port = 8080;
+configurable float ratio = 0.25;
+configurable string greeting = "hello";
+configurable boolean verbose = false;
+configurable int[] retries = [1, 2, 4];
+configurable Endpoint endpoint = {host: "localhost", port: port + 1};
+configurable int timeout = port * 2;
+
+public function main() {
+    io:println(port); // @output 8080
+    io:println(ratio); // @output 0.25
+    io:println(greeting); // @output hello
+    io:println(verbose); // @output false
+    io:println(retries); // @output [1,2,4]
+    io:println(endpoint); // @output {"host":"localhost","port":8081}
+    io:println(timeout); // @output 16160
+    io:println(retries is readonly); // @output true
+    io:println(endpoint is readonly); // @output true
+}
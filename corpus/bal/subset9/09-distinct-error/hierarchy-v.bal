@@ -0,0 +1,54 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+type Error distinct error;
+type FormatError distinct Error;
+type ParseError distinct Error;
+
+type Detail record {|
+    int code;
+|};
+
+type CodeError distinct error<Detail>;
+
+function makeError(int i) returns error {
+    if i == 0 {
+        return error FormatError("format");
+    }
+    if i == 1 {
+        return error ParseError("parse");
+    }
+    if i == 2 {
+        return error CodeError("code", code = 3);
+    }
+    return error("plain");
+}
+
+public function main() {
+    foreach int i in 0 ..< 4 {
+        error e = makeError(i);
+        io:println(e is Error, " ", e is FormatError, " ", e is ParseError, " ", e is CodeError);
+    }
+    // @output true true false false
+    // @output true false true false
+    // @output false false false true
+    // @output false false false false
+    Error e = error FormatError("upcast");
+    io:println(e is FormatError, " ", e.message()); // @output true upcast
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+type E1 distinct error;
+type E2 distinct error;
+type E3 E1 & E2;
+
+function classify(error e) returns string {
+    match e {
+        error E3(var m) => {
+            return "E3 " + m;
+        }
+        error E1(var m) => {
+            return "E1 " + m;
+        }
+        error(var m) => {
+            return "other " + m;
+        }
+    }
+}
+
+public function main() {
+    E3 e3 = error E3("both");
+    io:println(e3 is E1, " ", e3 is E2); // @output true true
+    io:println(classify(e3)); // @output E3 both
+    io:println(classify(error E1("one"))); // @output E1 one
+    io:println(classify(error E2("two"))); // @output other two
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+type NegativeError distinct error;
+
+function validate(int i) returns int|NegativeError {
+    if i < 0 {
+        return error NegativeError("negative");
+    }
+    return i;
+}
+
+public function main() {
+    foreach int i in [-1, 1] {
+        int|NegativeError r = validate(i);
+        if r is NegativeError {
+            io:println("error: ", r.message());
+        } else {
+            io:println(r + 1);
+        }
+    }
+    // @output error: negative
+    // @output 2
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+import ballerina/time;
+
+type Error distinct error;
+
+public function main() {
+    time:Utc|time:Error r = time:utcFromString("bad");
+    if r is time:FormatError {
+        io:println("format error"); // @output format error
+    }
+    io:println(r is time:Error); // @output true
+    error e = error Error("mine");
+    io:println(e is time:Error); // @output false
+}
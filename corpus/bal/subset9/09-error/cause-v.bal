@@ -0,0 +1,46 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+function digit(string s) returns int|error {
+    if s == "0" {
+        return 0;
+    }
+    return error("not a digit: " + s);
+}
+
+function parse(string s) returns int|error {
+    int|error n = digit(s);
+    if n is error {
+        return error("parse failed", n, input = s);
+    }
+    return n;
+}
+
+public function main() {
+    int|error r = parse("x1");
+    if r is error {
+        io:println(r.message()); // @output parse failed
+        error? cause = r.cause();
+        if cause is error {
+            io:println(cause.message()); // @output not a digit: x1
+            io:println(cause.cause() is ()); // @output true
+        }
+    }
+    io:println(error("no cause").cause() is ()); // @output true
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+type Detail record {|
+    int code;
+    string reason;
+|};
+
+type CodeError error<Detail>;
+
+public function main() {
+    CodeError e = error CodeError("code", code = 4, reason = "bad input");
+    Detail d = e.detail();
+    io:println(d.code, " ", d.reason); // @output 4 bad input
+    error plain = error("plain", count = 2);
+    io:println(plain.detail()); // @output {"count":2}
+    io:println(plain.detail()["count"]); // @output 2
+    io:println(error("none").detail().length()); // @output 0
+}
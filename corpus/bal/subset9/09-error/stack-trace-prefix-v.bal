@@ -0,0 +1,30 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+// The predeclared langlib prefixes name the types of their modules without an import.
+public function main() {
+    error e = error("boom");
+    error:StackFrame[] st = e.stackTrace();
+    io:println(st.length(), " ", st[0].toString()); // @output 1 main(stack-trace-prefix-v.bal:21)
+
+    string:RegExp digits = re `[0-9]+`;
+    regexp:Span? span = digits.find("abc123");
+    if span is regexp:Span {
+        io:println(span.substring()); // @output 123
+    }
+}
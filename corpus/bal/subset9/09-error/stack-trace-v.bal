@@ -0,0 +1,39 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+import ballerina/lang.'error;
+
+function make(int depth) returns error {
+    if depth == 0 {
+        return error("leaf");
+    }
+    return make(depth - 1);
+}
+
+public function main() {
+    error e = make(2);
+    foreach error:StackFrame frame in e.stackTrace() {
+        io:println(frame.toString());
+    }
+    // @output make(stack-trace-v.bal:23)
+    // @output make(stack-trace-v.bal:25)
+    // @output make(stack-trace-v.bal:25)
+    // @output main(stack-trace-v.bal:29)
+    error:StackFrame[] frames = error("here").stackTrace();
+    io:println(frames.length(), " ", frames[0].toString()); // @output 1 main(stack-trace-v.bal:37)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+type Detail record {|
+    int code;
+|};
+
+type CodeError distinct error<Detail>;
+
+public function main() {
+    error e = error("outer", error("inner", n = 1.5), name = "x", id = 3);
+    io:println(e.toString()); // @output error("outer",error("inner",n=1.5),name="x",id=3)
+    io:println(e.toBalString()); // @output error("outer",error("inner",n=1.5),name="x",id=3)
+    CodeError c = error CodeError("code", code = 7);
+    io:println(c.toString()); // @output error CodeError ("code",code=7)
+    error d = error("dec", amount = 1.50d, nothing = ());
+    io:println(d.toString()); // @output error("dec",amount=1.50,nothing=null)
+    io:println(d.toBalString()); // @output error("dec",amount=1.50d,nothing=())
+}
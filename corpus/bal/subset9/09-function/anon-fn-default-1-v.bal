@@ -0,0 +1,33 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+const int OFFSET = 100;
+
+public function main() {
+    var g = function(int a, int b = 10, string... rest) returns string => a.toString() + ":" + b.toString() + ":" + rest.length().toString();
+    io:println(g(1)); // @output 1:10:0
+    io:println(g(a = 4)); // @output 4:10:0
+    io:println(g(b = 2, a = 3)); // @output 3:2:0
+    io:println(g(1, 2, "x", "y")); // @output 1:2:2
+
+    var f = function(int a, int b = a * 2, int c = OFFSET) returns int {
+        return a + b + c;
+    };
+    io:println(f(1)); // @output 103
+    io:println(f(c = 0, a = 1)); // @output 3
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+type Adder function (int a, int b = 5) returns int;
+
+function add(int a, int b = 10) returns int {
+    return a + b;
+}
+
+function greet(string greeting, string name = "world", string punct = "!") returns string {
+    return greeting + ", " + name + punct;
+}
+
+public function main() {
+    Adder f = add;
+    io:println(f(1, 2)); // @output 3
+    io:println(f(1)); // @output 6
+    io:println(f(b = 3, a = 4)); // @output 7
+
+    var g = add;
+    io:println(g(1)); // @output 11
+    io:println(g(a = 2)); // @output 12
+
+    var h = greet;
+    io:println(h("hi", punct = "?")); // @output hi, world?
+
+    function (string greeting, string name = "there", string punct = ".") returns string local = greet;
+    io:println(local("hello")); // @output hello, there.
+    io:println(local(name = "you", greeting = "hey")); // @output hey, you.
+
+    function (int p, int q) returns int diff = (p, q) => p - q;
+    io:println(diff(q = 1, p = 10)); // @output 9
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+type Shape object {
+    function scale(int factor, int offset = 1) returns int;
+};
+
+class Square {
+    int side = 3;
+
+    function scale(int factor, int offset = 100) returns int {
+        return self.side * factor + offset;
+    }
+}
+
+public function main() {
+    Shape s = new Square();
+    io:println(s.scale(2)); // @output 7
+    io:println(s.scale(offset = 0, factor = 4)); // @output 12
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+type IntOp function (int a, int b) returns int;
+
+function apply(function (int) returns int f, int x) returns int {
+    return f(x);
+}
+
+function combine(IntOp op, int a, int b) returns int {
+    return op(a, b);
+}
+
+public function main() {
+    function (int) returns int double = x => x * 2;
+    io:println(double(21)); // @output 42
+
+    int offset = 10;
+    io:println(apply(x => x + offset, 5)); // @output 15
+
+    IntOp sub = (a, b) => a - b;
+    io:println(sub(7, 3)); // @output 4
+    io:println(combine((a, b) => a * b, 6, 7)); // @output 42
+
+    function () returns string greet = () => "hello";
+    io:println(greet()); // @output hello
+
+    function (int) returns string sign = n => n < 0 ? "neg" : "non-neg";
+    io:println(sign(-1)); // @output neg
+    io:println(sign(3)); // @output non-neg
+
+    function (int) returns function (int) returns int adder = a => b => a + b;
+    function (int) returns int inc = adder(1);
+    io:println(inc(2)); // @output 3
+}
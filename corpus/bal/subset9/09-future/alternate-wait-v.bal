@@ -0,0 +1,34 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+function compute(int n) returns int|error {
+    if n < 0 {
+        return error("negative");
+    }
+    return n * 10;
+}
+
+public function main() {
+    future<int|error> e1 = start compute(-1);
+    future<int|error> e2 = start compute(-2);
+    int|error r1 = wait e1 | e2;
+    io:println(r1 is error); // @output true
+    future<int|error> f1 = start compute(-3);
+    future<int|error> f2 = start compute(4);
+    int|error r2 = wait f1 | f2;
+    io:println(r2); // @output 40
+}
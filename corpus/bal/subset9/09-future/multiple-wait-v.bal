@@ -0,0 +1,34 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+function square(int n) returns int {
+    return n * n;
+}
+
+function name(string s) returns string {
+    return s + "!";
+}
+
+public function main() {
+    future<int> f1 = start square(3);
+    future<string> f2 = start name("done");
+    record {| int a; string b; |} r = wait {a: f1, b: f2};
+    io:println(r.a); // @output 9
+    io:println(r.b); // @output done!
+    var r2 = wait {f1, f2};
+    io:println(r2); // @output {"f1":9,"f2":"done!"}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+class Counter {
+    int count;
+
+    function init(int count) {
+        self.count = count;
+    }
+
+    function next() returns int {
+        self.count += 1;
+        return self.count;
+    }
+}
+
+public function main() {
+    Counter c = new (41);
+    future<int> f1 = start c.next();
+    int r1 = wait f1;
+    io:println(r1); // @output 42
+    function (int) returns int double = function(int x) returns int {
+        return x * 2;
+    };
+    future<int> f2 = start double(21);
+    int r2 = wait f2;
+    io:println(r2); // @output 42
+}
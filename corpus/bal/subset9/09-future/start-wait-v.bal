@@ -0,0 +1,33 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+function add(int a, int b) returns int {
+    return a + b;
+}
+
+public function main() {
+    future<int> f1 = start add(1, 2);
+    future<int> f2 = start add(3, 4);
+    int r1 = wait f1;
+    int r2 = wait f2;
+    io:println(r1 + r2); // @output 10
+    int again = wait f1;
+    io:println(again); // @output 3
+    future f3 = start add(5, 6);
+    any|error r3 = wait f3;
+    io:println(r3); // @output 11
+}
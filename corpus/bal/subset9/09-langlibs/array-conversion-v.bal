@@ -0,0 +1,40 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+import ballerina/lang.array;
+
+public function main() {
+    string[] names = ["a", "b"];
+    [int, string][] entries = names.enumerate();
+    io:println(entries); // @output [[0,"a"],[1,"b"]]
+
+    var it = names.iterator();
+    record {| string value; |}? next = it.next();
+    io:println(next); // @output {"value":"a"}
+    io:println(it.next()); // @output {"value":"b"}
+    io:println(it.next() is ()); // @output true
+
+    stream<string> st = names.toStream();
+    io:println(st.next()); // @output {"value":"a"}
+
+    byte[] bytes = [72, 105];
+    string encoded = bytes.toBase64();
+    io:println(encoded); // @output SGk=
+    io:println(array:fromBase64(encoded)); // @output [72,105]
+    io:println(array:fromBase64("S G k =")); // @output [72,105]
+    io:println(array:fromBase64("!!") is error); // @output true
+}
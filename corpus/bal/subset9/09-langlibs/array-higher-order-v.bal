@@ -0,0 +1,34 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+public function main() {
+    int[] xs = [3, 1, 2];
+    io:println(xs.map(x => x * 2)); // @output [6,2,4]
+    string[] strs = xs.map(function (int x) returns string => x < 2 ? "small" : "big");
+    io:println(strs); // @output ["big","small","big"]
+    io:println(xs.filter(x => x > 1)); // @output [3,2]
+    io:println(xs.reduce((acc, x) => acc + x, 0)); // @output 6
+    io:println(xs.reduce(function (string acc, int x) returns string => acc + (x > 1 ? "b" : "a"), "")); // @output bab
+    io:println(xs.some(x => x > 2)); // @output true
+    io:println(xs.every(x => x > 2)); // @output false
+    int sum = 0;
+    xs.forEach(function (int x) {
+        sum += x;
+    });
+    io:println(sum); // @output 6
+}
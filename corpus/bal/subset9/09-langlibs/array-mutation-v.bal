@@ -0,0 +1,32 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+public function main() {
+    int[] xs = [1, 2, 3];
+    io:println(xs.pop()); // @output 3
+    io:println(xs.shift()); // @output 1
+    xs.unshift(7, 8);
+    io:println(xs); // @output [7,8,2]
+    io:println(xs.remove(1)); // @output 8
+    xs.setLength(4);
+    io:println(xs); // @output [7,2,0,0]
+    xs.setLength(1);
+    io:println(xs); // @output [7]
+    xs.removeAll();
+    io:println(xs.length()); // @output 0
+}
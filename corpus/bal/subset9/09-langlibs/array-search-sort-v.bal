@@ -0,0 +1,44 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+import ballerina/lang.array;
+
+type Person record {|
+    string name;
+    int age;
+|};
+
+public function main() {
+    int[] xs = [3, 1, 2, 1];
+    io:println(xs.indexOf(1)); // @output 1
+    io:println(xs.indexOf(1, 2)); // @output 3
+    io:println(xs.indexOf(5) is ()); // @output true
+    io:println(xs.lastIndexOf(1)); // @output 3
+    io:println(xs.lastIndexOf(1, 2)); // @output 1
+    io:println(xs.slice(1)); // @output [1,2,1]
+    io:println(xs.slice(1, 3)); // @output [1,2]
+    io:println(xs.reverse()); // @output [1,2,1,3]
+    io:println(xs.sort()); // @output [1,1,2,3]
+    io:println(xs.sort(array:DESCENDING)); // @output [3,2,1,1]
+    io:println(xs); // @output [3,1,2,1]
+
+    Person[] people = [{name: "Bob", age: 30}, {name: "Alice", age: 25}, {name: "Carol", age: 25}];
+    Person[] byAge = people.sort("ascending", p => p.age);
+    io:println(byAge.map(p => p.name)); // @output ["Alice","Carol","Bob"]
+    Person[] byName = people.sort(key = p => p.name, direction = "descending");
+    io:println(byName.map(p => p.name)); // @output ["Carol","Bob","Alice"]
+}
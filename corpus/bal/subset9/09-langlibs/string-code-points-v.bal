@@ -0,0 +1,35 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+import ballerina/lang.'string as strings;
+
+public function main() {
+    io:println(strings:codePointCompare("a", "b")); // @output -1
+    io:println("é".codePointCompare("e")); // @output 1
+    io:println("😀x".toCodePointInts()); // @output [128512,120]
+    io:println(strings:fromCodePointInts([104, 233])); // @output hé
+    io:println(strings:fromCodePointInts([0xD800]) is error); // @output true
+    io:println("a😀b".getCodePoint(1)); // @output 128512
+    io:println("hé".toBytes()); // @output [104,195,169]
+    io:println(strings:fromBytes([104, 195, 169])); // @output hé
+    io:println(strings:fromBytes([255]) is error); // @output true
+
+    var it = "a😀".iterator();
+    io:println(it.next()); // @output {"value":"a"}
+    io:println(it.next()); // @output {"value":"😀"}
+    io:println(it.next() is ()); // @output true
+}
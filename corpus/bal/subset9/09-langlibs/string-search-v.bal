@@ -0,0 +1,32 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+public function main() {
+    string s = "héllo wörld";
+    io:println(s.substring(1, 4)); // @output éll
+    io:println(s.substring(6)); // @output wörld
+    io:println(s.indexOf("l")); // @output 2
+    io:println(s.indexOf("l", 4)); // @output 9
+    io:println(s.indexOf("z") is ()); // @output true
+    io:println(s.lastIndexOf("l")); // @output 9
+    io:println(s.lastIndexOf("l", 8)); // @output 3
+    io:println(s.includes("wö")); // @output true
+    io:println(s.includes("hé", 1)); // @output false
+    io:println(s.startsWith("hé")); // @output true
+    io:println(s.endsWith("wör")); // @output false
+}
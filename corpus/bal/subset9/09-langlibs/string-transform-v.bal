@@ -0,0 +1,32 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+import ballerina/lang.'string as strings;
+
+public function main() {
+    io:println("Straße".toUpperAscii()); // @output STRAßE
+    io:println("ÀBC".toLowerAscii()); // @output Àbc
+    io:println("ABc".equalsIgnoreCaseAscii("abC")); // @output true
+    io:println("[" + " \t x y \n".trim() + "]"); // @output [x y]
+    io:println(strings:'join(", ", "a", "b", "c")); // @output a, b, c
+    io:println(strings:concat("x", "y", "z")); // @output xyz
+    io:println("[" + "ab".padStart(4) + "]"); // @output [  ab]
+    io:println("ab".padEnd(4, "é")); // @output abéé
+    io:println("-12".padZero(5)); // @output -0012
+    io:println("7".padZero(3, "#")); // @output ##7
+    io:println("long".padStart(2)); // @output long
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+type Row record {|
+    readonly int id;
+    anydata back?;
+|};
+
+public function main() {
+    int[][] xs = [[1, 2], [3]];
+    int[][] ys = xs.clone();
+    ys[0][0] = 10;
+    io:println(xs, " ", ys); // @output [[1,2],[3]] [[10,2],[3]]
+    io:println(xs.isReadOnly()); // @output false
+    readonly & int[][] ro = xs.cloneReadOnly();
+    io:println(ro.isReadOnly(), " ", ro[0].isReadOnly()); // @output true true
+    io:println(ro.clone().isReadOnly()); // @output true
+    map<anydata> m = {a: 1};
+    m["self"] = m;
+    map<anydata> c = m.clone();
+    io:println(c["self"] === c, " ", c === m); // @output true false
+    io:println("s".isReadOnly(), " ", (1.5).isReadOnly()); // @output true true
+    table<Row> key(id) t = table [{id: 1}];
+    Row r = t.get(1);
+    r.back = t;
+    table<Row> key(id) tc = t.clone();
+    anydata back = tc.get(1).back;
+    io:println(back === tc, " ", back === t); // @output true false
+    table<Row> key(id) tr = t.cloneReadOnly();
+    anydata readonlyBack = tr.get(1).back;
+    io:println(readonlyBack === tr, " ", tr.isReadOnly()); // @output true true
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+type Point record {|
+    int x;
+    int y;
+    string label?;
+|};
+
+// Default values of fields are not applied by cloneWithType.
+type Score record {|
+    string name;
+    float? score = ();
+|};
+
+public function main() {
+    map<anydata> m = {x: 1, y: 2.0};
+    Point|error p = m.cloneWithType();
+    io:println(p); // @output {"x":1,"y":2}
+    Point|error q = {x: 1}.cloneWithType(Point);
+    io:println(q is error); // @output true
+    record {| int x; |}|error r = m.cloneWithType();
+    if r is error {
+        io:println(r.message()); // @output 'mapping' value cannot be converted to the target type
+    }
+    Score|error sc = {name: "a"}.cloneWithType();
+    io:println(sc is error); // @output true
+    Score|error sj = "{\"name\": \"a\"}".fromJsonStringWithType();
+    io:println(sj is error); // @output true
+    io:println({name: "a", score: 1.5}.cloneWithType(Score)); // @output {"name":"a","score":1.5}
+    [float, string...]|error t = [1, "a", "b"].cloneWithType();
+    io:println(t); // @output [1.0,"a","b"]
+    int[]|string[]|error u = ["a", "b"].cloneWithType();
+    io:println(u is string[]); // @output true
+    readonly & int[]|error ro = [1, 2].cloneWithType();
+    io:println(ro is int[] && ro.isReadOnly()); // @output true
+    any x = 5;
+    float|error f = x.ensureType();
+    io:println(f); // @output 5.0
+    byte|error b = (300).ensureType();
+    io:println(b is error); // @output true
+    string|error s = x.ensureType(string);
+    if s is error {
+        io:println(s.message()); // @output incompatible types: 'int' value cannot be cast to the target type
+    }
+}
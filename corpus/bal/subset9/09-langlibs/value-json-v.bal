@@ -0,0 +1,50 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+type Person record {|
+    string name;
+    int age;
+|};
+
+public function main() {
+    map<anydata> m = {name: "Ann", tags: ["a", "b"], ratio: 0.5d};
+    json j = m.toJson();
+    io:println(j); // @output {"name":"Ann","tags":["a","b"],"ratio":0.5}
+    io:println(m.toJsonString()); // @output {"name":"Ann", "tags":["a", "b"], "ratio":0.5}
+    io:println([1, (), true, 2.5, "q\"t"].toJsonString()); // @output [1, null, true, 2.5, "q\"t"]
+    json|error k = "{\"b\": 1, \"a\": [1.5, -0, 20]}".fromJsonString();
+    io:println(k); // @output {"b":1,"a":[1.5,-0.0,20]}
+    if k is map<json> {
+        io:println(k["a"] is json[], " ", k["b"] is int); // @output true true
+    }
+    json|error bad = "{\"a\": 1".fromJsonString();
+    io:println(bad is error); // @output true
+    json pj = {name: "Bob", age: 4};
+    Person|error p = pj.fromJsonWithType();
+    io:println(p); // @output {"name":"Bob","age":4}
+    Person|error ps = "{\"name\": \"Cy\", \"age\": \"old\"}".fromJsonStringWithType();
+    io:println(ps is error); // @output true
+    json j1 = {a: 1, b: {c: 2}};
+    json j2 = {b: {d: 3}, e: 4};
+    json|error merged = j1.mergeJson(j2);
+    io:println(merged, " ", j1); // @output {"a":1,"b":{"c":2,"d":3},"e":4} {"a":1,"b":{"c":2,"d":3},"e":4}
+    json n = ();
+    io:println(n.mergeJson(3)); // @output 3
+    json i = 1;
+    io:println(i.mergeJson(2) is error); // @output true
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+import ballerina/lang.value;
+
+public function main() {
+    any n = ();
+    io:println(n.toString() == "", " ", (12).toString(), " ", "hi".toString()); // @output true 12 hi
+    io:println([1, "a"].toString()); // @output [1,"a"]
+    io:println("hi".toBalString(), " ", n.toBalString(), " ", (1.5d).toBalString()); // @output "hi" () 1.5d
+    map<anydata> m = {a: [1, 2.5, 3d], b: "x", c: ()};
+    string s = m.toBalString();
+    io:println(s); // @output {"a":[1,2.5,3d],"b":"x","c":()}
+    anydata|error back = s.fromBalString();
+    io:println(back == m); // @output true
+    io:println("float:NaN".fromBalString()); // @output NaN
+    io:println("[2.0f,3F,1.5D,-4e2f]".fromBalString()); // @output [2.0,3.0,1.5,-400.0]
+    anydata|error f = "2.5f".fromBalString();
+    io:println(f is float); // @output true
+    io:println("[1,".fromBalString() is error); // @output true
+    io:println(value:count(), " ", value:count(1, "a", error("e"))); // @output 0 3
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+function describe(int|string v) returns string {
+    return let boolean isInt = v is int in isInt ? "int" : "string";
+}
+
+public function main() {
+    int|string u = 3;
+    io:println(let int|string v = u in v is int ? v + 1 : v); // @output 4
+    io:println(describe("x"), " ", describe(1)); // @output string int
+    var square = function(int n) returns int {
+        return let int k = n in k * k;
+    };
+    io:println(square(4)); // @output 16
+    var adder = let int base = 100 in function(int n) returns int {
+        return base + n;
+    };
+    io:println(adder(5)); // @output 105
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+int total = let int a = 2, int b = a * 3 in a + b;
+
+function next() returns int => 5;
+
+public function main() {
+    int x = let int y = next() in y * 2;
+    io:println(x, " ", total); // @output 10 8
+    string s = let var n = "ab", string m = n + "c" in m + m;
+    io:println(s); // @output abcabc
+    int z = 10;
+    io:println(let int z2 = z + 1 in let int z3 = z2 * 2 in z3); // @output 22
+    io:println(let [int, string] [p, q] = [1, "one"] in [q, p]); // @output ["one",1]
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+type CodeError error<record {| int code; |}>;
+
+function errorKind(error e) returns string {
+    match e {
+        error CodeError(var m, code = 404) => {
+            return "not found: " + m;
+        }
+        error CodeError(_, code = var c) => {
+            io:println(c);
+            return "code";
+        }
+        error(_, error(var cm)) => {
+            return "caused by " + cm;
+        }
+        error(var m, ...var d) => {
+            io:println(d.length());
+            return m;
+        }
+    }
+}
+
+public function main() {
+    io:println(errorKind(error CodeError("missing", code = 404))); // @output not found: missing
+    // @output 500
+    io:println(errorKind(error CodeError("bad", code = 500))); // @output code
+    io:println(errorKind(error("outer", error("inner")))); // @output caused by inner
+    // @output 2
+    io:println(errorKind(error("plain", a = 1, b = 2))); // @output plain
+
+    int|error v = error("failed");
+    match v {
+        error("failed") => {
+            io:println("failed"); // @output failed
+        }
+        _ => {
+            io:println("other");
+        }
+    }
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+function classify(any v) returns string {
+    match v {
+        [] => {
+            return "empty";
+        }
+        [0, var b] => {
+            io:println(b);
+            return "zero then";
+        }
+        [var a, var b] => {
+            io:println(<int>a + <int>b);
+            return "pair";
+        }
+        [var head, ...var tail] => {
+            io:println(head, " ", tail.length());
+            return "list";
+        }
+        _ => {
+            return "other";
+        }
+    }
+}
+
+public function main() {
+    io:println(classify([])); // @output empty
+    // @output 5
+    io:println(classify([0, 5])); // @output zero then
+    // @output 5
+    io:println(classify([2, 3])); // @output pair
+    // @output 1 2
+    io:println(classify([1, 2, 3])); // @output list
+    io:println(classify("x")); // @output other
+
+    [int, string] t = [1, "a"];
+    match t {
+        [1, var s] => {
+            io:println(s); // @output a
+        }
+        [_, _] => {
+            io:println("not one");
+        }
+    }
+}
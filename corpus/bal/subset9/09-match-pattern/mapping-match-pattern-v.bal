@@ -0,0 +1,51 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+function describe(json j) returns string {
+    match j {
+        {kind: "point", x: var x, y: var y} => {
+            io:println(x, " ", y);
+            return "point";
+        }
+        {name: var name, ...var rest} => {
+            io:println(name, " ", rest.length());
+            return "named";
+        }
+        {} => {
+            return "mapping";
+        }
+        _ => {
+            return "other";
+        }
+    }
+}
+
+public function main() {
+    // @output 1 2
+    io:println(describe({kind: "point", x: 1, y: 2})); // @output point
+    // @output ann 2
+    io:println(describe({name: "ann", age: 3, id: 7})); // @output named
+    io:println(describe({a: 1})); // @output mapping
+    io:println(describe([1])); // @output other
+
+    record {| string id; int count; |} r = {id: "r1", count: 4};
+    match r {
+        {id: "r1", count: var c} => {
+            io:println(c); // @output 4
+        }
+    }
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+function sum(any v) returns int {
+    match v {
+        [var a, var b] | {a: var a, b: var b} if a is int && b is int => {
+            return a + b;
+        }
+        var x if x is int => {
+            return x;
+        }
+    }
+    return -1;
+}
+
+function sign(int n) returns string {
+    match n {
+        0 => {
+            return "zero";
+        }
+        var x if x > 0 => {
+            return "positive";
+        }
+        _ => {
+            return "negative";
+        }
+    }
+}
+
+public function main() {
+    io:println(sum([1, 2])); // @output 3
+    io:println(sum({a: 3, b: 4})); // @output 7
+    io:println(sum({a: 3, b: "x"})); // @output -1
+    io:println(sum(9)); // @output 9
+    io:println(sign(0)); // @output zero
+    io:println(sign(5)); // @output positive
+    io:println(sign(-5)); // @output negative
+
+    int total = 0;
+    foreach any v in [[1, 2], [3, 4, 5], "s"] {
+        match v {
+            [var a, var b] => {
+                total += <int>a + <int>b;
+                continue;
+            }
+            [_, _, var c] => {
+                function () returns int f = function() returns int {
+                    return <int>c;
+                };
+                total += f();
+                break;
+            }
+        }
+    }
+    io:println(total); // @output 8
+}
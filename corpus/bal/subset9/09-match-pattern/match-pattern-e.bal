@@ -0,0 +1,49 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+type Num int;
+
+type Point record {|
+    int x;
+    int y;
+|};
+
+function f(Point p, [int, string] t, error e) returns int {
+    match p {
+        {x: var x, y: var y} => {
+            return x + y;
+        }
+        {x: 1} => { // @error
+            return 1;
+        }
+    }
+    match t {
+        [var a, _, _] => { // @error
+            return <int>a;
+        }
+        [var a, _] => {
+            return a;
+        }
+    }
+    match e {
+        error Num(var m) => { // @error
+            return m.length();
+        }
+    }
+    return 0;
+}
+
+public function main() {
+}
@@ -18,7 +18,7 @@ class FailingListener {
     public function attach(service object {} svc, () attachPoint = ()) returns error? {
         var _ = svc;
         var _ = attachPoint;
-        return error("attach failed");
+        return error("attach failed"); // @panic attach failed
     }
 
     public function detach(service object {} svc) returns error? {
@@ -37,7 +37,7 @@ class FailingListener {
 
 listener FailingListener l = new ();
 
-service on l { // @panic attach failed
+service on l {
 }
 
 public function main() {
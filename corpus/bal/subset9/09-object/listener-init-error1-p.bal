@@ -35,10 +35,10 @@ class SimpleListener {
 }
 
 function makeListener() returns SimpleListener|error {
-    return error("cannot init listener");
+    return error("cannot init listener"); // @panic cannot init listener
 }
 
-listener SimpleListener l = makeListener(); // @panic cannot init listener
+listener SimpleListener l = makeListener();
 
 service on l {
 }
@@ -16,7 +16,7 @@
 
 class SimpleListener {
     function init() returns error? {
-        return error("listener init failed");
+        return error("listener init failed"); // @panic listener init failed
     }
 
     public function attach(service object {} svc, () attachPoint = ()) returns () {
@@ -38,7 +38,7 @@ class SimpleListener {
     }
 }
 
-listener SimpleListener l = new (); // @panic listener init failed
+listener SimpleListener l = new ();
 
 service on l {
 }
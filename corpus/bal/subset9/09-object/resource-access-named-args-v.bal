@@ -0,0 +1,34 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+client class Greeter {
+    resource function get greeting/[string name](string greeting = "Hello", string suffix = "!") returns string {
+        return greeting + " " + name + suffix;
+    }
+}
+
+public function main() {
+    Greeter g = new ();
+    string r1 = g->/greeting/["Alice"];
+    io:println(r1); // @output Hello Alice!
+    string r2 = g->/greeting/["Bob"](suffix = "?");
+    io:println(r2); // @output Hello Bob?
+    string r3 = g->/greeting/["Carol"].get(suffix = ".", greeting = "Hi");
+    io:println(r3); // @output Hi Carol.
+    string r4 = g->/greeting/["Dan"]("Hey");
+    io:println(r4); // @output Hey Dan!
+}
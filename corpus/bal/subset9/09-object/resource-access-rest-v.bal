@@ -0,0 +1,42 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+client class Store {
+    resource function get items/[string... path]() {
+        io:println(path);
+    }
+
+    resource function post items/[string category]/[int... ids](string note) {
+        io:println(category, " ", ids, " ", note);
+    }
+
+    resource function get .() {
+        io:println("root");
+    }
+}
+
+public function main() {
+    Store s = new ();
+    string[] path = ["a", "b"];
+    s->/items/[...path]; // @output ["a","b"]
+    s->/items/x/[...path]; // @output ["x","a","b"]
+    s->/items; // @output []
+    s->/items/[...path].get(); // @output ["a","b"]
+    int[] ids = [1, 2];
+    s->/items/books/[...ids].post("n"); // @output books [1,2] n
+    s->/; // @output root
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+client class Store {
+    resource function get items/[string id]() returns string {
+        return "item " + id;
+    }
+
+    resource function get items/latest() returns string {
+        return "latest";
+    }
+
+    resource function get items/[string... path]() returns string {
+        return string `path ${path.length()}`;
+    }
+
+    resource function get [string... path]() returns string {
+        return "fallback";
+    }
+}
+
+public function main() {
+    Store s = new ();
+    string r1 = s->/items/latest;
+    io:println(r1); // @output latest
+    string r2 = s->/items/["a"];
+    io:println(r2); // @output item a
+    string r3 = s->/items/a/b;
+    io:println(r3); // @output path 2
+    string r4 = s->/items;
+    io:println(r4); // @output path 0
+    string r5 = s->/orders/a;
+    io:println(r5); // @output fallback
+    string[] path = ["latest"];
+    string r6 = s->/items/[...path];
+    io:println(r6); // @output latest
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+client class English {
+    resource function get greeting/[string name]() returns string {
+        return "Hello " + name;
+    }
+}
+
+client class French {
+    resource function get greeting/[string name]() returns string {
+        return "Bonjour " + name;
+    }
+
+    resource function get greeting/world() returns string {
+        return "Bonjour le monde";
+    }
+}
+
+public function main() {
+    (English|French)[] greeters = [new English(), new French()];
+    foreach English|French g in greeters {
+        string r1 = g->/greeting/["Ada"];
+        io:println(r1);
+        string r2 = g->/greeting/world;
+        io:println(r2);
+    }
+    // @output Hello Ada
+    // @output Hello world
+    // @output Bonjour Ada
+    // @output Bonjour le monde
+}
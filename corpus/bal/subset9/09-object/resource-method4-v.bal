@@ -23,6 +23,7 @@ client class foo {
     }
 
     resource function get [string base]/[string foo]/[int bar](int[] vals) {
+        io:println(base);
         io:println(foo);
         io:println(bar);
         io:println(vals);
@@ -31,5 +32,11 @@ client class foo {
 
 public function main() {
     foo f = new ();
-    f->/test/["foo"]/[4]([]); // @error
+    f->/test/["foo"]/[4]([1]); // @output foo
+    // @output 4
+    // @output [1]
+    f->/other/["foo"]/[5]([]); // @output other
+    // @output foo
+    // @output 5
+    // @output []
 }
\ No newline at end of file
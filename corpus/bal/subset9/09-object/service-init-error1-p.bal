@@ -36,9 +36,9 @@ class SimpleListener {
 
 listener SimpleListener l = new ();
 
-service on l { // @panic service init failed
+service on l {
     function init() returns error? {
-        return error("service init failed");
+        return error("service init failed"); // @panic service init failed
     }
 }
 
@@ -18,7 +18,7 @@ class FailingListener {
     public function attach(service object {} svc, () attachPoint = ()) returns error? {
         var _ = svc;
         var _ = attachPoint;
-        return error("attach failed");
+        return error("attach failed"); // @panic attach failed
     }
 
     public function detach(service object {} svc) returns error? {
@@ -35,7 +35,7 @@ class FailingListener {
     }
 }
 
-service on new FailingListener() { // @panic attach failed
+service on new FailingListener() {
 }
 
 public function main() {
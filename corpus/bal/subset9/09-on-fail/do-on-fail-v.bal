@@ -0,0 +1,86 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+type CodeError error<record {| int code; |}>;
+
+function parse(string s) returns int|error {
+    if s == "" {
+        return error("empty");
+    }
+    return s.length();
+}
+
+function firstLength(string s) returns int|error {
+    do {
+        int n = check parse(s);
+        return n;
+    } on fail var e {
+        io:println("do failed: ", e.message());
+    }
+    fail error("no length");
+}
+
+function describe(int code) returns string {
+    do {
+        if code > 0 {
+            fail error CodeError("bad code", code = code);
+        }
+        return "ok";
+    } on fail CodeError e {
+        return "code error: " + e.message();
+    }
+}
+
+public function main() {
+    do {
+        fail error("boom");
+    } on fail error e {
+        io:println("caught ", e.message()); // @output caught boom
+    }
+
+    int|error r = firstLength(""); // @output do failed: empty
+    if r is error {
+        io:println(r.message()); // @output no length
+    }
+    io:println(firstLength("abc")); // @output 3
+
+    io:println(describe(0)); // @output ok
+    io:println(describe(42)); // @output code error: bad code
+
+    int v;
+    do {
+        v = check parse("");
+    } on fail {
+        v = -1;
+    }
+    io:println(v); // @output -1
+
+    do {
+        do {
+            fail error("inner");
+        } on fail error e {
+            io:println("inner caught ", e.message()); // @output inner caught inner
+            fail error("outer", e);
+        }
+    } on fail error e {
+        io:println("outer caught ", e.message()); // @output outer caught outer
+    }
+
+    do {
+        io:println("no failure"); // @output no failure
+    }
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+function validate(int n) returns int|error {
+    if n < 0 {
+        fail error("negative");
+    }
+    return n;
+}
+
+function run(function () returns int|error f) returns int|error {
+    do {
+        return f();
+    } on fail {
+        return 0;
+    }
+}
+
+public function main() {
+    io:println(validate(1)); // @output 1
+    int|error r = validate(-1);
+    if r is error {
+        io:println(r.message()); // @output negative
+    }
+
+    // A check within a function expression fails that function rather than the enclosing do statement.
+    int|error s = run(function () returns int|error {
+        int n = check validate(-2);
+        return n;
+    });
+    if s is error {
+        io:println("function failed: ", s.message()); // @output function failed: negative
+    }
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+isolated function parse(string s) returns int|error {
+    if s == "" {
+        return error("empty");
+    }
+    return s.length();
+}
+
+function next(int i) returns boolean|error {
+    if i > 2 {
+        return error("too far");
+    }
+    return true;
+}
+
+function sum(string[] xs) returns int {
+    int total = 0;
+    foreach string x in xs {
+        total += check parse(x);
+    } on fail error e {
+        io:println("foreach failed: ", e.message());
+        return -1;
+    }
+    return total;
+}
+
+public function main() {
+    io:println(sum(["a", "bc"])); // @output 3
+    io:println(sum(["a", ""])); // @output foreach failed: empty
+    // @output -1
+
+    int i = 0;
+    while i < 5 {
+        i += 1;
+        if i == 3 {
+            fail error("three");
+        }
+    } on fail error e {
+        io:println("while failed at ", i, ": ", e.message()); // @output while failed at 3: three
+    }
+
+    int j = 0;
+    while check next(j) {
+        j += 1;
+    } on fail error e {
+        io:println("condition failed: ", e.message()); // @output condition failed: too far
+    }
+
+    lock {
+        int _ = check parse("");
+    } on fail error e {
+        io:println("lock failed: ", e.message()); // @output lock failed: empty
+    }
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+class RangeIterator {
+    int current;
+    int end;
+
+    function init(int 'start, int end) {
+        self.current = 'start;
+        self.end = end;
+    }
+
+    public isolated function next() returns record {|int value;|}? {
+        if self.current >= self.end {
+            return ();
+        }
+        int value = self.current;
+        self.current += 1;
+        return {value: value};
+    }
+}
+
+class Range {
+    int 'start;
+    int end;
+
+    function init(int 'start, int end) {
+        self.'start = 'start;
+        self.end = end;
+    }
+
+    public function iterator() returns RangeIterator {
+        return new (self.'start, self.end);
+    }
+}
+
+public function main() {
+    Range r = new (2, 5);
+    int[] squares = from var i in r select i * i;
+    io:println(squares); // @output [4,9,16]
+    int[] pairs = from var i in r join var j in r on i equals j select i + j;
+    io:println(pairs); // @output [4,6,8]
+}
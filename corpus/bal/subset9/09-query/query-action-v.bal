@@ -0,0 +1,60 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+class Numbers {
+    int n = 0;
+
+    public isolated function next() returns record {|int value;|}|error? {
+        if self.n >= 2 {
+            return error("stopped");
+        }
+        self.n += 1;
+        return {value: self.n};
+    }
+}
+
+function printAll(stream<int, error?> numbers) returns error? {
+    check from var n in numbers do {
+        io:println(n);
+    };
+}
+
+public function main() {
+    int[] xs = [1, 2, 3, 4];
+    int sum = 0;
+    from var x in xs
+        where x % 2 == 0
+        do {
+            sum += x;
+        };
+    io:println(sum); // @output 6
+
+    string[] pairs = [];
+    from var x in [1, 2]
+        join var y in ["1", "2"] on string `${x}` equals y
+        do {
+            pairs.push(string `${x}${y}`);
+        };
+    io:println(pairs); // @output ["11","22"]
+
+    stream<int, error?> numbers = new (new Numbers());
+    error? result = printAll(numbers); // @output 1
+    // @output 2
+    io:println(result); // @output error("stopped")
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+class Counter {
+    int n = 0;
+    int max;
+
+    function init(int max) {
+        self.max = max;
+    }
+
+    public isolated function next() returns record {|int value;|}|error? {
+        if self.n >= self.max {
+            return error("exhausted");
+        }
+        self.n += 1;
+        return {value: self.n};
+    }
+}
+
+public function main() {
+    stream<int> doubled = stream from var i in [1, 2, 3] select i * 2;
+    int[] fromDoubled = from var i in doubled select i;
+    io:println(fromDoubled); // @output [2,4,6]
+
+    stream<int, error?> counter = new (new Counter(2));
+    int[]|error collected = from var i in counter select i;
+    io:println(collected); // @output error("exhausted")
+
+    stream<int, error?> numbers = new (new Counter(2));
+    stream<string, error?> labels = from var i in numbers select string `#${i}`;
+    record {|string value;|}|error? first = labels.next();
+    io:println(first); // @output {"value":"#1"}
+    record {|string value;|}|error? second = labels.next();
+    io:println(second); // @output {"value":"#2"}
+    record {|string value;|}|error? last = labels.next();
+    io:println(last); // @output error("exhausted")
+}
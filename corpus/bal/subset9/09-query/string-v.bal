@@ -0,0 +1,28 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+public function main() {
+    string[] words = ["query", "to", "string"];
+    string joined = from var w in words select w;
+    io:println(joined); // @output querytostring
+    string upper = from var c in "abc" where c != "b" select c + c;
+    io:println(upper); // @output aacc
+    string[] chars = from var c in "xyz" select c;
+    io:println(chars); // @output ["x","y","z"]
+}
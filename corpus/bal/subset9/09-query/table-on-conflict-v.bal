@@ -0,0 +1,33 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+type Entry record {|
+    readonly string key;
+    int value;
+|};
+
+public function main() {
+    Entry[] entries = [{key: "a", value: 1}, {key: "b", value: 2}, {key: "a", value: 3}];
+    table<Entry> key(key)|error latest = table key(key) from var e in entries select e on conflict ();
+    io:println(latest); // @output [{"key":"a","value":3},{"key":"b","value":2}]
+    table<Entry> key(key)|error failed = table key(key) from var e in entries
+        select e
+        on conflict error("duplicate key " + e.key);
+    io:println(failed); // @output error("duplicate key a")
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+type Employee record {|
+    readonly int id;
+    string name;
+    int salary;
+|};
+
+public function main() {
+    Employee[] employees = [
+        {id: 3, name: "Carol", salary: 300},
+        {id: 1, name: "Alice", salary: 100},
+        {id: 2, name: "Bob", salary: 200}
+    ];
+    table<Employee> key(id) byId = table key(id) from var e in employees select e;
+    io:println(byId.get(2).name); // @output Bob
+    table<Employee> keyless = table key() from var e in employees where e.salary > 100 select e;
+    io:println(keyless.length()); // @output 2
+    table<Employee> key(id) inferred = from var e in employees
+        order by e.id
+        limit 2
+        select {id: e.id, name: e.name, salary: e.salary * 2};
+    io:println(inferred); // @output [{"id":1,"name":"Alice","salary":200},{"id":2,"name":"Bob","salary":400}]
+    int[] ids = from var e in byId select e.id;
+    io:println(ids); // @output [3,1,2]
+}
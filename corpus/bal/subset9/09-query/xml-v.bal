@@ -0,0 +1,32 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+public function main() {
+    string[] names = ["a", "b"];
+    xml items = from var n in names select xml `<item>${n}</item>`;
+    io:println(items); // @output <item>a</item><item>b</item>
+    xml mixed = xml `<x/>text<y/>`;
+    xml elements = from var item in mixed where item is xml:Element select item;
+    io:println(elements); // @output <x/><y/>
+    int count = 0;
+    from var _ in mixed do {
+        count += 1;
+    };
+    io:println(count); // @output 3
+}
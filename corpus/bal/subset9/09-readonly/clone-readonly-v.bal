@@ -0,0 +1,36 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+public function main() {
+    int[] a = [1, 2];
+    int[] & readonly b = a.cloneReadOnly();
+    a.push(3);
+    io:println(a); // @output [1,2,3]
+    io:println(b); // @output [1,2]
+    io:println(b is readonly); // @output true
+    io:println(b.cloneReadOnly() === b); // @output true
+
+    map<int[]> m = {x: [1], y: [2, 3]};
+    map<int[]> & readonly n = m.cloneReadOnly();
+    io:println(n); // @output {"x":[1],"y":[2,3]}
+    io:println(n["y"] is readonly); // @output true
+
+    string s = "abc";
+    io:println(s.cloneReadOnly()); // @output abc
+}
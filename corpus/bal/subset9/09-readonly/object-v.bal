@@ -0,0 +1,49 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+type Shape readonly & object {
+    function area() returns int;
+};
+
+readonly class Rectangle {
+    *Shape;
+
+    int width;
+    int[] sides;
+
+    function init(int width, int height) {
+        self.width = width;
+        self.sides = [width, height, width, height];
+    }
+
+    function area() returns int {
+        return self.width * self.sides[1];
+    }
+}
+
+public function main() {
+    Rectangle r = new (2, 3);
+    Shape s = r;
+    io:println(s.area()); // @output 6
+    io:println(r is readonly); // @output true
+    io:println(r.sides is readonly); // @output true
+
+    any a = r;
+    io:println(a is Shape); // @output true
+}
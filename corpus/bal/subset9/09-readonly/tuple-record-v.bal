@@ -0,0 +1,44 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+type Point record {|
+    int x;
+    int y;
+|};
+
+type Path readonly & record {|
+    string name;
+    Point[] points;
+|};
+
+public function main() {
+    readonly & [int, string] pair = [1, "one"];
+    io:println(pair); // @output [1,"one"]
+    io:println(pair is readonly); // @output true
+
+    Path path = {name: "p", points: [{x: 1, y: 2}, {x: 3, y: 4}]};
+    io:println(path.points is readonly); // @output true
+    io:println(path.points[0] is readonly); // @output true
+    io:println(path.points[1].y); // @output 4
+
+    Point[] & readonly points = [{x: 5, y: 6}];
+    any a = points;
+    io:println(a is Point[] & readonly); // @output true
+    io:println(a is Path); // @output false
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+import ballerina/lang.regexp;
+
+function printSpan(regexp:Span? span) {
+    if span is () {
+        io:println("no match");
+    } else {
+        io:println(span.startIndex, "-", span.endIndex, " ", span.substring());
+    }
+}
+
+function printGroups(regexp:Groups? groups) {
+    if groups is () {
+        io:println("no match");
+        return;
+    }
+    foreach regexp:Span? span in groups {
+        printSpan(span);
+    }
+}
+
+public function main() {
+    string:RegExp r = re `([a-z]+)(\d+)?`;
+    printSpan(r.find("12abc34 xyz")); // @output 2-7 abc34
+    printSpan(r.find("12abc34 xyz", 5)); // @output 8-11 xyz
+    printSpan(r.find("123")); // @output no match
+    printSpan(re `é+`.find("aééb")); // @output 1-3 éé
+
+    printGroups(r.findGroups("12abc34 xyz", 3));
+    // @output 3-7 bc34
+    // @output 3-5 bc
+    // @output 5-7 34
+    printGroups(regexp:findGroups(r, "xyz"));
+    // @output 0-3 xyz
+    // @output 0-3 xyz
+    // @output no match
+
+    foreach regexp:Span span in r.findAll("ab1 cd ef22") {
+        printSpan(span);
+    }
+    // @output 0-3 ab1
+    // @output 4-6 cd
+    // @output 7-11 ef22
+    io:println(r.findAllGroups("a1 b").length()); // @output 2
+
+    printSpan(re `b+`.matchAt("abbbc", 1)); // @output 1-4 bbb
+    printSpan(re `b+`.matchAt("abbbc")); // @output no match
+    printGroups(re `(a)|(b)`.matchGroupsAt("ab", 1));
+    // @output 1-2 b
+    // @output no match
+    // @output 1-2 b
+    printGroups(re `(\d+)-(\d+)`.fullMatchGroups("10-20"));
+    // @output 0-5 10-20
+    // @output 0-2 10
+    // @output 3-5 20
+    printGroups(re `\d+`.fullMatchGroups("10-20")); // @output no match
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+public function main() {
+    string word = "cat";
+    int digit = 7;
+    string:RegExp wordRe = re `${word}s?`;
+    io:println(wordRe); // @output (?:cat)s?
+    io:println(wordRe.isFullMatch("cats")); // @output true
+    string:RegExp repeated = re `(${wordRe})+${digit}`;
+    io:println(repeated); // @output ((?:(?:cat)s?))+(?:7)
+    io:println(repeated.isFullMatch("catcats7")); // @output true
+    io:println(repeated.isFullMatch("cat")); // @output false
+    string name = "ab";
+    string:RegExp names = re `${name}+`;
+    io:println(names.isFullMatch("abab")); // @output true
+    io:println(names.isFullMatch("abb")); // @output false
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+public function main() {
+    string:RegExp r = re `[a-z]+\d*`;
+    io:println(r); // @output [a-z]+\d*
+    io:println(r.isFullMatch("abc123")); // @output true
+    io:println(r.isFullMatch("ABC")); // @output false
+    io:println(re `(?i:abc)`.isFullMatch("AbC")); // @output true
+    io:println(re `\p{Lu}\P{Lu}+`.isFullMatch("Ébc")); // @output true
+    io:println(re `\u{1F600}+`.isFullMatch("😀😀")); // @output true
+    io:println(re `[^\-\]]{2,3}`.isFullMatch("ab")); // @output true
+    io:println(re `[^\-\]]{2,3}`.isFullMatch("a-")); // @output false
+    io:println(re `\.\*\(\)`.isFullMatch(".*()")); // @output true
+    io:println(re `a|b|`.isFullMatch("")); // @output true
+}
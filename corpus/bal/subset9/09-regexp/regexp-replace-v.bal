@@ -0,0 +1,47 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+import ballerina/lang.regexp;
+
+public function main() {
+    string:RegExp digits = re `\d+`;
+    io:println(digits.replace("a1b22c333", "#")); // @output a#b22c333
+    io:println(digits.replace("a1b22c333", "#", 2)); // @output a1b#c333
+    io:println(digits.replace("abc", "#")); // @output abc
+    io:println(digits.replaceAll("a1b22c333", "#")); // @output a#b#c#
+    io:println(digits.replaceAll("a1b22c333", "$0")); // @output a$0b$0c$0
+    io:println(re `(\w+)@(\w+)`.replaceAll("x@y, p@q", isolated function(regexp:Groups groups) returns string {
+        regexp:Span? user = groups[1];
+        regexp:Span? host = groups[2];
+        if user is () || host is () {
+            return "";
+        }
+        return host.substring() + "." + user.substring();
+    })); // @output y.x, q.p
+
+    io:println(re `,\s*`.split("a, b,c,   d")); // @output ["a","b","c","d"]
+    io:println(re `,`.split("abc")); // @output ["abc"]
+
+    string:RegExp|error fromStr = regexp:fromString("a+b");
+    if fromStr is string:RegExp {
+        io:println(fromStr.isFullMatch("aaab")); // @output true
+    }
+    string:RegExp|error invalid = regexp:fromString("a{2,1}");
+    if invalid is error {
+        io:println(invalid.message()); // @output invalid regular expression: invalid quantifier: minimum 2 is greater than maximum 1
+    }
+}
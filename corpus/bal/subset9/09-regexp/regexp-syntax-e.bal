@@ -0,0 +1,25 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+public function main() {
+    string:RegExp _ = re `[z-a]`; // @error
+    string:RegExp _ = re `(abc`; // @error
+    string:RegExp _ = re `a{3,1}`; // @error
+    string:RegExp _ = re `*a`; // @error
+    string:RegExp _ = re `\q`; // @error
+    string:RegExp _ = re `\p{Foo}`; // @error
+    string:RegExp _ = re `(?ii:a)`; // @error
+}
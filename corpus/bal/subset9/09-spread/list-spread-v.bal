@@ -0,0 +1,34 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+public function main() {
+    int[] xs = [1, 2];
+    int[] ys = [...xs, 3, ...xs];
+    io:println(ys); // @output [1,2,3,1,2]
+    [int, int, string] t = [...[4, 5], "a"];
+    io:println(t); // @output [4,5,"a"]
+    [string, int...] u = ["x", ...xs];
+    io:println(u); // @output ["x",1,2]
+    var v = [0, ...t];
+    io:println(v); // @output [0,4,5,"a"]
+    byte[] bs = [...[1, 2], 3];
+    io:println(bs); // @output [1,2,3]
+    int[] empty = [];
+    io:println([...empty]); // @output []
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+type Person record {|
+    string name;
+    int age;
+    string city = "Colombo";
+|};
+
+public function main() {
+    map<json> base = {a: 1, b: "x"};
+    map<json> m = {...base, extra: 1};
+    io:println(m); // @output {"a":1,"b":"x","extra":1}
+    record {| string name; int age; |} p = {name: "Ann", age: 30};
+    Person q = {...p};
+    io:println(q); // @output {"name":"Ann","age":30,"city":"Colombo"}
+    Person r = {city: "Kandy", ...p};
+    io:println(r); // @output {"city":"Kandy","name":"Ann","age":30}
+    var s = {...p, id: 7};
+    io:println(s.id); // @output 7
+    io:println(s.name); // @output Ann
+    base["a"] = 2;
+    io:println(m["a"]); // @output 1
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+function sum(int... xs) returns int {
+    int total = 0;
+    foreach int x in xs {
+        total += x;
+    }
+    return total;
+}
+
+function pair(int a, string b) returns string {
+    return string `${a}${b}`;
+}
+
+public function main() {
+    int[] xs = [1, 2, 3];
+    function (int...) returns int f = sum;
+    io:println(f(...xs)); // @output 6
+    io:println(f(4, ...xs)); // @output 10
+    function (int, string) returns string g = pair;
+    [int, string] p = [2, "b"];
+    io:println(g(...p)); // @output 2b
+}
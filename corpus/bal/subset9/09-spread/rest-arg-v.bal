@@ -0,0 +1,52 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+function sum(int... xs) returns int {
+    int total = 0;
+    foreach int x in xs {
+        total += x;
+    }
+    return total;
+}
+
+function greet(string greeting, string name = "World", string... rest) returns string {
+    string s = greeting + " " + name;
+    foreach string r in rest {
+        s += " " + r;
+    }
+    return s;
+}
+
+function pair(int a, string b) returns string {
+    return string `${a}${b}`;
+}
+
+public function main() {
+    int[] xs = [1, 2, 3];
+    io:println(sum(...xs)); // @output 6
+    io:println(sum(10, ...xs)); // @output 16
+    io:println(sum(...[4, 5])); // @output 9
+    [string] t1 = ["Hi"];
+    io:println(greet(...t1)); // @output Hi World
+    [string, string, string...] t2 = ["Hi", "Bob", "x", "y"];
+    io:println(greet(...t2)); // @output Hi Bob x y
+    io:println(greet("Yo", ...["Ann", "z"])); // @output Yo Ann z
+    [int, string] p = [1, "a"];
+    io:println(pair(...p)); // @output 1a
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+type Employee record {|
+    readonly int id;
+    string name;
+    int salary;
+|};
+
+type EmployeeTable table<Employee> key(id);
+
+public function main() {
+    EmployeeTable employees = table [
+        {id: 1, name: "Alice", salary: 100},
+        {id: 2, name: "Bob", salary: 200}
+    ];
+    io:println(employees.length()); // @output 2
+    io:println(employees); // @output [{"id":1,"name":"Alice","salary":100},{"id":2,"name":"Bob","salary":200}]
+
+    table<map<int>> counts = table [{a: 1}, {a: 2}, {a: 1}];
+    io:println(counts.length()); // @output 3
+
+    table<Employee> key(id) empty = table [];
+    io:println(empty.length()); // @output 0
+}
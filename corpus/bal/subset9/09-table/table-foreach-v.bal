@@ -0,0 +1,47 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+type Student record {|
+    readonly int id;
+    string name;
+    int score;
+|};
+
+public function main() {
+    table<Student> key(id) students = table [
+        {id: 3, name: "Carol", score: 70},
+        {id: 1, name: "Alice", score: 90},
+        {id: 2, name: "Bob", score: 80}
+    ];
+    int total = 0;
+    foreach Student s in students {
+        io:println(s.name);
+        total += s.score;
+    }
+    // @output Carol
+    // @output Alice
+    // @output Bob
+    io:println(total); // @output 240
+
+    students.put({id: 1, name: "Alicia", score: 95});
+    foreach var s in students {
+        io:println(s.id, " ", s.name);
+    }
+    // @output 3 Carol
+    // @output 1 Alicia
+    // @output 2 Bob
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+type Row record {|
+    readonly int id;
+    string? name?;
+    int[] tags;
+|};
+
+public function main() {
+    table<Row> key(name) _ = table []; // @error
+    table<Row> key(tags) _ = table []; // @error
+    table<Row> key(id, id) _ = table []; // @error
+    table<int> _ = table []; // @error
+    table<Row> t = table [{id: 1, tags: []}];
+    Row? _ = t[1]; // @error
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+type Point record {|
+    readonly int x;
+    readonly int y;
+    string label;
+|};
+
+type Person record {|
+    readonly string name;
+    int age;
+|};
+
+public function main() {
+    table<Person> key(name) people = table [
+        {name: "Alice", age: 30},
+        {name: "Bob", age: 40}
+    ];
+    Person? alice = people["Alice"];
+    io:println(alice); // @output {"name":"Alice","age":30}
+    io:println(people["Carol"] is ()); // @output true
+
+    table<Point> key(x, y) points = table [
+        {x: 1, y: 2, label: "a"},
+        {x: 2, y: 1, label: "b"}
+    ];
+    Point? p = points[2, 1];
+    if p is Point {
+        io:println(p.label); // @output b
+    }
+    io:println(points.hasKey([1, 2])); // @output true
+    io:println(points.hasKey([1, 1])); // @output false
+}
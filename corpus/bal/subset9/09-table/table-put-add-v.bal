@@ -0,0 +1,47 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+type Item record {|
+    readonly string code;
+    int qty;
+|};
+
+public function main() {
+    table<Item> key(code) items = table [
+        {code: "a", qty: 1},
+        {code: "b", qty: 2}
+    ];
+    items.add({code: "c", qty: 3});
+    items.put({code: "a", qty: 10});
+    io:println(items); // @output [{"code":"a","qty":10},{"code":"b","qty":2},{"code":"c","qty":3}]
+
+    Item removed = items.remove("b");
+    io:println(removed.qty); // @output 2
+    io:println(items.length()); // @output 2
+
+    Item[] rows = items.toArray();
+    io:println(rows.length()); // @output 2
+
+    items.removeAll();
+    io:println(items.length()); // @output 0
+
+    table<map<int>> logs = table [];
+    logs.add({n: 1});
+    logs.add({n: 1});
+    logs.put({n: 1});
+    io:println(logs.length()); // @output 3
+}
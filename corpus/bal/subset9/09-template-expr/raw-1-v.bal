@@ -0,0 +1,29 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+public function main() {
+    int x = 3;
+    object:RawTemplate t = `a${x}b${"c"}`;
+    io:println(t.strings); // @output ["a","b",""]
+    io:println(t.insertions); // @output [3,"c"]
+    object:RawTemplate e = ``;
+    io:println(e.strings.length(), " ", e.insertions.length()); // @output 1 0
+    any a = `x${1}`;
+    io:println(a is object:RawTemplate); // @output true
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+type IntTemplate object {
+    *object:RawTemplate;
+    public int[] insertions;
+};
+
+type PairTemplate object {
+    *object:RawTemplate;
+    public [int, string] insertions;
+};
+
+function sum(IntTemplate t) returns int {
+    int total = 0;
+    foreach int i in t.insertions {
+        total += i;
+    }
+    return total;
+}
+
+function describe(PairTemplate t) returns string {
+    string s = t.insertions[1];
+    foreach int _ in 0 ..< t.insertions[0] {
+        s += "!";
+    }
+    return s;
+}
+
+public function main() {
+    io:println(sum(`${1} + ${2} + ${3}`)); // @output 6
+    io:println(describe(`${3} ${"hey"}`)); // @output hey!!!
+}
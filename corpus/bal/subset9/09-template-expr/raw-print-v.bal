@@ -0,0 +1,30 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+import ballerina/io;
+
+public function main() {
+    int x = 3;
+    string name = "world";
+    io:println(`hello ${name}, x = ${x}`); // @output hello world, x = 3
+    io:println(`outer ${`inner ${x}`} end`); // @output outer inner 3 end
+    io:println(`list ${[1, 2]} nil ${()}|`); // @output list [1,2] nil |
+    io:println(`e: ${error("bad")}`); // @output e: error("bad")
+    io:print(`a${1}b`, " ", `c`, "\n"); // @output a1b c
+    io:PrintableRawTemplate t = `v=${x}`;
+    io:println(t, " ", t.strings); // @output v=3 ["v=",""]
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+int calls = 0;
+
+function attempt(int failures) returns int|error {
+    calls += 1;
+    if calls <= failures {
+        return error("failed");
+    }
+    return calls;
+}
+
+function retryDefault(int failures) returns int|error {
+    calls = 0;
+    int result = 0;
+    retry {
+        result = check attempt(failures);
+    }
+    return result;
+}
+
+function retryCount(int count, int failures) returns int|error {
+    calls = 0;
+    int result = 0;
+    retry (count) {
+        result = check attempt(failures);
+    }
+    return result;
+}
+
+public function main() {
+    io:println(retryDefault(0)); // @output 1
+    io:println(retryDefault(3)); // @output 4
+    io:println(retryDefault(4)); // @output error("failed")
+    io:println(retryCount(1, 1)); // @output 2
+    io:println(retryCount(1, 2)); // @output error("failed")
+    io:println(retryCount(0, 1)); // @output error("failed")
+    io:println(calls); // @output 1
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+
+class SkipRetryable {
+    int remaining;
+
+    function init(int remaining) {
+        self.remaining = remaining;
+    }
+
+    function shouldRetry(error e) returns boolean {
+        io:println("retry? ", e.message());
+        if e.message() == "fatal" || self.remaining == 0 {
+            return false;
+        }
+        self.remaining -= 1;
+        return true;
+    }
+}
+
+function validate(string message) returns error? {
+    if message != "" {
+        return error(message);
+    }
+}
+
+function run(string[] errors) returns error? {
+    int i = 0;
+    retry<SkipRetryable>(5) {
+        string message = errors[i];
+        i += 1;
+        check validate(message);
+        io:println("done after ", i);
+    }
+}
+
+public function main() {
+    error? r = run(["busy", "busy", ""]);
+    // @output retry? busy
+    // @output retry? busy
+    // @output done after 3
+    io:println(r is ()); // @output true
+    r = run(["busy", "fatal", ""]);
+    // @output retry? busy
+    // @output retry? fatal
+    io:println(r); // @output error("fatal")
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+import ballerina/lang.'transaction as trx;
+
+int calls = 0;
+
+transactional function update() returns int|error {
+    trx:onCommit(isolated function(trx:Info info) {
+        io:println("commit ", info.retryNumber);
+    });
+    trx:onRollback(isolated function(trx:Info info, error? cause, boolean willRetry) {
+        io:println("rollback ", info.retryNumber, " ", willRetry, " ", info.prevAttempt is trx:Info, " ", cause is error);
+    });
+    calls += 1;
+    if calls < 3 {
+        return error("conflict");
+    }
+    return calls;
+}
+
+public function main() returns error? {
+    retry transaction {
+        int value = check update();
+        io:println("value ", value);
+        check commit;
+    }
+    // @output rollback 0 true false true
+    // @output rollback 1 true true true
+    // @output value 3
+    // @output commit 2
+    io:println(transactional); // @output false
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+import ballerina/lang.'transaction as trx;
+
+transactional function onEnd() {
+    trx:onCommit(isolated function(trx:Info info) {
+        io:println("committed ", info.retryNumber);
+    });
+    trx:onRollback(isolated function(trx:Info info, error? cause, boolean willRetry) {
+        if cause is error {
+            io:println("rolled back: ", cause.message(), " ", willRetry);
+        } else {
+            io:println("rolled back ", willRetry, " ", info.retryNumber);
+        }
+    });
+}
+
+function early(boolean exit) returns int|error {
+    transaction {
+        onEnd();
+        if exit {
+            return 1;
+        }
+        check commit;
+    }
+    return 2;
+}
+
+function validate() returns error? {
+    return error("failed");
+}
+
+function failing() returns error? {
+    transaction {
+        onEnd();
+        check validate();
+        check commit;
+    }
+}
+
+public function main() returns error? {
+    transaction {
+        onEnd();
+        io:println(transactional); // @output true
+        check commit;
+        // @output committed 0
+        io:println(transactional); // @output false
+    }
+    transaction {
+        onEnd();
+        trx:setData("payload");
+        io:println(trx:getData()); // @output payload
+        rollback;
+        // @output rolled back false 0
+    }
+    transaction {
+        onEnd();
+        rollback error("cancelled");
+        // @output rolled back: cancelled false
+    }
+    transaction {
+        onEnd();
+        trx:setRollbackOnly(error("invalid"));
+        io:println(trx:getRollbackOnly()); // @output true
+        error? result = commit;
+        // @output rolled back: invalid false
+        if result is error {
+            io:println(result.message()); // @output transaction is marked as rollback only
+        }
+    }
+    io:println(early(true)); // @output rolled back false 0
+    // @output 1
+    io:println(early(false)); // @output committed 0
+    // @output 2
+    io:println(failing()); // @output rolled back: failed false
+    // @output error("failed")
+}
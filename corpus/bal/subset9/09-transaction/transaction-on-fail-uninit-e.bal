@@ -0,0 +1,41 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+function commitOnFailure(boolean fails) returns int {
+    int x;
+    transaction {
+        if fails {
+            fail error("failed");
+        }
+        x = 1;
+        check commit;
+    } on fail {
+        return x; // @error
+    }
+    return x;
+}
+
+function retryOnFailure(int|error v) returns int {
+    int x;
+    retry {
+        x = check v;
+    } on fail error e {
+        return x + e.message().length(); // @error
+    }
+    return x;
+}
+
+public function main() {
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+import ballerina/io;
+import ballerina/lang.'transaction as trx;
+
+int calls = 0;
+
+transactional function update(int failures) returns int|error {
+    trx:onRollback(isolated function(trx:Info info, error? cause, boolean willRetry) {
+        io:println("rollback ", info.retryNumber, " ", willRetry, " ", cause is error);
+    });
+    calls += 1;
+    if calls <= failures {
+        return error("conflict " + calls.toString());
+    }
+    return calls;
+}
+
+function transfer(boolean fails) returns string {
+    string state = "started";
+    transaction {
+        if fails {
+            fail error("insufficient funds");
+        }
+        check commit;
+        state = "committed";
+    } on fail error e {
+        state = "failed: " + e.message();
+    }
+    return state;
+}
+
+function retryUpdate(int failures) returns int {
+    calls = 0;
+    retry(2) transaction {
+        int value = check update(failures);
+        check commit;
+        return value;
+    } on fail var e {
+        io:println("gave up: ", e.message());
+        return -1;
+    }
+}
+
+function retryBlock(int failures) returns string {
+    int attempts = 0;
+    retry(1) {
+        attempts += 1;
+        if attempts <= failures {
+            fail error("attempt " + attempts.toString());
+        }
+    } on fail error e {
+        return e.message();
+    }
+    return "done after " + attempts.toString();
+}
+
+public function main() {
+    io:println(transfer(false)); // @output committed
+    io:println(transfer(true)); // @output failed: insufficient funds
+    io:println(transactional); // @output false
+    io:println(retryUpdate(1));
+    // @output rollback 0 true true
+    // @output 2
+    io:println(retryUpdate(5));
+    // @output rollback 0 true true
+    // @output rollback 1 true true
+    // @output rollback 2 false true
+    // @output gave up: conflict 3
+    // @output -1
+    io:println(retryBlock(1)); // @output done after 2
+    io:println(retryBlock(2)); // @output attempt 2
+}
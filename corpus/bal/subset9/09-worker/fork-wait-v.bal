@@ -0,0 +1,33 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+public function main() {
+    fork {
+        worker f1 returns int {
+            return 40;
+        }
+        worker f2 returns string {
+            return "two";
+        }
+    }
+    record {| int f1; string f2; |} results = wait {f1, f2};
+    io:println(results.f1 + 2); // @output 42
+    io:println(results.f2); // @output two
+    int n = wait f1;
+    io:println(n); // @output 40
+}
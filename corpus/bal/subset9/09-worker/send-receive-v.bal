@@ -0,0 +1,36 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+public function main() {
+    int base = 10;
+    worker w1 {
+        int a = base + 1;
+        a -> w2;
+        int b = <- w2;
+        b -> function;
+    }
+    worker w2 {
+        int a = <- w1;
+        (a * 2) -> w1;
+        "done" -> function;
+    }
+    int b = <- w1;
+    string s = <- w2;
+    io:println(b); // @output 22
+    io:println(s); // @output done
+}
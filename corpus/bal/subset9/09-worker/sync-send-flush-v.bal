@@ -0,0 +1,54 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+function produce() returns int[] {
+    worker producer returns error? {
+        foreach int i in 1 ... 3 {
+            check validate(i);
+        }
+        error? r = 1 ->> consumer;
+        r = 2 ->> consumer;
+        3 -> consumer;
+        error? f = flush consumer;
+        io:println(r is (), " ", f is ()); // @output true true
+    }
+    worker consumer returns int[] {
+        int[] received = [];
+        int|error a = <- producer;
+        int|error b = <- producer;
+        int|error c = <- producer;
+        if a is int && b is int && c is int {
+            received = [a, b, c];
+        }
+        return received;
+    }
+    int[] result = [];
+    result.push(1);
+    return result;
+}
+
+function validate(int i) returns error? {
+    if i > 5 {
+        return error("too large");
+    }
+}
+
+public function main() {
+    int[] r = produce();
+    io:println(r.length()); // @output 1
+}
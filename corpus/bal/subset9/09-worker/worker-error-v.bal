@@ -0,0 +1,36 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+function fetch(int id) returns int|error {
+    worker fetcher returns error? {
+        if id < 0 {
+            return error("invalid id");
+        }
+        (id * 100) -> function;
+    }
+    int|error value = <- fetcher;
+    return value;
+}
+
+public function main() {
+    io:println(fetch(2)); // @output 200
+    int|error e = fetch(-1);
+    if e is error {
+        io:println(e.message()); // @output invalid id
+    }
+}
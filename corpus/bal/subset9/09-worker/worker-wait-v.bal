@@ -0,0 +1,42 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+function compute(int n) returns int {
+    worker doubler returns int {
+        return n * 2;
+    }
+    worker namer returns string {
+        return "n" + n.toString();
+    }
+    int doubled = wait doubler;
+    record {| int doubled; string name; |} r = wait {doubled: doubler, name: namer};
+    return doubled + r.doubled + r.name.length();
+}
+
+function failing() returns int|error {
+    worker w returns int|error {
+        return error("worker failed");
+    }
+    return wait w;
+}
+
+public function main() {
+    io:println(compute(5)); // @output 22
+    int|error r = failing();
+    io:println(r is error); // @output true
+}
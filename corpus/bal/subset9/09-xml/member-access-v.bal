@@ -0,0 +1,33 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+public function main() {
+    xml x = xml `<a/>text<!--c--><b><c/></b>`;
+    io:println(x[0]); // @output <a/>
+    io:println(x[1]); // @output text
+    io:println(x[3]); // @output <b><c/></b>
+    io:println(x[4]); // @output
+    io:println(x.length()); // @output 4
+    io:println(x.get(2)); // @output <!--c-->
+    io:println(x.elements()); // @output <a/><b><c/></b>
+    io:println(x.children()); // @output <c/>
+    io:println((xml `<a>x<b>y</b>z</a>`).data()); // @output xyz
+    xml:Element e = xml `<p>q</p>`;
+    io:println(e.getName()); // @output p
+    io:println(e.getChildren()); // @output q
+}
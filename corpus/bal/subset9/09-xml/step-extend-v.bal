@@ -0,0 +1,26 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+public function main() {
+    xml x = xml `<a><b>1</b><c>2</c><b>3<d>4</d></b></a><a><b>5</b></a>`;
+    io:println(x/<b>[0]); // @output <b>1</b><b>5</b>
+    io:println(x/<b>[1]); // @output <b>3<d>4</d></b>
+    io:println(x/*.<c>); // @output <c>2</c>
+    io:println(x/<b>.children()); // @output 13<d>4</d>5
+    io:println(x/*.<b>[1].elements()); // @output <b>3<d>4</d></b>
+}
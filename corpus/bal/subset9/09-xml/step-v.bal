@@ -0,0 +1,33 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+xmlns "http://example.com/p" as p;
+
+public function main() {
+    xml x = xml `<a><b>1</b><p:c>2</p:c><!--note--><b>3<d>4</d></b>tail</a>`;
+    io:println(x/*); // @output <b>1</b><p:c>2</p:c><!--note--><b>3<d>4</d></b>tail
+    io:println(x/<b>); // @output <b>1</b><b>3<d>4</d></b>
+    io:println(x/<p:c>); // @output <p:c>2</p:c>
+    io:println(x/<p:*>); // @output <p:c>2</p:c>
+    io:println(x/<b|p:c>); // @output <b>1</b><p:c>2</p:c><b>3<d>4</d></b>
+    io:println(x/<*>); // @output <b>1</b><p:c>2</p:c><b>3<d>4</d></b>
+    io:println(x/**/<d>); // @output <d>4</d>
+    io:println(x/**/<*>); // @output <b>1</b><p:c>2</p:c><b>3<d>4</d></b><d>4</d>
+    io:println(x/<e>); // @output
+    io:println((x/<b>) is xml<xml:Element>); // @output true
+}
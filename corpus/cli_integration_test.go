@@ -106,6 +106,42 @@ func TestBalRunCorpus(t *testing.T) {
 	}
 }
 
+// TestBalRunConfigArgs runs the configurable project with `-Ckey=value` program
+// arguments and the BAL_CONFIG_FILES and BAL_CONFIG_DATA environment variables, and
+// checks the output against corpus/cli/output/run/config-args/<case>.txtar.
+func TestBalRunConfigArgs(t *testing.T) {
+	if runtime.GOOS == "js" || runtime.GOARCH == "wasm" {
+		t.Skip("skipping CLI integration test on WASM (js/wasm)")
+	}
+	balBin, repoRoot, coverDir := integrationTestBalCLI(t, false)
+	projectDir := filepath.Join(repoRoot, "corpus", "cli", "testdata", "run", "projects", "configurable")
+	configFile := filepath.Join(repoRoot, "corpus", "cli", "testdata", "run", "config-files", "unknown-key.toml")
+
+	tests := []struct {
+		name string
+		args []string
+		env  map[string]string
+		file string
+	}{
+		{"override", []string{"-Cport=9090", "-Cgreeting=hi"}, nil, "override.txtar"},
+		{"unknown_key", []string{"-Cport=9090", "-Cunknown=1"}, nil, "unknown-key.txtar"},
+		// A value in Config.toml takes precedence over one in BAL_CONFIG_DATA.
+		{"config_data", nil, map[string]string{"BAL_CONFIG_DATA": "greeting = \"data\"\nport = 7070"}, "config-data.txtar"},
+		{"config_data_unknown_key", nil, map[string]string{"BAL_CONFIG_DATA": "[testorg.cli_configurable.db]\nhosts = \"x\""}, "config-data-unknown-key.txtar"},
+		{"config_file_unknown_key", nil, map[string]string{"BAL_CONFIG_FILES": configFile}, "config-file-unknown-key.txtar"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			assertBalCommandMatchesTxtarFragmentsForBinary(t, balBin, repoRoot, coverDir,
+				append([]string{"run", projectDir, "--"}, tt.args...),
+				"run", "config-args", tt.file)
+		})
+	}
+}
+
 // TestBalRunWorkspaceCorpus tests the workspace branch in runBallerina (cli/cmd/run.go:206-229).
 // It covers three behaviours:
 //  1. workspace_root_rejected  — running the workspace root directly is rejected.
@@ -173,6 +173,66 @@ func TestDependentlyTypedAlias(t *testing.T) {
 	runExtern(t, fileCase("dependent-alias-v"), testharness.NewTestPal(), externs)
 }
 
+func TestDependentlyTypedRestAndDefaults(t *testing.T) {
+	const org, mod = "$anon", "dependently-typed-rest-v"
+	externs := []testharness.ExternRegistration{
+		{Org: org, Module: mod, FuncName: "sum", Impl: func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+			total, ok := args[0].(int64)
+			if !ok {
+				return nil, fmt.Errorf("expected int argument, got %T", args[0])
+			}
+			td, ok := args[1].(*values.TypeDesc)
+			if !ok {
+				return nil, fmt.Errorf("expected typedesc argument, got %T", args[1])
+			}
+			for _, arg := range args[2:] {
+				val, ok := arg.(int64)
+				if !ok {
+					return nil, fmt.Errorf("expected int rest argument, got %T", arg)
+				}
+				total += val
+			}
+			if semtypes.IsSubtype(ctx.TypeCtx, td.Type, semtypes.STRING) {
+				return fmt.Sprintf("%d", total), nil
+			}
+			return total, nil
+		}},
+		{Org: org, Module: mod, FuncName: "either", Impl: func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+			first, ok := args[0].(bool)
+			if !ok {
+				return nil, fmt.Errorf("expected boolean argument, got %T", args[0])
+			}
+			for _, arg := range args[1:] {
+				if _, ok := arg.(*values.TypeDesc); !ok {
+					return nil, fmt.Errorf("expected typedesc argument, got %T", arg)
+				}
+			}
+			if first {
+				return int64(1), nil
+			}
+			if semtypes.IsSubtype(ctx.TypeCtx, args[2].(*values.TypeDesc).Type, semtypes.CHAR) {
+				return "o", nil
+			}
+			return "one", nil
+		}},
+		{Org: org, Module: mod, FuncName: "convert", Impl: func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+			val, ok := args[0].(int64)
+			if !ok {
+				return nil, fmt.Errorf("expected int argument, got %T", args[0])
+			}
+			td, ok := args[1].(*values.TypeDesc)
+			if !ok {
+				return nil, fmt.Errorf("expected typedesc argument, got %T", args[1])
+			}
+			if semtypes.IsSubtype(ctx.TypeCtx, td.Type, semtypes.STRING) {
+				return fmt.Sprintf("%d", val), nil
+			}
+			return val, nil
+		}},
+	}
+	runExtern(t, fileCase("dependently-typed-rest-v"), testharness.NewTestPal(), externs)
+}
+
 func TestDependentlyTypedIncludedRecordParam(t *testing.T) {
 	externs := []testharness.ExternRegistration{{
 		Org: "$anon", Module: "dependently-typed-incl-record-v", FuncName: "shift",
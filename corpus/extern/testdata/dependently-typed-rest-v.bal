@@ -0,0 +1,47 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+import ballerina/io;
+
+public function main() {
+    int a = sum(1, int, 2, 3);
+    io:println(a); // @output 6
+    string b = sum(1);
+    io:println(b); // @output 1
+    int[] xs = [4, 5];
+    string c = sum(1, string, ...xs);
+    io:println(c); // @output 10
+
+    // Each inferred typedesc is inferred independently from the contextually expected type.
+    int|string d = either(true);
+    io:println(d); // @output 1
+    int|string e = either(false);
+    io:println(e); // @output one
+    byte|string:Char f = either(false, byte, string:Char);
+    io:println(f); // @output o
+
+    // A typedesc param with a default expression that is not inferred.
+    anydata g = convert(3);
+    io:println(g); // @output 3
+    string h = convert(3, string);
+    io:println(h); // @output 3
+}
+
+function sum(int val, typedesc<int|string> retTy = <>, int... rest) returns retTy = external;
+
+function either(boolean first, typedesc<int> a = <>, typedesc<string> b = <>) returns a|b = external;
+
+function convert(int val, typedesc<anydata> t = int) returns t = external;
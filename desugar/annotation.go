@@ -0,0 +1,185 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package desugar
+
+import (
+	"maps"
+	"slices"
+
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/tools/diagnostics"
+)
+
+// hoistAnnotatedTypedescs creates a hidden module variable holding the typedesc of each type definition and class
+// with annotations visible at runtime. The variable is initialized with the annotation values in the init function,
+// and every reference to the type name as an expression is replaced by a reference to it (see walkTypeNameRef).
+func hoistAnnotatedTypedescs(pkgCtx *packageContext, pkg *ast.BLangPackage) {
+	for i := range pkg.TypeDefinitions {
+		typeDef := &pkg.TypeDefinitions[i]
+		hoistAnnotatedTypedesc(pkgCtx, pkg, typeDef.Symbol(), typeDef.GetAnnotationAttachments(), model.AttachPointType, typeDef.GetPosition())
+	}
+	for i := range pkg.ClassDefinitions {
+		classDef := &pkg.ClassDefinitions[i]
+		hoistAnnotatedTypedesc(pkgCtx, pkg, classDef.Symbol(), classDef.GetAnnotationAttachments(), model.AttachPointType|model.AttachPointClass, classDef.GetPosition())
+	}
+}
+
+func hoistAnnotatedTypedesc(pkgCtx *packageContext, pkg *ast.BLangPackage, typeSym model.SymbolRef, attachments []ast.AnnotationAttachmentNode, points model.AttachPoints, pos diagnostics.Location) {
+	annots := createAnnotationsMapping(pkgCtx, attachments, points, pos)
+	if annots == nil {
+		return
+	}
+	constraint := pkgCtx.symbolType(typeSym)
+	ty := semtypes.TypedescContaining(pkgCtx.typeEnv(), constraint)
+	td := &ast.BLangTypedescExpr{Constraint: constraint}
+	td.SetDeterminedType(ty)
+	td.SetPosition(pos)
+	initExpr := createPkgLangInternalInvocation(pkgCtx, "annotatedTypedesc", ty, []ast.BLangExpression{td, annots}, pos)
+
+	name := pkgCtx.nextDesugarSymbolName()
+	sym := model.NewValueSymbol(name, false, false, false)
+	symRef := pkgCtx.addModuleSymbol(name, &sym)
+	pkgCtx.setSymbolType(symRef, ty)
+
+	ident := &ast.BLangIdentifier{Value: name}
+	ident.SetDeterminedType(semtypes.NEVER)
+	ident.SetPosition(pos)
+
+	gv := &ast.BLangSimpleVariable{Name: ident}
+	gv.SetDeterminedType(ty)
+	gv.SetSymbol(symRef)
+	gv.SetInitialExpression(initExpr)
+	gv.SetPosition(pos)
+	pkg.AddGlobalVariable(gv)
+	pkgCtx.annotatedTypedescs[typeSym] = symRef
+}
+
+// walkTypeNameRef replaces a type name used as an expression by its typedesc. The typedesc of a type with
+// annotations is the value of the variable created by hoistAnnotatedTypedescs.
+func walkTypeNameRef(cx *functionContext, expr *ast.BLangSimpleVarRef) desugaredNode[ast.BLangActionOrExpression] {
+	if global, ok := cx.pkgCtx.annotatedTypedescs[expr.Symbol()]; ok {
+		ident := &ast.BLangIdentifier{Value: cx.pkgCtx.getSymbol(global).Name()}
+		ident.SetDeterminedType(semtypes.NEVER)
+		ident.SetPosition(expr.GetPosition())
+		ref := &ast.BLangSimpleVarRef{VariableName: ident}
+		ref.SetSymbol(global)
+		ref.SetDeterminedType(expr.GetDeterminedType())
+		ref.SetPosition(expr.GetPosition())
+		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: ref}
+	}
+	td := &ast.BLangTypedescExpr{Constraint: cx.symbolType(expr.Symbol())}
+	td.SetDeterminedType(expr.GetDeterminedType())
+	td.SetPosition(expr.GetPosition())
+	return desugaredNode[ast.BLangActionOrExpression]{replacementNode: td}
+}
+
+// createFunctionAnnotationStmts creates the init function statements that record the annotations of the functions
+// and class methods of pkg with the runtime.
+func createFunctionAnnotationStmts(pkgCtx *packageContext, pkg *ast.BLangPackage) []ast.StatementNode {
+	var stmts []ast.StatementNode
+	add := func(name string, fn *ast.BLangFunction, points model.AttachPoints) {
+		pos := fn.GetPosition()
+		annots := createAnnotationsMapping(pkgCtx, fn.GetAnnotationAttachments(), points, pos)
+		if annots == nil {
+			return
+		}
+		pkgID := pkgCtx.pkg.PackageID
+		args := []ast.BLangExpression{
+			createStringLiteral(pkgID.OrgName.Value(), pos),
+			createStringLiteral(pkgID.Name.Value(), pos),
+			createStringLiteral(name, pos),
+			annots,
+		}
+		inv := createPkgLangInternalInvocation(pkgCtx, "annotateFunction", semtypes.NIL, args, pos)
+		stmts = append(stmts, createExpressionStmt(inv, pos))
+	}
+	for i := range pkg.Functions {
+		fn := &pkg.Functions[i]
+		add(fn.Name.Value, fn, model.AttachPointFunction)
+	}
+	for i := range pkg.ClassDefinitions {
+		classDef := &pkg.ClassDefinitions[i]
+		for _, name := range slices.Sorted(maps.Keys(classDef.Methods)) {
+			method := classDef.Methods[name]
+			points := model.AttachPointFunction | model.AttachPointObjectMethod
+			if method.IsRemote() {
+				points |= model.AttachPointServiceRemoteMethod
+			}
+			add(classDef.Name.Value+"."+name, method, points)
+		}
+	}
+	return stmts
+}
+
+// createAnnotationsMapping creates a mapping constructor from the key of each annotation in attachments to its value.
+// An annotation whose type is an array gets the list of the values of all its attachments. Annotations attached with
+// a `source` attach point are not visible at runtime and are left out. It returns nil if there is nothing to record.
+func createAnnotationsMapping(pkgCtx *packageContext, attachments []ast.AnnotationAttachmentNode, points model.AttachPoints, pos diagnostics.Location) *ast.BLangMappingConstructorExpr {
+	var keys []model.SymbolRef
+	valuesByKey := make(map[model.SymbolRef][]ast.BLangExpression)
+	for _, each := range attachments {
+		attachment := each.(*ast.BLangAnnotationAttachment)
+		ref := attachment.Symbol()
+		sym := pkgCtx.getSymbol(ref).(*model.AnnotationSymbol)
+		if sym.AttachPoints()&points&^sym.SourceOnlyAttachPoints() == 0 {
+			continue
+		}
+		var value ast.BLangExpression
+		if attachment.Expr != nil {
+			value = attachment.Expr.(ast.BLangExpression)
+		} else {
+			value = createBoolLiteral(true, attachment.GetPosition())
+		}
+		if _, ok := valuesByKey[ref]; !ok {
+			keys = append(keys, ref)
+		}
+		valuesByKey[ref] = append(valuesByKey[ref], value)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	cx := pkgCtx.typeCtx()
+	fields := make([]ast.MappingField, len(keys))
+	for i, ref := range keys {
+		annotTy := pkgCtx.symbolType(ref)
+		exprs := valuesByKey[ref]
+		value := exprs[0]
+		if semtypes.IsSubtype(cx, annotTy, semtypes.LIST) {
+			list := &ast.BLangListConstructorExpr{Exprs: exprs, AtomicType: *semtypes.ToListAtomicType(cx, annotTy)}
+			list.SetDeterminedType(annotTy)
+			list.SetPosition(pos)
+			value = list
+		}
+		key := &ast.BLangMappingKey{Expr: createStringLiteral(annotationKey(pkgCtx, ref), pos), Kind: ast.MappingKeyStringLiteral}
+		key.SetPosition(pos)
+		field := &ast.BLangMappingKeyValueField{Key: key, ValueExpr: value}
+		field.SetPosition(pos)
+		fields[i] = field
+	}
+	mapping := &ast.BLangMappingConstructorExpr{Fields: fields}
+	mapping.SetDeterminedType(semtypes.MAPPING)
+	mapping.SetPosition(pos)
+	return mapping
+}
+
+// annotationKey returns the key `org/module:name` identifying an annotation at runtime.
+func annotationKey(pkgCtx *packageContext, ref model.SymbolRef) string {
+	pkg := pkgCtx.compilerCtx.SymbolPackage(ref)
+	return pkg.Organization + "/" + pkg.Package + ":" + pkgCtx.getSymbol(ref).Name()
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package desugar
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/tools/diagnostics"
+)
+
+// Destructuring with list, mapping and error binding patterns is lowered to simple variable definitions and
+// assignments. The value matched by each binding pattern is held in a variable, and each member of the binding
+// pattern is bound to the corresponding member of that value:
+//
+//	var [a, {b}] = e;
+//
+// becomes
+//
+//	var $pattern$0 = e;
+//	var a = $pattern$0[0];
+//	var $pattern$1 = $pattern$0[1];
+//	var b = $pattern$1["b"];
+//
+// Wildcard binding patterns bind nothing and are dropped.
+
+func walkBindingPatternVarDef(cx *functionContext, stmt ast.VariableDefinitionNode) desugaredNode[ast.StatementNode] {
+	variable := stmt.GetVariable()
+	varDefs := bindingPatternVarDefs(cx, variable, variable.GetInitialExpression().(ast.BLangExpression), stmt.GetPosition())
+	var stmts []ast.StatementNode
+	for _, varDef := range varDefs {
+		result := walkSimpleVariableDef(cx, varDef)
+		stmts = append(stmts, result.initStmts...)
+		stmts = append(stmts, result.replacementNode)
+	}
+	return desugaredNode[ast.StatementNode]{
+		initStmts:       stmts[:len(stmts)-1],
+		replacementNode: stmts[len(stmts)-1],
+	}
+}
+
+func walkBindingPatternAssignment(cx *functionContext, stmt *ast.BLangAssignment) desugaredNode[ast.StatementNode] {
+	pos := stmt.GetPosition()
+	result := walkExpression(cx, stmt.Expr)
+	stmts := result.initStmts
+	valueDef, valueRef := assignToLocal(cx, result.replacementNode.(ast.BLangExpression), pos)
+	stmts = append(stmts, valueDef)
+	stmts = append(stmts, destructureVarRef(cx, stmt.VarRef, valueRef, pos)...)
+	return desugaredNode[ast.StatementNode]{
+		initStmts:       stmts[:len(stmts)-1],
+		replacementNode: stmts[len(stmts)-1],
+	}
+}
+
+// expandBindingPatternVarDefs replaces each variable declaration with a list, mapping or error binding pattern
+// by the simple variable definitions it is lowered to.
+func expandBindingPatternVarDefs(cx *functionContext, decls []ast.VariableDefinitionNode) []ast.VariableDefinitionNode {
+	var result []ast.VariableDefinitionNode
+	for _, decl := range decls {
+		if _, ok := decl.(*ast.BLangSimpleVariableDef); ok {
+			result = append(result, decl)
+			continue
+		}
+		variable := decl.GetVariable()
+		for _, varDef := range bindingPatternVarDefs(cx, variable, variable.GetInitialExpression().(ast.BLangExpression), decl.GetPosition()) {
+			result = append(result, varDef)
+		}
+	}
+	return result
+}
+
+// bindingPatternVarDefs defines the variable holding the value of expr matched by the binding pattern of
+// variable, followed by the variables declared by the binding pattern.
+func bindingPatternVarDefs(cx *functionContext, variable ast.VariableNode, expr ast.BLangExpression, pos diagnostics.Location) []*ast.BLangSimpleVariableDef {
+	if simple, ok := variable.(*ast.BLangSimpleVariable); ok {
+		if ast.IsWildcardVariable(simple) {
+			return nil
+		}
+		simple.Expr = expr
+		return []*ast.BLangSimpleVariableDef{createBindingVarDef(simple, pos)}
+	}
+	symbol := variable.Symbol()
+	ty := variable.(ast.BLangNode).GetDeterminedType()
+	name := &ast.BLangIdentifier{Value: cx.getSymbol(symbol).Name()}
+	name.SetDeterminedType(semtypes.NEVER)
+	patternVar := &ast.BLangSimpleVariable{Name: name}
+	patternVar.SetSymbol(symbol)
+	patternVar.SetDeterminedType(ty)
+	patternVar.SetTypeNode(variable.(interface{ TypeNode() ast.BType }).TypeNode())
+	patternVar.Expr = expr
+	varDefs := []*ast.BLangSimpleVariableDef{createBindingVarDef(patternVar, pos)}
+	source := createVarRef(name, symbol, ty)
+	setPositionIfMissing(source, pos)
+
+	bind := func(member ast.VariableNode, value ast.BLangExpression) {
+		varDefs = append(varDefs, bindingPatternVarDefs(cx, member, value, pos)...)
+	}
+	switch v := variable.(type) {
+	case *ast.BLangTupleVariable:
+		for i, member := range v.Members {
+			bind(member, createListMemberAccess(source, i, member.(ast.BLangNode).GetDeterminedType(), pos))
+		}
+		if v.RestVariable != nil {
+			bind(v.RestVariable, createListRestInvocation(cx, source, len(v.Members), v.RestVariable.GetDeterminedType(), pos))
+		}
+	case *ast.BLangRecordVariable:
+		keys := make([]string, len(v.Fields))
+		for i, field := range v.Fields {
+			keys[i] = field.Key.Value
+			bind(field.Value, createMappingMemberAccess(source, keys[i], field.Value.(ast.BLangNode).GetDeterminedType(), pos))
+		}
+		if v.RestVariable != nil {
+			bind(v.RestVariable, createMappingRestInvocation(cx, source, keys, v.RestVariable.GetDeterminedType(), pos))
+		}
+	case *ast.BLangErrorVariable:
+		if v.Message != nil {
+			bind(v.Message, createLangInternalInvocation(cx, "errorMessage", semtypes.STRING, []ast.BLangExpression{source}, pos))
+		}
+		if v.Cause != nil {
+			bind(v.Cause, createLangInternalInvocation(cx, "errorCause", v.Cause.(ast.BLangNode).GetDeterminedType(), []ast.BLangExpression{source}, pos))
+		}
+		if len(v.Detail) == 0 && v.RestDetail == nil {
+			break
+		}
+		detailDef, detail := assignToLocal(cx, createLangInternalInvocation(cx, "errorDetail", semtypes.MAPPING, []ast.BLangExpression{source}, pos), pos)
+		varDefs = append(varDefs, detailDef.(*ast.BLangSimpleVariableDef))
+		keys := make([]string, len(v.Detail))
+		for i, entry := range v.Detail {
+			keys[i] = entry.Key.Value
+			bind(entry.Value, createMappingMemberAccess(detail, keys[i], entry.Value.(ast.BLangNode).GetDeterminedType(), pos))
+		}
+		if v.RestDetail != nil {
+			bind(v.RestDetail, createMappingRestInvocation(cx, detail, keys, v.RestDetail.GetDeterminedType(), pos))
+		}
+	}
+	return varDefs
+}
+
+func createBindingVarDef(variable *ast.BLangSimpleVariable, pos diagnostics.Location) *ast.BLangSimpleVariableDef {
+	varDef := &ast.BLangSimpleVariableDef{Var: variable}
+	varDef.SetDeterminedType(semtypes.NEVER)
+	setPositionIfMissing(varDef, pos)
+	return varDef
+}
+
+// destructureVarRef assigns the members of the value referenced by source to the variables referenced by the
+// binding pattern ref of a destructuring assignment.
+func destructureVarRef(cx *functionContext, ref ast.LExpr, source *ast.BLangSimpleVarRef, pos diagnostics.Location) []ast.StatementNode {
+	var stmts []ast.StatementNode
+	assign := func(target ast.LExpr, value ast.BLangExpression) {
+		switch target := target.(type) {
+		case *ast.BLangWildCardBindingPattern:
+		case *ast.BLangTupleVarRef, *ast.BLangRecordVarRef, *ast.BLangErrorVarRef:
+			valueDef, valueRef := assignToLocal(cx, value, pos)
+			stmts = append(stmts, valueDef)
+			stmts = append(stmts, destructureVarRef(cx, target, valueRef, pos)...)
+		default:
+			assignment := &ast.BLangAssignment{VarRef: target, Expr: value}
+			assignment.SetDeterminedType(semtypes.NEVER)
+			setPositionIfMissing(assignment, pos)
+			stmts = append(stmts, assignment)
+		}
+	}
+	switch r := ref.(type) {
+	case *ast.BLangTupleVarRef:
+		for i, member := range r.Expressions {
+			assign(member, createListMemberAccess(source, i, member.GetDeterminedType(), pos))
+		}
+		if r.RestParam != nil {
+			assign(r.RestParam, createListRestInvocation(cx, source, len(r.Expressions), r.RestParam.GetDeterminedType(), pos))
+		}
+	case *ast.BLangRecordVarRef:
+		keys := make([]string, len(r.RecordRefFields))
+		for i, field := range r.RecordRefFields {
+			keys[i] = field.VariableName.Value
+			assign(field.VariableReference, createMappingMemberAccess(source, keys[i], field.VariableReference.GetDeterminedType(), pos))
+		}
+		if r.RestParam != nil {
+			assign(r.RestParam, createMappingRestInvocation(cx, source, keys, r.RestParam.GetDeterminedType(), pos))
+		}
+	case *ast.BLangErrorVarRef:
+		if r.Message != nil {
+			assign(r.Message, createLangInternalInvocation(cx, "errorMessage", semtypes.STRING, []ast.BLangExpression{source}, pos))
+		}
+		if r.Cause != nil {
+			causeTy := semtypes.Union(semtypes.ERROR, semtypes.NIL)
+			assign(r.Cause, createLangInternalInvocation(cx, "errorCause", causeTy, []ast.BLangExpression{source}, pos))
+		}
+		if len(r.Detail) == 0 && r.RestVar == nil {
+			break
+		}
+		detailDef, detail := assignToLocal(cx, createLangInternalInvocation(cx, "errorDetail", semtypes.MAPPING, []ast.BLangExpression{source}, pos), pos)
+		stmts = append(stmts, detailDef)
+		keys := make([]string, len(r.Detail))
+		for i := range r.Detail {
+			entry := &r.Detail[i]
+			keys[i] = entry.Name.Value
+			assign(entry.Expr.(ast.LExpr), createMappingMemberAccess(detail, keys[i], entry.GetDeterminedType(), pos))
+		}
+		if r.RestVar != nil {
+			assign(r.RestVar, createMappingRestInvocation(cx, detail, keys, r.RestVar.GetDeterminedType(), pos))
+		}
+	}
+	return stmts
+}
+
+func createListMemberAccess(source ast.BLangExpression, index int, ty semtypes.SemType, pos diagnostics.Location) *ast.BLangIndexBasedAccess {
+	access := &ast.BLangIndexBasedAccess{IndexExpr: createIntLiteral(int64(index))}
+	access.Expr = source
+	access.SetDeterminedType(ty)
+	setPositionIfMissing(access, pos)
+	return access
+}
+
+func createMappingMemberAccess(source ast.BLangExpression, key string, ty semtypes.SemType, pos diagnostics.Location) *ast.BLangIndexBasedAccess {
+	access := &ast.BLangIndexBasedAccess{IndexExpr: createStringLiteral(key, pos)}
+	access.Expr = source
+	access.SetDeterminedType(ty)
+	setPositionIfMissing(access, pos)
+	return access
+}
+
+func createListRestInvocation(cx *functionContext, source ast.BLangExpression, start int, ty semtypes.SemType, pos diagnostics.Location) *ast.BLangInvocation {
+	args := []ast.BLangExpression{source, createIntLiteral(int64(start)), createTypedescExpr(cx, ty, pos)}
+	return createLangInternalInvocation(cx, "listRest", ty, args, pos)
+}
+
+func createMappingRestInvocation(cx *functionContext, source ast.BLangExpression, excludedKeys []string, ty semtypes.SemType, pos diagnostics.Location) *ast.BLangInvocation {
+	keyExprs := make([]ast.BLangExpression, len(excludedKeys))
+	for i, key := range excludedKeys {
+		keyExprs[i] = createStringLiteral(key, pos)
+	}
+	ld := semtypes.NewListDefinition()
+	keysTy := ld.DefineListTypeWrappedWithEnvSemType(cx.typeEnv(), semtypes.STRING)
+	keys := &ast.BLangListConstructorExpr{Exprs: keyExprs, AtomicType: *semtypes.ToListAtomicType(cx.typeCtx(), keysTy)}
+	keys.SetDeterminedType(keysTy)
+	setPositionIfMissing(keys, pos)
+	args := []ast.BLangExpression{source, keys, createTypedescExpr(cx, ty, pos)}
+	return createLangInternalInvocation(cx, "mappingRest", ty, args, pos)
+}
+
+func createTypedescExpr(cx *functionContext, ty semtypes.SemType, pos diagnostics.Location) *ast.BLangTypedescExpr {
+	td := &ast.BLangTypedescExpr{Constraint: ty}
+	td.SetDeterminedType(semtypes.TypedescContaining(cx.typeEnv(), ty))
+	setPositionIfMissing(td, pos)
+	return td
+}
@@ -274,6 +274,10 @@ func prettyPrintFallback(p *ast.PrettyPrinter, node ast.BLangNode) {
 		p.StartNode()
 		p.PrintString("service-init")
 		p.EndNode()
+	case *ast.BLangTypedescExpr:
+		p.StartNode()
+		p.PrintString("typedesc-expr")
+		p.EndNode()
 	default:
 		panic(fmt.Sprintf("desugar pretty printer: unsupported node %T", n))
 	}
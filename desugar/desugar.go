@@ -19,6 +19,8 @@ package desugar
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 
 	"ballerina-lang-go/ast"
@@ -47,6 +49,8 @@ type packageContext struct {
 	addedImplicitImports map[string]bool
 	desugarSymbolCounter int
 	typeContext          semtypes.Context
+	// annotatedTypedescs maps each type with runtime annotations to the module variable holding its typedesc.
+	annotatedTypedescs map[model.SymbolRef]model.SymbolRef
 }
 
 var _ desugarContext = &packageContext{}
@@ -58,6 +62,7 @@ func newPackageContext(compilerCtx *context.CompilerContext, pkg *ast.BLangPacka
 		importedSymbols:      importedSymbols,
 		addedImplicitImports: make(map[string]bool),
 		typeContext:          semtypes.ContextFrom(compilerCtx.GetTypeEnv()),
+		annotatedTypedescs:   make(map[model.SymbolRef]model.SymbolRef),
 	}
 }
 
@@ -135,6 +140,16 @@ type functionContext struct {
 	// typeContext is the non-shared type context for this function. It is owned
 	// by the goroutine desugaring this function and must not be shared.
 	typeContext semtypes.Context
+	// workerGroup is the hidden variable holding the worker group of the function.
+	// Workers inherit it from the function that declares them.
+	workerGroup    model.SymbolRef
+	hasWorkerGroup bool
+	// onFailDepth is the number of desugared on-fail clauses enclosing the
+	// statement being desugared. A failing `check` within one of them fails to
+	// the clause instead of returning from the function.
+	onFailDepth int
+	// inTransaction is set while desugaring the body of a transaction statement.
+	inTransaction bool
 }
 
 // typeCtx returns the function-local type context, lazily creating it on first
@@ -301,12 +316,14 @@ type moduleInitNode struct {
 	name *ast.BLangIdentifier
 }
 
-func collectModuleInitNodes(pkg *ast.BLangPackage) []moduleInitNode {
+func collectModuleInitNodes(pkgCtx *packageContext, pkg *ast.BLangPackage) []moduleInitNode {
 	nodes := make([]moduleInitNode, 0, len(pkg.GlobalVars)+len(pkg.Constants))
 	for i := range pkg.GlobalVars {
 		gv := &pkg.GlobalVars[i]
 		var expr ast.BLangExpression
-		if gv.Expr != nil {
+		if gv.IsConfigurable() {
+			expr = configurableInitExpr(pkgCtx, gv)
+		} else if gv.Expr != nil {
 			expr = gv.Expr.(ast.BLangExpression)
 		}
 		nodes = append(nodes, moduleInitNode{
@@ -330,6 +347,86 @@ func collectModuleInitNodes(pkg *ast.BLangPackage) []moduleInitNode {
 	return nodes
 }
 
+// configurableInitExpr builds the initializer of a configurable variable, which is the configured value when there is
+// one and the default value otherwise. A required variable has no default; the runtime reports a missing value before
+// any module is initialized.
+func configurableInitExpr(pkgCtx *packageContext, gv *ast.BLangSimpleVariable) ast.BLangExpression {
+	pos := gv.GetPosition()
+	pkgID := pkgCtx.pkg.PackageID
+	keyArgs := func() []ast.BLangExpression {
+		return []ast.BLangExpression{
+			createStringLiteral(pkgID.OrgName.Value(), pos),
+			createStringLiteral(pkgID.Name.Value(), pos),
+			createStringLiteral(gv.Name.Value, pos),
+		}
+	}
+	ty := pkgCtx.symbolType(gv.Symbol())
+	valueArgs := append(keyArgs(), configurableFieldOrderExpr(pkgCtx, gv))
+	value := createPkgLangInternalInvocation(pkgCtx, "configurableValue", ty, valueArgs, pos)
+	if gv.Expr == nil {
+		return value
+	}
+	hasValue := createPkgLangInternalInvocation(pkgCtx, "hasConfigurableValue", semtypes.BOOLEAN, keyArgs(), pos)
+	ternary := &ast.BLangTernaryExpr{Expr: hasValue, ThenExpr: value, ElseExpr: gv.Expr.(ast.BLangExpression)}
+	ternary.SetDeterminedType(ty)
+	ternary.SetPosition(pos)
+	return ternary
+}
+
+// configurableFieldOrderExpr builds a list of the names of the fields of the record types the type of gv refers to, in
+// declaration order. Mapping values read from configuration are created with their fields in this order.
+func configurableFieldOrderExpr(pkgCtx *packageContext, gv *ast.BLangSimpleVariable) ast.BLangExpression {
+	var names []string
+	seen := make(map[string]bool)
+	visited := make(map[model.SymbolRef]bool)
+	var walk func(td ast.TypeDescriptor)
+	walk = func(td ast.TypeDescriptor) {
+		switch td := td.(type) {
+		case *ast.BLangUserDefinedType:
+			ref := td.Symbol()
+			if visited[ref] {
+				return
+			}
+			visited[ref] = true
+			for i := range pkgCtx.pkg.TypeDefinitions {
+				if defn := &pkgCtx.pkg.TypeDefinitions[i]; defn.Symbol() == ref {
+					walk(defn.GetTypeData().TypeDescriptor)
+				}
+			}
+		case *ast.BLangRecordType:
+			for name, field := range td.Fields() {
+				if !seen[name] {
+					seen[name] = true
+					names = append(names, name)
+				}
+				walk(field.Type)
+			}
+		case *ast.BLangArrayType:
+			walk(td.Elemtype.TypeDescriptor)
+		case *ast.BLangUnionTypeNode:
+			walk(td.Lhs().TypeDescriptor)
+			walk(td.Rhs().TypeDescriptor)
+		case *ast.BLangIntersectionTypeNode:
+			walk(td.Lhs().TypeDescriptor)
+			walk(td.Rhs().TypeDescriptor)
+		}
+	}
+	if typeNode := gv.TypeNode(); typeNode != nil {
+		walk(typeNode)
+	}
+	pos := gv.GetPosition()
+	elements := make([]ast.BLangExpression, len(names))
+	for i, name := range names {
+		elements[i] = createStringLiteral(name, pos)
+	}
+	ld := semtypes.NewListDefinition()
+	listTy := ld.DefineListTypeWrappedWithEnvSemType(pkgCtx.typeEnv(), semtypes.STRING)
+	list := &ast.BLangListConstructorExpr{Exprs: elements, AtomicType: *semtypes.ToListAtomicType(pkgCtx.typeCtx(), listTy)}
+	list.SetDeterminedType(listTy)
+	list.SetPosition(pos)
+	return list
+}
+
 // We desugar by moving all these to the init function, so they should no longer be there
 func clearModuleInitExprs(pkg *ast.BLangPackage) {
 	for i := range pkg.GlobalVars {
@@ -345,12 +442,17 @@ func clearModuleInitExprs(pkg *ast.BLangPackage) {
 type dependencyVisitor struct {
 	compilerCtx *context.CompilerContext
 	nodeSet     map[model.SymbolRef]int // symbol → index into nodes slice
+	typedescs   map[model.SymbolRef]model.SymbolRef
 	deps        map[int]struct{}
 }
 
 // mark current node depnds on on the given
 func (v *dependencyVisitor) depends(ref model.SymbolRef) {
 	unnarrowed := v.compilerCtx.UnnarrowedSymbol(ref)
+	if global, ok := v.typedescs[unnarrowed]; ok {
+		// A reference to an annotated type evaluates to the variable holding its typedesc.
+		unnarrowed = global
+	}
 	if idx, ok := v.nodeSet[unnarrowed]; ok {
 		v.deps[idx] = struct{}{}
 	}
@@ -368,7 +470,7 @@ func (v *dependencyVisitor) Visit(node ast.BLangNode) ast.Visitor {
 
 func (v *dependencyVisitor) VisitTypeData(_ *ast.TypeData) ast.Visitor { return v }
 
-func toplogicallySortInits(compilerCtx *context.CompilerContext, nodes []moduleInitNode) ([]int, bool) {
+func toplogicallySortInits(compilerCtx *context.CompilerContext, nodes []moduleInitNode, typedescs map[model.SymbolRef]model.SymbolRef) ([]int, bool) {
 	nodeSet := make(map[model.SymbolRef]int, len(nodes))
 	for i, n := range nodes {
 		nodeSet[n.sym] = i
@@ -382,6 +484,7 @@ func toplogicallySortInits(compilerCtx *context.CompilerContext, nodes []moduleI
 		v := &dependencyVisitor{
 			compilerCtx: compilerCtx,
 			nodeSet:     nodeSet,
+			typedescs:   typedescs,
 			deps:        make(map[int]struct{}),
 		}
 		ast.Walk(v, nodes[i].expr)
@@ -486,15 +589,16 @@ func serviceInitResultType(pkgCtx *packageContext, svc *ast.BLangService, svcTy
 }
 
 func desugarInitFn(pkgCtx *packageContext, compilerCtx *context.CompilerContext, pkg *ast.BLangPackage) {
-	nodes := collectModuleInitNodes(pkg)
-	order, ok := toplogicallySortInits(compilerCtx, nodes)
+	nodes := collectModuleInitNodes(pkgCtx, pkg)
+	order, ok := toplogicallySortInits(compilerCtx, nodes, pkgCtx.annotatedTypedescs)
 	if !ok {
 		pkgCtx.internalError("module init dependency ordering failed")
 		return
 	}
 
 	// we need init if the package has any module level constant/variable with init expressions or services
-	needInit := pkg.InitFunction != nil || len(pkg.Services) > 0
+	annotationStmts := createFunctionAnnotationStmts(pkgCtx, pkg)
+	needInit := pkg.InitFunction != nil || len(pkg.Services) > 0 || len(annotationStmts) > 0
 	if !needInit {
 		for _, n := range nodes {
 			if n.expr != nil {
@@ -541,6 +645,7 @@ func desugarInitFn(pkgCtx *packageContext, compilerCtx *context.CompilerContext,
 		}
 	}
 	clearModuleInitExprs(pkg)
+	initStmts = append(initStmts, annotationStmts...)
 
 	for i := range pkg.Services {
 		initStmts = append(initStmts, buildServiceInitStmts(pkgCtx, pkg, &pkg.Services[i])...)
@@ -1069,25 +1174,57 @@ func createDefaultValueFunction(name string, defaultExpr ast.BLangExpression) *a
 	return fn
 }
 
-type desugaredRecordFieldResult struct {
+type desugaredDefaultFnResult struct {
 	fn     *ast.BLangFunction
 	symRef model.SymbolRef
 }
 
 type desugaredTypeDescResult struct {
-	recordFields []desugaredRecordFieldResult
+	defaultFns []desugaredDefaultFnResult
 }
 
 func desugarTypeDesc(ctx desugarContext, typeDesc ast.BType, parentScope model.Scope) desugaredTypeDescResult {
 	switch td := typeDesc.(type) {
 	case *ast.BLangRecordType:
 		return desugarRecordTypeDesc(ctx, td, parentScope)
+	case *ast.BLangFunctionType:
+		return desugarFunctionTypeDesc(ctx, td, parentScope)
+	case *ast.BLangObjectType:
+		return desugarObjectTypeDesc(ctx, td, parentScope)
 	}
 	return desugaredTypeDescResult{}
 }
 
+func desugarObjectTypeDesc(ctx desugarContext, objType *ast.BLangObjectType, parentScope model.Scope) desugaredTypeDescResult {
+	var fns []desugaredDefaultFnResult
+	for _, m := range slices.SortedFunc(objType.Members(), func(a, b ast.ObjectMember) int {
+		return strings.Compare(a.Name(), b.Name())
+	}) {
+		if method, ok := m.(*ast.BMethodDecl); ok {
+			fns = append(fns, desugarFunctionTypeDesc(ctx, &method.BLangFunctionType, parentScope).defaultFns...)
+		}
+	}
+	return desugaredTypeDescResult{defaultFns: fns}
+}
+
+func desugarFunctionTypeDesc(ctx desugarContext, fnType *ast.BLangFunctionType, parentScope model.Scope) desugaredTypeDescResult {
+	var fns []desugaredDefaultFnResult
+	for i := range fnType.RequiredParams {
+		param := &fnType.RequiredParams[i]
+		if param.InitExpr == nil {
+			continue
+		}
+		symRef := param.DefaultFnRef
+		fn := createDefaultValueFunction(ctx.getSymbol(symRef).Name(), param.InitExpr)
+		fn.SetSymbol(symRef)
+		fn.SetScope(ctx.newFunctionScope(parentScope))
+		fns = append(fns, desugaredDefaultFnResult{fn: fn, symRef: symRef})
+	}
+	return desugaredTypeDescResult{defaultFns: fns}
+}
+
 func desugarRecordTypeDesc(ctx desugarContext, recType *ast.BLangRecordType, parentScope model.Scope) desugaredTypeDescResult {
-	var fields []desugaredRecordFieldResult
+	var fields []desugaredDefaultFnResult
 	for _, field := range recType.FieldPtrs() {
 		if field.DefaultExpr == nil {
 			continue
@@ -1098,10 +1235,10 @@ func desugarRecordTypeDesc(ctx desugarContext, recType *ast.BLangRecordType, par
 		fn.SetSymbol(symRef)
 		fn.SetScope(fnScope)
 
-		fields = append(fields, desugaredRecordFieldResult{fn: fn, symRef: symRef})
+		fields = append(fields, desugaredDefaultFnResult{fn: fn, symRef: symRef})
 
 	}
-	return desugaredTypeDescResult{recordFields: fields}
+	return desugaredTypeDescResult{defaultFns: fields}
 }
 
 func desugarTopLevelTypeDescs(cx *packageContext, pkg *ast.BLangPackage) {
@@ -1113,21 +1250,35 @@ func desugarTopLevelTypeDescs(cx *packageContext, pkg *ast.BLangPackage) {
 			return
 		}
 		result := desugarTypeDesc(cx, typeDesc, nil)
-		for _, rf := range result.recordFields {
-			pkg.Functions = append(pkg.Functions, *rf.fn)
+		for _, df := range result.defaultFns {
+			pkg.Functions = append(pkg.Functions, *df.fn)
 		}
 	}
 }
 
-func desugarFunctionParamDefaults(ctx desugarContext, fn *ast.BLangFunction) []*ast.BLangFunction {
+// defaultableParamOwner is a function or resource method whose parameters can have defaults.
+type defaultableParamOwner interface {
+	Symbol() model.SymbolRef
+	Scope() model.Scope
+	RequiredParameters() []ast.BLangSimpleVariable
+}
+
+func desugarFunctionParamDefaults(ctx desugarContext, fn defaultableParamOwner) []*ast.BLangFunction {
+	return desugarParamDefaults(ctx, fn, true)
+}
+
+// desugarParamDefaults creates the functions providing the defaults of the parameters of fn. When allDefaultable is
+// false, parameters with defaults that semantic analysis found no function could provide are skipped.
+func desugarParamDefaults(ctx desugarContext, fn defaultableParamOwner, allDefaultable bool) []*ast.BLangFunction {
 	fnSym := ctx.getSymbol(fn.Symbol()).(model.FunctionSymbol)
 	defaultableParams := fnSym.DefaultableParams()
+	requiredParams := fn.RequiredParameters()
 	var results []*ast.BLangFunction
-	for j := range fn.RequiredParams {
-		param := &fn.RequiredParams[j]
+	for j := range requiredParams {
+		param := &requiredParams[j]
 		dp, ok := defaultableParams.Get(j)
 		if !ok {
-			if param.IsDefaultableParam() {
+			if allDefaultable && param.IsDefaultableParam() {
 				ctx.internalError("defaultable param info missing for parameter marked as defaultable")
 			}
 			continue
@@ -1144,8 +1295,8 @@ func desugarFunctionParamDefaults(ctx desugarContext, fn *ast.BLangFunction) []*
 		defaultFn.SetScope(fnScope)
 
 		symbolMapping := make(map[model.SymbolRef]model.SymbolRef)
-		for k := range fn.RequiredParams[:j] {
-			precedingParam := fn.RequiredParams[k]
+		for k := range requiredParams[:j] {
+			precedingParam := requiredParams[k]
 			paramName := precedingParam.Name.Value
 			paramTy := ctx.symbolType(precedingParam.Symbol())
 			newParam := newSimpleVariable(paramName, paramTy)
@@ -1189,6 +1340,11 @@ func desugarClassMethodDefaults(pkgCtx *packageContext, pkg *ast.BLangPackage) {
 	for i := range pkg.ClassDefinitions {
 		classDef := &pkg.ClassDefinitions[i]
 		desugarObjectMethodDefaults(classDef.InitFunction, classDef.Methods)
+		for _, rm := range classDef.ResourceMethods {
+			for _, fn := range desugarFunctionParamDefaults(pkgCtx, rm) {
+				pkg.Functions = append(pkg.Functions, *fn)
+			}
+		}
 	}
 	for i := range pkg.Services {
 		svc := &pkg.Services[i]
@@ -1196,6 +1352,43 @@ func desugarClassMethodDefaults(pkgCtx *packageContext, pkg *ast.BLangPackage) {
 	}
 }
 
+// desugarLambdaFunctionDefaults creates the functions providing the defaults of the parameters of anonymous
+// functions. Like those of module level functions, they are module level functions taking the preceding parameters.
+func desugarLambdaFunctionDefaults(pkgCtx *packageContext, pkg *ast.BLangPackage) {
+	finder := &lambdaFinder{seen: make(map[model.SymbolRef]bool)}
+	ast.Walk(finder, pkg)
+	for _, lambda := range finder.lambdas {
+		// Defaults referring to local variables of the enclosing functions have no function providing them.
+		for _, fn := range desugarParamDefaults(pkgCtx, lambda.Function, false) {
+			pkg.Functions = append(pkg.Functions, *fn)
+		}
+	}
+}
+
+// lambdaFinder collects the anonymous functions with parameters of a package. An expression shared by a type
+// definition and the function created for its default is seen twice, so lambdas are deduplicated by symbol.
+type lambdaFinder struct {
+	seen    map[model.SymbolRef]bool
+	lambdas []*ast.BLangLambdaFunction
+}
+
+var _ ast.Visitor = &lambdaFinder{}
+
+func (f *lambdaFinder) Visit(node ast.BLangNode) ast.Visitor {
+	if node == nil {
+		return nil
+	}
+	if lambda, ok := node.(*ast.BLangLambdaFunction); ok && len(lambda.Function.RequiredParams) > 0 && !f.seen[lambda.Function.Symbol()] {
+		f.seen[lambda.Function.Symbol()] = true
+		f.lambdas = append(f.lambdas, lambda)
+	}
+	return f
+}
+
+func (f *lambdaFinder) VisitTypeData(_ *ast.TypeData) ast.Visitor {
+	return nil
+}
+
 type symbolRemapper struct {
 	mapping map[model.SymbolRef]model.SymbolRef
 }
@@ -1248,6 +1441,7 @@ func DesugarPackage(compilerCtx *context.CompilerContext, pkg *ast.BLangPackage,
 
 	desugarTopLevelFunctionDefaults(pkgCtx, pkg)
 	desugarClassMethodDefaults(pkgCtx, pkg)
+	desugarLambdaFunctionDefaults(pkgCtx, pkg)
 
 	desugarObjectDefinitionConcurrently := func(class *ast.BLangClassDefinition) {
 		wg.Go(func() {
@@ -1288,6 +1482,7 @@ func DesugarPackage(compilerCtx *context.CompilerContext, pkg *ast.BLangPackage,
 	}
 
 	hoistInlineServiceListeners(pkgCtx, pkg)
+	hoistAnnotatedTypedescs(pkgCtx, pkg)
 	desugarInitFn(pkgCtx, compilerCtx, pkg)
 
 	// Desugar all functions after desugarInitFn has created any lifecycle hooks.
@@ -1424,13 +1619,19 @@ func desugarFunction(pkgCtx *packageContext, fn *ast.BLangFunction) *ast.BLangFu
 	cx := &functionContext{
 		pkgCtx: pkgCtx,
 	}
+	return desugarFunctionInContext(cx, fn)
+}
 
+func desugarFunctionInContext(cx *functionContext, fn *ast.BLangFunction) *ast.BLangFunction {
 	// Push function scope
 	cx.pushScope(fn.Scope())
 	defer cx.popScope()
 
 	switch body := fn.Body.(type) {
 	case *ast.BLangBlockFunctionBody:
+		if !fn.IsWorker() && declaresWorkers(body) {
+			addWorkerGroupVar(cx, body)
+		}
 		walkBlockFunctionBody(cx, body)
 	case *ast.BLangExprFunctionBody:
 		if body.Expr != nil {
@@ -1460,15 +1661,19 @@ func convertExprBodyToBlockBody(
 	returnStmt := &ast.BLangReturn{
 		Expr: result.replacementNode,
 	}
+	returnStmt.SetDeterminedType(semtypes.NEVER)
+	setPositionIfMissing(returnStmt, exprBody.Expr.GetPosition())
 
 	// Build block with init statements + return
 	stmts := make([]ast.StatementNode, 0, len(result.initStmts)+1)
 	stmts = append(stmts, result.initStmts...)
 	stmts = append(stmts, returnStmt)
 
-	return &ast.BLangBlockFunctionBody{
+	body := &ast.BLangBlockFunctionBody{
 		Stmts: stmts,
 	}
+	setPositionIfMissing(body, exprBody.GetPosition())
+	return body
 }
 
 // BLangServiceInit is a desugar-only expression that constructs an
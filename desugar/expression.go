@@ -36,6 +36,7 @@ type invocable interface {
 	SetReceiver(ast.BLangExpression)
 	CallArgs() []ast.BLangExpression
 	SetCallArgs([]ast.BLangExpression)
+	ParamsSymbol() model.SymbolRef
 }
 
 func walkExpression(cx *functionContext, node ast.BLangActionOrExpression) desugaredNode[ast.BLangActionOrExpression] {
@@ -46,6 +47,8 @@ func walkExpression(cx *functionContext, node ast.BLangActionOrExpression) desug
 		return walkUnaryExpr(cx, expr)
 	case *ast.BLangElvisExpr:
 		return walkElvisExpr(cx, expr)
+	case *ast.BLangTernaryExpr:
+		return walkTernaryExpr(cx, expr)
 	case *ast.BLangGroupExpr:
 		return walkGroupExpr(cx, expr)
 	case *ast.BLangIndexBasedAccess:
@@ -58,6 +61,8 @@ func walkExpression(cx *functionContext, node ast.BLangActionOrExpression) desug
 		return walkListConstructorExpr(cx, expr)
 	case *ast.BLangMappingConstructorExpr:
 		return walkMappingConstructorExpr(cx, expr)
+	case *ast.BLangTableConstructorExpr:
+		return walkTableConstructorExpr(cx, expr)
 	case *ast.BLangErrorConstructorExpr:
 		return walkErrorConstructorExpr(cx, expr)
 	case *ast.BLangCheckedExpr:
@@ -78,13 +83,21 @@ func walkExpression(cx *functionContext, node ast.BLangActionOrExpression) desug
 		return walkArrowFunction(cx, expr)
 	case *ast.BLangQueryExpr:
 		return walkQueryExpr(cx, expr)
+	case *ast.BLangLetExpr:
+		return walkLetExpr(cx, expr)
 	case *ast.BLangTypedescExpr:
 		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
 	case *ast.BLangLiteral:
+		if expr.GetValueType() != nil && expr.GetValueType().BTypeGetTag() == ast.TypeTags_BYTE_ARRAY {
+			return walkByteArrayLiteral(cx, expr)
+		}
 		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
 	case *ast.BLangNumericLiteral:
 		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
 	case *ast.BLangSimpleVarRef:
+		if cx.getSymbol(expr.Symbol()).Kind() == model.SymbolKindType {
+			return walkTypeNameRef(cx, expr)
+		}
 		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
 	case *ast.BLangLocalVarRef:
 		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
@@ -102,6 +115,13 @@ func walkExpression(cx *functionContext, node ast.BLangActionOrExpression) desug
 			initStmts:       result.initStmts,
 			replacementNode: expr,
 		}
+	case *ast.BLangRestArgsExpression:
+		result := walkExpression(cx, expr.Expr)
+		expr.Expr = result.replacementNode.(ast.BLangExpression)
+		return desugaredNode[ast.BLangActionOrExpression]{
+			initStmts:       result.initStmts,
+			replacementNode: expr,
+		}
 	case *ast.BLangRemoteMethodCallAction:
 		return walkInvocation(cx, expr)
 	case *ast.BLangClientResourceAccessAction:
@@ -134,10 +154,55 @@ func walkExpression(cx *functionContext, node ast.BLangActionOrExpression) desug
 		return desugaredNode[ast.BLangActionOrExpression]{initStmts: initStmts, replacementNode: expr}
 	case *ast.BLangXMLPILiteral, *ast.BLangXMLCommentLiteral, *ast.BLangXMLTextLiteral:
 		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
+	case *ast.BLangXMLFilterExpr:
+		return walkXMLFilterExpr(cx, expr)
+	case *ast.BLangXMLStepExpr:
+		return walkXMLStepExpr(cx, expr)
 	case *ast.BLangTemplateExpr:
 		return walkTemplateExpr(cx, expr)
 	case *ast.BLangXMLTemplateExpr:
 		return walkXMLTemplateExpr(cx, expr)
+	case *ast.BLangWorkerAsyncSendExpr:
+		return walkWorkerSend(cx, expr, &expr.BLangWorkerSendExprBase)
+	case *ast.BLangWorkerSyncSendExpr:
+		return walkWorkerSend(cx, expr, &expr.BLangWorkerSendExprBase)
+	case *ast.BLangWorkerReceive:
+		walkWorkerReceive(cx, expr)
+		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
+	case *ast.BLangAlternateWorkerReceive:
+		for i := range expr.WorkerReceives {
+			walkWorkerReceive(cx, &expr.WorkerReceives[i])
+		}
+		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
+	case *ast.BLangMultipleWorkerReceive:
+		for i := range expr.ReceiveFields {
+			walkWorkerReceive(cx, &expr.ReceiveFields[i].Receive)
+		}
+		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
+	case *ast.BLangWorkerFlushExpr:
+		expr.GroupSymbol = cx.workerGroupSymbol()
+		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
+	case *ast.BLangWaitExpr:
+		var initStmts []ast.StatementNode
+		for i, future := range expr.Exprs {
+			r := walkExpression(cx, future)
+			initStmts = append(initStmts, r.initStmts...)
+			expr.Exprs[i] = r.replacementNode.(ast.BLangExpression)
+		}
+		return desugaredNode[ast.BLangActionOrExpression]{initStmts: initStmts, replacementNode: expr}
+	case *ast.BLangWaitForAllExpr:
+		var initStmts []ast.StatementNode
+		for i := range expr.KeyValuePairs {
+			field := &expr.KeyValuePairs[i]
+			r := walkExpression(cx, field.ValueExpr)
+			initStmts = append(initStmts, r.initStmts...)
+			field.ValueExpr = r.replacementNode.(ast.BLangExpression)
+		}
+		return desugaredNode[ast.BLangActionOrExpression]{initStmts: initStmts, replacementNode: expr}
+	case *ast.BLangCommitExpr:
+		return walkCommitExpr(cx, expr)
+	case *ast.BLangTransactionalExpr:
+		return walkTransactionalExpr(cx, expr)
 	default:
 		panic(fmt.Sprintf("unexpected expression type: %T", node))
 	}
@@ -355,27 +420,117 @@ func walkUnaryExpr(cx *functionContext, expr *ast.BLangUnaryExpr) desugaredNode[
 	}
 }
 
+// walkElvisExpr lowers `lhs ?: rhs` to an if statement assigning a temporary, so that rhs, along with the
+// statements it desugars to, is only evaluated when lhs is nil.
 func walkElvisExpr(cx *functionContext, expr *ast.BLangElvisExpr) desugaredNode[ast.BLangActionOrExpression] {
-	var initStmts []ast.StatementNode
+	basePos := expr.GetPosition()
+	lhsResult := walkExpression(cx, expr.LhsExpr)
+	initStmts := lhsResult.initStmts
+	lhsTy := expr.LhsExpr.GetDeterminedType()
+	lhsVarName, lhsSymbol, initStmts := createOperandTempVar(cx, lhsTy, lhsResult.replacementNode.(ast.BLangExpression), basePos, initStmts)
 
-	if expr.LhsExpr != nil {
-		result := walkExpression(cx, expr.LhsExpr)
-		initStmts = append(initStmts, result.initStmts...)
-		expr.LhsExpr = result.replacementNode.(ast.BLangExpression)
+	resultTy := expr.GetDeterminedType()
+	resultVarName, resultSymbol, initStmts := createOperandTempVar(cx, resultTy, nil, basePos, initStmts)
+
+	rhsBody := createConditionalBranch(cx, expr.RhsExpr, resultVarName, resultSymbol, resultTy, basePos)
+	lhsRef := createVarRef(lhsVarName, lhsSymbol, semtypes.Diff(lhsTy, semtypes.NIL))
+	setPositionIfMissing(lhsRef, basePos)
+	lhsBody := &ast.BLangBlockStmt{
+		Stmts: []ast.StatementNode{createResultAssignment(resultVarName, resultSymbol, resultTy, lhsRef, basePos)},
+	}
+	lhsBody.SetDeterminedType(semtypes.NEVER)
+	ifStmt := &ast.BLangIf{
+		Expr:     createNilTypeTest(lhsVarName, lhsSymbol, lhsTy, basePos),
+		Body:     *rhsBody,
+		ElseStmt: lhsBody,
 	}
+	ifStmt.SetDeterminedType(semtypes.NEVER)
+	ifStmt.SetScope(cx.currentScope())
+	setPositionIfMissing(ifStmt, basePos)
+	initStmts = append(initStmts, ifStmt)
 
-	if expr.RhsExpr != nil {
-		result := walkExpression(cx, expr.RhsExpr)
+	replacementRef := createVarRef(resultVarName, resultSymbol, resultTy)
+	setPositionIfMissing(replacementRef, basePos)
+	return desugaredNode[ast.BLangActionOrExpression]{
+		initStmts:       initStmts,
+		replacementNode: replacementRef,
+	}
+}
+
+// walkByteArrayLiteral lowers a base16 or base64 byte array literal to a readonly list constructor of its bytes.
+func walkByteArrayLiteral(cx *functionContext, expr *ast.BLangLiteral) desugaredNode[ast.BLangActionOrExpression] {
+	pos := expr.GetPosition()
+	bytes := expr.GetValue().([]byte)
+	members := make([]ast.BLangExpression, len(bytes))
+	for i, b := range bytes {
+		member := createIntLiteral(int64(b))
+		member.SetDeterminedType(semtypes.IntConst(int64(b)))
+		setPositionIfMissing(member, pos)
+		members[i] = member
+	}
+	listTy := expr.GetDeterminedType()
+	list := &ast.BLangListConstructorExpr{Exprs: members, AtomicType: *semtypes.ToListAtomicType(cx.typeCtx(), listTy)}
+	list.SetDeterminedType(listTy)
+	list.SetPosition(pos)
+	return desugaredNode[ast.BLangActionOrExpression]{replacementNode: list}
+}
+
+// walkLetExpr lowers a let expression to the declarations of its variables followed by its body.
+func walkLetExpr(cx *functionContext, expr *ast.BLangLetExpr) desugaredNode[ast.BLangActionOrExpression] {
+	var initStmts []ast.StatementNode
+	for _, decl := range expr.LetVarDeclarations {
+		result := walkStatement(cx, decl.(ast.StatementNode))
 		initStmts = append(initStmts, result.initStmts...)
-		expr.RhsExpr = result.replacementNode.(ast.BLangExpression)
+		initStmts = append(initStmts, result.replacementNode)
 	}
+	result := walkExpression(cx, expr.Expr)
+	initStmts = append(initStmts, result.initStmts...)
+	return desugaredNode[ast.BLangActionOrExpression]{
+		initStmts:       initStmts,
+		replacementNode: result.replacementNode,
+	}
+}
+
+// walkTernaryExpr lowers `cond ? thenExpr : elseExpr` to an if statement assigning a temporary, so that only the
+// chosen branch, along with the statements it desugars to, is evaluated.
+func walkTernaryExpr(cx *functionContext, expr *ast.BLangTernaryExpr) desugaredNode[ast.BLangActionOrExpression] {
+	basePos := expr.GetPosition()
+	condResult := walkExpression(cx, expr.Expr)
+	initStmts := condResult.initStmts
+
+	resultTy := expr.GetDeterminedType()
+	resultVarName, resultSymbol, initStmts := createOperandTempVar(cx, resultTy, nil, basePos, initStmts)
+
+	thenBody := createConditionalBranch(cx, expr.ThenExpr, resultVarName, resultSymbol, resultTy, basePos)
+	elseBody := createConditionalBranch(cx, expr.ElseExpr, resultVarName, resultSymbol, resultTy, basePos)
+	ifStmt := &ast.BLangIf{
+		Expr:     condResult.replacementNode.(ast.BLangExpression),
+		Body:     *thenBody,
+		ElseStmt: elseBody,
+	}
+	ifStmt.SetDeterminedType(semtypes.NEVER)
+	ifStmt.SetScope(cx.currentScope())
+	setPositionIfMissing(ifStmt, basePos)
+	initStmts = append(initStmts, ifStmt)
 
+	replacementRef := createVarRef(resultVarName, resultSymbol, resultTy)
+	setPositionIfMissing(replacementRef, basePos)
 	return desugaredNode[ast.BLangActionOrExpression]{
 		initStmts:       initStmts,
-		replacementNode: expr,
+		replacementNode: replacementRef,
 	}
 }
 
+// createConditionalBranch returns a block evaluating expr, which is only evaluated on one branch of a conditional
+// expression, and assigning it to the result of the conditional expression.
+func createConditionalBranch(cx *functionContext, expr ast.BLangExpression, resultVarName *ast.BLangIdentifier, resultSymbol model.SymbolRef, resultTy semtypes.SemType, pos diagnostics.Location) *ast.BLangBlockStmt {
+	result := walkExpression(cx, expr)
+	stmts := append(result.initStmts, createResultAssignment(resultVarName, resultSymbol, resultTy, result.replacementNode.(ast.BLangExpression), pos))
+	body := &ast.BLangBlockStmt{Stmts: stmts}
+	body.SetDeterminedType(semtypes.NEVER)
+	return body
+}
+
 func walkGroupExpr(cx *functionContext, expr *ast.BLangGroupExpr) desugaredNode[ast.BLangActionOrExpression] {
 	var initStmts []ast.StatementNode
 
@@ -406,6 +561,13 @@ func walkIndexBasedAccess(cx *functionContext, expr *ast.BLangIndexBasedAccess)
 		expr.IndexExpr = result.replacementNode.(ast.BLangExpression)
 	}
 
+	if semtypes.IsSubtype(cx.typeCtx(), expr.Expr.GetDeterminedType(), semtypes.XML) {
+		return desugaredNode[ast.BLangActionOrExpression]{
+			initStmts:       initStmts,
+			replacementNode: walkXMLMemberAccess(cx, expr),
+		}
+	}
+
 	return desugaredNode[ast.BLangActionOrExpression]{
 		initStmts:       initStmts,
 		replacementNode: expr,
@@ -421,6 +583,14 @@ func walkFieldBaseAccess(cx *functionContext, expr *ast.BLangFieldBaseAccess) de
 		expr.Expr = result.replacementNode.(ast.BLangExpression)
 	}
 
+	if expr.OptionalFieldAccess {
+		containerTy := expr.Expr.GetDeterminedType()
+		nonMappingTy := semtypes.Diff(containerTy, semtypes.Union(semtypes.MAPPING, semtypes.NIL))
+		if !semtypes.IsEmpty(cx.typeCtx(), nonMappingTy) {
+			return walkLaxOptionalFieldAccess(cx, expr, initStmts)
+		}
+	}
+
 	name := expr.Field.Value
 	lit := &ast.BLangLiteral{
 		Value:         name,
@@ -442,8 +612,83 @@ func walkFieldBaseAccess(cx *functionContext, expr *ast.BLangFieldBaseAccess) de
 	}
 }
 
+// walkLaxOptionalFieldAccess lowers `j?.k` where j is json (or an error) that need not be a mapping. Index access
+// on a nil container already yields nil, so only the remaining cases need a separate branch: an error container is
+// passed through and any other value results in an error.
+func walkLaxOptionalFieldAccess(cx *functionContext, expr *ast.BLangFieldBaseAccess, initStmts []ast.StatementNode) desugaredNode[ast.BLangActionOrExpression] {
+	basePos := expr.GetPosition()
+	containerTy := expr.Expr.GetDeterminedType()
+	containerVarName, containerSymbol, initStmts := createOperandTempVar(cx, containerTy, expr.Expr, basePos, initStmts)
+	resultTy := expr.GetDeterminedType()
+	resultVarName, resultSymbol, initStmts := createNilResultVar(cx, resultTy, basePos, initStmts)
+
+	mappingTy := semtypes.Intersect(containerTy, semtypes.MAPPING)
+	lit := createStringLiteral(expr.Field.Value, basePos)
+	indexAccess := &ast.BLangIndexBasedAccess{IndexExpr: lit}
+	indexAccess.Expr = createVarRef(containerVarName, containerSymbol, mappingTy)
+	indexAccess.SetDeterminedType(semtypes.Diff(resultTy, semtypes.ERROR))
+	setPositionIfMissing(indexAccess, basePos)
+	mappingBody := &ast.BLangBlockStmt{
+		Stmts: []ast.StatementNode{createResultAssignment(resultVarName, resultSymbol, resultTy, indexAccess, basePos)},
+	}
+	mappingBody.SetDeterminedType(semtypes.NEVER)
+
+	errorBody := &ast.BLangBlockStmt{
+		Stmts: []ast.StatementNode{
+			createResultAssignment(resultVarName, resultSymbol, resultTy, createErrorWithMessage("JSON value is not a mapping", basePos), basePos),
+		},
+	}
+	errorBody.SetDeterminedType(semtypes.NEVER)
+	nonNilIf := &ast.BLangIf{
+		Expr: createNotExpr(createNilTypeTest(containerVarName, containerSymbol, containerTy, basePos), basePos),
+		Body: *errorBody,
+	}
+	nonNilIf.SetDeterminedType(semtypes.NEVER)
+	nonNilIf.SetScope(cx.currentScope())
+	setPositionIfMissing(nonNilIf, basePos)
+
+	var elseStmt ast.StatementNode = nonNilIf
+	if semtypes.ContainsBasicType(containerTy, semtypes.ERROR) {
+		errorRef := createVarRef(containerVarName, containerSymbol, semtypes.Intersect(containerTy, semtypes.ERROR))
+		setPositionIfMissing(errorRef, basePos)
+		passThroughBody := &ast.BLangBlockStmt{
+			Stmts: []ast.StatementNode{createResultAssignment(resultVarName, resultSymbol, resultTy, errorRef, basePos)},
+		}
+		passThroughBody.SetDeterminedType(semtypes.NEVER)
+		errorIf := &ast.BLangIf{
+			Expr:     createMatchTypeTest(createVarRef(containerVarName, containerSymbol, containerTy), semtypes.ERROR, basePos),
+			Body:     *passThroughBody,
+			ElseStmt: nonNilIf,
+		}
+		errorIf.SetDeterminedType(semtypes.NEVER)
+		errorIf.SetScope(cx.currentScope())
+		setPositionIfMissing(errorIf, basePos)
+		elseStmt = errorIf
+	}
+
+	mappingIf := &ast.BLangIf{
+		Expr:     createMatchTypeTest(createVarRef(containerVarName, containerSymbol, containerTy), semtypes.MAPPING, basePos),
+		Body:     *mappingBody,
+		ElseStmt: elseStmt,
+	}
+	mappingIf.SetDeterminedType(semtypes.NEVER)
+	mappingIf.SetScope(cx.currentScope())
+	setPositionIfMissing(mappingIf, basePos)
+	initStmts = append(initStmts, mappingIf)
+
+	replacementRef := createVarRef(resultVarName, resultSymbol, resultTy)
+	setPositionIfMissing(replacementRef, basePos)
+	return desugaredNode[ast.BLangActionOrExpression]{
+		initStmts:       initStmts,
+		replacementNode: replacementRef,
+	}
+}
+
 func walkTemplateExpr(cx *functionContext, expr *ast.BLangTemplateExpr) desugaredNode[ast.BLangActionOrExpression] {
-	if len(expr.Insertions) == 0 {
+	if expr.Kind == ast.TemplateExprKindRaw {
+		return walkRawTemplateExpr(cx, expr)
+	}
+	if len(expr.Insertions) == 0 && expr.Kind != ast.TemplateExprKindRegExp {
 		lit := &ast.BLangLiteral{Value: expr.Strings[0], OriginalValue: expr.Strings[0]}
 		lit.SetPosition(expr.GetPosition())
 		lit.SetDeterminedType(semtypes.StringConst(expr.Strings[0]))
@@ -458,6 +703,54 @@ func walkTemplateExpr(cx *functionContext, expr *ast.BLangTemplateExpr) desugare
 	return desugaredNode[ast.BLangActionOrExpression]{initStmts: initStmts, replacementNode: expr}
 }
 
+// walkRawTemplateExpr lowers a raw template to the construction of an object of its type, with the `strings` and
+// `insertions` fields initialized from the template:
+//
+//	rawTemplate(T, [s0, s1, ...], [e0, ...])
+func walkRawTemplateExpr(cx *functionContext, expr *ast.BLangTemplateExpr) desugaredNode[ast.BLangActionOrExpression] {
+	pos := expr.GetPosition()
+	ty := expr.GetDeterminedType()
+	var initStmts []ast.StatementNode
+	for i, ins := range expr.Insertions {
+		r := walkExpression(cx, ins)
+		initStmts = append(initStmts, r.initStmts...)
+		expr.Insertions[i] = r.replacementNode.(ast.BLangExpression)
+	}
+	strs := make([]ast.BLangExpression, len(expr.Strings))
+	for i, s := range expr.Strings {
+		strs[i] = createStringLiteral(s, pos)
+	}
+	td := &ast.BLangTypedescExpr{Constraint: ty}
+	td.SetDeterminedType(semtypes.TypedescContaining(cx.typeEnv(), ty))
+	td.SetPosition(pos)
+	args := []ast.BLangExpression{
+		td,
+		createRawTemplateFieldList(cx, ty, "strings", strs, pos),
+		createRawTemplateFieldList(cx, ty, "insertions", expr.Insertions, pos),
+	}
+	return desugaredNode[ast.BLangActionOrExpression]{
+		initStmts:       initStmts,
+		replacementNode: createLangInternalInvocation(cx, "rawTemplate", ty, args, pos),
+	}
+}
+
+// createRawTemplateFieldList creates the list for a field of a raw template of type ty. The list gets the field type
+// when that is a single list type, and `(any|error)[]` otherwise.
+func createRawTemplateFieldList(cx *functionContext, ty semtypes.SemType, field string, exprs []ast.BLangExpression, pos diagnostics.Location) *ast.BLangListConstructorExpr {
+	tyCtx := cx.typeCtx()
+	listTy := semtypes.ObjectMemberType(tyCtx, semtypes.StringConst(field), ty)
+	lat := semtypes.ToListAtomicType(tyCtx, listTy)
+	if lat == nil {
+		ld := semtypes.NewListDefinition()
+		listTy = ld.DefineListTypeWrappedWithEnvSemType(cx.typeEnv(), semtypes.Union(semtypes.ANY, semtypes.ERROR))
+		lat = semtypes.ToListAtomicType(tyCtx, listTy)
+	}
+	list := &ast.BLangListConstructorExpr{Exprs: exprs, AtomicType: *lat}
+	list.SetDeterminedType(listTy)
+	list.SetPosition(pos)
+	return list
+}
+
 func walkClientResourceAccessAction(cx *functionContext, expr *ast.BLangClientResourceAccessAction) desugaredNode[ast.BLangActionOrExpression] {
 	var initStmts []ast.StatementNode
 	if expr.Expr != nil {
@@ -467,7 +760,7 @@ func walkClientResourceAccessAction(cx *functionContext, expr *ast.BLangClientRe
 	}
 	for i := range expr.Path {
 		seg := &expr.Path[i]
-		if seg.Kind != ast.ResourceAccessSegmentComputed {
+		if seg.Expr == nil {
 			continue
 		}
 		result := walkExpression(cx, seg.Expr)
@@ -509,7 +802,7 @@ func walkXMLTemplateExpr(cx *functionContext, expr *ast.BLangXMLTemplateExpr) de
 }
 
 func shouldEscapeXMLTemplateInsertion(insert ast.BLangExpression, kind ast.XMLTemplateInsertionKind, cx *functionContext) bool {
-	if kind == ast.XMLTemplateInsertionKindAttribute {
+	if kind != ast.XMLTemplateInsertionKindContent {
 		return true
 	}
 	// content needs to be escaped if they are not xml
@@ -522,6 +815,12 @@ func escapeXMLTemplateInsertion(cx *functionContext, insert ast.BLangExpression,
 		return createLangInternalInvocation(cx, "escapeXMLAttribute", semtypes.STRING, []ast.BLangExpression{insert}, insert.GetPosition())
 	case ast.XMLTemplateInsertionKindContent:
 		return createLangInternalInvocation(cx, "escapeXMLContent", semtypes.STRING, []ast.BLangExpression{insert}, insert.GetPosition())
+	case ast.XMLTemplateInsertionKindComment:
+		return createLangInternalInvocation(cx, "xmlCommentText", semtypes.STRING, []ast.BLangExpression{insert}, insert.GetPosition())
+	case ast.XMLTemplateInsertionKindPI:
+		return createLangInternalInvocation(cx, "xmlPIText", semtypes.STRING, []ast.BLangExpression{insert}, insert.GetPosition())
+	case ast.XMLTemplateInsertionKindCDATA:
+		return createLangInternalInvocation(cx, "xmlCDATAText", semtypes.STRING, []ast.BLangExpression{insert}, insert.GetPosition())
 	default:
 		cx.internalError("unexpected xml template insert kind")
 		return insert
@@ -611,6 +910,7 @@ func walkInvocation(cx *functionContext, expr invocable) desugaredNode[ast.BLang
 		args[i] = result.replacementNode.(ast.BLangExpression)
 	}
 	expr.SetCallArgs(args)
+	initStmts = append(initStmts, expandRestArgParamMembers(cx, expr)...)
 
 	if ast.IsStreamOperation(expr) {
 		return desugaredNode[ast.BLangActionOrExpression]{
@@ -626,6 +926,10 @@ func walkInvocation(cx *functionContext, expr invocable) desugaredNode[ast.BLang
 		}
 	}
 	fnSym, isDirectCall := cx.getSymbol(symbolRef).(model.FunctionSymbol)
+	if paramsRef := expr.ParamsSymbol(); !isDirectCall && !paramsRef.IsEmpty() {
+		// A call through a function value uses the parameters of the function type.
+		fnSym, isDirectCall = cx.getSymbol(paramsRef).(model.FunctionSymbol)
+	}
 	if !isDirectCall {
 		return desugaredNode[ast.BLangActionOrExpression]{
 			initStmts:       initStmts,
@@ -642,6 +946,56 @@ func walkInvocation(cx *functionContext, expr invocable) desugaredNode[ast.BLang
 	}
 }
 
+// expandRestArgParamMembers replaces the members of a rest argument that are passed to
+// required parameters with separate arguments, so that defaults can be supplied for the
+// parameters after them. The remaining members, if any, stay in a rest argument that
+// supplies the rest parameter.
+func expandRestArgParamMembers(cx *functionContext, expr invocable) []ast.StatementNode {
+	args := expr.CallArgs()
+	if len(args) == 0 {
+		return nil
+	}
+	restArg, ok := args[len(args)-1].(*ast.BLangRestArgsExpression)
+	if !ok {
+		return nil
+	}
+	listTy := restArg.Expr.GetDeterminedType()
+	lat := semtypes.ToListAtomicType(cx.typeCtx(), listTy)
+	// A rest argument that is statically empty is dropped, so it is expanded as well.
+	isEmpty := lat != nil && lat.Members.FixedLength == 0 && semtypes.IsNever(lat.Rest())
+	if restArg.ParamMembers == 0 && !isEmpty {
+		return nil
+	}
+	pos := restArg.GetPosition()
+	var initStmts []ast.StatementNode
+	// Preserve the evaluation order of the arguments before the rest argument.
+	newArgs := make([]ast.BLangExpression, 0, len(args)-1+restArg.ParamMembers)
+	for _, arg := range args[:len(args)-1] {
+		varDef, varRef := assignToLocal(cx, arg, pos)
+		initStmts = append(initStmts, varDef)
+		newArgs = append(newArgs, varRef)
+	}
+	sourceDef, source := assignToLocal(cx, restArg.Expr, pos)
+	initStmts = append(initStmts, sourceDef)
+	for i := range restArg.ParamMembers {
+		newArgs = append(newArgs, createListMemberAccess(source, i, lat.MemberAtInnerVal(i), pos))
+	}
+	if lat.Members.FixedLength > restArg.ParamMembers || !semtypes.IsNever(lat.Rest()) {
+		tailTys := make([]semtypes.SemType, 0, lat.Members.FixedLength)
+		for i := restArg.ParamMembers; i < lat.Members.FixedLength; i++ {
+			tailTys = append(tailTys, lat.MemberAtInnerVal(i))
+		}
+		ld := semtypes.NewListDefinition()
+		tailTy := ld.DefineListTypeWrapped(cx.typeEnv(), tailTys, len(tailTys), lat.Rest(), semtypes.CellMutability_CELL_MUT_LIMITED)
+		tail := &ast.BLangRestArgsExpression{Expr: createListRestInvocation(cx, source, restArg.ParamMembers, tailTy, pos)}
+		tail.SetDeterminedType(tailTy)
+		setPositionIfMissing(tail, pos)
+		newArgs = append(newArgs, tail)
+	}
+	expr.SetCallArgs(newArgs)
+	return initStmts
+}
+
 func invocationSymbol(expr invocable) (model.SymbolRef, bool) {
 	switch e := expr.(type) {
 	case *ast.BLangInvocation:
@@ -699,15 +1053,22 @@ func walkDirectCallArgs(cx *functionContext, expr invocable, fnSym model.Functio
 			reordered[i] = synthesizeInferredTypedescArg(cx, sig.ParamTypes[i], pos)
 			continue
 		}
+		if isDefaultable && dp.Kind == model.DefaultableParamKindConstant {
+			reordered[i] = synthesizeConstantDefaultArg(dp.Value, pos)
+			continue
+		}
 		for j := len(transformed); j < i; j++ {
 			varDef, varRef := assignToLocal(cx, reordered[j], pos)
 			initStmts = append(initStmts, varDef)
 			reordered[j] = varRef
 			transformed = append(transformed, varRef)
 		}
+		// The default of a parameter of a function type descriptor cannot refer to the preceding
+		// parameters, so its function takes fewer arguments.
+		defaultFnSym := cx.pkgCtx.compilerCtx.GetSymbol(dp.Symbol).(model.FunctionSymbol)
 		defaultInv := &ast.BLangInvocation{}
-		defaultInv.Name = &ast.BLangIdentifier{Value: cx.pkgCtx.compilerCtx.GetSymbol(dp.Symbol).Name()}
-		defaultInv.ArgExprs = reordered[:i]
+		defaultInv.Name = &ast.BLangIdentifier{Value: defaultFnSym.Name()}
+		defaultInv.ArgExprs = reordered[:len(defaultFnSym.Signature().ParamTypes)]
 		defaultInv.SetSymbol(dp.Symbol)
 		defaultInv.SetDeterminedType(sig.ParamTypes[i])
 		setPositionIfMissing(defaultInv, pos)
@@ -733,6 +1094,19 @@ func synthesizeInferredTypedescArg(cx *functionContext, tdTy semtypes.SemType, p
 	return tdExpr
 }
 
+// synthesizeConstantDefaultArg builds the literal that fills the slot of a
+// parameter whose default is the single value of the singleton type value.
+func synthesizeConstantDefaultArg(value semtypes.SemType, pos diagnostics.Location) *ast.BLangLiteral {
+	v := semtypes.SingleShape(value).Get().Value
+	lit := &ast.BLangLiteral{Value: v}
+	if v != nil {
+		lit.OriginalValue = fmt.Sprint(v)
+	}
+	lit.SetDeterminedType(value)
+	setPositionIfMissing(lit, pos)
+	return lit
+}
+
 func assignToLocal(cx *functionContext, initExpr ast.BLangExpression, pos diagnostics.Location) (ast.StatementNode, *ast.BLangSimpleVarRef) {
 	ty := initExpr.GetDeterminedType()
 	tempName, tempSymRef := cx.addDesugardSymbol(ty, model.SymbolKindVariable, false)
@@ -761,102 +1135,12 @@ func walkListConstructorExpr(cx *functionContext, expr *ast.BLangListConstructor
 		expr.Exprs[i] = result.replacementNode.(ast.BLangExpression)
 	}
 
-	if expr.HasSpreadMembers() {
-		return desugarListConstructorWithSpread(cx, expr, initStmts)
-	}
-
 	return desugaredNode[ast.BLangActionOrExpression]{
 		initStmts:       initStmts,
 		replacementNode: expr,
 	}
 }
 
-func desugarListConstructorWithSpread(
-	cx *functionContext,
-	expr *ast.BLangListConstructorExpr,
-	initStmts []ast.StatementNode,
-) desugaredNode[ast.BLangActionOrExpression] {
-	pos := expr.GetPosition()
-	emptyList := &ast.BLangListConstructorExpr{Exprs: []ast.BLangExpression{}}
-	emptyList.SetDeterminedType(expr.GetDeterminedType())
-	emptyList.AtomicType = semtypes.LIST_ATOMIC_INNER
-	setPositionIfMissing(emptyList, pos)
-
-	resultDef, resultRef := assignToLocal(cx, emptyList, pos)
-	initStmts = append(initStmts, resultDef)
-
-	for i, memberExpr := range expr.Exprs {
-		if !expr.IsSpreadMember(i) {
-			pushMember := createPushInvocation(cx, resultRef, memberExpr)
-			if pushMember == nil {
-				return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
-			}
-			pushStmt := &ast.BLangExpressionStmt{Expr: pushMember}
-			setPositionIfMissing(pushStmt, pos)
-			initStmts = append(initStmts, pushStmt)
-			continue
-		}
-		initStmts = appendSpreadListPushStmts(cx, initStmts, resultRef, memberExpr, pos)
-	}
-
-	resultRef.SetDeterminedType(expr.GetDeterminedType())
-	return desugaredNode[ast.BLangActionOrExpression]{
-		initStmts:       initStmts,
-		replacementNode: resultRef,
-	}
-}
-
-func appendSpreadListPushStmts(
-	cx *functionContext,
-	initStmts []ast.StatementNode,
-	resultRef *ast.BLangSimpleVarRef,
-	spreadExpr ast.BLangExpression,
-	pos diagnostics.Location,
-) []ast.StatementNode {
-	spreadDef, spreadRef := assignToLocal(cx, spreadExpr, pos)
-	initStmts = append(initStmts, spreadDef)
-
-	lengthRef, ok := createQueryLengthRef(cx, &initStmts, spreadRef, pos)
-	if !ok {
-		return initStmts
-	}
-	counterRef := createQueryCounterRef(cx, &initStmts, pos)
-	tyCtx := semtypes.ContextFrom(cx.typeEnv())
-	elemTy := semtypes.ListProj(tyCtx, spreadExpr.GetDeterminedType(), semtypes.INT)
-	spreadAccess := &ast.BLangIndexBasedAccess{
-		IndexExpr: counterRef,
-	}
-	spreadAccess.Expr = spreadRef
-	spreadAccess.SetDeterminedType(elemTy)
-	setPositionIfMissing(spreadAccess, pos)
-
-	pushMember := createPushInvocation(cx, resultRef, spreadAccess)
-	if pushMember == nil {
-		return initStmts
-	}
-	pushStmt := &ast.BLangExpressionStmt{Expr: pushMember}
-	setPositionIfMissing(pushStmt, pos)
-	bodyStmts := []ast.StatementNode{
-		pushStmt,
-		createIncrementStmt(counterRef),
-	}
-	cond := &ast.BLangBinaryExpr{
-		LhsExpr: counterRef,
-		RhsExpr: lengthRef,
-		OpKind:  model.OperatorKind_LESS_THAN,
-	}
-	cond.SetDeterminedType(semtypes.BOOLEAN)
-	whileStmt := &ast.BLangWhile{
-		Expr: cond,
-		Body: ast.BLangBlockStmt{Stmts: bodyStmts},
-	}
-	whileStmt.SetScope(cx.currentScope())
-	whileStmt.SetDeterminedType(semtypes.NEVER)
-	setPositionIfMissing(whileStmt, pos)
-	initStmts = append(initStmts, whileStmt)
-	return initStmts
-}
-
 func walkErrorConstructorExpr(cx *functionContext, expr *ast.BLangErrorConstructorExpr) desugaredNode[ast.BLangActionOrExpression] {
 	var initStmts []ast.StatementNode
 
@@ -954,6 +1238,10 @@ func desugarCheckedExpr(cx *functionContext, expr *ast.BLangCheckedExpr, isPanic
 		panicStmt := &ast.BLangPanic{Expr: tempVarRefForBody}
 		panicStmt.SetPosition(expr.GetPosition())
 		bodyStmt = panicStmt
+	} else if cx.onFailDepth > 0 {
+		failStmt := &ast.BLangFail{Expr: tempVarRefForBody}
+		failStmt.SetPosition(basePos)
+		bodyStmt = failStmt
 	} else {
 		returnStmt := &ast.BLangReturn{Expr: tempVarRefForBody}
 		returnStmt.SetPosition(basePos)
@@ -985,7 +1273,9 @@ func desugarCheckedExpr(cx *functionContext, expr *ast.BLangCheckedExpr, isPanic
 
 func walkLambdaFunction(cx *functionContext, expr *ast.BLangLambdaFunction) desugaredNode[ast.BLangActionOrExpression] {
 	// Desugar the function body
-	if expr.Function != nil {
+	if expr.Function != nil && expr.Function.IsWorker() {
+		expr.Function = desugarWorkerFunction(cx, expr.Function)
+	} else if expr.Function != nil {
 		expr.Function = desugarFunction(cx.pkgCtx, expr.Function)
 	}
 
@@ -1024,18 +1314,17 @@ func walkTypeTestExpr(cx *functionContext, expr *ast.BLangTypeTestExpr) desugare
 	}
 }
 
+// walkAnnotAccessExpr desugars `t.@a` into a lookup of the key of a in the annotations of the typedesc t.
 func walkAnnotAccessExpr(cx *functionContext, expr *ast.BLangAnnotAccessExpr) desugaredNode[ast.BLangActionOrExpression] {
-	var initStmts []ast.StatementNode
-
-	if expr.Expr != nil {
-		result := walkExpression(cx, expr.Expr)
-		initStmts = append(initStmts, result.initStmts...)
-		expr.Expr = result.replacementNode.(ast.BLangExpression)
+	result := walkExpression(cx, expr.Expr)
+	pos := expr.GetPosition()
+	args := []ast.BLangExpression{
+		result.replacementNode.(ast.BLangExpression),
+		createStringLiteral(annotationKey(cx.pkgCtx, expr.Symbol()), pos),
 	}
-
 	return desugaredNode[ast.BLangActionOrExpression]{
-		initStmts:       initStmts,
-		replacementNode: expr,
+		initStmts:       result.initStmts,
+		replacementNode: createLangInternalInvocation(cx, "annotationValue", expr.GetDeterminedType(), args, pos),
 	}
 }
 
@@ -1124,6 +1413,10 @@ func fillNewExprInitDefaults(cx *functionContext, expr *ast.BLangNewExpression)
 			expr.ArgsExprs = append(expr.ArgsExprs, varRef)
 			continue
 		}
+		if dp.Kind == model.DefaultableParamKindConstant {
+			expr.ArgsExprs = append(expr.ArgsExprs, synthesizeConstantDefaultArg(dp.Value, pos))
+			continue
+		}
 		defaultInv := &ast.BLangInvocation{}
 		defaultInv.Name = &ast.BLangIdentifier{Value: cx.getSymbol(dp.Symbol).Name()}
 		defaultInv.ArgExprs = append([]ast.BLangExpression(nil), expr.ArgsExprs[:i]...)
@@ -1142,6 +1435,12 @@ func walkMappingConstructorExpr(cx *functionContext, expr *ast.BLangMappingConst
 	var initStmts []ast.StatementNode
 
 	for _, field := range expr.Fields {
+		if spread, ok := field.(*ast.BLangMappingSpreadField); ok {
+			result := walkExpression(cx, spread.Expr)
+			initStmts = append(initStmts, result.initStmts...)
+			spread.Expr = result.replacementNode.(ast.BLangExpression)
+			continue
+		}
 		kv := field.(*ast.BLangMappingKeyValueField)
 
 		if kv.Key.Kind != ast.MappingKeyComputed {
@@ -1168,6 +1467,19 @@ func walkMappingConstructorExpr(cx *functionContext, expr *ast.BLangMappingConst
 	}
 }
 
+func walkTableConstructorExpr(cx *functionContext, expr *ast.BLangTableConstructorExpr) desugaredNode[ast.BLangActionOrExpression] {
+	var initStmts []ast.StatementNode
+	for i, row := range expr.RecordLiteralList {
+		result := walkMappingConstructorExpr(cx, row)
+		initStmts = append(initStmts, result.initStmts...)
+		expr.RecordLiteralList[i] = result.replacementNode.(*ast.BLangMappingConstructorExpr)
+	}
+	return desugaredNode[ast.BLangActionOrExpression]{
+		initStmts:       initStmts,
+		replacementNode: expr,
+	}
+}
+
 func isNilLiftableBinaryOp(op model.OperatorKind) bool {
 	switch op {
 	case model.OperatorKind_ADD, model.OperatorKind_SUB,
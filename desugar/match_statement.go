@@ -0,0 +1,427 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package desugar
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/tools/diagnostics"
+)
+
+// A match statement with list, mapping, error or capture match patterns is lowered to if statements. The matched
+// value is held in a variable, each match pattern is lowered to an expression testing the shape of that value, and
+// the variables the match pattern captures are bound to the members of the value:
+//
+//	match e {
+//	    [var a, 1] if a > 0 => { B1 }
+//	    {x: var b} | [var b] => { B2 }
+//	}
+//
+// becomes
+//
+//	var $m = e;
+//	boolean $done = false;
+//	if $m is list && length($m) == 2 && $m[1] == 1 {
+//	    var a = $m[0];
+//	    if a > 0 {
+//	        $done = true;
+//	        { B1 }
+//	    }
+//	}
+//	if !$done {
+//	    T b;
+//	    boolean $matched = false;
+//	    if $m is mapping && hasKey($m, "x") {
+//	        b = $m["x"];
+//	        $matched = true;
+//	    }
+//	    if !$matched && $m is list && length($m) == 1 {
+//	        b = $m[0];
+//	        $matched = true;
+//	    }
+//	    if $matched {
+//	        $done = true;
+//	        { B2 }
+//	    }
+//	}
+//
+// Match statements with only const and wildcard match patterns are left to BIR generation.
+
+// matchSource builds an expression for the value matched by a match pattern, with the given determined type. It
+// builds a new expression each time, since a match pattern both tests and binds the value.
+type matchSource func(ty semtypes.SemType) ast.BLangExpression
+
+func hasStructuralMatchPattern(stmt *ast.BLangMatchStatement) bool {
+	for i := range stmt.MatchClauses {
+		for _, pattern := range stmt.MatchClauses[i].Patterns {
+			if ast.IsStructuralMatchPattern(pattern) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func walkStructuralMatchStatement(cx *functionContext, stmt *ast.BLangMatchStatement, initStmts []ast.StatementNode) desugaredNode[ast.StatementNode] {
+	pos := stmt.GetPosition()
+	valueDef, valueRef := assignToLocal(cx, stmt.Expr.(ast.BLangExpression), pos)
+	stmts := append(initStmts, valueDef)
+	value := func(ty semtypes.SemType) ast.BLangExpression {
+		ref := createVarRef(&ast.BLangIdentifier{Value: valueRef.VariableName.Value}, valueRef.Symbol(), ty)
+		setPositionIfMissing(ref, pos)
+		return ref
+	}
+	doneDef, done := assignToLocal(cx, createBoolLiteral(false, pos), pos)
+	stmts = append(stmts, doneDef)
+	for i := range stmt.MatchClauses {
+		stmts = append(stmts, matchClauseStmts(cx, &stmt.MatchClauses[i], value, valueRef.GetDeterminedType(), done, i == 0))
+	}
+	return desugaredNode[ast.StatementNode]{
+		initStmts:       stmts[:len(stmts)-1],
+		replacementNode: stmts[len(stmts)-1],
+	}
+}
+
+func matchClauseStmts(cx *functionContext, clause *ast.BLangMatchClause, value matchSource, valueTy semtypes.SemType, done *ast.BLangSimpleVarRef, isFirst bool) ast.StatementNode {
+	pos := clause.GetPosition()
+	cx.pushScope(clause.Scope())
+	defer cx.popScope()
+	var notDone ast.BLangExpression
+	if !isFirst {
+		notDone = createNotExpr(createMatchVarRef(done, pos), pos)
+	}
+
+	if len(clause.Patterns) == 1 {
+		pattern := clause.Patterns[0]
+		test := matchPatternTest(cx, pattern, value, valueTy, pos)
+		var stmts []ast.StatementNode
+		for _, binding := range matchPatternBindings(cx, pattern, value, valueTy, pos) {
+			binding.variable.Expr = binding.expr
+			stmts = append(stmts, createBindingVarDef(binding.variable, pos))
+		}
+		stmts = append(stmts, matchClauseBody(cx, clause, done, pos)...)
+		cond := createConjunction([]ast.BLangExpression{notDone, test}, pos)
+		if cond == nil {
+			block := &ast.BLangBlockStmt{Stmts: stmts}
+			setPositionIfMissing(block, pos)
+			return block
+		}
+		return createMatchIf(cx, cond, stmts, pos)
+	}
+
+	var stmts []ast.StatementNode
+	for _, variable := range ast.MatchPatternVariables(clause.Patterns[0]) {
+		stmts = append(stmts, createBindingVarDef(variable, pos))
+	}
+	matchedDef, matched := assignToLocal(cx, createBoolLiteral(false, pos), pos)
+	stmts = append(stmts, matchedDef)
+	for i, pattern := range clause.Patterns {
+		var notMatched ast.BLangExpression
+		if i > 0 {
+			notMatched = createNotExpr(createMatchVarRef(matched, pos), pos)
+		}
+		test := matchPatternTest(cx, pattern, value, valueTy, pos)
+		var patternStmts []ast.StatementNode
+		for _, binding := range matchPatternBindings(cx, pattern, value, valueTy, pos) {
+			variable := binding.variable
+			target := createVarRef(&ast.BLangIdentifier{Value: variable.Name.Value}, variable.Symbol(), variable.GetDeterminedType())
+			patternStmts = append(patternStmts, createMatchAssignment(target, binding.expr, pos))
+		}
+		patternStmts = append(patternStmts, createMatchAssignment(createMatchVarRef(matched, pos), createBoolLiteral(true, pos), pos))
+		cond := createConjunction([]ast.BLangExpression{notMatched, test}, pos)
+		if cond == nil {
+			cond = createBoolLiteral(true, pos)
+		}
+		stmts = append(stmts, createMatchIf(cx, cond, patternStmts, pos))
+	}
+	stmts = append(stmts, createMatchIf(cx, createMatchVarRef(matched, pos), matchClauseBody(cx, clause, done, pos), pos))
+	if notDone == nil {
+		block := &ast.BLangBlockStmt{Stmts: stmts}
+		setPositionIfMissing(block, pos)
+		return block
+	}
+	return createMatchIf(cx, notDone, stmts, pos)
+}
+
+// matchClauseBody returns the statements run once a match pattern of clause has matched: the body of the clause,
+// guarded by its match guard if it has one.
+func matchClauseBody(cx *functionContext, clause *ast.BLangMatchClause, done *ast.BLangSimpleVarRef, pos diagnostics.Location) []ast.StatementNode {
+	var guard desugaredNode[ast.BLangActionOrExpression]
+	if clause.Guard != nil {
+		guard = walkExpression(cx, clause.Guard)
+	}
+	bodyResult := walkBlockStmt(cx, &clause.Body)
+	body := []ast.StatementNode{
+		createMatchAssignment(createMatchVarRef(done, pos), createBoolLiteral(true, pos), pos),
+		bodyResult.replacementNode,
+	}
+	if clause.Guard == nil {
+		return body
+	}
+	return append(guard.initStmts, createMatchIf(cx, guard.replacementNode.(ast.BLangExpression), body, pos))
+}
+
+// matchPatternNarrowedType returns the type of the values of type ty that may match a list, mapping or error
+// match pattern, as tested by matchPatternTest.
+func matchPatternNarrowedType(pattern ast.BLangMatchPattern, ty semtypes.SemType) semtypes.SemType {
+	switch p := pattern.(type) {
+	case *ast.BLangListMatchPattern:
+		return semtypes.Intersect(ty, semtypes.LIST)
+	case *ast.BLangMappingMatchPattern:
+		return semtypes.Intersect(ty, semtypes.MAPPING)
+	case *ast.BLangErrorMatchPattern:
+		return semtypes.Intersect(ty, errorMatchPatternType(p))
+	default:
+		return ty
+	}
+}
+
+func errorMatchPatternType(p *ast.BLangErrorMatchPattern) semtypes.SemType {
+	if p.ErrorTypeReference == nil {
+		return semtypes.ERROR
+	}
+	return p.ErrorTypeReference.GetDeterminedType()
+}
+
+// matchPatternTest returns an expression testing whether the value of src, of type ty, matches pattern, or nil if
+// every value of type ty matches it.
+func matchPatternTest(cx *functionContext, pattern ast.BLangMatchPattern, src matchSource, ty semtypes.SemType, pos diagnostics.Location) ast.BLangExpression {
+	tyCx := cx.typeCtx()
+	switch p := pattern.(type) {
+	case *ast.BLangConstPattern:
+		result := walkExpression(cx, p.Expr)
+		if len(result.initStmts) > 0 {
+			cx.internalError("const match pattern expression desugared to statements")
+		}
+		return createMatchBinaryExpr(src(ty), result.replacementNode.(ast.BLangExpression), model.OperatorKind_EQUAL, pos)
+	case *ast.BLangWildCardMatchPattern:
+		if semtypes.IsEmpty(tyCx, semtypes.Intersect(ty, semtypes.ERROR)) {
+			return nil
+		}
+		return createNotExpr(createMatchTypeTest(src(ty), semtypes.ERROR, pos), pos)
+	case *ast.BLangCaptureMatchPattern:
+		return nil
+	case *ast.BLangListMatchPattern:
+		listTy := matchPatternNarrowedType(p, ty)
+		var conds []ast.BLangExpression
+		if !semtypes.IsSubtype(tyCx, ty, semtypes.LIST) {
+			conds = append(conds, createMatchTypeTest(src(ty), semtypes.LIST, pos))
+		}
+		lengthOp := model.OperatorKind_EQUAL
+		if p.RestMatchPattern != nil {
+			lengthOp = model.OperatorKind_GREATER_EQUAL
+		}
+		length := createLengthInvocation(cx, src(listTy))
+		conds = append(conds, createMatchBinaryExpr(length, createIntLiteral(int64(len(p.MatchPatterns))), lengthOp, pos))
+		for i, member := range p.MatchPatterns {
+			memberTy := semtypes.ListMemberTypeInnerVal(tyCx, listTy, semtypes.IntConst(int64(i)))
+			conds = append(conds, matchPatternTest(cx, member, listMemberSource(src, listTy, i, pos), memberTy, pos))
+		}
+		return createConjunction(conds, pos)
+	case *ast.BLangMappingMatchPattern:
+		mappingTy := matchPatternNarrowedType(p, ty)
+		var conds []ast.BLangExpression
+		if !semtypes.IsSubtype(tyCx, ty, semtypes.MAPPING) {
+			conds = append(conds, createMatchTypeTest(src(ty), semtypes.MAPPING, pos))
+		}
+		for _, field := range p.FieldMatchPatterns {
+			key := field.FieldName.Value
+			conds = append(conds, createHasKeyInvocation(cx, src(mappingTy), key, pos))
+			fieldTy := semtypes.Diff(semtypes.MappingMemberTypeInnerVal(tyCx, mappingTy, semtypes.StringConst(key)), semtypes.UNDEF)
+			conds = append(conds, matchPatternTest(cx, field.MatchPattern, mappingMemberSource(src, mappingTy, key, pos), fieldTy, pos))
+		}
+		return createConjunction(conds, pos)
+	case *ast.BLangErrorMatchPattern:
+		errorTy := matchPatternNarrowedType(p, ty)
+		var conds []ast.BLangExpression
+		if !semtypes.IsSubtype(tyCx, ty, errorMatchPatternType(p)) {
+			conds = append(conds, createMatchTypeTest(src(ty), errorMatchPatternType(p), pos))
+		}
+		if p.MessageMatchPattern != nil {
+			conds = append(conds, matchPatternTest(cx, p.MessageMatchPattern, errorPartSource(cx, src, errorTy, "errorMessage", pos), semtypes.STRING, pos))
+		}
+		if p.CauseMatchPattern != nil {
+			causeTy := semtypes.Union(semtypes.ERROR, semtypes.NIL)
+			conds = append(conds, matchPatternTest(cx, p.CauseMatchPattern, errorPartSource(cx, src, errorTy, "errorCause", pos), causeTy, pos))
+		}
+		detail := errorPartSource(cx, src, errorTy, "errorDetail", pos)
+		for _, field := range p.FieldMatchPatterns {
+			key := field.ArgName.Value
+			conds = append(conds, createHasKeyInvocation(cx, detail(semtypes.MAPPING), key, pos))
+			conds = append(conds, matchPatternTest(cx, field.MatchPattern, mappingMemberSource(detail, semtypes.MAPPING, key, pos), semtypes.VAL, pos))
+		}
+		return createConjunction(conds, pos)
+	default:
+		cx.internalError("unexpected match pattern type")
+		return nil
+	}
+}
+
+type matchPatternBinding struct {
+	variable *ast.BLangSimpleVariable
+	expr     ast.BLangExpression
+}
+
+// matchPatternBindings returns the values bound to the variables captured by pattern, given src, the value of
+// type ty it matched.
+func matchPatternBindings(cx *functionContext, pattern ast.BLangMatchPattern, src matchSource, ty semtypes.SemType, pos diagnostics.Location) []matchPatternBinding {
+	tyCx := cx.typeCtx()
+	var bindings []matchPatternBinding
+	bind := func(variable *ast.BLangSimpleVariable, expr ast.BLangExpression) {
+		bindings = append(bindings, matchPatternBinding{variable: variable, expr: expr})
+	}
+	switch p := pattern.(type) {
+	case *ast.BLangCaptureMatchPattern:
+		bind(p.Variable, src(p.Variable.GetDeterminedType()))
+	case *ast.BLangListMatchPattern:
+		listTy := matchPatternNarrowedType(p, ty)
+		for i, member := range p.MatchPatterns {
+			memberTy := semtypes.ListMemberTypeInnerVal(tyCx, listTy, semtypes.IntConst(int64(i)))
+			bindings = append(bindings, matchPatternBindings(cx, member, listMemberSource(src, listTy, i, pos), memberTy, pos)...)
+		}
+		if rest := p.RestMatchPattern; rest != nil {
+			bind(rest.Variable, createListRestInvocation(cx, src(listTy), len(p.MatchPatterns), rest.Variable.GetDeterminedType(), pos))
+		}
+	case *ast.BLangMappingMatchPattern:
+		mappingTy := matchPatternNarrowedType(p, ty)
+		keys := make([]string, len(p.FieldMatchPatterns))
+		for i, field := range p.FieldMatchPatterns {
+			keys[i] = field.FieldName.Value
+			fieldTy := semtypes.Diff(semtypes.MappingMemberTypeInnerVal(tyCx, mappingTy, semtypes.StringConst(keys[i])), semtypes.UNDEF)
+			bindings = append(bindings, matchPatternBindings(cx, field.MatchPattern, mappingMemberSource(src, mappingTy, keys[i], pos), fieldTy, pos)...)
+		}
+		if rest := p.RestMatchPattern; rest != nil {
+			bind(rest.Variable, createMappingRestInvocation(cx, src(mappingTy), keys, rest.Variable.GetDeterminedType(), pos))
+		}
+	case *ast.BLangErrorMatchPattern:
+		errorTy := matchPatternNarrowedType(p, ty)
+		if p.MessageMatchPattern != nil {
+			bindings = append(bindings, matchPatternBindings(cx, p.MessageMatchPattern, errorPartSource(cx, src, errorTy, "errorMessage", pos), semtypes.STRING, pos)...)
+		}
+		if p.CauseMatchPattern != nil {
+			causeTy := semtypes.Union(semtypes.ERROR, semtypes.NIL)
+			bindings = append(bindings, matchPatternBindings(cx, p.CauseMatchPattern, errorPartSource(cx, src, errorTy, "errorCause", pos), causeTy, pos)...)
+		}
+		detail := errorPartSource(cx, src, errorTy, "errorDetail", pos)
+		keys := make([]string, len(p.FieldMatchPatterns))
+		for i, field := range p.FieldMatchPatterns {
+			keys[i] = field.ArgName.Value
+			bindings = append(bindings, matchPatternBindings(cx, field.MatchPattern, mappingMemberSource(detail, semtypes.MAPPING, keys[i], pos), semtypes.VAL, pos)...)
+		}
+		if rest := p.RestMatchPattern; rest != nil {
+			bind(rest.Variable, createMappingRestInvocation(cx, detail(semtypes.MAPPING), keys, rest.Variable.GetDeterminedType(), pos))
+		}
+	}
+	return bindings
+}
+
+func listMemberSource(src matchSource, listTy semtypes.SemType, index int, pos diagnostics.Location) matchSource {
+	return func(ty semtypes.SemType) ast.BLangExpression {
+		return createListMemberAccess(src(listTy), index, ty, pos)
+	}
+}
+
+func mappingMemberSource(src matchSource, mappingTy semtypes.SemType, key string, pos diagnostics.Location) matchSource {
+	return func(ty semtypes.SemType) ast.BLangExpression {
+		return createMappingMemberAccess(src(mappingTy), key, ty, pos)
+	}
+}
+
+// errorPartSource returns the source of the message, cause or detail of an error, given by the lang.__internal
+// function name.
+func errorPartSource(cx *functionContext, src matchSource, errorTy semtypes.SemType, name string, pos diagnostics.Location) matchSource {
+	return func(ty semtypes.SemType) ast.BLangExpression {
+		return createLangInternalInvocation(cx, name, ty, []ast.BLangExpression{src(errorTy)}, pos)
+	}
+}
+
+func createHasKeyInvocation(cx *functionContext, mapping ast.BLangExpression, key string, pos diagnostics.Location) *ast.BLangInvocation {
+	args := []ast.BLangExpression{mapping, createStringLiteral(key, pos)}
+	return createLangInternalInvocation(cx, "hasKey", semtypes.BOOLEAN, args, pos)
+}
+
+// createConjunction returns the conjunction of the non-nil conds, or nil if there are none.
+func createConjunction(conds []ast.BLangExpression, pos diagnostics.Location) ast.BLangExpression {
+	var result ast.BLangExpression
+	for _, cond := range conds {
+		if cond == nil {
+			continue
+		}
+		if result == nil {
+			result = cond
+			continue
+		}
+		result = createMatchBinaryExpr(result, cond, model.OperatorKind_AND, pos)
+	}
+	return result
+}
+
+func createMatchBinaryExpr(lhs, rhs ast.BLangExpression, opKind model.OperatorKind, pos diagnostics.Location) *ast.BLangBinaryExpr {
+	expr := &ast.BLangBinaryExpr{
+		LhsExpr: lhs,
+		RhsExpr: rhs,
+		OpKind:  opKind,
+	}
+	expr.SetDeterminedType(semtypes.BOOLEAN)
+	setPositionIfMissing(expr, pos)
+	return expr
+}
+
+func createNotExpr(expr ast.BLangExpression, pos diagnostics.Location) *ast.BLangUnaryExpr {
+	notExpr := &ast.BLangUnaryExpr{
+		Expr:     expr,
+		Operator: model.OperatorKind_NOT,
+	}
+	notExpr.SetDeterminedType(semtypes.BOOLEAN)
+	setPositionIfMissing(notExpr, pos)
+	return notExpr
+}
+
+func createMatchTypeTest(expr ast.BLangExpression, ty semtypes.SemType, pos diagnostics.Location) *ast.BLangTypeTestExpr {
+	typeTest := &ast.BLangTypeTestExpr{
+		Expr: expr,
+		Type: ast.TypeData{Type: ty},
+	}
+	typeTest.SetDeterminedType(semtypes.BOOLEAN)
+	setPositionIfMissing(typeTest, pos)
+	return typeTest
+}
+
+func createMatchVarRef(ref *ast.BLangSimpleVarRef, pos diagnostics.Location) *ast.BLangSimpleVarRef {
+	newRef := createVarRef(&ast.BLangIdentifier{Value: ref.VariableName.Value}, ref.Symbol(), ref.GetDeterminedType())
+	setPositionIfMissing(newRef, pos)
+	return newRef
+}
+
+func createMatchAssignment(target *ast.BLangSimpleVarRef, expr ast.BLangExpression, pos diagnostics.Location) *ast.BLangAssignment {
+	assignment := &ast.BLangAssignment{VarRef: target, Expr: expr}
+	assignment.SetDeterminedType(semtypes.NEVER)
+	setPositionIfMissing(assignment, pos)
+	return assignment
+}
+
+func createMatchIf(cx *functionContext, cond ast.BLangExpression, stmts []ast.StatementNode, pos diagnostics.Location) *ast.BLangIf {
+	ifStmt := &ast.BLangIf{
+		Expr: cond,
+		Body: ast.BLangBlockStmt{Stmts: stmts},
+	}
+	ifStmt.SetScope(cx.currentScope())
+	ifStmt.SetDeterminedType(semtypes.NEVER)
+	setPositionIfMissing(ifStmt, pos)
+	return ifStmt
+}
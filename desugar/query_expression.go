@@ -28,6 +28,11 @@ import (
 )
 
 func walkQueryExpr(cx *functionContext, expr *ast.BLangQueryExpr) desugaredNode[ast.BLangActionOrExpression] {
+	for _, clause := range expr.QueryClauseList {
+		if letClause, ok := clause.(*ast.BLangLetClause); ok {
+			letClause.LetVarDeclarations = expandBindingPatternVarDefs(cx, letClause.LetVarDeclarations)
+		}
+	}
 	fromClause := expr.QueryClauseList[0].(*ast.BLangFromClause)
 
 	finalClauseIndex := len(expr.QueryClauseList) - 1
@@ -41,55 +46,28 @@ func walkQueryExpr(cx *functionContext, expr *ast.BLangQueryExpr) desugaredNode[
 		selectClause  *ast.BLangSelectClause
 		collectClause *ast.BLangCollectClause
 	)
-	if clause, ok := expr.QueryClauseList[finalClauseIndex].(*ast.BLangSelectClause); ok {
+	// A do clause has neither; its body takes the place of adding to the result.
+	switch clause := expr.QueryClauseList[finalClauseIndex].(type) {
+	case *ast.BLangSelectClause:
 		selectClause = clause
-	} else {
-		collectClause = expr.QueryClauseList[finalClauseIndex].(*ast.BLangCollectClause)
+	case *ast.BLangCollectClause:
+		collectClause = clause
 	}
 	if collectClause != nil || queryExprNeedsRowPipeline(expr, 1, finalClauseIndex) {
 		return walkQueryExprWithRows(cx, expr, fromClause, selectClause, collectClause, finalClauseIndex, onConflictClause)
 	}
 	orderByClauseIndices := queryOrderByClauseIndices(expr, 1, finalClauseIndex)
 
-	queryTy := expr.GetDeterminedType()
 	basePos := expr.GetPosition()
 
 	var initStmts []ast.StatementNode
-	collRef, keysRef, lenRef, _, ok := createQueryCollectionSource(cx, &initStmts, fromClause.Collection, basePos)
+	errRef := createQueryCompletionErrorStore(cx, expr, &initStmts, basePos)
+	collRef, keysRef, lenRef, _, ok := createQueryCollectionSource(cx, &initStmts, &fromClause.BLangInputClause, errRef, basePos)
 	if !ok {
 		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
 	}
 
-	resultName, resultSymbol := cx.addDesugardSymbol(queryTy, model.SymbolKindVariable, false)
-	resultVar := &ast.BLangSimpleVariable{
-		Name: &ast.BLangIdentifier{Value: resultName},
-	}
-	resultVar.SetDeterminedType(queryTy)
-	switch expr.QueryConstructType {
-	case ast.TypeKind_MAP:
-		emptyMap := &ast.BLangMappingConstructorExpr{
-			Fields: []ast.MappingField{},
-		}
-		emptyMap.SetDeterminedType(semtypes.Intersect(queryTy, semtypes.MAPPING))
-		resultVar.SetInitialExpression(emptyMap)
-	default:
-		emptyList := &ast.BLangListConstructorExpr{
-			Exprs: []ast.BLangExpression{},
-		}
-		emptyList.SetDeterminedType(semtypes.LIST)
-		emptyList.AtomicType = semtypes.LIST_ATOMIC_INNER
-		resultVar.SetInitialExpression(emptyList)
-	}
-	resultVar.SetSymbol(resultSymbol)
-	resultVarDef := &ast.BLangSimpleVariableDef{Var: resultVar}
-	setPositionIfMissing(resultVarDef, basePos)
-	initStmts = append(initStmts, resultVarDef)
-
-	resultRef := &ast.BLangSimpleVarRef{
-		VariableName: resultVar.Name,
-	}
-	resultRef.SetSymbol(resultSymbol)
-	resultRef.SetDeterminedType(queryTy)
+	storeRef := createQueryResultStore(cx, expr, &initStmts, basePos)
 
 	var seenKeysRef *ast.BLangSimpleVarRef
 	if onConflictClause != nil && expr.QueryConstructType == ast.TypeKind_MAP {
@@ -132,7 +110,7 @@ func walkQueryExpr(cx *functionContext, expr *ast.BLangQueryExpr) desugaredNode[
 		stageStart,
 		finalClauseIndex,
 		stageInput,
-		resultRef,
+		storeRef,
 		selectClause,
 		onConflictClause,
 		seenKeysRef,
@@ -143,6 +121,10 @@ func walkQueryExpr(cx *functionContext, expr *ast.BLangQueryExpr) desugaredNode[
 		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
 	}
 
+	resultRef, ok := createQueryResult(cx, expr, storeRef, errRef, &initStmts, basePos)
+	if !ok {
+		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
+	}
 	setPositionIfMissing(resultRef, basePos)
 	return desugaredNode[ast.BLangActionOrExpression]{
 		initStmts:       initStmts,
@@ -188,13 +170,18 @@ type queryOrderStageInput struct {
 	payloadStores []queryLetStore
 }
 
+// createQueryCollectionSource evaluates the collection of an input clause. A
+// list or mapping is iterated directly; the members of any other collection
+// are first gathered into a list. When gathering them completes with an
+// error, the error is stored in errRef.
 func createQueryCollectionSource(
 	cx *functionContext,
 	initStmts *[]ast.StatementNode,
-	collectionExpr ast.BLangExpression,
+	clause *ast.BLangInputClause,
+	errRef *ast.BLangSimpleVarRef,
 	pos diagnostics.Location,
 ) (*ast.BLangSimpleVarRef, *ast.BLangSimpleVarRef, *ast.BLangSimpleVarRef, semtypes.SemType, bool) {
-	collResult := walkExpression(cx, collectionExpr)
+	collResult := walkExpression(cx, clause.Collection)
 	*initStmts = append(*initStmts, collResult.initStmts...)
 	collExpr := collResult.replacementNode.(ast.BLangExpression)
 	collTy := collExpr.GetDeterminedType()
@@ -216,9 +203,16 @@ func createQueryCollectionSource(
 		*initStmts = append(*initStmts, keysVarDef)
 		keysRef = keysLocalRef
 		lengthSource = keysRef
+	case semtypes.IsSubtype(tyCtx, collTy, semtypes.TABLE):
+		rowsVarDef, rowsRef := assignToLocal(cx, createTableToArrayInvocation(cx, collRef), pos)
+		*initStmts = append(*initStmts, rowsVarDef)
+		collRef = rowsRef
+		collTy = rowsRef.GetDeterminedType()
+		lengthSource = collRef
 	default:
-		cx.internalError("query collection type should have been validated during type resolution")
-		return nil, nil, nil, semtypes.SemType{}, false
+		collRef = appendQueryMembersStmts(cx, initStmts, clause, collRef, errRef, pos)
+		collTy = collRef.GetDeterminedType()
+		lengthSource = collRef
 	}
 
 	lenRef, ok := createQueryLengthRef(cx, initStmts, lengthSource, pos)
@@ -237,42 +231,16 @@ func walkQueryExprWithRows(
 	finalClauseIndex int,
 	onConflictClause *ast.BLangOnConflictClause,
 ) desugaredNode[ast.BLangActionOrExpression] {
-	queryTy := expr.GetDeterminedType()
 	basePos := expr.GetPosition()
 	var initStmts []ast.StatementNode
 
-	resultName, resultSymbol := cx.addDesugardSymbol(queryTy, model.SymbolKindVariable, false)
-	resultVar := &ast.BLangSimpleVariable{
-		Name: &ast.BLangIdentifier{Value: resultName},
-	}
-	resultVar.SetDeterminedType(queryTy)
+	var storeRef *ast.BLangSimpleVarRef
 	if collectClause == nil {
-		switch expr.QueryConstructType {
-		case ast.TypeKind_MAP:
-			emptyMap := &ast.BLangMappingConstructorExpr{
-				Fields: []ast.MappingField{},
-			}
-			emptyMap.SetDeterminedType(semtypes.Intersect(queryTy, semtypes.MAPPING))
-			resultVar.SetInitialExpression(emptyMap)
-		default:
-			emptyList := &ast.BLangListConstructorExpr{
-				Exprs: []ast.BLangExpression{},
-			}
-			emptyList.SetDeterminedType(semtypes.LIST)
-			emptyList.AtomicType = semtypes.LIST_ATOMIC_INNER
-			resultVar.SetInitialExpression(emptyList)
-		}
-	}
-	resultVar.SetSymbol(resultSymbol)
-	resultVarDef := &ast.BLangSimpleVariableDef{Var: resultVar}
-	setPositionIfMissing(resultVarDef, basePos)
-	initStmts = append(initStmts, resultVarDef)
-
-	resultRef := &ast.BLangSimpleVarRef{
-		VariableName: resultVar.Name,
+		storeRef = createQueryResultStore(cx, expr, &initStmts, basePos)
+	} else {
+		storeRef = declareQueryResult(cx, expr.GetDeterminedType(), nil, &initStmts, basePos)
 	}
-	resultRef.SetSymbol(resultSymbol)
-	resultRef.SetDeterminedType(queryTy)
+	errRef := createQueryCompletionErrorStore(cx, expr, &initStmts, basePos)
 
 	var seenKeysRef *ast.BLangSimpleVarRef
 	if onConflictClause != nil && expr.QueryConstructType == ast.TypeKind_MAP {
@@ -280,7 +248,7 @@ func walkQueryExprWithRows(
 	}
 
 	rowsRef := createQueryListStore(cx, &initStmts, basePos)
-	bindings, ok := appendInitialQueryRows(cx, rowsRef, fromClause, &initStmts, basePos)
+	bindings, ok := appendInitialQueryRows(cx, rowsRef, fromClause, errRef, &initStmts, basePos)
 	if !ok {
 		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
 	}
@@ -288,7 +256,7 @@ func walkQueryExprWithRows(
 	for i := 1; i < finalClauseIndex; i++ {
 		switch clause := expr.QueryClauseList[i].(type) {
 		case *ast.BLangJoinClause:
-			bindings, rowsRef, ok = appendQueryJoinClauseRows(cx, rowsRef, bindings, clause, basePos, &initStmts)
+			bindings, rowsRef, ok = appendQueryJoinClauseRows(cx, rowsRef, bindings, clause, errRef, basePos, &initStmts)
 		case *ast.BLangLetClause:
 			bindings, ok = applyQueryLetClauseToRows(cx, rowsRef, bindings, clause, basePos, &initStmts)
 		case *ast.BLangWhereClause:
@@ -308,13 +276,13 @@ func walkQueryExprWithRows(
 		}
 	}
 
-	if selectClause != nil {
+	if collectClause == nil {
 		ok = appendQueryRowsSelectResultStmts(
 			cx,
 			rowsRef,
 			bindings,
 			expr,
-			resultRef,
+			storeRef,
 			selectClause,
 			onConflictClause,
 			seenKeysRef,
@@ -326,7 +294,7 @@ func walkQueryExprWithRows(
 			cx,
 			rowsRef,
 			bindings,
-			resultRef,
+			storeRef,
 			collectClause,
 			basePos,
 			&initStmts,
@@ -336,6 +304,10 @@ func walkQueryExprWithRows(
 		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
 	}
 
+	resultRef, ok := createQueryResult(cx, expr, storeRef, errRef, &initStmts, basePos)
+	if !ok {
+		return desugaredNode[ast.BLangActionOrExpression]{replacementNode: expr}
+	}
 	setPositionIfMissing(resultRef, basePos)
 	return desugaredNode[ast.BLangActionOrExpression]{
 		initStmts:       initStmts,
@@ -347,6 +319,7 @@ func appendInitialQueryRows(
 	cx *functionContext,
 	rowsRef *ast.BLangSimpleVarRef,
 	fromClause *ast.BLangFromClause,
+	errRef *ast.BLangSimpleVarRef,
 	initStmts *[]ast.StatementNode,
 	pos diagnostics.Location,
 ) ([]queryRowBinding, bool) {
@@ -355,7 +328,7 @@ func appendInitialQueryRows(
 		return nil, false
 	}
 	*initStmts = append(*initStmts, createQueryBindingDeclaration(loopBinding, pos))
-	collRef, keysRef, rowCountRef, _, ok := createQueryCollectionSource(cx, initStmts, fromClause.Collection, pos)
+	collRef, keysRef, rowCountRef, _, ok := createQueryCollectionSource(cx, initStmts, &fromClause.BLangInputClause, errRef, pos)
 	if !ok {
 		return nil, false
 	}
@@ -535,8 +508,8 @@ func applyQueryLetClauseToRows(
 	bodyStmts = appendQueryRowRestoreStmts(bodyStmts, rowRef, bindings, pos)
 
 	newBindings := append([]queryRowBinding{}, bindings...)
-	for i := range clause.LetVarDeclarations {
-		varDef := &clause.LetVarDeclarations[i]
+	for _, decl := range clause.LetVarDeclarations {
+		varDef := decl.(*ast.BLangSimpleVariableDef)
 		if varDef.Var == nil || varDef.Var.Expr == nil {
 			cx.internalError("query let clause bindings should have been validated during type resolution")
 			return nil, false
@@ -937,6 +910,7 @@ func appendQueryJoinClauseRows(
 	rowsRef *ast.BLangSimpleVarRef,
 	bindings []queryRowBinding,
 	clause *ast.BLangJoinClause,
+	errRef *ast.BLangSimpleVarRef,
 	pos diagnostics.Location,
 	initStmts *[]ast.StatementNode,
 ) ([]queryRowBinding, *ast.BLangSimpleVarRef, bool) {
@@ -946,6 +920,12 @@ func appendQueryJoinClauseRows(
 	}
 	*initStmts = append(*initStmts, createQueryBindingDeclaration(joinBinding, pos))
 
+	// The join collection is evaluated once, not once per outer row.
+	joinCollRef, joinKeysRef, joinRowCountRef, _, ok := createQueryCollectionSource(cx, initStmts, &clause.BLangInputClause, errRef, pos)
+	if !ok {
+		return nil, nil, false
+	}
+
 	newRowsRef := createQueryListStore(cx, initStmts, pos)
 	rowCountRef, ok := createQueryLengthRef(cx, initStmts, rowsRef, pos)
 	if !ok {
@@ -971,13 +951,6 @@ func appendQueryJoinClauseRows(
 		matchedRef = matchedLocalRef
 	}
 
-	var joinSetup []ast.StatementNode
-	joinCollRef, joinKeysRef, joinRowCountRef, _, ok := createQueryCollectionSource(cx, &joinSetup, clause.Collection, pos)
-	if !ok {
-		return nil, nil, false
-	}
-	outerBody = appendModelStatements(outerBody, joinSetup)
-
 	var innerSetup []ast.StatementNode
 	innerCounterRef := createQueryCounterRef(cx, &innerSetup, pos)
 	outerBody = appendModelStatements(outerBody, innerSetup)
@@ -1499,6 +1472,220 @@ func createQueryMapStore(
 	return mapRef
 }
 
+// declareQueryResult declares a local of type ty holding initExpr, or no
+// initial value when initExpr is nil.
+func declareQueryResult(
+	cx *functionContext,
+	ty semtypes.SemType,
+	initExpr ast.BLangExpression,
+	initStmts *[]ast.StatementNode,
+	pos diagnostics.Location,
+) *ast.BLangSimpleVarRef {
+	resultName, resultSymbol := cx.addDesugardSymbol(ty, model.SymbolKindVariable, false)
+	resultVar := &ast.BLangSimpleVariable{
+		Name: &ast.BLangIdentifier{Value: resultName},
+	}
+	resultVar.SetDeterminedType(ty)
+	if initExpr != nil {
+		resultVar.SetInitialExpression(initExpr)
+	}
+	resultVar.SetSymbol(resultSymbol)
+	resultVarDef := &ast.BLangSimpleVariableDef{Var: resultVar}
+	setPositionIfMissing(resultVarDef, pos)
+	*initStmts = append(*initStmts, resultVarDef)
+
+	resultRef := &ast.BLangSimpleVarRef{
+		VariableName: resultVar.Name,
+	}
+	resultRef.SetSymbol(resultSymbol)
+	resultRef.SetDeterminedType(ty)
+	return resultRef
+}
+
+// createQueryResultStore declares the value each selected member is added to.
+// For a map, table or list this is the result itself; the members of a stream,
+// string or xml are gathered into a list and converted by createQueryResult.
+func createQueryResultStore(
+	cx *functionContext,
+	queryExpr *ast.BLangQueryExpr,
+	initStmts *[]ast.StatementNode,
+	pos diagnostics.Location,
+) *ast.BLangSimpleVarRef {
+	queryTy := queryExpr.GetDeterminedType()
+	var initExpr ast.BLangExpression
+	switch queryExpr.QueryConstructType {
+	case ast.TypeKind_MAP:
+		emptyMap := &ast.BLangMappingConstructorExpr{
+			Fields: []ast.MappingField{},
+		}
+		emptyMap.SetDeterminedType(semtypes.Intersect(queryTy, semtypes.MAPPING))
+		initExpr = emptyMap
+	case ast.TypeKind_TABLE:
+		emptyTable := &ast.BLangTableConstructorExpr{}
+		emptyTable.SetDeterminedType(semtypes.Intersect(queryTy, semtypes.TABLE))
+		setPositionIfMissing(emptyTable, pos)
+		initExpr = emptyTable
+	case ast.TypeKind_STREAM, ast.TypeKind_STRING, ast.TypeKind_XML:
+		return createQueryListStore(cx, initStmts, pos)
+	default:
+		if isQueryAction(queryExpr) {
+			initExpr = createQueryNilLiteral(pos)
+			break
+		}
+		emptyList := &ast.BLangListConstructorExpr{
+			Exprs: []ast.BLangExpression{},
+		}
+		emptyList.SetDeterminedType(semtypes.LIST)
+		emptyList.AtomicType = semtypes.LIST_ATOMIC_INNER
+		initExpr = emptyList
+	}
+	return declareQueryResult(cx, queryTy, initExpr, initStmts, pos)
+}
+
+// createQueryResult converts the store the selected members were added to
+// into the result of the query. An error the input collections completed with
+// replaces the result, except for a stream, which completes with it.
+func createQueryResult(
+	cx *functionContext,
+	queryExpr *ast.BLangQueryExpr,
+	storeRef *ast.BLangSimpleVarRef,
+	errRef *ast.BLangSimpleVarRef,
+	initStmts *[]ast.StatementNode,
+	pos diagnostics.Location,
+) (*ast.BLangSimpleVarRef, bool) {
+	queryTy := queryExpr.GetDeterminedType()
+	var convertName string
+	switch queryExpr.QueryConstructType {
+	case ast.TypeKind_STREAM:
+		valueTy := semtypes.StreamValueType(cx.typeCtx(), queryTy)
+		md := semtypes.NewMappingDefinition()
+		recordTy := md.DefineMappingTypeWrapped(cx.typeEnv(),
+			[]semtypes.Field{semtypes.FieldFrom("value", valueTy, false, false)}, semtypes.NEVER)
+		var completionExpr ast.BLangExpression = createQueryNilLiteral(pos)
+		if errRef != nil {
+			completionExpr = createQueryVarRefAt(errRef, pos)
+		}
+		args := []ast.BLangExpression{
+			createTypedescExpr(cx, queryTy, pos),
+			createTypedescExpr(cx, recordTy, pos),
+			storeRef,
+			completionExpr,
+		}
+		streamInvocation := createLangInternalInvocation(cx, "queryStream", queryTy, args, pos)
+		return declareQueryResult(cx, queryTy, streamInvocation, initStmts, pos), true
+	case ast.TypeKind_STRING:
+		convertName = "queryString"
+	case ast.TypeKind_XML:
+		convertName = "queryXML"
+	}
+	resultRef := storeRef
+	if convertName != "" {
+		convertTy := semtypes.Diff(queryTy, semtypes.ERROR)
+		convertInvocation := createLangInternalInvocation(cx, convertName, convertTy,
+			[]ast.BLangExpression{storeRef}, pos)
+		resultRef = declareQueryResult(cx, queryTy, convertInvocation, initStmts, pos)
+	}
+	if errRef == nil {
+		return resultRef, true
+	}
+
+	isErrorExpr := &ast.BLangTypeTestExpr{}
+	isErrorExpr.Expr = createQueryVarRefAt(errRef, pos)
+	isErrorExpr.Type = ast.TypeData{Type: semtypes.ERROR}
+	isErrorExpr.SetDeterminedType(semtypes.BOOLEAN)
+	assignResult := &ast.BLangAssignment{
+		VarRef: createQueryVarRefAt(resultRef, pos),
+		Expr:   createQueryVarRefAt(errRef, pos),
+	}
+	assignResult.SetDeterminedType(semtypes.NEVER)
+	setPositionIfMissing(assignResult, pos)
+	errorIf := &ast.BLangIf{
+		Expr: isErrorExpr,
+		Body: ast.BLangBlockStmt{Stmts: []ast.StatementNode{assignResult}},
+	}
+	errorIf.SetScope(cx.currentScope())
+	errorIf.SetDeterminedType(semtypes.NEVER)
+	setPositionIfMissing(errorIf, pos)
+	*initStmts = append(*initStmts, errorIf)
+	return resultRef, true
+}
+
+// createQueryCompletionErrorStore declares the local that holds the error an
+// input collection of the query completed with. It returns nil when none of
+// the input collections can complete with an error.
+func createQueryCompletionErrorStore(
+	cx *functionContext,
+	queryExpr *ast.BLangQueryExpr,
+	initStmts *[]ast.StatementNode,
+	pos diagnostics.Location,
+) *ast.BLangSimpleVarRef {
+	for _, clause := range queryExpr.QueryClauseList {
+		var input *ast.BLangInputClause
+		switch clause := clause.(type) {
+		case *ast.BLangFromClause:
+			input = &clause.BLangInputClause
+		case *ast.BLangJoinClause:
+			input = &clause.BLangInputClause
+		default:
+			continue
+		}
+		if !semtypes.IsEmpty(cx.typeCtx(), input.CompletionType) {
+			errorOrNil := semtypes.Union(semtypes.ERROR, semtypes.NIL)
+			return declareQueryResult(cx, errorOrNil, createQueryNilLiteral(pos), initStmts, pos)
+		}
+	}
+	return nil
+}
+
+// appendQueryMembersStmts gathers the members of a string, xml, stream or
+// iterable object collection into a list, storing the error the iteration
+// completed with in errRef.
+func appendQueryMembersStmts(
+	cx *functionContext,
+	initStmts *[]ast.StatementNode,
+	clause *ast.BLangInputClause,
+	collRef *ast.BLangSimpleVarRef,
+	errRef *ast.BLangSimpleVarRef,
+	pos diagnostics.Location,
+) *ast.BLangSimpleVarRef {
+	membersRef := createQueryListStore(cx, initStmts, pos)
+	errorOrNil := semtypes.Union(semtypes.ERROR, semtypes.NIL)
+	membersInvocation := createLangInternalInvocation(cx, "queryMembers", errorOrNil,
+		[]ast.BLangExpression{collRef, createQueryVarRefAt(membersRef, pos)}, pos)
+	if errRef == nil || semtypes.IsEmpty(cx.typeCtx(), clause.CompletionType) {
+		membersStmt := &ast.BLangExpressionStmt{Expr: membersInvocation}
+		setPositionIfMissing(membersStmt, pos)
+		*initStmts = append(*initStmts, membersStmt)
+		return membersRef
+	}
+	completionVarDef, completionRef := assignToLocal(cx, membersInvocation, pos)
+	*initStmts = append(*initStmts, completionVarDef)
+	isErrorExpr := &ast.BLangTypeTestExpr{}
+	isErrorExpr.Expr = completionRef
+	isErrorExpr.Type = ast.TypeData{Type: semtypes.ERROR}
+	isErrorExpr.SetDeterminedType(semtypes.BOOLEAN)
+	assignErr := &ast.BLangAssignment{
+		VarRef: createQueryVarRefAt(errRef, pos),
+		Expr:   createQueryVarRefAt(completionRef, pos),
+	}
+	assignErr.SetDeterminedType(semtypes.NEVER)
+	setPositionIfMissing(assignErr, pos)
+	errorIf := &ast.BLangIf{
+		Expr: isErrorExpr,
+		Body: ast.BLangBlockStmt{Stmts: []ast.StatementNode{assignErr}},
+	}
+	errorIf.SetScope(cx.currentScope())
+	errorIf.SetDeterminedType(semtypes.NEVER)
+	setPositionIfMissing(errorIf, pos)
+	*initStmts = append(*initStmts, errorIf)
+	return membersRef
+}
+
+func isQueryAction(queryExpr *ast.BLangQueryExpr) bool {
+	_, ok := queryExpr.QueryClauseList[len(queryExpr.QueryClauseList)-1].(*ast.BLangDoClause)
+	return ok
+}
+
 func createPreOrderLetStores(
 	cx *functionContext,
 	queryExpr *ast.BLangQueryExpr,
@@ -1513,8 +1700,8 @@ func createPreOrderLetStores(
 		if !isLet {
 			continue
 		}
-		for i := range clause.LetVarDeclarations {
-			varDef := &clause.LetVarDeclarations[i]
+		for _, decl := range clause.LetVarDeclarations {
+			varDef := decl.(*ast.BLangSimpleVariableDef)
 			binding, ok := queryRowBindingFromVarDef(cx, varDef, "let")
 			if !ok {
 				return nil, false
@@ -1656,6 +1843,10 @@ func appendQuerySelectResultStmts(
 	basePos diagnostics.Location,
 	bodyStmts []ast.StatementNode,
 ) ([]ast.StatementNode, bool) {
+	if selectClause == nil {
+		doClause := queryExpr.QueryClauseList[len(queryExpr.QueryClauseList)-1].(*ast.BLangDoClause)
+		return append(bodyStmts, walkBlockStmt(cx, doClause.Body).replacementNode), true
+	}
 	selectResult := walkExpression(cx, selectClause.Expression)
 	bodyStmts = append(bodyStmts, selectResult.initStmts...)
 	selectExpr := selectResult.replacementNode.(ast.BLangExpression)
@@ -1708,62 +1899,7 @@ func appendQuerySelectResultStmts(
 				OpKind:  model.OperatorKind_EQUAL,
 			}
 			conflictCond.SetDeterminedType(semtypes.BOOLEAN)
-
-			conflictResult := walkExpression(cx, onConflictClause.Expression)
-			conflictBody := make([]ast.StatementNode, 0, len(conflictResult.initStmts)+2)
-			conflictBody = append(conflictBody, conflictResult.initStmts...)
-
-			conflictExpr := conflictResult.replacementNode.(ast.BLangExpression)
-			conflictTy := conflictExpr.GetDeterminedType()
-			conflictName, conflictSymbol := cx.addDesugardSymbol(conflictTy, model.SymbolKindVariable, false)
-			conflictVar := &ast.BLangSimpleVariable{
-				Name: &ast.BLangIdentifier{Value: conflictName},
-			}
-			conflictVar.SetDeterminedType(conflictTy)
-			conflictVar.SetInitialExpression(conflictExpr)
-			conflictVar.SetSymbol(conflictSymbol)
-			conflictVarDef := &ast.BLangSimpleVariableDef{Var: conflictVar}
-			setPositionIfMissing(conflictVarDef, basePos)
-			conflictBody = append(conflictBody, conflictVarDef)
-
-			conflictRef := &ast.BLangSimpleVarRef{
-				VariableName: conflictVar.Name,
-			}
-			conflictRef.SetSymbol(conflictSymbol)
-			conflictRef.SetDeterminedType(conflictTy)
-
-			isErrorExpr := &ast.BLangTypeTestExpr{}
-			isErrorExpr.Expr = conflictRef
-			isErrorExpr.Type = ast.TypeData{Type: semtypes.ERROR}
-			isErrorExpr.SetDeterminedType(semtypes.BOOLEAN)
-
-			assignResult := &ast.BLangAssignment{
-				VarRef: resultRef,
-				Expr:   conflictRef,
-			}
-			assignResult.SetDeterminedType(semtypes.NEVER)
-			breakStmt := &ast.BLangBreak{}
-			breakStmt.SetDeterminedType(semtypes.NEVER)
-			errorBody := ast.BLangBlockStmt{
-				Stmts: []ast.StatementNode{assignResult, breakStmt},
-			}
-			errorIf := &ast.BLangIf{
-				Expr: isErrorExpr,
-				Body: errorBody,
-			}
-			errorIf.SetScope(cx.currentScope())
-			errorIf.SetDeterminedType(semtypes.NEVER)
-			conflictBody = append(conflictBody, errorIf)
-
-			onConflictIf := &ast.BLangIf{
-				Expr: conflictCond,
-				Body: ast.BLangBlockStmt{
-					Stmts: conflictBody,
-				},
-			}
-			onConflictIf.SetScope(cx.currentScope())
-			onConflictIf.SetDeterminedType(semtypes.NEVER)
-			bodyStmts = append(bodyStmts, onConflictIf)
+			bodyStmts = append(bodyStmts, createQueryOnConflictIf(cx, resultRef, onConflictClause, conflictCond, basePos))
 
 			markSeen := createMapPutAssignment(seenKeysRef, keyAccess, createBoolLiteral(true, basePos))
 			setPositionIfMissing(markSeen, basePos)
@@ -1773,6 +1909,24 @@ func appendQuerySelectResultStmts(
 		mapPutStmt := createMapPutAssignment(resultRef, keyAccess, valueAccess)
 		setPositionIfMissing(mapPutStmt, basePos)
 		bodyStmts = append(bodyStmts, mapPutStmt)
+	case ast.TypeKind_TABLE:
+		rowVarDef, rowRef := assignToLocal(cx, selectExpr, basePos)
+		bodyStmts = append(bodyStmts, rowVarDef)
+		// Without an on conflict clause, add panics when the key is already present.
+		methodName := "add"
+		if onConflictClause != nil {
+			hasKey := createLangInternalInvocation(cx, "queryTableHasKey", semtypes.BOOLEAN,
+				[]ast.BLangExpression{resultRef, rowRef}, basePos)
+			bodyStmts = append(bodyStmts, createQueryOnConflictIf(cx, resultRef, onConflictClause, hasKey, basePos))
+			methodName = "put"
+		}
+		insertInvocation := createTableInsertInvocation(cx, methodName, resultRef, rowRef)
+		if insertInvocation == nil {
+			return nil, false
+		}
+		insertStmt := &ast.BLangExpressionStmt{Expr: insertInvocation}
+		setPositionIfMissing(insertStmt, basePos)
+		bodyStmts = append(bodyStmts, insertStmt)
 	default:
 		pushInvocation := createArrayPushInvocation(cx.pkgCtx, resultRef, selectExpr)
 		if pushInvocation == nil {
@@ -1783,6 +1937,73 @@ func appendQuerySelectResultStmts(
 	return bodyStmts, true
 }
 
+// createQueryOnConflictIf creates the statement run when the key of a selected
+// member is already in the result: if the on conflict expression evaluates to
+// an error, the error becomes the result and the query stops.
+func createQueryOnConflictIf(
+	cx *functionContext,
+	resultRef *ast.BLangSimpleVarRef,
+	onConflictClause *ast.BLangOnConflictClause,
+	conflictCond ast.BLangExpression,
+	basePos diagnostics.Location,
+) *ast.BLangIf {
+	conflictResult := walkExpression(cx, onConflictClause.Expression)
+	conflictBody := make([]ast.StatementNode, 0, len(conflictResult.initStmts)+2)
+	conflictBody = append(conflictBody, conflictResult.initStmts...)
+
+	conflictExpr := conflictResult.replacementNode.(ast.BLangExpression)
+	conflictTy := conflictExpr.GetDeterminedType()
+	conflictName, conflictSymbol := cx.addDesugardSymbol(conflictTy, model.SymbolKindVariable, false)
+	conflictVar := &ast.BLangSimpleVariable{
+		Name: &ast.BLangIdentifier{Value: conflictName},
+	}
+	conflictVar.SetDeterminedType(conflictTy)
+	conflictVar.SetInitialExpression(conflictExpr)
+	conflictVar.SetSymbol(conflictSymbol)
+	conflictVarDef := &ast.BLangSimpleVariableDef{Var: conflictVar}
+	setPositionIfMissing(conflictVarDef, basePos)
+	conflictBody = append(conflictBody, conflictVarDef)
+
+	conflictRef := &ast.BLangSimpleVarRef{
+		VariableName: conflictVar.Name,
+	}
+	conflictRef.SetSymbol(conflictSymbol)
+	conflictRef.SetDeterminedType(conflictTy)
+
+	isErrorExpr := &ast.BLangTypeTestExpr{}
+	isErrorExpr.Expr = conflictRef
+	isErrorExpr.Type = ast.TypeData{Type: semtypes.ERROR}
+	isErrorExpr.SetDeterminedType(semtypes.BOOLEAN)
+
+	assignResult := &ast.BLangAssignment{
+		VarRef: resultRef,
+		Expr:   conflictRef,
+	}
+	assignResult.SetDeterminedType(semtypes.NEVER)
+	breakStmt := &ast.BLangBreak{}
+	breakStmt.SetDeterminedType(semtypes.NEVER)
+	errorBody := ast.BLangBlockStmt{
+		Stmts: []ast.StatementNode{assignResult, breakStmt},
+	}
+	errorIf := &ast.BLangIf{
+		Expr: isErrorExpr,
+		Body: errorBody,
+	}
+	errorIf.SetScope(cx.currentScope())
+	errorIf.SetDeterminedType(semtypes.NEVER)
+	conflictBody = append(conflictBody, errorIf)
+
+	onConflictIf := &ast.BLangIf{
+		Expr: conflictCond,
+		Body: ast.BLangBlockStmt{
+			Stmts: conflictBody,
+		},
+	}
+	onConflictIf.SetScope(cx.currentScope())
+	onConflictIf.SetDeterminedType(semtypes.NEVER)
+	return onConflictIf
+}
+
 func appendQueryIntermediateClauseStmts(
 	cx *functionContext,
 	queryExpr *ast.BLangQueryExpr,
@@ -1796,8 +2017,8 @@ func appendQueryIntermediateClauseStmts(
 	for i := startClauseIndex; i < endClauseIndex; i++ {
 		switch clause := queryExpr.QueryClauseList[i].(type) {
 		case *ast.BLangLetClause:
-			for i := range clause.LetVarDeclarations {
-				varDef := &clause.LetVarDeclarations[i]
+			for _, decl := range clause.LetVarDeclarations {
+				varDef := decl.(*ast.BLangSimpleVariableDef)
 				if varDef.Var == nil || varDef.Var.Expr == nil {
 					cx.internalError("query let clause bindings should have been validated during type resolution")
 					return nil, false
@@ -2022,11 +2243,21 @@ func createLangInternalInvocation(
 	returnTy semtypes.SemType,
 	args []ast.BLangExpression,
 	pos diagnostics.Location,
+) *ast.BLangInvocation {
+	return createPkgLangInternalInvocation(cx.pkgCtx, name, returnTy, args, pos)
+}
+
+func createPkgLangInternalInvocation(
+	pkgCtx *packageContext,
+	name string,
+	returnTy semtypes.SemType,
+	args []ast.BLangExpression,
+	pos diagnostics.Location,
 ) *ast.BLangInvocation {
 	pkgName := langinternal.PackageName
-	space, _ := cx.getImportedSymbolSpace(pkgName)
+	space, _ := pkgCtx.getImportedSymbolSpace(pkgName)
 	symbolRef, _ := space.GetSymbol(name)
-	cx.addImplicitImport(pkgName, ast.BLangImportPackage{
+	pkgCtx.addImplicitImport(pkgName, ast.BLangImportPackage{
 		OrgName:      &ast.BLangIdentifier{Value: "ballerina"},
 		PkgNameComps: []ast.BLangIdentifier{{Value: "lang"}, {Value: "__internal"}},
 		Alias:        &ast.BLangIdentifier{Value: pkgName},
@@ -2040,6 +2271,34 @@ func createLangInternalInvocation(
 	return inv
 }
 
+// createTableInsertInvocation creates lang.table:add or lang.table:put,
+// bound to the polymorphic symbol like createTableToArrayInvocation.
+func createTableInsertInvocation(cx *functionContext, name string, tableExpr ast.BLangExpression, rowExpr ast.BLangExpression) *ast.BLangInvocation {
+	pkgName := "lang.table"
+	space, ok := cx.getImportedSymbolSpace(pkgName)
+	if !ok {
+		cx.internalError(pkgName + " symbol space not found")
+		return nil
+	}
+	symbolRef, ok := space.GetSymbol(name)
+	if !ok {
+		cx.internalError(pkgName + ":" + name + " symbol not found")
+		return nil
+	}
+	cx.addImplicitImport(pkgName, ast.BLangImportPackage{
+		OrgName:      &ast.BLangIdentifier{Value: "ballerina"},
+		PkgNameComps: []ast.BLangIdentifier{{Value: "lang"}, {Value: "table"}},
+		Alias:        &ast.BLangIdentifier{Value: pkgName},
+	})
+	inv := &ast.BLangInvocation{PkgAlias: &ast.BLangIdentifier{Value: pkgName}}
+	inv.Name = &ast.BLangIdentifier{Value: name}
+	inv.ArgExprs = []ast.BLangExpression{tableExpr, rowExpr}
+	inv.SetSymbol(symbolRef)
+	inv.SetDeterminedType(semtypes.NIL)
+	setPositionIfMissing(inv, tableExpr.GetPosition())
+	return inv
+}
+
 func createPushInvocation(cx *functionContext, listExpr ast.BLangExpression, valueExpr ast.BLangExpression) *ast.BLangInvocation {
 	pkgName := "lang.array"
 	space, ok := cx.getImportedSymbolSpace(pkgName)
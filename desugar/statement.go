@@ -21,6 +21,7 @@ import (
 	"ballerina-lang-go/ast"
 	"ballerina-lang-go/model"
 	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/tools/diagnostics"
 )
 
 func walkStatement(cx *functionContext, node ast.StatementNode) desugaredNode[ast.StatementNode] {
@@ -45,6 +46,8 @@ func walkStatement(cx *functionContext, node ast.StatementNode) desugaredNode[as
 		return visitForEach(cx, stmt)
 	case *ast.BLangSimpleVariableDef:
 		return walkSimpleVariableDef(cx, stmt)
+	case *ast.BLangTupleVariableDef, *ast.BLangRecordVariableDef, *ast.BLangErrorVariableDef:
+		return walkBindingPatternVarDef(cx, stmt.(ast.VariableDefinitionNode))
 	case *ast.BLangReturn:
 		return walkReturn(cx, stmt)
 	case *ast.BLangPanic:
@@ -57,6 +60,22 @@ func walkStatement(cx *functionContext, node ast.StatementNode) desugaredNode[as
 		return walkMatchStatement(cx, stmt)
 	case *ast.BLangXMLNS:
 		return desugaredNode[ast.StatementNode]{replacementNode: stmt}
+	case *ast.BLangWorker:
+		walkWorker(cx, stmt)
+		return desugaredNode[ast.StatementNode]{replacementNode: stmt}
+	case *ast.BLangFork:
+		for i := range stmt.Workers {
+			walkWorker(cx, &stmt.Workers[i])
+		}
+		return desugaredNode[ast.StatementNode]{replacementNode: stmt}
+	case *ast.BLangTransaction:
+		return walkTransaction(cx, stmt)
+	case *ast.BLangRetry:
+		return walkRetry(cx, stmt)
+	case *ast.BLangRollback:
+		return walkRollback(cx, stmt)
+	case *ast.BLangFail:
+		return walkFail(cx, stmt)
 	default:
 		panic("unexpected statement type")
 	}
@@ -88,6 +107,10 @@ func walkBlockFunctionBody(cx *functionContext, body *ast.BLangBlockFunctionBody
 }
 
 func walkAssignment(cx *functionContext, stmt *ast.BLangAssignment) desugaredNode[ast.StatementNode] {
+	switch stmt.VarRef.(type) {
+	case *ast.BLangTupleVarRef, *ast.BLangRecordVarRef, *ast.BLangErrorVarRef:
+		return walkBindingPatternAssignment(cx, stmt)
+	}
 	var initStmts []ast.StatementNode
 
 	if stmt.VarRef != nil {
@@ -179,11 +202,19 @@ func walkIf(cx *functionContext, stmt *ast.BLangIf) desugaredNode[ast.StatementN
 }
 
 func walkWhile(cx *functionContext, stmt *ast.BLangWhile) desugaredNode[ast.StatementNode] {
-	var initStmts []ast.StatementNode
+	if stmt.OnFailClause.Body != nil {
+		clause := stmt.OnFailClause
+		stmt.OnFailClause.Body = nil
+		stmt.OnFailClause.VariableDefinitionNode = nil
+		return walkWithOnFailClause(cx, &clause, stmt.GetPosition(), func() desugaredNode[ast.StatementNode] {
+			return walkWhile(cx, stmt)
+		})
+	}
+	var condStmts []ast.StatementNode
 
 	if stmt.Expr != nil {
 		result := walkExpression(cx, stmt.Expr)
-		initStmts = append(initStmts, result.initStmts...)
+		condStmts = result.initStmts
 		stmt.Expr = result.replacementNode.(ast.BLangExpression)
 	}
 
@@ -196,30 +227,52 @@ func walkWhile(cx *functionContext, stmt *ast.BLangWhile) desugaredNode[ast.Stat
 	cx.popScope()
 	cx.popLoopVar()
 
-	// Only walk onFail clause if it has a body
-	if stmt.OnFailClause.Body != nil {
-		onFailResult := walkOnFailClause(cx, &stmt.OnFailClause)
-		stmt.OnFailClause = *onFailResult.replacementNode.(*ast.BLangOnFailClause)
+	if len(condStmts) > 0 {
+		// The condition must be evaluated afresh on every iteration, so the statements it depends on go at the start
+		// of the body, followed by a break when it doesn't hold.
+		pos := stmt.Expr.GetPosition()
+		breakStmt := &ast.BLangBreak{}
+		setPositionIfMissing(breakStmt, pos)
+		exitIf := &ast.BLangIf{
+			Expr: createNotExpr(stmt.Expr, pos),
+			Body: ast.BLangBlockStmt{Stmts: []ast.StatementNode{breakStmt}},
+		}
+		exitIf.SetScope(stmt.Scope())
+		setPositionIfMissing(exitIf, pos)
+		stmt.Body.Stmts = append(append(condStmts, exitIf), stmt.Body.Stmts...)
+		stmt.Expr = createBoolLiteral(true, pos)
 	}
 
 	return desugaredNode[ast.StatementNode]{
-		initStmts:       initStmts,
 		replacementNode: stmt,
 	}
 }
 
 func walkLock(cx *functionContext, stmt *ast.BLangLock) desugaredNode[ast.StatementNode] {
+	if stmt.OnFailClause != nil {
+		clause := stmt.OnFailClause
+		stmt.OnFailClause = nil
+		return walkWithOnFailClause(cx, clause, stmt.GetPosition(), func() desugaredNode[ast.StatementNode] {
+			return walkLock(cx, stmt)
+		})
+	}
 	bodyResult := walkBlockStmt(cx, &stmt.Body)
 	stmt.Body = *bodyResult.replacementNode.(*ast.BLangBlockStmt)
 	return desugaredNode[ast.StatementNode]{replacementNode: stmt}
 }
 
 func walkDo(cx *functionContext, stmt *ast.BLangDo) desugaredNode[ast.StatementNode] {
+	hasOnFail := stmt.OnFailClause.Body != nil
+	if hasOnFail {
+		cx.onFailDepth++
+	}
 	bodyResult := walkBlockStmt(cx, &stmt.Body)
+	if hasOnFail {
+		cx.onFailDepth--
+	}
 	stmt.Body = *bodyResult.replacementNode.(*ast.BLangBlockStmt)
 
-	// Only walk onFail clause if it has a body
-	if stmt.OnFailClause.Body != nil {
+	if hasOnFail {
 		onFailResult := walkOnFailClause(cx, &stmt.OnFailClause)
 		stmt.OnFailClause = *onFailResult.replacementNode.(*ast.BLangOnFailClause)
 	}
@@ -229,6 +282,23 @@ func walkDo(cx *functionContext, stmt *ast.BLangDo) desugaredNode[ast.StatementN
 	}
 }
 
+// walkWithOnFailClause desugars a statement with an on-fail clause into a do statement with that clause, using walk to
+// desugar the statement itself. Failing checks within the statement fail to the clause.
+func walkWithOnFailClause(cx *functionContext, clause *ast.BLangOnFailClause, pos diagnostics.Location, walk func() desugaredNode[ast.StatementNode]) desugaredNode[ast.StatementNode] {
+	cx.onFailDepth++
+	result := walk()
+	cx.onFailDepth--
+	doStmt := &ast.BLangDo{
+		Body: ast.BLangBlockStmt{Stmts: append(result.initStmts, result.replacementNode)},
+	}
+	doStmt.Body.SetPosition(pos)
+	doStmt.SetDeterminedType(semtypes.NEVER)
+	doStmt.SetPosition(pos)
+	onFailResult := walkOnFailClause(cx, clause)
+	doStmt.OnFailClause = *onFailResult.replacementNode.(*ast.BLangOnFailClause)
+	return desugaredNode[ast.StatementNode]{replacementNode: doStmt}
+}
+
 func walkOnFailClause(cx *functionContext, clause *ast.BLangOnFailClause) desugaredNode[ast.StatementNode] {
 	bodyResult := walkBlockStmt(cx, clause.Body)
 	clause.Body = bodyResult.replacementNode.(*ast.BLangBlockStmt)
@@ -244,12 +314,12 @@ func walkSimpleVariableDef(cx *functionContext, stmt *ast.BLangSimpleVariableDef
 	if stmt.Var != nil {
 		if typeNode := stmt.Var.TypeNode(); typeNode != nil {
 			result := desugarTypeDesc(cx, typeNode, cx.currentScope())
-			for _, rf := range result.recordFields {
-				rf.fn = desugarFunction(cx.pkgCtx, rf.fn)
-				fnType := cx.symbolType(rf.symRef)
-				lambda := &ast.BLangLambdaFunction{Function: rf.fn}
+			for _, df := range result.defaultFns {
+				df.fn = desugarFunction(cx.pkgCtx, df.fn)
+				fnType := cx.symbolType(df.symRef)
+				lambda := &ast.BLangLambdaFunction{Function: df.fn}
 				lambda.SetDeterminedType(fnType)
-				setPositionIfMissing(lambda, rf.fn.GetPosition())
+				setPositionIfMissing(lambda, df.fn.GetPosition())
 
 				varName, varSymRef := cx.addDesugardSymbol(fnType, model.SymbolKindVariable, false)
 				varIdent := &ast.BLangIdentifier{Value: varName}
@@ -259,7 +329,7 @@ func walkSimpleVariableDef(cx *functionContext, stmt *ast.BLangSimpleVariableDef
 				simpleVar.SetDeterminedType(fnType)
 				simpleVar.SetSymbol(varSymRef)
 				varDef := &ast.BLangSimpleVariableDef{Var: simpleVar}
-				setPositionIfMissing(varDef, rf.fn.GetPosition())
+				setPositionIfMissing(varDef, df.fn.GetPosition())
 				initStmts = append(initStmts, varDef)
 			}
 		}
@@ -299,6 +369,17 @@ func walkReturn(cx *functionContext, stmt *ast.BLangReturn) desugaredNode[ast.St
 		initStmts = append(initStmts, result.initStmts...)
 		stmt.Expr = result.replacementNode
 	}
+	if cx.inTransaction {
+		// The return value is evaluated within the transaction, which then ends before returning.
+		if stmt.Expr != nil {
+			var name *ast.BLangIdentifier
+			var symbol model.SymbolRef
+			ty := stmt.Expr.GetDeterminedType()
+			name, symbol, initStmts = createOperandTempVar(cx, ty, stmt.Expr.(ast.BLangExpression), stmt.GetPosition(), initStmts)
+			stmt.Expr = createVarRef(name, symbol, ty)
+		}
+		initStmts = append(initStmts, createEndTransactionStmt(cx, nil, nil, stmt.GetPosition()))
+	}
 
 	return desugaredNode[ast.StatementNode]{
 		initStmts:       initStmts,
@@ -354,6 +435,13 @@ func walkContinue(cx *functionContext, stmt *ast.BLangContinue) desugaredNode[as
 }
 
 func visitForEach(cx *functionContext, stmt *ast.BLangForeach) desugaredNode[ast.StatementNode] {
+	if stmt.OnFailClause != nil {
+		clause := stmt.OnFailClause
+		stmt.OnFailClause = nil
+		return walkWithOnFailClause(cx, clause, stmt.GetPosition(), func() desugaredNode[ast.StatementNode] {
+			return visitForEach(cx, stmt)
+		})
+	}
 	cx.pushScope(stmt.Scope())
 	defer cx.popScope()
 	if isRangeExpr(stmt.Collection) {
@@ -367,6 +455,10 @@ func visitForEach(cx *functionContext, stmt *ast.BLangForeach) desugaredNode[ast
 	if semtypes.IsSubtype(tyCtx, stmt.Collection.GetDeterminedType(), semtypes.MAPPING) {
 		return desugarForEachOnMap(cx, stmt.Collection, stmt.VariableDef, &stmt.Body, stmt.Scope())
 	}
+	if semtypes.IsSubtype(tyCtx, stmt.Collection.GetDeterminedType(), semtypes.TABLE) {
+		rows := createTableToArrayInvocation(cx, stmt.Collection.(ast.BLangExpression))
+		return desugarForEachOnList(cx, rows, stmt.VariableDef, &stmt.Body, stmt.Scope())
+	}
 	return desugarForEachOnIterable(cx, stmt.Collection, stmt.VariableDef, &stmt.Body, stmt.Scope())
 }
 
@@ -697,6 +789,39 @@ func createKeysInvocation(cx *functionContext, collection ast.BLangExpression) *
 	return inv
 }
 
+// createTableToArrayInvocation creates lang.table:toArray(collection), giving
+// the rows of a table as a list in iteration order. toArray is generic, but
+// past this point only the lookup key of the callee is needed, so the call is
+// bound to the polymorphic symbol.
+func createTableToArrayInvocation(cx *functionContext, collection ast.BLangExpression) *ast.BLangInvocation {
+	pkgName := "lang.table"
+	space, ok := cx.getImportedSymbolSpace(pkgName)
+	if !ok {
+		cx.internalError(pkgName + " symbol space not found")
+		return nil
+	}
+	symbolRef, ok := space.GetSymbol("toArray")
+	if !ok {
+		cx.internalError(pkgName + ":toArray symbol not found")
+		return nil
+	}
+	rowTy := semtypes.TableRowType(cx.typeCtx(), collection.GetDeterminedType())
+	ld := semtypes.NewListDefinition()
+	rowArrayTy := ld.DefineListTypeWrappedWithEnvSemType(cx.typeEnv(), rowTy)
+	cx.addImplicitImport(pkgName, ast.BLangImportPackage{
+		OrgName:      &ast.BLangIdentifier{Value: "ballerina"},
+		PkgNameComps: []ast.BLangIdentifier{{Value: "lang"}, {Value: "table"}},
+		Alias:        &ast.BLangIdentifier{Value: pkgName},
+	})
+	inv := &ast.BLangInvocation{PkgAlias: &ast.BLangIdentifier{Value: pkgName}}
+	inv.Name = &ast.BLangIdentifier{Value: "toArray"}
+	inv.ArgExprs = []ast.BLangExpression{collection}
+	inv.SetSymbol(symbolRef)
+	inv.SetDeterminedType(rowArrayTy)
+	setPositionIfMissing(inv, collection.GetPosition())
+	return inv
+}
+
 func desugarForEachOnRange(cx *functionContext, rangeExpr *ast.BLangBinaryExpr, loopVarDef *ast.BLangSimpleVariableDef, body *ast.BLangBlockStmt, foreachScope model.Scope) desugaredNode[ast.StatementNode] {
 	var initStmts []ast.StatementNode
 
@@ -1006,6 +1131,10 @@ func walkMatchStatement(cx *functionContext, stmt *ast.BLangMatchStatement) desu
 		stmt.Expr = result.replacementNode
 	}
 
+	if hasStructuralMatchPattern(stmt) {
+		return walkStructuralMatchStatement(cx, stmt, initStmts)
+	}
+
 	for i := range stmt.MatchClauses {
 		clause := &stmt.MatchClauses[i]
 		if clause.Guard != nil {
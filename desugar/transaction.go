@@ -0,0 +1,274 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package desugar
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/tools/diagnostics"
+)
+
+// Transaction and retry statements are desugared into do/on-fail statements and calls to the transaction manager
+// in lang.__internal. For example
+//
+//	retry<T>(args) transaction { body }
+//
+// becomes
+//
+//	int $attempt = 0;
+//	T $mgr = new T(args);
+//	while true {
+//	    do {
+//	        startTransaction($attempt);
+//	        body
+//	        endTransaction((), false);
+//	        break;
+//	    } on fail error $err {
+//	        boolean $retry = $mgr.shouldRetry($err);
+//	        endTransaction($err, $retry);
+//	        if $retry {
+//	            $attempt = $attempt + 1;
+//	            continue;
+//	        }
+//	        fail $err;
+//	    }
+//	}
+//
+// The default retry manager retries while $attempt is less than its count. A retry statement without a transaction
+// omits the transaction manager calls, and a transaction statement that is not the body of a retry statement is a
+// single attempt without the loop.
+
+// defaultRetryCount is the number of retries done by the default retry manager when no count is given.
+const defaultRetryCount = 3
+
+func walkTransaction(cx *functionContext, stmt *ast.BLangTransaction) desugaredNode[ast.StatementNode] {
+	if stmt.OnFailClause != nil {
+		clause := stmt.OnFailClause
+		stmt.OnFailClause = nil
+		return walkWithOnFailClause(cx, clause, stmt.GetPosition(), func() desugaredNode[ast.StatementNode] {
+			return walkTransaction(cx, stmt)
+		})
+	}
+	pos := stmt.GetPosition()
+	attemptStmts := desugarAttempt(cx, &stmt.Body, createIntLiteral(0), true, pos)
+	errName, errSymbol, errVarDef := createOnFailVar(cx, pos)
+	onFailStmts := []ast.StatementNode{
+		createEndTransactionStmt(cx, createVarRef(errName, errSymbol, semtypes.ERROR), nil, pos),
+		createFailStmt(createVarRef(errName, errSymbol, semtypes.ERROR), pos),
+	}
+	doStmt := createDoOnFail(attemptStmts, errVarDef, onFailStmts, pos)
+	setPositionIfMissing(doStmt, pos)
+	return desugaredNode[ast.StatementNode]{
+		replacementNode: doStmt,
+	}
+}
+
+func walkRetry(cx *functionContext, stmt *ast.BLangRetry) desugaredNode[ast.StatementNode] {
+	if stmt.OnFailClause != nil {
+		clause := stmt.OnFailClause
+		stmt.OnFailClause = nil
+		return walkWithOnFailClause(cx, clause, stmt.GetPosition(), func() desugaredNode[ast.StatementNode] {
+			return walkRetry(cx, stmt)
+		})
+	}
+	pos := stmt.GetPosition()
+	attemptName, attemptSymbol, initStmts := createOperandTempVar(cx, semtypes.INT, createIntLiteral(0), pos, nil)
+	attemptRef := func() *ast.BLangSimpleVarRef {
+		return createVarRef(attemptName, attemptSymbol, semtypes.INT)
+	}
+
+	var shouldRetry func(errRef ast.BLangExpression) ast.BLangExpression
+	spec := &stmt.RetrySpec
+	if spec.RetryManager != nil {
+		result := walkExpression(cx, spec.RetryManager)
+		initStmts = append(initStmts, result.initStmts...)
+		mgrTy := spec.RetryManager.GetDeterminedType()
+		var mgrName *ast.BLangIdentifier
+		var mgrSymbol model.SymbolRef
+		mgrName, mgrSymbol, initStmts = createOperandTempVar(cx, mgrTy, result.replacementNode.(ast.BLangExpression), pos, initStmts)
+		shouldRetry = func(errRef ast.BLangExpression) ast.BLangExpression {
+			inv := createMethodInvocation(cx, createVarRef(mgrName, mgrSymbol, mgrTy), "shouldRetry", mgrTy, []ast.BLangExpression{errRef})
+			setPositionIfMissing(inv, pos)
+			return inv
+		}
+	} else {
+		var countExpr ast.BLangExpression = createIntLiteral(defaultRetryCount)
+		if len(spec.ArgExprs) > 0 {
+			result := walkExpression(cx, spec.ArgExprs[0])
+			initStmts = append(initStmts, result.initStmts...)
+			countExpr = result.replacementNode.(ast.BLangExpression)
+		}
+		var countName *ast.BLangIdentifier
+		var countSymbol model.SymbolRef
+		countName, countSymbol, initStmts = createOperandTempVar(cx, semtypes.INT, countExpr, pos, initStmts)
+		shouldRetry = func(ast.BLangExpression) ast.BLangExpression {
+			cond := &ast.BLangBinaryExpr{
+				LhsExpr: attemptRef(),
+				RhsExpr: createVarRef(countName, countSymbol, semtypes.INT),
+				OpKind:  model.OperatorKind_LESS_THAN,
+			}
+			cond.SetDeterminedType(semtypes.BOOLEAN)
+			setPositionIfMissing(cond, pos)
+			return cond
+		}
+	}
+
+	body, isTransaction := &stmt.Body, false
+	if stmt.Transaction != nil {
+		body, isTransaction = &stmt.Transaction.Body, true
+	}
+	attemptStmts := desugarAttempt(cx, body, attemptRef(), isTransaction, pos)
+	if len(attemptStmts) == 0 || isAppendReachable(attemptStmts[len(attemptStmts)-1]) {
+		breakStmt := &ast.BLangBreak{}
+		breakStmt.SetPosition(pos)
+		attemptStmts = append(attemptStmts, breakStmt)
+	}
+
+	errName, errSymbol, errVarDef := createOnFailVar(cx, pos)
+	retryName, retrySymbol, onFailStmts := createOperandTempVar(cx, semtypes.BOOLEAN, shouldRetry(createVarRef(errName, errSymbol, semtypes.ERROR)), pos, nil)
+	if isTransaction {
+		endStmt := createEndTransactionStmt(cx, createVarRef(errName, errSymbol, semtypes.ERROR), createVarRef(retryName, retrySymbol, semtypes.BOOLEAN), pos)
+		onFailStmts = append(onFailStmts, endStmt)
+	}
+	continueStmt := &ast.BLangContinue{}
+	continueStmt.SetPosition(pos)
+	retryBody := ast.BLangBlockStmt{Stmts: []ast.StatementNode{createIncrementStmt(attemptRef()), continueStmt}}
+	retryBody.SetPosition(pos)
+	retryIf := &ast.BLangIf{Expr: createVarRef(retryName, retrySymbol, semtypes.BOOLEAN), Body: retryBody}
+	retryIf.SetScope(cx.currentScope())
+	retryIf.SetDeterminedType(semtypes.NEVER)
+	retryIf.SetPosition(pos)
+	onFailStmts = append(onFailStmts, retryIf, createFailStmt(createVarRef(errName, errSymbol, semtypes.ERROR), pos))
+
+	loopBody := ast.BLangBlockStmt{Stmts: []ast.StatementNode{createDoOnFail(attemptStmts, errVarDef, onFailStmts, pos)}}
+	loopBody.SetPosition(pos)
+	loop := &ast.BLangWhile{Expr: createBoolLiteral(true, pos), Body: loopBody}
+	loop.SetScope(cx.currentScope())
+	loop.SetDeterminedType(semtypes.NEVER)
+	setPositionIfMissing(loop, pos)
+	return desugaredNode[ast.StatementNode]{
+		initStmts:       initStmts,
+		replacementNode: loop,
+	}
+}
+
+// desugarAttempt returns the statements making up one attempt at running body. When isTransaction is set, the
+// attempt runs within a new transaction whose retry number is the value of retryNumber.
+func desugarAttempt(cx *functionContext, body *ast.BLangBlockStmt, retryNumber ast.BLangExpression, isTransaction bool, pos diagnostics.Location) []ast.StatementNode {
+	prevInTransaction := cx.inTransaction
+	cx.inTransaction = prevInTransaction || isTransaction
+	cx.onFailDepth++
+	walkBlockStmt(cx, body)
+	cx.onFailDepth--
+	cx.inTransaction = prevInTransaction
+
+	if !isTransaction {
+		return body.Stmts
+	}
+	start := createLangInternalInvocation(cx, "startTransaction", semtypes.NIL, []ast.BLangExpression{retryNumber}, pos)
+	stmts := make([]ast.StatementNode, 0, len(body.Stmts)+2)
+	stmts = append(stmts, createExpressionStmt(start, pos))
+	stmts = append(stmts, body.Stmts...)
+	if isAppendReachable(stmts[len(stmts)-1]) {
+		stmts = append(stmts, createEndTransactionStmt(cx, nil, nil, pos))
+	}
+	return stmts
+}
+
+func walkRollback(cx *functionContext, stmt *ast.BLangRollback) desugaredNode[ast.StatementNode] {
+	var initStmts []ast.StatementNode
+	var errExpr ast.BLangExpression = createQueryNilLiteral(stmt.GetPosition())
+	if stmt.Expr != nil {
+		result := walkExpression(cx, stmt.Expr)
+		initStmts = append(initStmts, result.initStmts...)
+		errExpr = result.replacementNode.(ast.BLangExpression)
+	}
+	inv := createLangInternalInvocation(cx, "rollbackTransaction", semtypes.NIL, []ast.BLangExpression{errExpr}, stmt.GetPosition())
+	return desugaredNode[ast.StatementNode]{
+		initStmts:       initStmts,
+		replacementNode: createExpressionStmt(inv, stmt.GetPosition()),
+	}
+}
+
+func walkFail(cx *functionContext, stmt *ast.BLangFail) desugaredNode[ast.StatementNode] {
+	result := walkExpression(cx, stmt.Expr)
+	stmt.Expr = result.replacementNode.(ast.BLangExpression)
+	return desugaredNode[ast.StatementNode]{
+		initStmts:       result.initStmts,
+		replacementNode: stmt,
+	}
+}
+
+func walkCommitExpr(cx *functionContext, expr *ast.BLangCommitExpr) desugaredNode[ast.BLangActionOrExpression] {
+	inv := createLangInternalInvocation(cx, "commitTransaction", expr.GetDeterminedType(), nil, expr.GetPosition())
+	return desugaredNode[ast.BLangActionOrExpression]{replacementNode: inv}
+}
+
+func walkTransactionalExpr(cx *functionContext, expr *ast.BLangTransactionalExpr) desugaredNode[ast.BLangActionOrExpression] {
+	inv := createLangInternalInvocation(cx, "isTransactional", semtypes.BOOLEAN, nil, expr.GetPosition())
+	return desugaredNode[ast.BLangActionOrExpression]{replacementNode: inv}
+}
+
+// createEndTransactionStmt creates a call ending the current transaction. A nil cause ends a transaction whose
+// body completed normally, and a nil willRetry means the transaction will not be retried.
+func createEndTransactionStmt(cx *functionContext, cause ast.BLangExpression, willRetry ast.BLangExpression, pos diagnostics.Location) *ast.BLangExpressionStmt {
+	if cause == nil {
+		cause = createQueryNilLiteral(pos)
+	}
+	if willRetry == nil {
+		willRetry = createBoolLiteral(false, pos)
+	}
+	inv := createLangInternalInvocation(cx, "endTransaction", semtypes.NIL, []ast.BLangExpression{cause, willRetry}, pos)
+	return createExpressionStmt(inv, pos)
+}
+
+func createOnFailVar(cx *functionContext, pos diagnostics.Location) (*ast.BLangIdentifier, model.SymbolRef, *ast.BLangSimpleVariableDef) {
+	name, symbol := cx.addDesugardSymbol(semtypes.ERROR, model.SymbolKindVariable, false)
+	varName := &ast.BLangIdentifier{Value: name}
+	errVar := &ast.BLangSimpleVariable{Name: varName}
+	errVar.SetDeterminedType(semtypes.ERROR)
+	errVar.SetSymbol(symbol)
+	varDef := &ast.BLangSimpleVariableDef{Var: errVar}
+	varDef.SetDeterminedType(semtypes.NEVER)
+	setPositionIfMissing(varDef, pos)
+	return varName, symbol, varDef
+}
+
+func createDoOnFail(bodyStmts []ast.StatementNode, errVarDef *ast.BLangSimpleVariableDef, onFailStmts []ast.StatementNode, pos diagnostics.Location) *ast.BLangDo {
+	onFailBody := &ast.BLangBlockStmt{Stmts: onFailStmts}
+	onFailBody.SetPosition(pos)
+	doStmt := &ast.BLangDo{
+		Body: ast.BLangBlockStmt{Stmts: bodyStmts},
+		OnFailClause: ast.BLangOnFailClause{
+			Body:                   onFailBody,
+			VariableDefinitionNode: errVarDef,
+			IsInternal:             true,
+		},
+	}
+	doStmt.Body.SetPosition(pos)
+	doStmt.OnFailClause.SetPosition(pos)
+	doStmt.SetDeterminedType(semtypes.NEVER)
+	doStmt.SetPosition(pos)
+	return doStmt
+}
+
+func createFailStmt(expr ast.BLangExpression, pos diagnostics.Location) *ast.BLangFail {
+	failStmt := &ast.BLangFail{Expr: expr}
+	failStmt.SetPosition(pos)
+	return failStmt
+}
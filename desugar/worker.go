@@ -0,0 +1,103 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package desugar
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+)
+
+// addWorkerGroupVar declares the hidden variable holding the worker group of a function that declares workers.
+// It starts out as nil; the runtime creates the group when the first worker is started. Worker bodies refer to
+// it as a captured variable, which ties every worker to the invocation of the function that started it.
+func addWorkerGroupVar(cx *functionContext, body *ast.BLangBlockFunctionBody) {
+	ty := semtypes.Union(semtypes.HANDLE, semtypes.NIL)
+	_, symbol, initStmts := createNilResultVar(cx, ty, body.GetPosition(), nil)
+	cx.workerGroup = symbol
+	cx.hasWorkerGroup = true
+	body.Stmts = append(initStmts, body.Stmts...)
+}
+
+func (ctx *functionContext) workerGroupSymbol() model.SymbolRef {
+	if !ctx.hasWorkerGroup {
+		ctx.internalError("worker interaction outside a function with workers")
+	}
+	return ctx.workerGroup
+}
+
+// desugarWorkerFunction desugars the body of a worker. Workers share the worker group of the function that
+// declares them.
+func desugarWorkerFunction(parent *functionContext, fn *ast.BLangFunction) *ast.BLangFunction {
+	cx := &functionContext{
+		pkgCtx:         parent.pkgCtx,
+		workerGroup:    parent.workerGroup,
+		hasWorkerGroup: parent.hasWorkerGroup,
+	}
+	return desugarFunctionInContext(cx, fn)
+}
+
+func walkWorker(cx *functionContext, worker *ast.BLangWorker) {
+	worker.GroupSymbol = cx.workerGroupSymbol()
+	walkLambdaFunction(cx, worker.Lambda)
+}
+
+func walkWorkerSend(cx *functionContext, node ast.BLangActionOrExpression, send *ast.BLangWorkerSendExprBase) desugaredNode[ast.BLangActionOrExpression] {
+	result := walkExpression(cx, send.Expr)
+	send.Expr = result.replacementNode.(ast.BLangExpression)
+	send.GroupSymbol = cx.workerGroupSymbol()
+	return desugaredNode[ast.BLangActionOrExpression]{initStmts: result.initStmts, replacementNode: node}
+}
+
+func walkWorkerReceive(cx *functionContext, receive *ast.BLangWorkerReceive) {
+	receive.GroupSymbol = cx.workerGroupSymbol()
+}
+
+// declaresWorkers reports whether body declares named workers or contains a fork statement. Only statements are
+// searched: expressions (including anonymous functions, which get a worker group of their own) cannot declare
+// workers of this function.
+func declaresWorkers(body *ast.BLangBlockFunctionBody) bool {
+	finder := &workerDeclFinder{}
+	for _, stmt := range body.Stmts {
+		ast.Walk(finder, stmt.(ast.BLangNode))
+	}
+	return finder.found
+}
+
+type workerDeclFinder struct {
+	found bool
+}
+
+var _ ast.Visitor = &workerDeclFinder{}
+
+func (f *workerDeclFinder) Visit(node ast.BLangNode) ast.Visitor {
+	if node == nil || f.found {
+		return nil
+	}
+	switch node.(type) {
+	case *ast.BLangWorker, *ast.BLangFork:
+		f.found = true
+		return nil
+	case ast.BLangExpression:
+		return nil
+	}
+	return f
+}
+
+func (f *workerDeclFinder) VisitTypeData(_ *ast.TypeData) ast.Visitor {
+	return nil
+}
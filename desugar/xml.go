@@ -0,0 +1,147 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package desugar
+
+import (
+	"strings"
+
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+)
+
+// walkXMLFilterExpr lowers `x.<p>` to a call that keeps the elements of x matching the name pattern.
+func walkXMLFilterExpr(cx *functionContext, expr *ast.BLangXMLFilterExpr) desugaredNode[ast.BLangActionOrExpression] {
+	result := walkExpression(cx, expr.Expr)
+	args := []ast.BLangExpression{
+		result.replacementNode.(ast.BLangExpression),
+		createStringLiteral(xmlNamePattern(expr.Filters), expr.GetPosition()),
+	}
+	return desugaredNode[ast.BLangActionOrExpression]{
+		initStmts:       result.initStmts,
+		replacementNode: createLangInternalInvocation(cx, "xmlFilter", expr.GetDeterminedType(), args, expr.GetPosition()),
+	}
+}
+
+// walkXMLStepExpr lowers an xml step expression to a call doing the step. With step extensions the step is done
+// separately on each element of the operand, and the results of applying the extensions are concatenated:
+//
+//	xml[] $items = xmlStepItems(x, kind, pattern);
+//	xml $result = xml``;
+//	foreach xml $item in $items {
+//	    $result = $result + <extensions applied to $item>;
+//	}
+func walkXMLStepExpr(cx *functionContext, expr *ast.BLangXMLStepExpr) desugaredNode[ast.BLangActionOrExpression] {
+	pos := expr.GetPosition()
+	result := walkExpression(cx, expr.Expr)
+	initStmts := result.initStmts
+	args := []ast.BLangExpression{
+		result.replacementNode.(ast.BLangExpression),
+		createIntLiteral(int64(expr.Kind)),
+		createStringLiteral(xmlNamePattern(expr.Filters), pos),
+	}
+	if expr.Extension == nil {
+		return desugaredNode[ast.BLangActionOrExpression]{
+			initStmts:       initStmts,
+			replacementNode: createLangInternalInvocation(cx, "xmlStep", expr.GetDeterminedType(), args, pos),
+		}
+	}
+
+	resultTy := expr.GetDeterminedType()
+	emptySeq := &ast.BLangXMLSequenceLiteral{}
+	emptySeq.SetDeterminedType(semtypes.XMLSequence(semtypes.NEVER))
+	emptySeq.SetPosition(pos)
+	resultName, resultSymbol, initStmts := createOperandTempVar(cx, resultTy, emptySeq, pos, initStmts)
+
+	itemTy := expr.Item.GetDeterminedType()
+	itemName, itemSymbol := cx.addDesugardSymbol(itemTy, model.SymbolKindVariable, false)
+	itemVarName := &ast.BLangIdentifier{Value: itemName}
+	itemVar := &ast.BLangSimpleVariable{Name: itemVarName}
+	itemVar.SetDeterminedType(itemTy)
+	itemVar.SetSymbol(itemSymbol)
+	itemVarDef := &ast.BLangSimpleVariableDef{Var: itemVar}
+	itemVarDef.SetDeterminedType(semtypes.NEVER)
+	setPositionIfMissing(itemVarDef, pos)
+
+	itemRef := createVarRef(itemVarName, itemSymbol, itemTy)
+	setPositionIfMissing(itemRef, pos)
+	resultRef := createVarRef(resultName, resultSymbol, resultTy)
+	setPositionIfMissing(resultRef, pos)
+	extension := bindXMLStepItem(expr.Extension, itemRef)
+	concat := &ast.BLangBinaryExpr{
+		LhsExpr: resultRef,
+		RhsExpr: extension,
+		OpKind:  model.OperatorKind_ADD,
+	}
+	concat.SetDeterminedType(resultTy)
+	setPositionIfMissing(concat, pos)
+	body := &ast.BLangBlockStmt{
+		Stmts: []ast.StatementNode{createResultAssignment(resultName, resultSymbol, resultTy, concat, pos)},
+	}
+	body.SetDeterminedType(semtypes.NEVER)
+	setPositionIfMissing(body, pos)
+
+	items := createLangInternalInvocation(cx, "xmlStepItems", semtypes.LIST, args, pos)
+	loop := desugarForEachOnList(cx, items, itemVarDef, body, cx.currentScope())
+	initStmts = append(initStmts, loop.initStmts...)
+	initStmts = append(initStmts, loop.replacementNode)
+	replacementRef := createVarRef(resultName, resultSymbol, resultTy)
+	setPositionIfMissing(replacementRef, pos)
+	return desugaredNode[ast.BLangActionOrExpression]{
+		initStmts:       initStmts,
+		replacementNode: replacementRef,
+	}
+}
+
+// bindXMLStepItem replaces the step item at the root of the extension chain with item.
+func bindXMLStepItem(extension ast.BLangExpression, item ast.BLangExpression) ast.BLangExpression {
+	switch e := extension.(type) {
+	case *ast.BLangXMLStepItem:
+		return item
+	case *ast.BLangXMLFilterExpr:
+		e.Expr = bindXMLStepItem(e.Expr, item)
+	case *ast.BLangIndexBasedAccess:
+		e.Expr = bindXMLStepItem(e.Expr, item)
+	case *ast.BLangInvocation:
+		// A lang.xml method call has its receiver moved to the first argument by type resolution.
+		if e.Expr != nil {
+			e.Expr = bindXMLStepItem(e.Expr, item)
+		} else if len(e.ArgExprs) > 0 {
+			e.ArgExprs[0] = bindXMLStepItem(e.ArgExprs[0], item)
+		}
+	}
+	return extension
+}
+
+// xmlNamePattern encodes the alternatives of a name pattern as the runtime expects them, e.g. `a|p:*|*`.
+func xmlNamePattern(filters []ast.BLangXMLElementFilter) string {
+	alternatives := make([]string, len(filters))
+	for i, filter := range filters {
+		if filter.Prefix != "" {
+			alternatives[i] = filter.Prefix + ":" + filter.Name
+		} else {
+			alternatives[i] = filter.Name
+		}
+	}
+	return strings.Join(alternatives, "|")
+}
+
+// walkXMLMemberAccess lowers `x[i]` on an xml sequence, which yields the empty sequence when i is out of range.
+func walkXMLMemberAccess(cx *functionContext, expr *ast.BLangIndexBasedAccess) ast.BLangExpression {
+	args := []ast.BLangExpression{expr.Expr, expr.IndexExpr}
+	return createLangInternalInvocation(cx, "xmlGet", expr.GetDeterminedType(), args, expr.GetPosition())
+}
@@ -51,6 +51,121 @@ func GetInternalSymbols(ctx *context.CompilerContext) model.ExportedSymbolSpace
 		ParamTypes: []semtypes.SemType{templateInsertionAllowedTypes},
 		ReturnType: semtypes.STRING,
 	})
+	addInternalFunction(ctx, space, "xmlCommentText", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{templateInsertionAllowedTypes},
+		ReturnType: semtypes.STRING,
+	})
+	addInternalFunction(ctx, space, "xmlPIText", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{templateInsertionAllowedTypes},
+		ReturnType: semtypes.STRING,
+	})
+	addInternalFunction(ctx, space, "xmlCDATAText", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{templateInsertionAllowedTypes},
+		ReturnType: semtypes.STRING,
+	})
+	addInternalFunction(ctx, space, "xmlFilter", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.XML, semtypes.STRING},
+		ReturnType: semtypes.XML,
+	})
+	addInternalFunction(ctx, space, "xmlStep", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.XML, semtypes.INT, semtypes.STRING},
+		ReturnType: semtypes.XML,
+	})
+	addInternalFunction(ctx, space, "xmlStepItems", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.XML, semtypes.INT, semtypes.STRING},
+		ReturnType: semtypes.LIST,
+	})
+	addInternalFunction(ctx, space, "xmlGet", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.XML, semtypes.INT},
+		ReturnType: semtypes.XML,
+	})
+	addInternalFunction(ctx, space, "rawTemplate", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.TYPEDESC, semtypes.LIST, semtypes.LIST},
+		ReturnType: semtypes.OBJECT,
+	})
+	errorOrNil := semtypes.Union(semtypes.ERROR, semtypes.NIL)
+	addInternalFunction(ctx, space, "startTransaction", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.INT},
+		ReturnType: semtypes.NIL,
+	})
+	addInternalFunction(ctx, space, "commitTransaction", model.FunctionSignature{
+		ReturnType: errorOrNil,
+	})
+	addInternalFunction(ctx, space, "rollbackTransaction", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{errorOrNil},
+		ReturnType: semtypes.NIL,
+	})
+	addInternalFunction(ctx, space, "endTransaction", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{errorOrNil, semtypes.BOOLEAN},
+		ReturnType: semtypes.NIL,
+	})
+	addInternalFunction(ctx, space, "isTransactional", model.FunctionSignature{
+		ReturnType: semtypes.BOOLEAN,
+	})
+	addInternalFunction(ctx, space, "listRest", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.LIST, semtypes.INT, semtypes.TYPEDESC},
+		ReturnType: semtypes.LIST,
+	})
+	addInternalFunction(ctx, space, "mappingRest", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.MAPPING, semtypes.LIST, semtypes.TYPEDESC},
+		ReturnType: semtypes.MAPPING,
+	})
+	addInternalFunction(ctx, space, "errorMessage", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.ERROR},
+		ReturnType: semtypes.STRING,
+	})
+	addInternalFunction(ctx, space, "errorCause", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.ERROR},
+		ReturnType: errorOrNil,
+	})
+	addInternalFunction(ctx, space, "errorDetail", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.ERROR},
+		ReturnType: semtypes.MAPPING,
+	})
+	addInternalFunction(ctx, space, "hasKey", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.MAPPING, semtypes.STRING},
+		ReturnType: semtypes.BOOLEAN,
+	})
+	addInternalFunction(ctx, space, "hasConfigurableValue", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.STRING, semtypes.STRING, semtypes.STRING},
+		ReturnType: semtypes.BOOLEAN,
+	})
+	addInternalFunction(ctx, space, "configurableValue", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.STRING, semtypes.STRING, semtypes.STRING, semtypes.LIST},
+		ReturnType: semtypes.CreateAnydata(semtypes.ContextFrom(ctx.GetTypeEnv())),
+	})
+	addInternalFunction(ctx, space, "annotatedTypedesc", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.TYPEDESC, semtypes.MAPPING},
+		ReturnType: semtypes.TYPEDESC,
+	})
+	addInternalFunction(ctx, space, "annotationValue", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.TYPEDESC, semtypes.STRING},
+		ReturnType: semtypes.CreateAnydata(semtypes.ContextFrom(ctx.GetTypeEnv())),
+	})
+	addInternalFunction(ctx, space, "annotateFunction", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.STRING, semtypes.STRING, semtypes.STRING, semtypes.MAPPING},
+		ReturnType: semtypes.NIL,
+	})
+	addInternalFunction(ctx, space, "queryMembers", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.ANY, semtypes.LIST},
+		ReturnType: errorOrNil,
+	})
+	addInternalFunction(ctx, space, "queryStream", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.TYPEDESC, semtypes.TYPEDESC, semtypes.LIST, errorOrNil},
+		ReturnType: semtypes.STREAM,
+	})
+	addInternalFunction(ctx, space, "queryString", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.LIST},
+		ReturnType: semtypes.STRING,
+	})
+	addInternalFunction(ctx, space, "queryXML", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.LIST},
+		ReturnType: semtypes.XML,
+	})
+	addInternalFunction(ctx, space, "queryTableHasKey", model.FunctionSignature{
+		ParamTypes: []semtypes.SemType{semtypes.TABLE, semtypes.MAPPING},
+		ReturnType: semtypes.BOOLEAN,
+	})
 	return model.NewExportedSymbolSpaces([]*model.SymbolSpace{space}, nil)
 }
 
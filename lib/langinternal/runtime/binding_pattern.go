@@ -0,0 +1,57 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package langinternalruntime
+
+import (
+	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/values"
+)
+
+// listRest returns a new list of the given type holding the members of a list from an index onwards. It gives
+// the value of the rest binding pattern of a list binding pattern.
+func listRest(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	start := int(args[1].(int64))
+	ty := args[2].(*values.TypeDesc).Type
+	var members []values.BalValue
+	for i := start; i < list.Len(); i++ {
+		members = append(members, list.Get(i))
+	}
+	return values.NewList(ty, semtypes.ToListAtomicType(ctx.TypeCtx, ty), false, nil, 0, members), nil
+}
+
+// mappingRest returns a new mapping of the given type holding the fields of a mapping other than the excluded
+// ones. It gives the value of the rest binding pattern of a mapping or error binding pattern.
+func mappingRest(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	mapping := args[0].(*values.Map)
+	excludedList := args[1].(*values.List)
+	ty := args[2].(*values.TypeDesc).Type
+	excluded := make(map[string]bool, excludedList.Len())
+	for i := range excludedList.Len() {
+		excluded[excludedList.Get(i).(string)] = true
+	}
+	var entries []values.MapEntry
+	for _, key := range mapping.Keys() {
+		if excluded[key] {
+			continue
+		}
+		value, _ := mapping.Get(key)
+		entries = append(entries, values.MapEntry{Key: key, Value: value})
+	}
+	return values.NewMap(ty, semtypes.ToMappingAtomicType(ctx.TypeCtx, ty), false, entries), nil
+}
@@ -102,12 +102,40 @@ func initInternalModule(rt *runtime.Runtime) {
 		flattenFlags := args[2].(*values.List)
 		return queryCollect(ctx, rows, int(slotCount), flattenFlags)
 	})
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "queryMembers", queryMembers)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "queryStream", queryStream)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "queryString", queryString)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "queryXML", queryXML)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "queryTableHasKey", queryTableHasKey)
 	runtime.RegisterExternFunction(rt, orgName, moduleName, "escapeXMLContent", func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
 		return values.EscapeXMLContent(values.String(args[0], nil)), nil
 	})
 	runtime.RegisterExternFunction(rt, orgName, moduleName, "escapeXMLAttribute", func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
 		return values.EscapeXMLAttribute(values.String(args[0], nil)), nil
 	})
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "xmlCommentText", xmlCommentText)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "xmlPIText", xmlPIText)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "xmlCDATAText", xmlCDATAText)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "xmlFilter", xmlFilter)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "xmlStep", xmlStep)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "xmlStepItems", xmlStepItems)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "xmlGet", xmlGet)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "rawTemplate", rawTemplate)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "listRest", listRest)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "mappingRest", mappingRest)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "errorMessage", func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+		return args[0].(*values.Error).Message, nil
+	})
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "errorCause", func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+		return args[0].(*values.Error).Cause, nil
+	})
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "errorDetail", func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+		return args[0].(*values.Error).Detail, nil
+	})
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "hasKey", func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+		_, ok := args[0].(*values.Map).Get(args[1].(string))
+		return ok, nil
+	})
 }
 
 type queryGroupState struct {
@@ -0,0 +1,139 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package langinternalruntime
+
+import (
+	"strings"
+
+	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/values"
+)
+
+// queryMembers appends the members of a string, xml, stream or iterable
+// object collection to members. It returns the error the iteration completed
+// with, if any.
+func queryMembers(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	members := args[1].(*values.List)
+	switch collection := args[0].(type) {
+	case string:
+		for _, r := range collection {
+			members.Append(ctx.TypeCtx, string(r))
+		}
+		return nil, nil
+	case values.XMLValue:
+		for _, item := range values.XMLItems(collection) {
+			members.Append(ctx.TypeCtx, item)
+		}
+		return nil, nil
+	case *values.Stream:
+		return appendNextValues(ctx, members, collection.Next)
+	case *values.Object:
+		iteratorHandle, ok := ctx.LookupObjectMethod(collection, "iterator")
+		if !ok {
+			panic(values.NewErrorWithMessage("query collection does not have an iterator method"))
+		}
+		iterator, err := ctx.InvokeMethod(iteratorHandle, []values.BalValue{collection})
+		if err != nil {
+			return nil, err
+		}
+		iteratorObj := iterator.(*values.Object)
+		nextHandle, ok := ctx.LookupObjectMethod(iteratorObj, "next")
+		if !ok {
+			panic(values.NewErrorWithMessage("query iterator does not have a next method"))
+		}
+		var invokeErr error
+		next := func() values.BalValue {
+			result, err := ctx.InvokeMethod(nextHandle, []values.BalValue{iteratorObj})
+			if err != nil {
+				invokeErr = err
+				return nil
+			}
+			return result
+		}
+		completion, _ := appendNextValues(ctx, members, next)
+		if invokeErr != nil {
+			return nil, invokeErr
+		}
+		return completion, nil
+	default:
+		panic(values.NewErrorWithMessage("query collection is not iterable"))
+	}
+}
+
+// appendNextValues appends the value field of each record returned by next
+// until next returns nil or an error, and returns that error.
+func appendNextValues(ctx *extern.Context, members *values.List, next func() values.BalValue) (values.BalValue, error) {
+	for {
+		switch result := next().(type) {
+		case nil:
+			return nil, nil
+		case *values.Error:
+			return result, nil
+		case *values.Map:
+			value, _ := result.Get("value")
+			members.Append(ctx.TypeCtx, value)
+		}
+	}
+}
+
+// queryStream creates a stream of type args[0] that yields the values of the
+// list args[2], wrapped in records of type args[1], and then completes with
+// args[3].
+func queryStream(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	streamTy := args[0].(*values.TypeDesc).Type
+	recordTy := args[1].(*values.TypeDesc).Type
+	recordAtomic := semtypes.ToMappingAtomicType(ctx.TypeCtx, recordTy)
+	items := args[2].(*values.List)
+	completion := args[3]
+	index := 0
+	next := func() values.BalValue {
+		if index >= items.Len() {
+			return completion
+		}
+		value := items.Get(index)
+		index++
+		return values.NewMap(recordTy, recordAtomic, false, []values.MapEntry{{Key: "value", Value: value}})
+	}
+	return values.NewStream(streamTy, next, nil), nil
+}
+
+// queryString concatenates the strings in the list args[0].
+func queryString(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	items := args[0].(*values.List)
+	var sb strings.Builder
+	for i := 0; i < items.Len(); i++ {
+		sb.WriteString(items.Get(i).(string))
+	}
+	return sb.String(), nil
+}
+
+// queryXML concatenates the xml values in the list args[0].
+func queryXML(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	items := args[0].(*values.List)
+	xmlItems := make([]values.XMLValue, items.Len())
+	for i := range xmlItems {
+		xmlItems[i] = items.Get(i).(values.XMLValue)
+	}
+	return xmlConcat(xmlItems), nil
+}
+
+// queryTableHasKey reports whether the table args[0] has a row with the key
+// of the row args[1].
+func queryTableHasKey(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return args[0].(*values.Table).HasRowKey(args[1].(*values.Map)), nil
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package langinternalruntime
+
+import (
+	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/values"
+)
+
+// rawTemplate returns the value of a raw template: an object of the given type whose `strings` and `insertions`
+// fields hold the literal parts and the interpolated values of the template.
+func rawTemplate(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	ty := args[0].(*values.TypeDesc).Type
+	fields := map[string]values.BalValue{
+		"strings":    args[1],
+		"insertions": args[2],
+	}
+	return values.NewObject(ty, fields, nil, nil), nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package langinternalruntime
+
+import (
+	"fmt"
+	"strings"
+
+	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/values"
+)
+
+// The step kinds of an xml step expression, in the order of ast.XMLStepKind.
+const (
+	xmlStepChildren int64 = iota
+	xmlStepChildElements
+	xmlStepDescendants
+)
+
+func xmlCommentText(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	s := values.String(args[0], nil)
+	if strings.Contains(s, "--") {
+		panic(values.NewErrorWithMessage("xml comment interpolation must not contain '--'"))
+	}
+	return s, nil
+}
+
+func xmlPIText(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	s := values.String(args[0], nil)
+	if strings.Contains(s, "?>") {
+		panic(values.NewErrorWithMessage("xml processing instruction interpolation must not contain '?>'"))
+	}
+	return s, nil
+}
+
+func xmlCDATAText(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	s := values.String(args[0], nil)
+	if strings.Contains(s, "]]>") {
+		panic(values.NewErrorWithMessage("xml CDATA interpolation must not contain ']]>'"))
+	}
+	return s, nil
+}
+
+func xmlFilter(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	pattern := newXMLNamePattern(args[1].(string))
+	var result []values.XMLValue
+	for _, item := range values.XMLItems(args[0].(values.XMLValue)) {
+		if elem, ok := item.(*values.XMLElement); ok && pattern.matches(elem) {
+			result = append(result, elem)
+		}
+	}
+	return values.NewXMLConcatSequence(result...), nil
+}
+
+func xmlStep(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	kind := args[1].(int64)
+	pattern := newXMLNamePattern(args[2].(string))
+	var result []values.XMLValue
+	for _, item := range values.XMLItems(args[0].(values.XMLValue)) {
+		if elem, ok := item.(*values.XMLElement); ok {
+			result = appendXMLStep(result, elem, kind, pattern)
+		}
+	}
+	return xmlConcat(result), nil
+}
+
+// xmlStepItems does the step separately on each element of the sequence, so that the step extensions can be applied
+// to each result.
+func xmlStepItems(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	kind := args[1].(int64)
+	pattern := newXMLNamePattern(args[2].(string))
+	result := newQueryList(ctx)
+	for _, item := range values.XMLItems(args[0].(values.XMLValue)) {
+		if elem, ok := item.(*values.XMLElement); ok {
+			result.Append(ctx.TypeCtx, xmlConcat(appendXMLStep(nil, elem, kind, pattern)))
+		}
+	}
+	return result, nil
+}
+
+func xmlGet(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	index := args[1].(int64)
+	if index < 0 {
+		panic(values.NewErrorWithMessage(fmt.Sprintf("xml sequence index out of range: %d", index)))
+	}
+	items := values.XMLItems(args[0].(values.XMLValue))
+	if index >= int64(len(items)) {
+		return values.NewXMLConcatSequence(), nil
+	}
+	return items[index], nil
+}
+
+func appendXMLStep(result []values.XMLValue, elem *values.XMLElement, kind int64, pattern xmlNamePattern) []values.XMLValue {
+	for _, child := range values.XMLItems(elem.Children) {
+		if kind == xmlStepChildren {
+			result = append(result, child)
+			continue
+		}
+		childElem, ok := child.(*values.XMLElement)
+		if !ok {
+			continue
+		}
+		if pattern.matches(childElem) {
+			result = append(result, childElem)
+		}
+		if kind == xmlStepDescendants {
+			result = appendXMLStep(result, childElem, kind, pattern)
+		}
+	}
+	return result
+}
+
+func xmlConcat(items []values.XMLValue) *values.XMLSequence {
+	return values.NewXMLConcatSequence(values.XMLItems(values.NewXMLConcatSequence(items...))...)
+}
+
+// xmlNamePattern holds the alternatives of a name pattern. An alternative is `*`, `p:*`, `p:name` or `name`, and is
+// matched against the element name as written, prefix included.
+type xmlNamePattern []string
+
+func newXMLNamePattern(pattern string) xmlNamePattern {
+	return strings.Split(pattern, "|")
+}
+
+func (p xmlNamePattern) matches(elem *values.XMLElement) bool {
+	for _, alternative := range p {
+		switch {
+		case alternative == "*":
+			return true
+		case strings.HasSuffix(alternative, ":*"):
+			if strings.HasPrefix(elem.Name, strings.TrimSuffix(alternative, "*")) {
+				return true
+			}
+		case elem.Name == alternative:
+			return true
+		}
+	}
+	return false
+}
@@ -19,3 +19,42 @@
 # + arr - the array
 # + return - number of members in `arr`
 public isolated function length((any|error)[] arr) returns int = external;
+
+# Direction for `sort` function.
+public enum SortDirection {
+    ASCENDING = "ascending",
+    DESCENDING = "descending"
+}
+
+# Removes all members of an array.
+#
+# + arr - the array
+# + return - () if `arr` is mutable and its inherent type allows it to become empty
+public isolated function removeAll((any|error)[] arr) returns () = external;
+
+# Changes the length of an array.
+#
+# `setLength(arr, 0)` is equivalent to `removeAll(arr)`.
+#
+# + arr - the array of which to change the length
+# + length - new length
+public isolated function setLength((any|error)[] arr, int length) returns () = external;
+
+# Returns the string that is the Base64 representation of an array of bytes.
+#
+# The representation is the same as used by a Ballerina Base64Bytes literal.
+# The result will contain only characters `A..Z`, `a..z`, `0..9`, `+`, `/` and `=`.
+# There will be no whitespace in the returned string.
+#
+# + arr - the array
+# + return - Base64 string representation
+public isolated function toBase64(byte[] arr) returns string = external;
+
+# Returns the byte array that a string represents in Base64.
+#
+# `str` must consist of the characters `A..Z`, `a..z`, `0..9`, `+`, `/`, `=`
+# and whitespace as allowed by a Ballerina Base64Bytes literal.
+#
+# + str - Base64 string representation
+# + return - the byte array or an error
+public isolated function fromBase64(string str) returns byte[]|error = external;
@@ -19,3 +19,73 @@
 # + e - the error value
 # + return - error message
 public isolated function message(error e) returns string = external;
+
+# A frame of the call stack captured when an error was created.
+public type StackFrame readonly & object {
+    # Returns a string representation of the frame.
+    #
+    # + return - the frame as `callableName(fileName:lineNumber)`
+    public isolated function toString() returns string;
+};
+
+readonly class CallStackElement {
+    public final string callableName;
+    public final string? moduleName;
+    public final string fileName;
+    public final int lineNumber;
+
+    isolated function init(string callableName, string? moduleName, string fileName, int lineNumber) {
+        self.callableName = callableName;
+        self.moduleName = moduleName;
+        self.fileName = fileName;
+        self.lineNumber = lineNumber;
+    }
+
+    public isolated function toString() returns string {
+        return string `${self.callableName}(${self.fileName}:${self.lineNumber})`;
+    }
+}
+
+// `detail`, which returns the error's detail record, is typed by the detail
+// type of its argument, which cannot be expressed in source; it is provided
+// through the compiler's opaque-symbol mechanism.
+
+# Returns the error's cause.
+#
+# + e - the error value
+# + return - error cause
+public isolated function cause(error e) returns error? = external;
+
+# Returns the stack trace of the error, as captured when it was created.
+#
+# + e - the error value
+# + return - the frames of the call stack, innermost first
+public isolated function stackTrace(error e) returns StackFrame[] {
+    StackFrame[] frames = [];
+    foreach [string, string?, string, int] frame in externStackTrace(e) {
+        frames.push(new CallStackElement(frame[0], frame[1], frame[2], frame[3]));
+    }
+    return frames;
+}
+
+isolated function externStackTrace(error e) returns [string, string?, string, int][] = external;
+
+# Converts an error to a string.
+#
+# The details of the conversion are specified by the ToString abstract
+# operation defined in the Ballerina Language Specification, using the direct
+# style.
+#
+# + e - the error to be converted to a string
+# + return - a string resulting from the conversion
+public isolated function toString(error e) returns string = external;
+
+# Converts an error to a string that describes the value in Ballerina syntax.
+#
+# The details of the conversion are specified by the ToString abstract
+# operation defined in the Ballerina Language Specification, using the
+# expression style.
+#
+# + e - the error to be converted to a string
+# + return - a string resulting from the conversion
+public isolated function toBalString(error e) returns string = external;
@@ -0,0 +1,24 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+# The type of a raw template value.
+# A raw template expression constructs an object belonging to this type, holding the literal parts of the template in
+# `strings` and the values of its interpolations in `insertions`. `strings` always has exactly one more member than
+# `insertions`.
+public type RawTemplate object {
+    public string[] strings;
+    public (any|error)[] insertions;
+};
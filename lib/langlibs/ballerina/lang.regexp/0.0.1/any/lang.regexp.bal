@@ -0,0 +1,255 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// The RegExp type is the built-in regexp basic type, which cannot be expressed
+// in source; it is provided through the compiler's opaque-symbol mechanism.
+
+// A span of a string matched by a regular expression. Indices are in code
+// points, as for the other string functions.
+public type Span object {
+    // The index within the string where the span starts
+    public int startIndex;
+    // The index within the string following the end of the span
+    public int endIndex;
+
+    // Returns the substring of the string that the span refers to.
+    public isolated function substring() returns string;
+};
+
+// The spans of a match: the first member is the span of the whole match, and
+// the remaining members are the spans of the capturing groups, in order.
+// A capturing group that did not take part in the match has `()` as its span.
+public type Groups [Span, Span?...];
+
+// A function that computes the replacement for a match.
+public type ReplacerFunction isolated function (Groups groups) returns string;
+
+// The replacement for a match. A string is used literally as the replacement.
+public type Replacement ReplacerFunction|string;
+
+class SpanImpl {
+    public final int startIndex;
+    public final int endIndex;
+    private final string str;
+
+    isolated function init(string str, int startIndex, int endIndex) {
+        self.str = str;
+        self.startIndex = startIndex;
+        self.endIndex = endIndex;
+    }
+
+    public isolated function substring() returns string {
+        return externSubstring(self.str, self.startIndex, self.endIndex);
+    }
+}
+
+# Returns the first match of a regular expression within a string.
+#
+# + re - the regular expression
+# + str - the string in which to look for a match of `re`
+# + startIndex - the index within `str` at which to start looking for a match
+# + return - a `Span` describing the match, or `()` if no match was found
+public isolated function find(RegExp re, string str, int startIndex = 0) returns Span? {
+    int[] spans = externFind(re, str, startIndex);
+    if spans.length() == 0 {
+        return ();
+    }
+    return new SpanImpl(str, spans[0], spans[1]);
+}
+
+# Returns the `Groups` for the first match of a regular expression within a string.
+#
+# + re - the regular expression
+# + str - the string in which to look for a match of `re`
+# + startIndex - the index within `str` at which to start looking for a match
+# + return - a `Groups` list describing the match, or `()` if no match was found
+public isolated function findGroups(RegExp re, string str, int startIndex = 0) returns Groups? {
+    int[] spans = externFind(re, str, startIndex);
+    if spans.length() == 0 {
+        return ();
+    }
+    return toGroups(str, spans);
+}
+
+# Returns all non-overlapping matches of a regular expression within a string.
+#
+# + re - the regular expression
+# + str - the string in which to look for matches of `re`
+# + startIndex - the index within `str` at which to start looking for matches
+# + return - a list containing a `Span` for each match found
+public isolated function findAll(RegExp re, string str, int startIndex = 0) returns Span[] {
+    Span[] result = [];
+    foreach int[] spans in externFindAll(re, str, startIndex) {
+        result.push(new SpanImpl(str, spans[0], spans[1]));
+    }
+    return result;
+}
+
+# Returns the `Groups` of all non-overlapping matches of a regular expression within a string.
+#
+# + re - the regular expression
+# + str - the string in which to look for matches of `re`
+# + startIndex - the index within `str` at which to start looking for matches
+# + return - a list containing a `Groups` for each match found
+public isolated function findAllGroups(RegExp re, string str, int startIndex = 0) returns Groups[] {
+    Groups[] result = [];
+    foreach int[] spans in externFindAll(re, str, startIndex) {
+        result.push(toGroups(str, spans));
+    }
+    return result;
+}
+
+# Tests whether there is a match of a regular expression at a specific index in the string.
+#
+# + re - the regular expression
+# + str - the string in which to look for a match of `re`
+# + startIndex - the index within `str` at which to look for a match
+# + return - a `Span` describing the match, or `()` if `re` does not match at `startIndex`
+public isolated function matchAt(RegExp re, string str, int startIndex = 0) returns Span? {
+    int[] spans = externMatchAt(re, str, startIndex);
+    if spans.length() == 0 {
+        return ();
+    }
+    return new SpanImpl(str, spans[0], spans[1]);
+}
+
+# Returns the `Groups` of the match of a regular expression at a specific index in the string.
+#
+# + re - the regular expression
+# + str - the string in which to look for a match of `re`
+# + startIndex - the index within `str` at which to look for a match
+# + return - a `Groups` list describing the match, or `()` if `re` does not match at `startIndex`
+public isolated function matchGroupsAt(RegExp re, string str, int startIndex = 0) returns Groups? {
+    int[] spans = externMatchAt(re, str, startIndex);
+    if spans.length() == 0 {
+        return ();
+    }
+    return toGroups(str, spans);
+}
+
+# Tests whether there is a full match of a regular expression with a string.
+# A match of a regular expression in a string is a full match if it
+# starts at index 0 and ends at index `n`, where `n` is the length of the string.
+#
+# + re - the regular expression
+# + str - the string
+# + return - true if there is a full match of `re` with `str`, and false otherwise
+public isolated function isFullMatch(RegExp re, string str) returns boolean = external;
+
+# Returns the `Groups` of the full match of a regular expression with a string.
+#
+# + re - the regular expression
+# + str - the string in which to look for a match of `re`
+# + return - a `Groups` list describing the match, or `()` if there is no full match
+public isolated function fullMatchGroups(RegExp re, string str) returns Groups? {
+    int[] spans = externFullMatch(re, str);
+    if spans.length() == 0 {
+        return ();
+    }
+    return toGroups(str, spans);
+}
+
+# Replaces the first match of a regular expression.
+#
+# + re - the regular expression
+# + str - the string in which to perform the replacement
+# + replacement - a `Replacement` that gives the replacement for the match
+# + startIndex - the index within `str` at which to start looking for a match
+# + return - `str` with the first match, if any, replaced by the string specified by `replacement`
+public isolated function replace(RegExp re, string str, Replacement replacement, int startIndex = 0) returns string {
+    Groups? groups = findGroups(re, str, startIndex);
+    if groups is () {
+        return str;
+    }
+    Span span = groups[0];
+    return externSubstring(str, 0, span.startIndex) + replacementString(groups, replacement)
+        + externSubstring(str, span.endIndex, str.length());
+}
+
+# Replaces all matches of a regular expression.
+# After one match is found, it looks for the next match starting where the previous
+# match ended, so the matches will be non-overlapping.
+#
+# + re - the regular expression
+# + str - the string in which to perform the replacements
+# + replacement - a `Replacement` that gives the replacement for each match
+# + startIndex - the index within `str` at which to start looking for matches
+# + return - `str` with every match replaced by the string specified by `replacement`
+public isolated function replaceAll(RegExp re, string str, Replacement replacement, int startIndex = 0) returns string {
+    string result = "";
+    int prevEnd = 0;
+    foreach Groups groups in findAllGroups(re, str, startIndex) {
+        Span span = groups[0];
+        result += externSubstring(str, prevEnd, span.startIndex) + replacementString(groups, replacement);
+        prevEnd = span.endIndex;
+    }
+    return result + externSubstring(str, prevEnd, str.length());
+}
+
+# Splits a string into substrings separated by matches of a regular expression.
+# This finds the non-overlapping matches of a regular expression and
+# returns a list of substrings of `str` that occur before the first match,
+# between matches, or after the last match. If there are no matches, then
+# `[str]` will be returned.
+#
+# + re - the regular expression that specifies the separator
+# + str - the string to be split
+# + return - a list of substrings of `str` separated by matches of `re`
+public isolated function split(RegExp re, string str) returns string[] = external;
+
+# Constructs a regular expression from a string.
+# The syntax of the regular expression is the same as accepted by the `re` tagged data template expression.
+#
+# + str - the string representation of a regular expression
+# + return - the regular expression, or an error value if `str` is not a valid regular expression
+public isolated function fromString(string str) returns RegExp|error = external;
+
+isolated function toGroups(string str, int[] spans) returns Groups {
+    Groups groups = [new SpanImpl(str, spans[0], spans[1])];
+    int i = 2;
+    while i < spans.length() {
+        int startIndex = spans[i];
+        if startIndex < 0 {
+            groups.push(());
+        } else {
+            groups.push(new SpanImpl(str, startIndex, spans[i + 1]));
+        }
+        i += 2;
+    }
+    return groups;
+}
+
+isolated function replacementString(Groups groups, Replacement replacement) returns string {
+    if replacement is string {
+        return replacement;
+    }
+    return replacement(groups);
+}
+
+// The extern functions below describe a match as a list of index pairs: the
+// start and end of the whole match followed by the start and end of each
+// capturing group, with -1 for a group that did not take part in the match.
+// An empty list means there was no match.
+
+isolated function externFind(RegExp re, string str, int startIndex) returns int[] = external;
+
+isolated function externFindAll(RegExp re, string str, int startIndex) returns int[][] = external;
+
+isolated function externMatchAt(RegExp re, string str, int startIndex) returns int[] = external;
+
+isolated function externFullMatch(RegExp re, string str) returns int[] = external;
+
+isolated function externSubstring(string str, int startIndex, int endIndex) returns string = external;
@@ -0,0 +1,75 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+# Information about a transaction that does not change
+# after the transaction is started.
+public type Info readonly & record {|
+    # Unique identifier for the transaction branch
+    byte[] xid;
+    # The number of previous attempts in a sequence of retries
+    int retryNumber;
+    # Information about the previous attempt in a sequence of retries.
+    # This will be `()` if the `retryNumber` is 0.
+    Info? prevAttempt;
+    # The time at which the transaction was started, in milliseconds since the epoch
+    int startTime;
+|};
+
+# Type of a commit handler function. It is called with the information
+# about the transaction being committed.
+public type CommitHandler isolated function(Info info);
+
+# Type of a rollback handler function. It is called with the information
+# about the transaction being rolled back, the cause of the rollback, if
+# there is one, and whether the transaction will be retried.
+public type RollbackHandler isolated function(Info info, error? cause, boolean willRetry);
+
+# Returns information about the current transaction.
+#
+# + return - information about the current transaction
+public transactional isolated function info() returns Info = external;
+
+# Prevents the current transaction from committing successfully.
+# When the transaction is committed, it is rolled back instead and
+# the commit action returns an error.
+#
+# + e - the cause of the rollback, or `()` to use a default error
+public transactional isolated function setRollbackOnly(error? e) = external;
+
+# Tells whether it is known that the current transaction will be rolled back.
+#
+# + return - true if it is known that the current transaction will be rolled back
+public transactional isolated function getRollbackOnly() returns boolean = external;
+
+# Associates some data with the current transaction.
+#
+# + data - data to be set
+public transactional isolated function setData(readonly data) = external;
+
+# Retrieves data associated with the current transaction.
+#
+# + return - data for the current transaction, or `()` if no data has been set
+public transactional isolated function getData() returns readonly = external;
+
+# Adds a handler to be called if and when the current transaction commits.
+#
+# + handler - the function to be called on commit
+public transactional isolated function onCommit(CommitHandler handler) = external;
+
+# Adds a handler to be called if and when the current transaction rolls back.
+#
+# + handler - the function to be called on rollback
+public transactional isolated function onRollback(RollbackHandler handler) = external;
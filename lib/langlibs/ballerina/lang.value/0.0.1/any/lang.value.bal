@@ -14,5 +14,119 @@
 // specific language governing permissions and limitations
 // under the License.
 
-// This module currently exposes no symbols; it exists so that the lang.value
-// langlib resolves as a real bundle.
+# Returns true if `v` is read-only: if it is a simple value or a structured
+# value whose inherent type is a subtype of `readonly`.
+#
+# + v - the value to test
+# + return - true if `v` is read-only
+public isolated function isReadOnly(anydata v) returns boolean = external;
+
+# Constructs a value with a specified type by cloning another value.
+#
+# The shape of `v` is used to find a type in `t`; numbers are converted to
+# another numeric type where needed. Structured values in the result take
+# their inherent types from `t`. Default values of record fields are not
+# applied, so a field with a default value that `v` lacks results in an error.
+#
+# + v - the value to be cloned
+# + t - the type for the cloned value
+# + return - a new value that belongs to `t`, or an error if this cannot be done
+public isolated function cloneWithType(anydata v, typedesc<anydata> t = <>) returns t|error = external;
+
+# Safely casts a value to a type.
+#
+# This casts a value to a type in the same way as a type cast expression,
+# but returns an error if the cast cannot be done rather than panicking.
+#
+# + v - the value to be cast
+# + t - a typedesc for the type to which to cast it
+# + return - `v` cast to `t`, or an error
+public isolated function ensureType(any|error v, typedesc<any> t = <>) returns t|error = external;
+
+# Performs a direct conversion of a value to a string.
+#
+# A string is returned unchanged and `()` becomes the empty string. Other
+# values are converted as `io:println` would show them.
+#
+# + v - the value to be converted to a string
+# + return - a string resulting from the conversion
+public isolated function toString(any v) returns string = external;
+
+# Converts a value to a string that describes the value in Ballerina syntax.
+#
+# + v - the value to be converted to a string
+# + return - a string resulting from the conversion
+public isolated function toBalString(any v) returns string = external;
+
+# Parses and evaluates a subset of Ballerina expression syntax.
+#
+# The subset is the one produced by `toBalString` for simple values, lists
+# and mappings.
+#
+# + s - the string to be parsed and evaluated
+# + return - the result of evaluating the parsed expression, or an error if
+# the string cannot be parsed
+public isolated function fromBalString(string s) returns anydata|error = external;
+
+# Converts a value of type `anydata` to `json`.
+#
+# A new copy is made of all structured values. A table becomes a list of its
+# rows and an xml value becomes its string form. Arrays in the result have
+# inherent type json[] and mappings have inherent type map<json>.
+#
+# + v - anydata value
+# + return - representation of `v` as value of type json
+public isolated function toJson(anydata v) returns json = external;
+
+# Returns the string that represents `v` in JSON format.
+#
+# `v` is first converted using `toJson`.
+#
+# + v - anydata value
+# + return - string representation of `v` converted to `json`
+public isolated function toJsonString(anydata v) returns string = external;
+
+# Parses a string in JSON format and returns the value that it represents.
+#
+# A number is converted to an int if it is syntactically an integer in the
+# int range, to `-0.0` if it is a negative zero and to a decimal otherwise.
+#
+# + str - string in JSON format
+# + return - the value represented by `str`, or an error if `str` is not
+# valid JSON
+public isolated function fromJsonString(string str) returns json|error = external;
+
+# Converts a value of type json to a user-specified type.
+#
+# This works the same as `cloneWithType`.
+#
+# + v - json value
+# + t - type to convert to
+# + return - value belonging to `t`, or an error if this cannot be done
+public isolated function fromJsonWithType(json v, typedesc<anydata> t = <>) returns t|error = external;
+
+# Converts a string in JSON format to a user-specified type.
+#
+# This is a combination of `fromJsonString` followed by `fromJsonWithType`.
+#
+# + str - string in JSON format
+# + t - type to convert to
+# + return - value belonging to `t`, or an error if this cannot be done
+public isolated function fromJsonStringWithType(string str, typedesc<anydata> t = <>) returns t|error = external;
+
+# Merges two json values.
+#
+# If either value is `()` the other is returned. If both are mappings, each
+# field of `j2` is merged into `j1`, which is updated in place and returned.
+# Otherwise the merge fails.
+#
+# + j1 - json value
+# + j2 - json value
+# + return - the merge of `j1` with `j2`, or an error if the merge fails
+public isolated function mergeJson(json j1, json j2) returns json|error = external;
+
+# Returns the number of arguments.
+#
+# + vs - the arguments
+# + return - the number of arguments
+public isolated function count(any|error... vs) returns int = external;
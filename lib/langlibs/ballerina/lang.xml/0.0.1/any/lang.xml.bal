@@ -17,3 +17,46 @@
 // The XML subtypes (Element, Comment, Text, ProcessingInstruction) are
 // built-in types that cannot be expressed in source; they are provided through
 // the compiler's opaque-symbol mechanism.
+
+# Returns number of xml items in an xml value.
+#
+# + x - xml item
+# + return - number of XML items in `x`
+public isolated function length(xml x) returns int = external;
+
+# Returns the item of an xml sequence with a given index.
+#
+# + x - the xml sequence
+# + i - the index
+# + return - the item with index `i` in `x`
+public isolated function get(xml x, int i) returns xml = external;
+
+# Returns the children of elements in an xml value.
+#
+# + x - xml value
+# + return - xml sequence containing the children of each element x concatenated in order
+public isolated function children(xml x) returns xml = external;
+
+# Selects the elements from an xml value.
+#
+# + x - the xml value
+# + return - an xml sequence containing the elements in `x`
+public isolated function elements(xml x) returns xml<Element> = external;
+
+# Returns the children of an xml element.
+#
+# + elem - xml element
+# + return - children of `elem`
+public isolated function getChildren(Element elem) returns xml = external;
+
+# Returns a string giving the expanded name of an xml element.
+#
+# + elem - xml element
+# + return - element name
+public isolated function getName(Element elem) returns string = external;
+
+# Returns a string with the character data of an xml value.
+#
+# + x - the xml value
+# + return - a string consisting of all the character data of `x`
+public isolated function data(xml x) returns string = external;
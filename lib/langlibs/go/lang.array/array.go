@@ -19,16 +19,445 @@ package array
 import (
 	"ballerina-lang-go/runtime"
 	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/semtypes"
 	"ballerina-lang-go/values"
+	"encoding/base64"
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
 )
 
 const (
 	orgName    = "ballerina"
 	moduleName = "lang.array"
+
+	iteratorNextKey = "ballerina/lang.array:ListIterator.next"
 )
 
+// memberTypes memoizes the types constructed from the member type of an array, so that calls in a loop do not
+// define a new type on each iteration.
+type memberTypes struct {
+	env      semtypes.Env
+	define   func(env semtypes.Env, memberTy semtypes.SemType) semtypes.SemType
+	mu       sync.Mutex
+	interner *semtypes.SemtypeInterner
+	types    map[semtypes.InternHandle]semtypes.SemType
+}
+
+func newMemberTypes(env semtypes.Env, define func(env semtypes.Env, memberTy semtypes.SemType) semtypes.SemType) *memberTypes {
+	return &memberTypes{
+		env:      env,
+		define:   define,
+		interner: semtypes.NewSemtypeInterner(),
+		types:    make(map[semtypes.InternHandle]semtypes.SemType),
+	}
+}
+
+func (m *memberTypes) get(memberTy semtypes.SemType) semtypes.SemType {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	handle := m.interner.Intern(memberTy)
+	if ty, ok := m.types[handle]; ok {
+		return ty
+	}
+	ty := m.define(m.env, memberTy)
+	m.types[handle] = ty
+	return ty
+}
+
+func defineArray(env semtypes.Env, memberTy semtypes.SemType) semtypes.SemType {
+	ld := semtypes.NewListDefinition()
+	return ld.DefineListTypeWrappedWithEnvSemType(env, memberTy)
+}
+
+func defineEnumeration(env semtypes.Env, memberTy semtypes.SemType) semtypes.SemType {
+	ld := semtypes.NewListDefinition()
+	return ld.TupleTypeWrapped(env, semtypes.INT, memberTy)
+}
+
+func defineValueRecord(env semtypes.Env, memberTy semtypes.SemType) semtypes.SemType {
+	md := semtypes.NewMappingDefinition()
+	return md.DefineMappingTypeWrapped(env, []semtypes.Field{semtypes.FieldFrom("value", memberTy, false, false)}, semtypes.NEVER)
+}
+
+func defineIterator(env semtypes.Env, memberTy semtypes.SemType) semtypes.SemType {
+	return semtypes.CreateIteratorType(env, memberTy, semtypes.NIL)
+}
+
+func defineStream(env semtypes.Env, memberTy semtypes.SemType) semtypes.SemType {
+	sd := semtypes.NewStreamDefinition()
+	return sd.Define(env, memberTy, semtypes.NIL)
+}
+
+type arrayModule struct {
+	arrays       *memberTypes
+	enumerations *memberTypes
+	records      *memberTypes
+	iterators    *memberTypes
+	streams      *memberTypes
+	byteArrayTy  semtypes.SemType
+}
+
+func newArrayModule(env semtypes.Env) *arrayModule {
+	return &arrayModule{
+		arrays:       newMemberTypes(env, defineArray),
+		enumerations: newMemberTypes(env, defineEnumeration),
+		records:      newMemberTypes(env, defineValueRecord),
+		iterators:    newMemberTypes(env, defineIterator),
+		streams:      newMemberTypes(env, defineStream),
+		byteArrayTy:  defineArray(env, semtypes.BYTE),
+	}
+}
+
+func memberType(ctx *extern.Context, list *values.List) semtypes.SemType {
+	return semtypes.ListProj(ctx.TypeCtx, list.Type, semtypes.INT)
+}
+
+// newList creates a mutable list of type memberTy[] holding items.
+func (m *arrayModule) newList(ctx *extern.Context, memberTy semtypes.SemType, items []values.BalValue) *values.List {
+	ty := m.arrays.get(memberTy)
+	filler, _ := values.FillerFactoryFor(ctx.TypeCtx, memberTy)
+	return values.NewList(ty, semtypes.ToListAtomicType(ctx.TypeCtx, ty), false, filler, 0, items)
+}
+
+func (m *arrayModule) valueRecord(ctx *extern.Context, recordTy semtypes.SemType, value values.BalValue) *values.Map {
+	atomic := semtypes.ToMappingAtomicType(ctx.TypeCtx, recordTy)
+	return values.NewMap(recordTy, atomic, false, []values.MapEntry{{Key: "value", Value: value}})
+}
+
+func members(list *values.List) []values.BalValue {
+	items := make([]values.BalValue, list.Len())
+	for i := range items {
+		items[i] = list.Get(i)
+	}
+	return items
+}
+
+// callback resolves a function value passed to a higher-order function.
+func callback(ctx *extern.Context, fnValue values.BalValue) (func(args ...values.BalValue) (values.BalValue, error), error) {
+	fn, ok := fnValue.(*values.Function)
+	if !ok {
+		return nil, fmt.Errorf("expected a function value")
+	}
+	handle, ok := ctx.LookupFunctionValue(fn)
+	if !ok {
+		return nil, fmt.Errorf("function not found: %s", fn.LookupKey)
+	}
+	return func(args ...values.BalValue) (values.BalValue, error) {
+		return ctx.InvokeFunction(handle, args)
+	}, nil
+}
+
+func (m *arrayModule) mapFn(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	call, err := callback(ctx, args[1])
+	if err != nil {
+		return nil, err
+	}
+	fnTy := args[1].(*values.Function).Type
+	resultTy := semtypes.FunctionReturnType(ctx.TypeCtx, fnTy, semtypes.FunctionParamListType(ctx.TypeCtx, fnTy))
+	if semtypes.IsZero(resultTy) {
+		resultTy = semtypes.VAL
+	}
+	items := make([]values.BalValue, list.Len())
+	for i := range items {
+		if items[i], err = call(list.Get(i)); err != nil {
+			return nil, err
+		}
+	}
+	return m.newList(ctx, resultTy, items), nil
+}
+
+func (m *arrayModule) filter(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	call, err := callback(ctx, args[1])
+	if err != nil {
+		return nil, err
+	}
+	var items []values.BalValue
+	for i := 0; i < list.Len(); i++ {
+		member := list.Get(i)
+		keep, err := call(member)
+		if err != nil {
+			return nil, err
+		}
+		if keep.(bool) {
+			items = append(items, member)
+		}
+	}
+	return m.newList(ctx, memberType(ctx, list), items), nil
+}
+
+func forEach(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	call, err := callback(ctx, args[1])
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < list.Len(); i++ {
+		if _, err := call(list.Get(i)); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func reduce(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	call, err := callback(ctx, args[1])
+	if err != nil {
+		return nil, err
+	}
+	accum := args[2]
+	for i := 0; i < list.Len(); i++ {
+		if accum, err = call(accum, list.Get(i)); err != nil {
+			return nil, err
+		}
+	}
+	return accum, nil
+}
+
+// anyMatch reports whether func returns want for some member of the array.
+func anyMatch(want bool) extern.NativeFunc {
+	return func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+		list := args[0].(*values.List)
+		call, err := callback(ctx, args[1])
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < list.Len(); i++ {
+			result, err := call(list.Get(i))
+			if err != nil {
+				return nil, err
+			}
+			if result.(bool) == want {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+func some(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return anyMatch(true)(ctx, args)
+}
+
+func every(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	found, err := anyMatch(false)(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return !found.(bool), nil
+}
+
+func indexOf(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	start := int(args[2].(int64))
+	if start < 0 || start > list.Len() {
+		panic(values.NewErrorWithMessage(fmt.Sprintf("invalid array index: %d", start)))
+	}
+	for i := start; i < list.Len(); i++ {
+		if values.DeepEquals(list.Get(i), args[1]) {
+			return int64(i), nil
+		}
+	}
+	return nil, nil
+}
+
+// lastIndexOf is called without the start index when the call does not supply it.
+func lastIndexOf(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	start := list.Len() - 1
+	if len(args) > 2 {
+		start = int(args[2].(int64))
+		if start < 0 || start >= list.Len() {
+			panic(values.NewErrorWithMessage(fmt.Sprintf("invalid array index: %d", start)))
+		}
+	}
+	for i := start; i >= 0; i-- {
+		if values.DeepEquals(list.Get(i), args[1]) {
+			return int64(i), nil
+		}
+	}
+	return nil, nil
+}
+
+// slice is called without the end index when the call does not supply it.
+func (m *arrayModule) slice(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	start := int(args[1].(int64))
+	end := list.Len()
+	if len(args) > 2 {
+		end = int(args[2].(int64))
+	}
+	if start < 0 || start > list.Len() {
+		panic(values.NewErrorWithMessage(fmt.Sprintf("invalid array index: %d", start)))
+	}
+	if end < start || end > list.Len() {
+		panic(values.NewErrorWithMessage(fmt.Sprintf("invalid array index: %d", end)))
+	}
+	return m.newList(ctx, memberType(ctx, list), members(list)[start:end]), nil
+}
+
+func (m *arrayModule) reverse(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	items := members(list)
+	slices.Reverse(items)
+	return m.newList(ctx, memberType(ctx, list), items), nil
+}
+
+// sort returns a sorted copy of the array. The sort is stable; with a key function the members are ordered by
+// their keys.
+func (m *arrayModule) sort(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	ascending := args[1].(string) == "ascending"
+	items := members(list)
+	keys := items
+	if args[2] != nil {
+		call, err := callback(ctx, args[2])
+		if err != nil {
+			return nil, err
+		}
+		keys = make([]values.BalValue, len(items))
+		for i, item := range items {
+			if keys[i], err = call(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return int(values.CompareK(keys[a], keys[b], ascending))
+	})
+	sorted := make([]values.BalValue, len(items))
+	for i, idx := range order {
+		sorted[i] = items[idx]
+	}
+	return m.newList(ctx, memberType(ctx, list), sorted), nil
+}
+
+func pop(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	if list.Len() == 0 {
+		panic(values.NewErrorWithMessage("cannot pop an empty array"))
+	}
+	return list.Remove(ctx.TypeCtx, list.Len()-1), nil
+}
+
+func shift(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	if list.Len() == 0 {
+		panic(values.NewErrorWithMessage("cannot shift an empty array"))
+	}
+	return list.Remove(ctx.TypeCtx, 0), nil
+}
+
+func unshift(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	list.Insert(ctx.TypeCtx, 0, args[1:]...)
+	return nil, nil
+}
+
+func remove(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	return list.Remove(ctx.TypeCtx, int(args[1].(int64))), nil
+}
+
+func removeAll(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	args[0].(*values.List).SetLength(0)
+	return nil, nil
+}
+
+func setLength(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	args[0].(*values.List).SetLength(int(args[1].(int64)))
+	return nil, nil
+}
+
+func (m *arrayModule) enumerate(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	memberTy := memberType(ctx, list)
+	entryTy := m.enumerations.get(memberTy)
+	entryAtomic := semtypes.ToListAtomicType(ctx.TypeCtx, entryTy)
+	items := make([]values.BalValue, list.Len())
+	for i := range items {
+		items[i] = values.NewList(entryTy, entryAtomic, false, nil, 0, []values.BalValue{int64(i), list.Get(i)})
+	}
+	return m.newList(ctx, entryTy, items), nil
+}
+
+// listIterator is the state of an iterator object over an array.
+type listIterator struct {
+	list     *values.List
+	recordTy semtypes.SemType
+	index    int
+}
+
+func (m *arrayModule) iterator(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	memberTy := memberType(ctx, list)
+	state := &listIterator{list: list, recordTy: m.records.get(memberTy)}
+	return values.NewObject(m.iterators.get(memberTy),
+		map[string]values.BalValue{"$iterator": state},
+		map[string]string{"next": iteratorNextKey},
+		nil), nil
+}
+
+func (m *arrayModule) iteratorNext(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	self := args[0].(*values.Object)
+	state, _ := self.Get("$iterator")
+	it := state.(*listIterator)
+	if it.index >= it.list.Len() {
+		return nil, nil
+	}
+	value := it.list.Get(it.index)
+	it.index++
+	return m.valueRecord(ctx, it.recordTy, value), nil
+}
+
+func (m *arrayModule) toStream(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	memberTy := memberType(ctx, list)
+	recordTy := m.records.get(memberTy)
+	index := 0
+	next := func() values.BalValue {
+		if index >= list.Len() {
+			return nil
+		}
+		value := list.Get(index)
+		index++
+		return m.valueRecord(ctx, recordTy, value)
+	}
+	return values.NewStream(m.streams.get(memberTy), next, nil), nil
+}
+
+func toBase64(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	b := make([]byte, list.Len())
+	for i := range b {
+		b[i] = byte(list.Get(i).(int64))
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+func (m *arrayModule) fromBase64(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	str := strings.Join(strings.Fields(args[0].(string)), "")
+	b, err := base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		return values.NewErrorWithMessage("invalid base64 string"), nil
+	}
+	items := make([]values.BalValue, len(b))
+	for i, v := range b {
+		items[i] = int64(v)
+	}
+	return values.NewList(m.byteArrayTy, semtypes.ToListAtomicType(ctx.TypeCtx, m.byteArrayTy), false, nil, 0, items), nil
+}
+
 func initArrayModule(rt *runtime.Runtime) {
+	m := newArrayModule(rt.GetTypeEnv())
 	runtime.RegisterExternFunction(rt, orgName, moduleName, "push", func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
 		if list, ok := args[0].(*values.List); ok {
 			list.Append(ctx.TypeCtx, args[1:]...)
@@ -42,6 +471,29 @@ func initArrayModule(rt *runtime.Runtime) {
 		}
 		return nil, fmt.Errorf("first argument must be an array")
 	})
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "map", m.mapFn)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "filter", m.filter)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "forEach", forEach)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "reduce", reduce)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "some", some)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "every", every)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "indexOf", indexOf)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "lastIndexOf", lastIndexOf)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "slice", m.slice)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "reverse", m.reverse)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "sort", m.sort)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "pop", pop)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "shift", shift)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "unshift", unshift)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "remove", remove)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "removeAll", removeAll)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "setLength", setLength)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "enumerate", m.enumerate)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "iterator", m.iterator)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "ListIterator.next", m.iteratorNext)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "toStream", m.toStream)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "toBase64", toBase64)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "fromBase64", m.fromBase64)
 }
 
 func init() {
@@ -17,8 +17,11 @@
 package errorrt
 
 import (
+	"path/filepath"
+
 	"ballerina-lang-go/runtime"
 	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/semtypes"
 	"ballerina-lang-go/values"
 )
 
@@ -32,8 +35,70 @@ func errorMessage(_ *extern.Context, args []values.BalValue) (values.BalValue, e
 	return err.Message, nil
 }
 
+func errorCause(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	err := args[0].(*values.Error)
+	return err.Cause, nil
+}
+
+func errorDetail(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	err := args[0].(*values.Error)
+	return err.Detail, nil
+}
+
+func errorToString(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return values.String(args[0], make(map[uintptr]bool)), nil
+}
+
+func errorToBalString(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return values.ToBalString(args[0]), nil
+}
+
+// frameListTypes are the types of the value returned by externStackTrace: a
+// list of [callableName, moduleName, fileName, lineNumber] tuples.
+type frameListTypes struct {
+	frame     semtypes.SemType
+	frameList semtypes.SemType
+}
+
+func newFrameListTypes(env semtypes.Env) *frameListTypes {
+	frameLd := semtypes.NewListDefinition()
+	frame := frameLd.TupleTypeWrapped(env, semtypes.STRING, semtypes.Union(semtypes.STRING, semtypes.NIL), semtypes.STRING, semtypes.INT)
+	frameListLd := semtypes.NewListDefinition()
+	frameList := frameListLd.DefineListTypeWrappedWithEnvSemType(env, frame)
+	return &frameListTypes{frame: frame, frameList: frameList}
+}
+
+func (ft *frameListTypes) newList(ctx *extern.Context, ty semtypes.SemType, items []values.BalValue) *values.List {
+	atomic := semtypes.ToListAtomicType(ctx.TypeCtx, ty)
+	return values.NewList(ty, atomic, false, nil, 0, items)
+}
+
+func (ft *frameListTypes) stackTrace(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	err := args[0].(*values.Error)
+	frames := make([]values.BalValue, len(err.StackTrace))
+	for i, f := range err.StackTrace {
+		var module values.BalValue
+		if name := f.ModuleName(); name != "" {
+			module = name
+		}
+		fileName := "unknown"
+		if f.FilePath != "" {
+			fileName = filepath.Base(f.FilePath)
+		}
+		items := []values.BalValue{f.CallableName(), module, fileName, int64(f.Line)}
+		frames[i] = ft.newList(ctx, ft.frame, items)
+	}
+	return ft.newList(ctx, ft.frameList, frames), nil
+}
+
 func initErrorModule(rt *runtime.Runtime) {
+	ft := newFrameListTypes(rt.GetTypeEnv())
 	runtime.RegisterExternFunction(rt, orgName, moduleName, "message", errorMessage)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "cause", errorCause)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "detail", errorDetail)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "externStackTrace", ft.stackTrace)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "toString", errorToString)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "toBalString", errorToBalString)
 }
 
 func init() {
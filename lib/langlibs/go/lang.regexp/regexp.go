@@ -0,0 +1,181 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package regexpruntime
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"ballerina-lang-go/runtime"
+	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/values"
+)
+
+const (
+	orgName    = "ballerina"
+	moduleName = "lang.regexp"
+)
+
+// listTypes are the list types of the values returned by the extern functions.
+type listTypes struct {
+	intList     semtypes.SemType
+	intListList semtypes.SemType
+	stringList  semtypes.SemType
+}
+
+func newListTypes(env semtypes.Env) *listTypes {
+	intLd := semtypes.NewListDefinition()
+	intList := intLd.DefineListTypeWrappedWithEnvSemType(env, semtypes.INT)
+	intListLd := semtypes.NewListDefinition()
+	intListList := intListLd.DefineListTypeWrappedWithEnvSemType(env, intList)
+	stringLd := semtypes.NewListDefinition()
+	stringList := stringLd.DefineListTypeWrappedWithEnvSemType(env, semtypes.STRING)
+	return &listTypes{intList: intList, intListList: intListList, stringList: stringList}
+}
+
+func (lt *listTypes) newList(ctx *extern.Context, ty semtypes.SemType, items []values.BalValue) *values.List {
+	atomic := semtypes.ToListAtomicType(ctx.TypeCtx, ty)
+	return values.NewList(ty, atomic, false, nil, 0, items)
+}
+
+// spanList converts the byte offsets of a submatch to a list of code point
+// index pairs, as expected by the Ballerina side. Groups that did not take
+// part in the match are -1.
+func (lt *listTypes) spanList(ctx *extern.Context, str string, offset int, loc []int) *values.List {
+	items := make([]values.BalValue, len(loc))
+	for i, b := range loc {
+		if b < 0 {
+			items[i] = int64(-1)
+			continue
+		}
+		items[i] = int64(utf8.RuneCountInString(str[:offset+b]))
+	}
+	return lt.newList(ctx, lt.intList, items)
+}
+
+// byteOffset returns the byte offset of the code point at index in str. It
+// panics if index is not within [0, length of str].
+func byteOffset(str string, index int64) int {
+	if index < 0 {
+		panic(values.NewErrorWithMessage(fmt.Sprintf("index out of range: index: %d, size: %d", index, utf8.RuneCountInString(str))))
+	}
+	offset := 0
+	for i := int64(0); i < index; i++ {
+		if offset >= len(str) {
+			panic(values.NewErrorWithMessage(fmt.Sprintf("index out of range: index: %d, size: %d", index, utf8.RuneCountInString(str))))
+		}
+		_, size := utf8.DecodeRuneInString(str[offset:])
+		offset += size
+	}
+	return offset
+}
+
+func (lt *listTypes) find(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	re := args[0].(*values.RegExp)
+	str := args[1].(string)
+	offset := byteOffset(str, args[2].(int64))
+	loc := re.Regexp().FindStringSubmatchIndex(str[offset:])
+	if loc == nil {
+		return lt.newList(ctx, lt.intList, nil), nil
+	}
+	return lt.spanList(ctx, str, offset, loc), nil
+}
+
+func (lt *listTypes) findAll(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	re := args[0].(*values.RegExp)
+	str := args[1].(string)
+	offset := byteOffset(str, args[2].(int64))
+	matches := re.Regexp().FindAllStringSubmatchIndex(str[offset:], -1)
+	items := make([]values.BalValue, len(matches))
+	for i, loc := range matches {
+		items[i] = lt.spanList(ctx, str, offset, loc)
+	}
+	return lt.newList(ctx, lt.intListList, items), nil
+}
+
+func (lt *listTypes) matchAt(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	re := args[0].(*values.RegExp)
+	str := args[1].(string)
+	offset := byteOffset(str, args[2].(int64))
+	loc := re.Anchored().FindStringSubmatchIndex(str[offset:])
+	if loc == nil {
+		return lt.newList(ctx, lt.intList, nil), nil
+	}
+	return lt.spanList(ctx, str, offset, loc), nil
+}
+
+func (lt *listTypes) fullMatch(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	re := args[0].(*values.RegExp)
+	str := args[1].(string)
+	loc := re.Full().FindStringSubmatchIndex(str)
+	if loc == nil {
+		return lt.newList(ctx, lt.intList, nil), nil
+	}
+	return lt.spanList(ctx, str, 0, loc), nil
+}
+
+func (lt *listTypes) split(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	re := args[0].(*values.RegExp)
+	str := args[1].(string)
+	parts := re.Regexp().Split(str, -1)
+	items := make([]values.BalValue, len(parts))
+	for i, part := range parts {
+		items[i] = part
+	}
+	return lt.newList(ctx, lt.stringList, items), nil
+}
+
+func isFullMatch(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	re := args[0].(*values.RegExp)
+	str := args[1].(string)
+	return re.Full().MatchString(str), nil
+}
+
+func fromString(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	re, err := values.NewRegExp(args[0].(string))
+	if err != nil {
+		return err, nil
+	}
+	return re, nil
+}
+
+func substring(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	str := args[0].(string)
+	start := byteOffset(str, args[1].(int64))
+	end := byteOffset(str, args[2].(int64))
+	if end < start {
+		return nil, fmt.Errorf("substring end index %d is before start index %d", args[2].(int64), args[1].(int64))
+	}
+	return str[start:end], nil
+}
+
+func initRegExpModule(rt *runtime.Runtime) {
+	lt := newListTypes(rt.GetTypeEnv())
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "externFind", lt.find)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "externFindAll", lt.findAll)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "externMatchAt", lt.matchAt)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "externFullMatch", lt.fullMatch)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "externSubstring", substring)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "split", lt.split)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "isFullMatch", isFullMatch)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "fromString", fromString)
+}
+
+func init() {
+	runtime.RegisterModuleInitializer(initRegExpModule)
+}
@@ -18,26 +18,314 @@ package stringruntime
 
 import (
 	"fmt"
+	"strings"
 	"unicode/utf8"
 
 	"ballerina-lang-go/runtime"
 	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/semtypes"
 	"ballerina-lang-go/values"
 )
 
 const (
 	orgName    = "ballerina"
 	moduleName = "lang.string"
+
+	iteratorNextKey = "ballerina/lang.string:StringIterator.next"
 )
 
-func initStringModule(rt *runtime.Runtime) {
-	runtime.RegisterExternFunction(rt, orgName, moduleName, "length", func(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
-		s, ok := args[0].(string)
-		if !ok {
-			return nil, fmt.Errorf("first argument must be a string")
+// All indices are in code points; strings are held as UTF-8, so they are
+// converted to byte offsets before slicing.
+
+// stringTypes are the types of the values constructed by the extern functions.
+type stringTypes struct {
+	byteList   semtypes.SemType
+	intList    semtypes.SemType
+	iteratorTy semtypes.SemType
+	nextRecord semtypes.SemType
+}
+
+func newStringTypes(env semtypes.Env) *stringTypes {
+	byteLd := semtypes.NewListDefinition()
+	intLd := semtypes.NewListDefinition()
+	md := semtypes.NewMappingDefinition()
+	return &stringTypes{
+		byteList:   byteLd.DefineListTypeWrappedWithEnvSemType(env, semtypes.BYTE),
+		intList:    intLd.DefineListTypeWrappedWithEnvSemType(env, semtypes.INT),
+		iteratorTy: semtypes.CreateIteratorType(env, semtypes.CHAR, semtypes.NIL),
+		nextRecord: md.DefineMappingTypeWrapped(env, []semtypes.Field{semtypes.FieldFrom("value", semtypes.CHAR, false, false)}, semtypes.NEVER),
+	}
+}
+
+func (st *stringTypes) newList(ctx *extern.Context, ty semtypes.SemType, items []values.BalValue) *values.List {
+	atomic := semtypes.ToListAtomicType(ctx.TypeCtx, ty)
+	return values.NewList(ty, atomic, false, nil, 0, items)
+}
+
+func indexOutOfRange(index int64, str string) *values.Error {
+	return values.NewErrorWithMessage(fmt.Sprintf("index out of range: index: %d, size: %d", index, utf8.RuneCountInString(str)))
+}
+
+// byteOffset returns the byte offset of the code point at index in str. It
+// panics if index is not within [0, length of str].
+func byteOffset(str string, index int64) int {
+	if index < 0 {
+		panic(indexOutOfRange(index, str))
+	}
+	offset := 0
+	for i := int64(0); i < index; i++ {
+		if offset >= len(str) {
+			panic(indexOutOfRange(index, str))
+		}
+		_, size := utf8.DecodeRuneInString(str[offset:])
+		offset += size
+	}
+	return offset
+}
+
+func length(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	s, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("first argument must be a string")
+	}
+	return int64(utf8.RuneCountInString(s)), nil
+}
+
+func substring(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	str := args[0].(string)
+	startIndex, endIndex := args[1].(int64), args[2].(int64)
+	if endIndex < startIndex {
+		panic(indexOutOfRange(endIndex, str))
+	}
+	return str[byteOffset(str, startIndex):byteOffset(str, endIndex)], nil
+}
+
+func codePointCompare(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	// The order of UTF-8 byte sequences is the order of the code points they encode.
+	return int64(strings.Compare(args[0].(string), args[1].(string))), nil
+}
+
+func join(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	strs := make([]string, len(args)-1)
+	for i, arg := range args[1:] {
+		strs[i] = arg.(string)
+	}
+	return strings.Join(strs, args[0].(string)), nil
+}
+
+func concat(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	var sb strings.Builder
+	for _, arg := range args {
+		sb.WriteString(arg.(string))
+	}
+	return sb.String(), nil
+}
+
+// indexFrom returns the code point index of the first occurrence of substr in
+// str at or after startIndex, or -1 if there is none.
+func indexFrom(str, substr string, startIndex int64) int64 {
+	start := byteOffset(str, startIndex)
+	i := strings.Index(str[start:], substr)
+	if i < 0 {
+		return -1
+	}
+	return startIndex + int64(utf8.RuneCountInString(str[start:start+i]))
+}
+
+func indexOf(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	if i := indexFrom(args[0].(string), args[1].(string), args[2].(int64)); i >= 0 {
+		return i, nil
+	}
+	return nil, nil
+}
+
+func lastIndexOf(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	str, substr, startIndex := args[0].(string), args[1].(string), args[2].(int64)
+	if startIndex < 0 {
+		return nil, nil
+	}
+	startIndex = min(startIndex, int64(utf8.RuneCountInString(str)))
+	start := byteOffset(str, startIndex)
+	i := strings.LastIndex(str[:min(start+len(substr), len(str))], substr)
+	if i < 0 {
+		return nil, nil
+	}
+	return int64(utf8.RuneCountInString(str[:i])), nil
+}
+
+func includes(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return indexFrom(args[0].(string), args[1].(string), args[2].(int64)) >= 0, nil
+}
+
+func startsWith(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return strings.HasPrefix(args[0].(string), args[1].(string)), nil
+}
+
+func endsWith(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return strings.HasSuffix(args[0].(string), args[1].(string)), nil
+}
+
+// mapASCII replaces each byte of str in [from, from+26) by the corresponding
+// byte in [to, to+26). Multi-byte UTF-8 sequences never contain ASCII bytes,
+// so they are left unchanged.
+func mapASCII(str string, from, to byte) string {
+	b := []byte(str)
+	for i, c := range b {
+		if c >= from && c < from+26 {
+			b[i] = c - from + to
 		}
-		return int64(utf8.RuneCountInString(s)), nil
-	})
+	}
+	return string(b)
+}
+
+func toUpperASCII(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return mapASCII(args[0].(string), 'a', 'A'), nil
+}
+
+func toLowerASCII(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return mapASCII(args[0].(string), 'A', 'a'), nil
+}
+
+func equalsIgnoreCaseASCII(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return mapASCII(args[0].(string), 'A', 'a') == mapASCII(args[1].(string), 'A', 'a'), nil
+}
+
+func trim(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return strings.Trim(args[0].(string), "\t\n\v\f\r "), nil
+}
+
+func (st *stringTypes) toBytes(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	str := args[0].(string)
+	items := make([]values.BalValue, len(str))
+	for i := range len(str) {
+		items[i] = int64(str[i])
+	}
+	return st.newList(ctx, st.byteList, items), nil
+}
+
+func fromBytes(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	b := make([]byte, list.Len())
+	for i := range b {
+		b[i] = byte(list.Get(i).(int64))
+	}
+	if !utf8.Valid(b) {
+		return values.NewErrorWithMessage("invalid UTF-8 byte sequence"), nil
+	}
+	return string(b), nil
+}
+
+func (st *stringTypes) toCodePointInts(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	var items []values.BalValue
+	for _, r := range args[0].(string) {
+		items = append(items, int64(r))
+	}
+	return st.newList(ctx, st.intList, items), nil
+}
+
+func fromCodePointInts(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	list := args[0].(*values.List)
+	var sb strings.Builder
+	for i := range list.Len() {
+		cp := list.Get(i).(int64)
+		if cp < 0 || cp > utf8.MaxRune || !utf8.ValidRune(rune(cp)) {
+			return values.NewErrorWithMessage(fmt.Sprintf("invalid code point: %d", cp)), nil
+		}
+		sb.WriteRune(rune(cp))
+	}
+	return sb.String(), nil
+}
+
+func getCodePoint(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	str, index := args[0].(string), args[1].(int64)
+	offset := byteOffset(str, index)
+	if offset >= len(str) {
+		panic(indexOutOfRange(index, str))
+	}
+	r, _ := utf8.DecodeRuneInString(str[offset:])
+	return int64(r), nil
+}
+
+// padding returns the padChar characters needed to make str have length len.
+func padding(str string, length int64, padChar string) string {
+	n := length - int64(utf8.RuneCountInString(str))
+	if n <= 0 {
+		return ""
+	}
+	return strings.Repeat(padChar, int(n))
+}
+
+func padStart(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	str := args[0].(string)
+	return padding(str, args[1].(int64), args[2].(string)) + str, nil
+}
+
+func padEnd(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	str := args[0].(string)
+	return str + padding(str, args[1].(int64), args[2].(string)), nil
+}
+
+func padZero(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	str := args[0].(string)
+	pad := padding(str, args[1].(int64), args[2].(string))
+	if strings.HasPrefix(str, "+") || strings.HasPrefix(str, "-") {
+		return str[:1] + pad + str[1:], nil
+	}
+	return pad + str, nil
+}
+
+// stringIterator is the state of an iterator object over a string.
+type stringIterator struct {
+	str    string
+	offset int
+}
+
+func (st *stringTypes) iterator(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return values.NewObject(st.iteratorTy,
+		map[string]values.BalValue{"$iterator": &stringIterator{str: args[0].(string)}},
+		map[string]string{"next": iteratorNextKey},
+		nil), nil
+}
+
+func (st *stringTypes) iteratorNext(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	state, _ := args[0].(*values.Object).Get("$iterator")
+	it := state.(*stringIterator)
+	if it.offset >= len(it.str) {
+		return nil, nil
+	}
+	_, size := utf8.DecodeRuneInString(it.str[it.offset:])
+	value := it.str[it.offset : it.offset+size]
+	it.offset += size
+	atomic := semtypes.ToMappingAtomicType(ctx.TypeCtx, st.nextRecord)
+	return values.NewMap(st.nextRecord, atomic, false, []values.MapEntry{{Key: "value", Value: value}}), nil
+}
+
+func initStringModule(rt *runtime.Runtime) {
+	st := newStringTypes(rt.GetTypeEnv())
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "length", length)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "substring", substring)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "codePointCompare", codePointCompare)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "join", join)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "concat", concat)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "indexOf", indexOf)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "lastIndexOf", lastIndexOf)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "includes", includes)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "startsWith", startsWith)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "endsWith", endsWith)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "toUpperAscii", toUpperASCII)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "toLowerAscii", toLowerASCII)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "equalsIgnoreCaseAscii", equalsIgnoreCaseASCII)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "trim", trim)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "toBytes", st.toBytes)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "fromBytes", fromBytes)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "toCodePointInts", st.toCodePointInts)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "fromCodePointInts", fromCodePointInts)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "getCodePoint", getCodePoint)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "padStart", padStart)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "padEnd", padEnd)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "padZero", padZero)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "iterator", st.iterator)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "StringIterator.next", st.iteratorNext)
 }
 
 func init() {
@@ -0,0 +1,106 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tablert
+
+import (
+	"ballerina-lang-go/runtime"
+	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/values"
+)
+
+const (
+	orgName    = "ballerina"
+	moduleName = "lang.table"
+)
+
+func tableLength(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	t := args[0].(*values.Table)
+	return int64(t.Len()), nil
+}
+
+func tableRemoveAll(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	t := args[0].(*values.Table)
+	t.RemoveAll()
+	return nil, nil
+}
+
+func tablePut(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	t := args[0].(*values.Table)
+	t.Put(ctx.TypeCtx, args[1].(*values.Map))
+	return nil, nil
+}
+
+func tableAdd(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	t := args[0].(*values.Table)
+	t.Add(ctx.TypeCtx, args[1].(*values.Map))
+	return nil, nil
+}
+
+func tableGet(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	t := args[0].(*values.Table)
+	row, ok := t.Get(args[1])
+	if !ok {
+		panic(values.NewErrorWithMessage("no member found for key"))
+	}
+	return row, nil
+}
+
+func tableHasKey(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	t := args[0].(*values.Table)
+	_, ok := t.Get(args[1])
+	return ok, nil
+}
+
+func tableRemove(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	t := args[0].(*values.Table)
+	row, ok := t.Remove(args[1])
+	if !ok {
+		panic(values.NewErrorWithMessage("no member found for key"))
+	}
+	return row, nil
+}
+
+func tableToArray(env semtypes.Env) extern.NativeFunc {
+	ld := semtypes.NewListDefinition()
+	rowArrayTy := ld.DefineListTypeWrappedWithEnvSemType(env, semtypes.MAPPING)
+	return func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+		t := args[0].(*values.Table)
+		rows := t.Rows()
+		items := make([]values.BalValue, len(rows))
+		for i, row := range rows {
+			items[i] = row
+		}
+		atomic := semtypes.ToListAtomicType(ctx.TypeCtx, rowArrayTy)
+		return values.NewList(rowArrayTy, atomic, false, nil, 0, items), nil
+	}
+}
+
+func initTableModule(rt *runtime.Runtime) {
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "length", tableLength)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "removeAll", tableRemoveAll)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "put", tablePut)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "add", tableAdd)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "get", tableGet)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "hasKey", tableHasKey)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "remove", tableRemove)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "toArray", tableToArray(rt.GetTypeEnv()))
+}
+
+func init() {
+	runtime.RegisterModuleInitializer(initTableModule)
+}
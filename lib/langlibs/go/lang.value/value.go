@@ -0,0 +1,133 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package valuert
+
+import (
+	"ballerina-lang-go/runtime"
+	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/values"
+)
+
+const (
+	orgName    = "ballerina"
+	moduleName = "lang.value"
+)
+
+// valueTypes holds the structured types of values created by the module.
+type valueTypes struct {
+	jsonListTy    semtypes.SemType
+	jsonMapTy     semtypes.SemType
+	anydataListTy semtypes.SemType
+	anydataMapTy  semtypes.SemType
+}
+
+func newValueTypes(env semtypes.Env) *valueTypes {
+	cx := semtypes.ContextFrom(env)
+	jsonTy := semtypes.CreateJSON(cx)
+	anydataTy := semtypes.CreateAnydata(cx)
+	jsonListLd := semtypes.NewListDefinition()
+	jsonMapMd := semtypes.NewMappingDefinition()
+	anydataListLd := semtypes.NewListDefinition()
+	anydataMapMd := semtypes.NewMappingDefinition()
+	return &valueTypes{
+		jsonListTy:    jsonListLd.DefineListTypeWrappedWithEnvSemType(env, jsonTy),
+		jsonMapTy:     jsonMapMd.DefineMappingTypeWrapped(env, nil, jsonTy),
+		anydataListTy: anydataListLd.DefineListTypeWrappedWithEnvSemType(env, anydataTy),
+		anydataMapTy:  anydataMapMd.DefineMappingTypeWrapped(env, nil, anydataTy),
+	}
+}
+
+func clone(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return values.Clone(ctx.TypeCtx, args[0]), nil
+}
+
+func cloneReadOnly(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return values.CloneReadOnly(ctx.TypeCtx, args[0]), nil
+}
+
+func isReadOnly(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return semtypes.IsSubtype(ctx.TypeCtx, values.SemTypeForValue(args[0]), semtypes.VAL_READONLY), nil
+}
+
+func cloneWithType(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return values.CloneWithType(ctx.TypeCtx, args[0], args[1].(*values.TypeDesc).Type), nil
+}
+
+func ensureType(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return values.EnsureType(ctx.TypeCtx, args[0], args[1].(*values.TypeDesc).Type), nil
+}
+
+func toString(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return values.String(args[0], make(map[uintptr]bool)), nil
+}
+
+func toBalString(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return values.ToBalString(args[0]), nil
+}
+
+func count(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return int64(len(args)), nil
+}
+
+func mergeJson(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return values.MergeJSON(ctx.TypeCtx, args[0], args[1]), nil
+}
+
+func initValueModule(rt *runtime.Runtime) {
+	types := newValueTypes(rt.GetTypeEnv())
+
+	fromBalString := func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+		return values.FromBalString(ctx.TypeCtx, args[0].(string), types.anydataListTy, types.anydataMapTy), nil
+	}
+	toJson := func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+		return values.ToJSON(ctx.TypeCtx, args[0], types.jsonListTy, types.jsonMapTy), nil
+	}
+	toJsonString := func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+		return values.ToJSONString(values.ToJSON(ctx.TypeCtx, args[0], types.jsonListTy, types.jsonMapTy)), nil
+	}
+	fromJsonString := func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+		return values.ParseJSON(ctx.TypeCtx, args[0].(string), types.jsonListTy, types.jsonMapTy), nil
+	}
+	fromJsonStringWithType := func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+		v := values.ParseJSON(ctx.TypeCtx, args[0].(string), types.jsonListTy, types.jsonMapTy)
+		if err, ok := v.(*values.Error); ok {
+			return err, nil
+		}
+		return values.CloneWithType(ctx.TypeCtx, v, args[1].(*values.TypeDesc).Type), nil
+	}
+
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "clone", clone)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "cloneReadOnly", cloneReadOnly)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "isReadOnly", isReadOnly)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "cloneWithType", cloneWithType)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "ensureType", ensureType)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "toString", toString)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "toBalString", toBalString)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "fromBalString", fromBalString)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "toJson", toJson)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "toJsonString", toJsonString)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "fromJsonString", fromJsonString)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "fromJsonWithType", cloneWithType)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "fromJsonStringWithType", fromJsonStringWithType)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "mergeJson", mergeJson)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "count", count)
+}
+
+func init() {
+	runtime.RegisterModuleInitializer(initValueModule)
+}
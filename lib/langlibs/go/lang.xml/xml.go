@@ -0,0 +1,103 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package xmlrt
+
+import (
+	"fmt"
+	"strings"
+
+	"ballerina-lang-go/runtime"
+	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/values"
+)
+
+const (
+	orgName    = "ballerina"
+	moduleName = "lang.xml"
+)
+
+func xmlLength(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return int64(len(values.XMLItems(args[0].(values.XMLValue)))), nil
+}
+
+func xmlGet(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	items := values.XMLItems(args[0].(values.XMLValue))
+	i := args[1].(int64)
+	if i < 0 || i >= int64(len(items)) {
+		panic(values.NewErrorWithMessage(fmt.Sprintf("index out of range: index: %d, size: %d", i, len(items))))
+	}
+	return items[i], nil
+}
+
+func xmlChildren(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	var children []values.XMLValue
+	for _, item := range values.XMLItems(args[0].(values.XMLValue)) {
+		if elem, ok := item.(*values.XMLElement); ok {
+			children = append(children, values.XMLItems(elem.Children)...)
+		}
+	}
+	return values.NewXMLConcatSequence(values.XMLItems(values.NewXMLConcatSequence(children...))...), nil
+}
+
+func xmlElements(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	var elems []values.XMLValue
+	for _, item := range values.XMLItems(args[0].(values.XMLValue)) {
+		if elem, ok := item.(*values.XMLElement); ok {
+			elems = append(elems, elem)
+		}
+	}
+	return values.NewXMLConcatSequence(elems...), nil
+}
+
+func xmlGetChildren(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return values.NewXMLConcatSequence(values.XMLItems(args[0].(*values.XMLElement).Children)...), nil
+}
+
+func xmlGetName(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return args[0].(*values.XMLElement).Name, nil
+}
+
+func xmlData(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	var b strings.Builder
+	appendXMLData(&b, args[0].(values.XMLValue))
+	return b.String(), nil
+}
+
+func appendXMLData(b *strings.Builder, x values.XMLValue) {
+	for _, item := range values.XMLItems(x) {
+		switch v := item.(type) {
+		case *values.XMLText:
+			b.WriteString(v.Body)
+		case *values.XMLElement:
+			appendXMLData(b, v.Children)
+		}
+	}
+}
+
+func initXMLModule(rt *runtime.Runtime) {
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "length", xmlLength)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "get", xmlGet)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "children", xmlChildren)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "elements", xmlElements)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "getChildren", xmlGetChildren)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "getName", xmlGetName)
+	runtime.RegisterExternFunction(rt, orgName, moduleName, "data", xmlData)
+}
+
+func init() {
+	runtime.RegisterModuleInitializer(initXMLModule)
+}
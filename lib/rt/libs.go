@@ -25,7 +25,11 @@ import (
 	_ "ballerina-lang-go/lib/langlibs/go/lang.float"
 	_ "ballerina-lang-go/lib/langlibs/go/lang.int"
 	_ "ballerina-lang-go/lib/langlibs/go/lang.map"
+	_ "ballerina-lang-go/lib/langlibs/go/lang.regexp"
 	_ "ballerina-lang-go/lib/langlibs/go/lang.string"
+	_ "ballerina-lang-go/lib/langlibs/go/lang.table"
+	_ "ballerina-lang-go/lib/langlibs/go/lang.value"
+	_ "ballerina-lang-go/lib/langlibs/go/lang.xml"
 
 	// standard libraries
 	_ "ballerina-lang-go/lib/stdlibs/ballerina/http/0.0.1/go1.2/native"
@@ -17,6 +17,40 @@
 // Supported subset of ballerina/http for the Go runtime.
 // See lib/http/client-support.md for the full feature support matrix.
 
+// ── Error types ──────────────────────────────────────────────────────────────
+
+// Represents the generic HTTP error.
+public type Error distinct error;
+
+// Represents the errors of the HTTP client and of the responses it returns.
+public type ClientError distinct Error;
+
+// Represents a client error that has no more specific type.
+public type GenericClientError distinct ClientError;
+
+// Represents the error returned when a requested header is not present.
+public type HeaderNotFoundError distinct GenericClientError;
+
+// Represents an error that occurred while sending a request.
+public type OutboundRequestError distinct ClientError;
+
+// Represents an error that occurred while building a request, before it was sent.
+public type InitializingOutboundRequestError distinct OutboundRequestError;
+
+// createError is called by the native functions to construct the distinct errors of the module,
+// whose type-ids are only known to the compiled module.
+isolated function createError(string kind, string message) returns Error {
+    match kind {
+        "HeaderNotFoundError" => {
+            return error HeaderNotFoundError(message);
+        }
+        "InitializingOutboundRequestError" => {
+            return error InitializingOutboundRequestError(message);
+        }
+    }
+    return error GenericClientError(message);
+}
+
 // ── Shared types ─────────────────────────────────────────────────────────────
 
 // Represents the parsed header value details.
@@ -33,8 +67,8 @@ public type HeaderValue record {|
 #
 # + headerValue - The header value
 # + return - An array of `http:HeaderValue` typed records containing the value and its parameter map,
-#            or an `error` if the header parsing fails
-public isolated function parseHeader(string headerValue) returns HeaderValue[]|error = external;
+#            or an `http:ClientError` if the header parsing fails
+public isolated function parseHeader(string headerValue) returns HeaderValue[]|ClientError = external;
 
 // ── TLS / secure-socket types ─────────────────────────────────────────────────
 
@@ -328,13 +362,13 @@ public class Response {
 
     # Parses the response body as JSON.
     #
-    # + return - The parsed `json` value, or an `error` if the body is not valid JSON
-    public isolated function getJsonPayload() returns json|error = external;
+    # + return - The parsed `json` value, or an `http:ClientError` if the body is not valid JSON
+    public isolated function getJsonPayload() returns json|ClientError = external;
 
     # Returns the response body as a byte array.
     #
-    # + return - The response body as `byte[]`, or an `error` if extraction fails
-    public isolated function getBinaryPayload() returns byte[]|error = external;
+    # + return - The response body as `byte[]`, or an `http:ClientError` if extraction fails
+    public isolated function getBinaryPayload() returns byte[]|ClientError = external;
 
     # Checks whether the specified header is present in the response.
     #
@@ -349,16 +383,16 @@ public class Response {
     # + headerName - The header name (case-insensitive)
     # + position - Header position (`LEADING` or `TRAILING`). `TRAILING` is accepted
     #              but all lookups operate on transport headers
-    # + return - The first header value, or an `error` if the header is not found
-    public isolated function getHeader(string headerName, HeaderPosition position = LEADING) returns string|error = external;
+    # + return - The first header value, or an `http:HeaderNotFoundError` if the header is not found
+    public isolated function getHeader(string headerName, HeaderPosition position = LEADING) returns string|HeaderNotFoundError = external;
 
     # Returns all values for the specified header.
     #
     # + headerName - The header name (case-insensitive)
     # + position - Header position (`LEADING` or `TRAILING`). `TRAILING` is accepted
     #              but all lookups operate on transport headers
-    # + return - A `string[]` of all values for the header, or an `error` if the header is not found
-    public isolated function getHeaders(string headerName, HeaderPosition position = LEADING) returns string[]|error = external;
+    # + return - A `string[]` of all values for the header, or an `http:HeaderNotFoundError` if the header is not found
+    public isolated function getHeaders(string headerName, HeaderPosition position = LEADING) returns string[]|HeaderNotFoundError = external;
 
     # Returns the names of all response headers.
     #
@@ -451,30 +485,30 @@ public class Request {
 
     # Returns the request body as a plain string.
     #
-    # + return - The request body as a `string`, or an `error` if extraction fails
-    public isolated function getTextPayload() returns string|error = external;
+    # + return - The request body as a `string`, or an `http:ClientError` if extraction fails
+    public isolated function getTextPayload() returns string|ClientError = external;
 
     # Parses the request body as JSON.
     #
-    # + return - The parsed `json` value, or an `error` if the body is not valid JSON
-    public isolated function getJsonPayload() returns json|error = external;
+    # + return - The parsed `json` value, or an `http:ClientError` if the body is not valid JSON
+    public isolated function getJsonPayload() returns json|ClientError = external;
 
     # Returns the request body as a byte array.
     #
-    # + return - The request body as `byte[]`, or an `error` if extraction fails
-    public isolated function getBinaryPayload() returns byte[]|error = external;
+    # + return - The request body as `byte[]`, or an `http:ClientError` if extraction fails
+    public isolated function getBinaryPayload() returns byte[]|ClientError = external;
 
     # Returns the first value for the specified request header.
     #
     # + headerName - The header name (case-insensitive)
-    # + return - The first header value, or an `error` if the header is not found
-    public isolated function getHeader(string headerName) returns string|error = external;
+    # + return - The first header value, or an `http:HeaderNotFoundError` if the header is not found
+    public isolated function getHeader(string headerName) returns string|HeaderNotFoundError = external;
 
     # Returns all values for the specified request header.
     #
     # + headerName - The header name (case-insensitive)
-    # + return - A `string[]` of all values for the header, or an `error` if not found
-    public isolated function getHeaders(string headerName) returns string[]|error = external;
+    # + return - A `string[]` of all values for the header, or an `http:HeaderNotFoundError` if not found
+    public isolated function getHeaders(string headerName) returns string[]|HeaderNotFoundError = external;
 
     # Checks whether the specified header is present in the request.
     #
@@ -526,7 +560,10 @@ public enum Method {
 # `execute`, `forward`.
 #
 # **Not supported:** `submit`/`getResponse`, HTTP/2 server push methods
-# (`hasPromise`, `getNextPromise`, etc.), and resource function syntax (`client->/path.get(...)`).
+# (`hasPromise`, `getNextPromise`, etc.).
+#
+# **Resource methods:** `get`, `post`, `put`, `patch`, `delete`, `head` and `options` are also
+# available as resource methods, so `client->/users/[id].get()` sends a GET request to `/users/<id>`.
 #
 # **Message body:** The `message` parameter accepts `RequestMessage` (`json|Request`).
 # The `Content-Type` is inferred when not explicitly overridden via `mediaType`:
@@ -537,12 +574,12 @@ public enum Method {
 #
 # The `mediaType` parameter overrides the inferred `Content-Type` in all cases.
 #
-# **Return type:** All methods return `Response|error`. Automatic data binding via
+# **Return type:** All methods return `Response|ClientError`. Automatic data binding via
 # `targetType` is not supported — use `getTextPayload()`, `getJsonPayload()`, or
 # `getBinaryPayload()` to extract the response body.
 #
-# **Error types:** Errors are plain `error` values. The upstream distinct error types
-# (`http:ClientError`, `http:HeaderNotFoundError`, etc.) are not declared.
+# **Error types:** Errors are `http:ClientError` values. Failures to build the request are
+# `http:InitializingOutboundRequestError`s; other failures are `http:GenericClientError`s.
 public isolated client class Client {
 
     # Gets invoked to initialize the `client`. During initialization, the configurations
@@ -553,19 +590,19 @@ public isolated client class Client {
     # + config - The configurations to be used when initializing the `client`.
     #            Unsupported fields (`circuitBreaker`, `retryConfig`, `cookieConfig`, etc.)
     #            are not available in this implementation
-    # + return - `()` on success, or an `error` if initialisation fails
-    public isolated function init(string url, ClientConfiguration config = {}) returns error? {
+    # + return - `()` on success, or an `http:ClientError` if initialisation fails
+    public isolated function init(string url, ClientConfiguration config = {}) returns ClientError? {
         return self.initNative(url, config);
     }
 
-    private isolated function initNative(string url, ClientConfiguration config) returns error? = external;
+    private isolated function initNative(string url, ClientConfiguration config) returns ClientError? = external;
 
     # Retrieves a representation of the specified resource from the remote HTTP endpoint.
     #
     # + path - The request path (appended to the base URL)
     # + headers - Optional request headers as a `map<string|string[]>`
-    # + return - The `http:Response` or an `error` if the request fails
-    remote isolated function get(string path, map<string|string[]>? headers = ()) returns Response|error = external;
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
+    remote isolated function get(string path, map<string|string[]>? headers = ()) returns Response|ClientError = external;
 
     # Creates a new resource or submits data to a resource for processing.
     #
@@ -573,9 +610,9 @@ public isolated client class Client {
     # + message - The request body (`string`, `byte[]`, JSON-compatible value, or `http:Request`)
     # + headers - Optional request headers as a `map<string|string[]>`
     # + mediaType - Optional `Content-Type` override; inferred from `message` if omitted
-    # + return - The `http:Response` or an `error` if the request fails
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
     remote isolated function post(string path, RequestMessage message, map<string|string[]>? headers = (),
-            string? mediaType = ()) returns Response|error = external;
+            string? mediaType = ()) returns Response|ClientError = external;
 
     # Creates a new resource or replaces a representation of the specified resource.
     #
@@ -583,9 +620,9 @@ public isolated client class Client {
     # + message - The request body (`string`, `byte[]`, JSON-compatible value, or `http:Request`)
     # + headers - Optional request headers as a `map<string|string[]>`
     # + mediaType - Optional `Content-Type` override; inferred from `message` if omitted
-    # + return - The `http:Response` or an `error` if the request fails
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
     remote isolated function put(string path, RequestMessage message, map<string|string[]>? headers = (),
-            string? mediaType = ()) returns Response|error = external;
+            string? mediaType = ()) returns Response|ClientError = external;
 
     # Applies a partial modification to the specified resource.
     #
@@ -593,9 +630,9 @@ public isolated client class Client {
     # + message - The request body (`string`, `byte[]`, JSON-compatible value, or `http:Request`)
     # + headers - Optional request headers as a `map<string|string[]>`
     # + mediaType - Optional `Content-Type` override; inferred from `message` if omitted
-    # + return - The `http:Response` or an `error` if the request fails
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
     remote isolated function patch(string path, RequestMessage message, map<string|string[]>? headers = (),
-            string? mediaType = ()) returns Response|error = external;
+            string? mediaType = ()) returns Response|ClientError = external;
 
     # Deletes the specified resource.
     #
@@ -603,24 +640,24 @@ public isolated client class Client {
     # + message - Optional request body (`string`, `byte[]`, JSON-compatible value, or `http:Request`)
     # + headers - Optional request headers as a `map<string|string[]>`
     # + mediaType - Optional `Content-Type` override; inferred from `message` if omitted
-    # + return - The `http:Response` or an `error` if the request fails
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
     remote isolated function delete(string path, RequestMessage? message = (), map<string|string[]>? headers = (),
-            string? mediaType = ()) returns Response|error = external;
+            string? mediaType = ()) returns Response|ClientError = external;
 
     # Requests headers from the specified resource without fetching the response body.
     # Identical to `get` but the server must not return a message body.
     #
     # + path - The request path (appended to the base URL)
     # + headers - Optional request headers as a `map<string|string[]>`
-    # + return - The `http:Response` or an `error` if the request fails
-    remote isolated function head(string path, map<string|string[]>? headers = ()) returns Response|error = external;
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
+    remote isolated function head(string path, map<string|string[]>? headers = ()) returns Response|ClientError = external;
 
     # Requests the communication options available for the specified resource.
     #
     # + path - The request path (appended to the base URL)
     # + headers - Optional request headers as a `map<string|string[]>`
-    # + return - The `http:Response` or an `error` if the request fails
-    remote isolated function options(string path, map<string|string[]>? headers = ()) returns Response|error = external;
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
+    remote isolated function options(string path, map<string|string[]>? headers = ()) returns Response|ClientError = external;
 
     # Sends an HTTP request with an explicit verb to the specified path.
     # Use this for HTTP methods not covered by the dedicated remote functions.
@@ -630,9 +667,9 @@ public isolated client class Client {
     # + message - The request body (`string`, `byte[]`, JSON-compatible value, or `http:Request`)
     # + headers - Optional request headers as a `map<string|string[]>`
     # + mediaType - Optional `Content-Type` override; inferred from `message` if omitted
-    # + return - The `http:Response` or an `error` if the request fails
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
     remote isolated function execute(string httpVerb, string path, RequestMessage message,
-            map<string|string[]>? headers = (), string? mediaType = ()) returns Response|error = external;
+            map<string|string[]>? headers = (), string? mediaType = ()) returns Response|ClientError = external;
 
     # Forwards the inbound `Request` to the specified path, preserving the original HTTP method,
     # headers, and body. Useful for proxy and gateway patterns where the incoming request must be
@@ -640,6 +677,93 @@ public isolated client class Client {
     #
     # + path    - The request path (appended to the base URL)
     # + request - The inbound `http:Request` whose method, headers, and body are forwarded
-    # + return  - The `http:Response` from the upstream service, or an `error` if the request fails
-    remote isolated function forward(string path, Request request) returns Response|error = external;
+    # + return  - The `http:Response` from the upstream service, or an `http:ClientError` if the request fails
+    remote isolated function forward(string path, Request request) returns Response|ClientError = external;
+
+    # Retrieves a representation of the resource at the given path from the remote HTTP endpoint.
+    #
+    # + path - The path segments of the resource
+    # + headers - Optional request headers as a `map<string|string[]>`
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
+    resource isolated function get [PathParamType... path](map<string|string[]>? headers = ()) returns Response|ClientError {
+        return self->get(resourcePath(path), headers);
+    }
+
+    # Creates a new resource or submits data to the resource at the given path.
+    #
+    # + path - The path segments of the resource
+    # + message - The request body (`string`, `byte[]`, JSON-compatible value, or `http:Request`)
+    # + headers - Optional request headers as a `map<string|string[]>`
+    # + mediaType - Optional `Content-Type` override; inferred from `message` if omitted
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
+    resource isolated function post [PathParamType... path](RequestMessage message, map<string|string[]>? headers = (),
+            string? mediaType = ()) returns Response|ClientError {
+        return self->post(resourcePath(path), message, headers, mediaType);
+    }
+
+    # Creates a new resource or replaces the representation of the resource at the given path.
+    #
+    # + path - The path segments of the resource
+    # + message - The request body (`string`, `byte[]`, JSON-compatible value, or `http:Request`)
+    # + headers - Optional request headers as a `map<string|string[]>`
+    # + mediaType - Optional `Content-Type` override; inferred from `message` if omitted
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
+    resource isolated function put [PathParamType... path](RequestMessage message, map<string|string[]>? headers = (),
+            string? mediaType = ()) returns Response|ClientError {
+        return self->put(resourcePath(path), message, headers, mediaType);
+    }
+
+    # Applies a partial modification to the resource at the given path.
+    #
+    # + path - The path segments of the resource
+    # + message - The request body (`string`, `byte[]`, JSON-compatible value, or `http:Request`)
+    # + headers - Optional request headers as a `map<string|string[]>`
+    # + mediaType - Optional `Content-Type` override; inferred from `message` if omitted
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
+    resource isolated function patch [PathParamType... path](RequestMessage message, map<string|string[]>? headers = (),
+            string? mediaType = ()) returns Response|ClientError {
+        return self->patch(resourcePath(path), message, headers, mediaType);
+    }
+
+    # Deletes the resource at the given path.
+    #
+    # + path - The path segments of the resource
+    # + message - Optional request body (`string`, `byte[]`, JSON-compatible value, or `http:Request`)
+    # + headers - Optional request headers as a `map<string|string[]>`
+    # + mediaType - Optional `Content-Type` override; inferred from `message` if omitted
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
+    resource isolated function delete [PathParamType... path](RequestMessage? message = (), map<string|string[]>? headers = (),
+            string? mediaType = ()) returns Response|ClientError {
+        return self->delete(resourcePath(path), message, headers, mediaType);
+    }
+
+    # Requests headers of the resource at the given path without fetching the response body.
+    #
+    # + path - The path segments of the resource
+    # + headers - Optional request headers as a `map<string|string[]>`
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
+    resource isolated function head [PathParamType... path](map<string|string[]>? headers = ()) returns Response|ClientError {
+        return self->head(resourcePath(path), headers);
+    }
+
+    # Requests the communication options available for the resource at the given path.
+    #
+    # + path - The path segments of the resource
+    # + headers - Optional request headers as a `map<string|string[]>`
+    # + return - The `http:Response` or an `http:ClientError` if the request fails
+    resource isolated function options [PathParamType... path](map<string|string[]>? headers = ()) returns Response|ClientError {
+        return self->options(resourcePath(path), headers);
+    }
+}
+
+// Represents the types of the path segments of a client resource access action.
+public type PathParamType string|int|float|boolean|decimal;
+
+// Builds the request path of a client resource access action from its path segments.
+isolated function resourcePath(PathParamType[] path) returns string {
+    string result = "";
+    foreach PathParamType segment in path {
+        result += string `/${segment}`;
+    }
+    return result == "" ? "/" : result;
 }
@@ -52,6 +52,24 @@ const (
 	moduleName = "http"
 )
 
+// Names of the distinct errors of the module, as understood by its createError function.
+const (
+	genericClientError               = "GenericClientError"
+	headerNotFoundError              = "HeaderNotFoundError"
+	initializingOutboundRequestError = "InitializingOutboundRequestError"
+)
+
+// newHTTPError constructs the distinct error of the module named by kind. The type-ids of
+// the errors are only known to the compiled module, so the value is created by its
+// createError function.
+func newHTTPError(ctx *extern.Context, kind, msg string) (values.BalValue, error) {
+	fn, ok := ctx.LookupFunction(orgName, moduleName, "createError")
+	if !ok {
+		return nil, fmt.Errorf("%s:%s: createError not found", orgName, moduleName)
+	}
+	return ctx.InvokeFunction(fn, []values.BalValue{kind, msg})
+}
+
 // hopByHopHeaders is the set of headers that must not be forwarded by a proxy per
 // RFC 7230 §6.1 and RFC 2616 §13.5.1. Keys are lowercase canonical form.
 var hopByHopHeaders = map[string]struct{}{
@@ -317,7 +335,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			var ct string
 			bodyReader, contentLength, ct = msgToBody(ctx.TypeCtx, args[2])
 			if bodyReader == nil && ct == "json_error" {
-				return values.NewErrorWithMessage("failed to serialize body to JSON"), nil
+				return newHTTPError(ctx, initializingOutboundRequestError, "failed to serialize body to JSON")
 			}
 			contentType = ct
 		}
@@ -342,7 +360,7 @@ func initHttpModule(rt *runtime.Runtime) {
 		statusCode, respHeaders, respBodyStream, err := clientHandle.(pal.HTTPClient).Execute(
 			goCtxOrBackground(ctx), verb, urlVal.(string)+path, bodyReader, contentLength, contentType, reqHeaders)
 		if err != nil {
-			return values.NewErrorWithMessage(err.Error()), nil
+			return newHTTPError(ctx, genericClientError, err.Error())
 		}
 		return buildResponse(ctx.TypeCtx, statusCode, respHeaders, respBodyStream), nil
 	}
@@ -381,7 +399,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			}
 			result, err := parseHeader(ctx.TypeCtx, input)
 			if err != nil {
-				return values.NewErrorWithMessage(err.Error()), nil
+				return newHTTPError(ctx, genericClientError, err.Error())
 			}
 			return result, nil
 		})
@@ -458,7 +476,7 @@ func initHttpModule(rt *runtime.Runtime) {
 							if certPath, ok := v.(string); ok && certPath != "" {
 								data, err := rt.Platform().FS.ReadFile(certPath)
 								if err != nil {
-									return values.NewErrorWithMessage("secureSocket.cert: " + err.Error()), nil
+									return newHTTPError(ctx, genericClientError, "secureSocket.cert: "+err.Error())
 								}
 								tlsCfg.CACertPEM = data
 							}
@@ -469,7 +487,7 @@ func initHttpModule(rt *runtime.Runtime) {
 									if p, ok := cv.(string); ok && p != "" {
 										data, err := rt.Platform().FS.ReadFile(p)
 										if err != nil {
-											return values.NewErrorWithMessage("secureSocket.key.certFile: " + err.Error()), nil
+											return newHTTPError(ctx, genericClientError, "secureSocket.key.certFile: "+err.Error())
 										}
 										tlsCfg.ClientCertPEM = data
 									}
@@ -478,7 +496,7 @@ func initHttpModule(rt *runtime.Runtime) {
 									if p, ok := kv.(string); ok && p != "" {
 										data, err := rt.Platform().FS.ReadFile(p)
 										if err != nil {
-											return values.NewErrorWithMessage("secureSocket.key.keyFile: " + err.Error()), nil
+											return newHTTPError(ctx, genericClientError, "secureSocket.key.keyFile: "+err.Error())
 										}
 										tlsCfg.ClientKeyPEM = data
 									}
@@ -579,7 +597,7 @@ func initHttpModule(rt *runtime.Runtime) {
 						if mv, ok := rlMap.Get("maxStatusLineLength"); ok {
 							if n, ok := mv.(int64); ok {
 								if n < 0 {
-									return values.NewErrorWithMessage("invalid value for responseLimits.maxStatusLineLength: must be >= 0"), nil
+									return newHTTPError(ctx, genericClientError, "invalid value for responseLimits.maxStatusLineLength: must be >= 0")
 								}
 								responseLimits.MaxStatusLineLength = int(n)
 							}
@@ -587,7 +605,7 @@ func initHttpModule(rt *runtime.Runtime) {
 						if mv, ok := rlMap.Get("maxHeaderSize"); ok {
 							if n, ok := mv.(int64); ok {
 								if n < 0 {
-									return values.NewErrorWithMessage("invalid value for responseLimits.maxHeaderSize: must be >= 0"), nil
+									return newHTTPError(ctx, genericClientError, "invalid value for responseLimits.maxHeaderSize: must be >= 0")
 								}
 								responseLimits.MaxHeaderSize = n
 							}
@@ -595,7 +613,7 @@ func initHttpModule(rt *runtime.Runtime) {
 						if mv, ok := rlMap.Get("maxEntityBodySize"); ok {
 							if n, ok := mv.(int64); ok {
 								if n < -1 {
-									return values.NewErrorWithMessage("invalid value for responseLimits.maxEntityBodySize: must be >= -1"), nil
+									return newHTTPError(ctx, genericClientError, "invalid value for responseLimits.maxEntityBodySize: must be >= -1")
 								}
 								responseLimits.MaxEntityBodySize = n
 							}
@@ -659,7 +677,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			statusCode, respHeaders, respBodyStream, err := clientHandle.(pal.HTTPClient).Execute(
 				goCtxOrBackground(ctx), "GET", urlVal.(string)+path, nil, 0, "", reqHeaders)
 			if err != nil {
-				return values.NewErrorWithMessage(err.Error()), nil
+				return newHTTPError(ctx, genericClientError, err.Error())
 			}
 			return buildResponse(ctx.TypeCtx, statusCode, respHeaders, respBodyStream), nil
 		})
@@ -683,7 +701,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			statusCode, respHeaders, respBodyStream, err := clientHandle.(pal.HTTPClient).Execute(
 				goCtxOrBackground(ctx), "HEAD", urlVal.(string)+path, nil, 0, "", reqHeaders)
 			if err != nil {
-				return values.NewErrorWithMessage(err.Error()), nil
+				return newHTTPError(ctx, genericClientError, err.Error())
 			}
 			return buildResponse(ctx.TypeCtx, statusCode, respHeaders, respBodyStream), nil
 		})
@@ -702,7 +720,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			statusCode, respHeaders, respBodyStream, err := clientHandle.(pal.HTTPClient).Execute(
 				goCtxOrBackground(ctx), "OPTIONS", urlVal.(string)+path, nil, 0, "", reqHeaders)
 			if err != nil {
-				return values.NewErrorWithMessage(err.Error()), nil
+				return newHTTPError(ctx, genericClientError, err.Error())
 			}
 			return buildResponse(ctx.TypeCtx, statusCode, respHeaders, respBodyStream), nil
 		})
@@ -736,7 +754,7 @@ func initHttpModule(rt *runtime.Runtime) {
 				var ct string
 				bodyReader, contentLength, ct = msgToBody(ctx.TypeCtx, args[3])
 				if bodyReader == nil && ct == "json_error" {
-					return values.NewErrorWithMessage("failed to serialize body to JSON"), nil
+					return newHTTPError(ctx, initializingOutboundRequestError, "failed to serialize body to JSON")
 				}
 				contentType = ct
 			}
@@ -761,7 +779,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			statusCode, respHeaders, respBodyStream, err := clientHandle.(pal.HTTPClient).Execute(
 				goCtxOrBackground(ctx), verb, urlVal.(string)+path, bodyReader, contentLength, contentType, reqHeaders)
 			if err != nil {
-				return values.NewErrorWithMessage(err.Error()), nil
+				return newHTTPError(ctx, genericClientError, err.Error())
 			}
 			return buildResponse(ctx.TypeCtx, statusCode, respHeaders, respBodyStream), nil
 		})
@@ -813,7 +831,7 @@ func initHttpModule(rt *runtime.Runtime) {
 				} else {
 					buf := holder.materialize()
 					if holder.readErr != nil {
-						return values.NewErrorWithMessage("failed to read request body: " + holder.readErr.Error()), nil
+						return newHTTPError(ctx, initializingOutboundRequestError, "failed to read request body: "+holder.readErr.Error())
 					}
 					if len(buf) > 0 {
 						bodyReader = bytes.NewReader(buf)
@@ -832,7 +850,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			statusCode, respHeaders, respBodyStream, err := clientHandle.(pal.HTTPClient).Execute(
 				goCtxOrBackground(ctx), method, urlVal.(string)+path, bodyReader, forwardContentLength, contentType, reqHeaders)
 			if err != nil {
-				return values.NewErrorWithMessage(err.Error()), nil
+				return newHTTPError(ctx, genericClientError, err.Error())
 			}
 			return buildResponse(ctx.TypeCtx, statusCode, respHeaders, respBodyStream), nil
 		})
@@ -912,7 +930,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			self := args[0].(*values.Object)
 			b, err := toJSONBytes(args[1])
 			if err != nil {
-				return values.NewErrorWithMessage("setJsonPayload: " + err.Error()), nil
+				return newHTTPError(ctx, genericClientError, "setJsonPayload: "+err.Error())
 			}
 			self.Put("body", &responseBodyHolder{buf: b})
 			ct := "application/json"
@@ -930,11 +948,11 @@ func initHttpModule(rt *runtime.Runtime) {
 			self := args[0].(*values.Object)
 			list, ok := args[1].(*values.List)
 			if !ok {
-				return values.NewErrorWithMessage("setBinaryPayload: expected byte[]"), nil
+				return newHTTPError(ctx, genericClientError, "setBinaryPayload: expected byte[]")
 			}
 			b, ok := listToBytes(list)
 			if !ok {
-				return values.NewErrorWithMessage("setBinaryPayload: invalid byte value"), nil
+				return newHTTPError(ctx, genericClientError, "setBinaryPayload: invalid byte value")
 			}
 			self.Put("body", &responseBodyHolder{buf: b})
 			ct := "application/octet-stream"
@@ -1025,7 +1043,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			if holder, ok := bodyVal.(*responseBodyHolder); ok {
 				buf, err := holder.materialize()
 				if err != nil {
-					return values.NewErrorWithMessage(err.Error()), nil
+					return newHTTPError(ctx, genericClientError, err.Error())
 				}
 				return string(buf), nil
 			}
@@ -1045,7 +1063,7 @@ func initHttpModule(rt *runtime.Runtime) {
 				var err error
 				body, err = holder.materialize()
 				if err != nil {
-					return values.NewErrorWithMessage(err.Error()), nil
+					return newHTTPError(ctx, genericClientError, err.Error())
 				}
 			} else if s, ok := bodyVal.(string); ok {
 				body = []byte(s)
@@ -1054,7 +1072,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			dec.UseNumber()
 			var v interface{}
 			if err := dec.Decode(&v); err != nil {
-				return values.NewErrorWithMessage("failed to parse JSON payload: " + err.Error()), nil
+				return newHTTPError(ctx, genericClientError, "failed to parse JSON payload: "+err.Error())
 			}
 			return values.GoToBalValue(ctx.TypeCtx, v, types.jsonListTy, types.jsonMapTy), nil
 		})
@@ -1068,7 +1086,7 @@ func initHttpModule(rt *runtime.Runtime) {
 				var err error
 				raw, err = holder.materialize()
 				if err != nil {
-					return values.NewErrorWithMessage(err.Error()), nil
+					return newHTTPError(ctx, genericClientError, err.Error())
 				}
 			} else if s, ok := bodyVal.(string); ok {
 				raw = []byte(s)
@@ -1095,11 +1113,11 @@ func initHttpModule(rt *runtime.Runtime) {
 			name := strings.ToLower(args[1].(string))
 			v, ok := responseHeaders(self).Get(name)
 			if !ok {
-				return values.NewErrorWithMessage("header not found: " + name), nil
+				return newHTTPError(ctx, headerNotFoundError, "header not found: "+name)
 			}
 			list := v.(*values.List)
 			if list.Len() == 0 {
-				return values.NewErrorWithMessage("header has no values: " + name), nil
+				return newHTTPError(ctx, headerNotFoundError, "header has no values: "+name)
 			}
 			return list.Get(0), nil
 		})
@@ -1110,7 +1128,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			name := strings.ToLower(args[1].(string))
 			v, ok := responseHeaders(self).Get(name)
 			if !ok {
-				return values.NewErrorWithMessage("header not found: " + name), nil
+				return newHTTPError(ctx, headerNotFoundError, "header not found: "+name)
 			}
 			return v.(*values.List), nil
 		})
@@ -1188,7 +1206,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			self := args[0].(*values.Object)
 			b, err := toJSONBytes(args[1])
 			if err != nil {
-				return values.NewErrorWithMessage("setJsonPayload: " + err.Error()), nil
+				return newHTTPError(ctx, genericClientError, "setJsonPayload: "+err.Error())
 			}
 			self.Put("$body", &requestBodyHolder{buf: b})
 			ct := "application/json"
@@ -1349,7 +1367,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			}
 			buf := holder.materialize()
 			if holder.readErr != nil {
-				return values.NewErrorWithMessage("failed to read request body: " + holder.readErr.Error()), nil
+				return newHTTPError(ctx, genericClientError, "failed to read request body: "+holder.readErr.Error())
 			}
 			return string(buf), nil
 		})
@@ -1364,14 +1382,14 @@ func initHttpModule(rt *runtime.Runtime) {
 			if holder != nil {
 				body = holder.materialize()
 				if holder.readErr != nil {
-					return values.NewErrorWithMessage("failed to read request body: " + holder.readErr.Error()), nil
+					return newHTTPError(ctx, genericClientError, "failed to read request body: "+holder.readErr.Error())
 				}
 			}
 			dec := json.NewDecoder(bytes.NewReader(body))
 			dec.UseNumber()
 			var v interface{}
 			if err := dec.Decode(&v); err != nil {
-				return values.NewErrorWithMessage("getJsonPayload: " + err.Error()), nil
+				return newHTTPError(ctx, genericClientError, "getJsonPayload: "+err.Error())
 			}
 			return values.GoToBalValue(ctx.TypeCtx, v, types.jsonListTy, types.jsonMapTy), nil
 		})
@@ -1386,7 +1404,7 @@ func initHttpModule(rt *runtime.Runtime) {
 			if holder != nil {
 				raw = holder.materialize()
 				if holder.readErr != nil {
-					return values.NewErrorWithMessage("failed to read request body: " + holder.readErr.Error()), nil
+					return newHTTPError(ctx, genericClientError, "failed to read request body: "+holder.readErr.Error())
 				}
 			}
 			items := make([]values.BalValue, len(raw))
@@ -1403,15 +1421,15 @@ func initHttpModule(rt *runtime.Runtime) {
 			hdrsVal, _ := self.Get("$headers")
 			hdrs, ok := hdrsVal.(*values.Map)
 			if !ok {
-				return values.NewErrorWithMessage("header not found: " + name), nil
+				return newHTTPError(ctx, headerNotFoundError, "header not found: "+name)
 			}
 			v, ok := hdrs.Get(name)
 			if !ok {
-				return values.NewErrorWithMessage("header not found: " + name), nil
+				return newHTTPError(ctx, headerNotFoundError, "header not found: "+name)
 			}
 			list := v.(*values.List)
 			if list.Len() == 0 {
-				return values.NewErrorWithMessage("header has no values: " + name), nil
+				return newHTTPError(ctx, headerNotFoundError, "header has no values: "+name)
 			}
 			return list.Get(0), nil
 		})
@@ -1423,11 +1441,11 @@ func initHttpModule(rt *runtime.Runtime) {
 			hdrsVal, _ := self.Get("$headers")
 			hdrs, ok := hdrsVal.(*values.Map)
 			if !ok {
-				return values.NewErrorWithMessage("header not found: " + name), nil
+				return newHTTPError(ctx, headerNotFoundError, "header not found: "+name)
 			}
 			v, ok := hdrs.Get(name)
 			if !ok {
-				return values.NewErrorWithMessage("header not found: " + name), nil
+				return newHTTPError(ctx, headerNotFoundError, "header not found: "+name)
 			}
 			return v.(*values.List), nil
 		})
@@ -29,7 +29,17 @@ public type FileOutputStream stdout|stderr;
 // Defines all the printable types.
 // 1. any typed value
 // 2. errors
-public type Printable any|error;
+// 3. `io:PrintableRawTemplate` - a raw template value
+public type Printable any|error|PrintableRawTemplate;
+
+# Represents raw templates.
+# e.g: `The respective int value is ${val}`
+# + strings - String values of the template as an array
+# + insertions - Parameterized values/expressions after evaluations as an array
+public type PrintableRawTemplate object {
+    *object:RawTemplate;
+    public Printable[] insertions;
+};
 
 // Represents io module related errors.
 // Note: distinct error subtypes are not yet supported; Error is currently an alias for error.
@@ -42,7 +52,7 @@ public enum FileWriteOption {
     APPEND
 }
 
-# Prints `any` or `error` to the standard output stream.
+# Prints `any`, `error`, or string templates value(s) to the standard output stream.
 # ```ballerina
 # io:print("Start processing the CSV file from ", srcFileName);
 # ```
@@ -52,7 +62,7 @@ public isolated function print(Printable... values) {
     externPrint(stdout, false, values);
 }
 
-# Prints `any` or `error` to the standard output stream and terminates the line.
+# Prints `any`, `error`, or string templates value(s) to the standard output stream and terminates the line.
 # ```ballerina
 # io:println("Start processing the CSV file from ", srcFileName);
 # ```
@@ -108,11 +118,11 @@ public isolated function fileReadLines(string path) returns string[]|Error {
 
 # Reads the entire file content as a byte array.
 # ```ballerina
-# byte[]|io:Error content = io:fileReadBytes("./resources/myfile.txt");
+# readonly & byte[]|io:Error content = io:fileReadBytes("./resources/myfile.txt");
 # ```
 # + path - The file path
-# + return - A byte array or an `io:Error`
-public isolated function fileReadBytes(string path) returns byte[]|Error {
+# + return - A read-only byte array or an `io:Error`
+public isolated function fileReadBytes(string path) returns readonly & byte[]|Error {
     return externFileReadBytes(path);
 }
 
@@ -198,7 +208,7 @@ public isolated function fileWriteXml(string path, xml content, FileWriteOption
 
 isolated function externFileReadString(string path) returns string|Error = external;
 isolated function externFileReadLines(string path) returns string[]|Error = external;
-isolated function externFileReadBytes(string path) returns byte[]|Error = external;
+isolated function externFileReadBytes(string path) returns readonly & byte[]|Error = external;
 isolated function externFileReadJson(string path) returns json|Error = external;
 isolated function externFileWriteString(string path, string content, FileWriteOption option) returns Error? = external;
 isolated function externFileWriteLines(string path, string[] content, FileWriteOption option) returns Error? = external;
@@ -30,10 +30,10 @@ import (
 )
 
 type fileIOTypes struct {
-	strArrTy   semtypes.SemType
-	byteArrTy  semtypes.SemType
-	jsonListTy semtypes.SemType
-	jsonMapTy  semtypes.SemType
+	strArrTy          semtypes.SemType
+	readonlyByteArrTy semtypes.SemType
+	jsonListTy        semtypes.SemType
+	jsonMapTy         semtypes.SemType
 }
 
 func fileIOError(msg string) values.BalValue {
@@ -71,10 +71,10 @@ func initFileIOModule(rt *runtime.Runtime) {
 	jmd := semtypes.NewMappingDefinition()
 	jld := semtypes.NewListDefinition()
 	types := fileIOTypes{
-		strArrTy:   sld.DefineListTypeWrappedWithEnvSemType(env, semtypes.STRING),
-		byteArrTy:  bld.DefineListTypeWrappedWithEnvSemType(env, semtypes.BYTE),
-		jsonMapTy:  jmd.DefineMappingTypeWrapped(env, nil, jsonTy),
-		jsonListTy: jld.DefineListTypeWrappedWithEnvSemType(env, jsonTy),
+		strArrTy:          sld.DefineListTypeWrappedWithEnvSemType(env, semtypes.STRING),
+		readonlyByteArrTy: bld.DefineListTypeWrapped(env, nil, 0, semtypes.BYTE, semtypes.CellMutability_CELL_MUT_NONE),
+		jsonMapTy:         jmd.DefineMappingTypeWrapped(env, nil, jsonTy),
+		jsonListTy:        jld.DefineListTypeWrappedWithEnvSemType(env, jsonTy),
 	}
 
 	runtime.RegisterExternFunction(rt, orgName, moduleName, "externFileReadString",
@@ -115,7 +115,7 @@ func initFileIOModule(rt *runtime.Runtime) {
 			for i, b := range data {
 				items[i] = int64(b)
 			}
-			return values.NewList(types.byteArrTy, semtypes.ToListAtomicType(ctx.TypeCtx, types.byteArrTy), false, nil, 0, items), nil
+			return values.NewList(types.readonlyByteArrTy, semtypes.ToListAtomicType(ctx.TypeCtx, types.readonlyByteArrTy), true, nil, 0, items), nil
 		})
 
 	runtime.RegisterExternFunction(rt, orgName, moduleName, "externFileReadJson",
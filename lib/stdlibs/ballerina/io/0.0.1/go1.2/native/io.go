@@ -22,6 +22,7 @@ import (
 
 	"ballerina-lang-go/runtime"
 	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/semtypes"
 	"ballerina-lang-go/values"
 )
 
@@ -33,11 +34,11 @@ const (
 	stderrStream = int64(2)
 )
 
-func Write(rt *runtime.Runtime, stream int64, newline bool, vals []values.BalValue) {
+func Write(rt *runtime.Runtime, tc semtypes.Context, stream int64, newline bool, vals []values.BalValue) {
 	parts := make([]string, len(vals))
 	visited := make(map[uintptr]bool)
 	for i, v := range vals {
-		parts[i] = values.String(v, visited)
+		parts[i] = printableString(tc, v, visited)
 	}
 	joined := strings.Join(parts, "")
 	var out []byte
@@ -54,8 +55,49 @@ func Write(rt *runtime.Runtime, stream int64, newline bool, vals []values.BalVal
 	}
 }
 
+// printableString renders a printable value. A raw template is rendered by interleaving its strings with its
+// rendered insertions.
+func printableString(tc semtypes.Context, v values.BalValue, visited map[uintptr]bool) string {
+	strs, insertions, ok := rawTemplateParts(tc, v)
+	if !ok {
+		return values.String(v, visited)
+	}
+	var b strings.Builder
+	for i := range insertions.Len() {
+		b.WriteString(strs.Get(i).(string))
+		b.WriteString(printableString(tc, insertions.Get(i), visited))
+	}
+	b.WriteString(strs.Get(insertions.Len()).(string))
+	return b.String()
+}
+
+// rawTemplateParts returns the `strings` and `insertions` fields of v if it belongs to object:RawTemplate.
+func rawTemplateParts(tc semtypes.Context, v values.BalValue) (*values.List, *values.List, bool) {
+	obj, ok := v.(*values.Object)
+	if !ok || !isPublicField(tc, obj.Type, "strings") || !isPublicField(tc, obj.Type, "insertions") {
+		return nil, nil, false
+	}
+	strsValue, _ := obj.Get("strings")
+	insertionsValue, _ := obj.Get("insertions")
+	strs, ok := strsValue.(*values.List)
+	if !ok {
+		return nil, nil, false
+	}
+	insertions, ok := insertionsValue.(*values.List)
+	if !ok || strs.Len() != insertions.Len()+1 {
+		return nil, nil, false
+	}
+	return strs, insertions, true
+}
+
+func isPublicField(tc semtypes.Context, ty semtypes.SemType, name string) bool {
+	nameTy := semtypes.StringConst(name)
+	return semtypes.IsSubtype(tc, semtypes.ObjectMemberKind(tc, nameTy, ty), semtypes.StringConst("field")) &&
+		semtypes.IsSubtype(tc, semtypes.ObjectMemberVisibility(tc, nameTy, ty), semtypes.StringConst("public"))
+}
+
 func externPrintExtern(rt *runtime.Runtime) extern.NativeFunc {
-	return func(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	return func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
 		stream, _ := args[0].(int64)
 		newLine, _ := args[1].(bool)
 		var vals []values.BalValue
@@ -64,7 +106,7 @@ func externPrintExtern(rt *runtime.Runtime) extern.NativeFunc {
 				vals = append(vals, list.Get(i))
 			}
 		}
-		Write(rt, stream, newLine, vals)
+		Write(rt, ctx.TypeCtx, stream, newLine, vals)
 		return nil, nil
 	}
 }
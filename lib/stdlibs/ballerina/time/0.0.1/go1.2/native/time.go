@@ -380,8 +380,14 @@ func emailOffsetStr(zh string) string {
 	return zh
 }
 
-func newFormatError(msg string) *values.Error {
-	return values.NewError(semtypes.ERROR, msg, nil, "FormatError", nil)
+// newFormatError constructs a time:FormatError. FormatError is distinct, so the
+// value is created by the module's createFormatError function, which carries its type-id.
+func newFormatError(ctx *extern.Context, msg string) (values.BalValue, error) {
+	fn, ok := ctx.LookupFunction(orgName, moduleName, "createFormatError")
+	if !ok {
+		return nil, fmt.Errorf("%s:%s: createFormatError not found", orgName, moduleName)
+	}
+	return ctx.InvokeFunction(fn, []values.BalValue{msg})
 }
 
 func mapInt(m *values.Map, key string) int64 {
@@ -479,8 +485,8 @@ func initTimeModule(rt *runtime.Runtime) {
 			str := getStringArg(args, 0)
 			t, err := time.Parse(time.RFC3339Nano, str)
 			if err != nil {
-				return newFormatError(fmt.Sprintf(
-					"The provided string '%s' does not adhere to the expected RFC 3339 format 'YYYY-MM-DDTHH:MM:SS.SSZ'. ", str)), nil
+				return newFormatError(ctx, fmt.Sprintf(
+					"The provided string '%s' does not adhere to the expected RFC 3339 format 'YYYY-MM-DDTHH:MM:SS.SSZ'. ", str))
 			}
 			return goTimeToUtc(utcTy, ctx.TypeCtx, t), nil
 		})
@@ -520,11 +526,11 @@ func initTimeModule(rt *runtime.Runtime) {
 			month := int(mapInt(m, "month"))
 			day := int(mapInt(m, "day"))
 			if month < 1 || month > 12 {
-				return newFormatError(fmt.Sprintf("invalid month: %d", month)), nil
+				return newFormatError(ctx, fmt.Sprintf("invalid month: %d", month))
 			}
 			t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
 			if t.Day() != day || int(t.Month()) != month || t.Year() != year {
-				return newFormatError(fmt.Sprintf("invalid date: %04d-%02d-%02d", year, month, day)), nil
+				return newFormatError(ctx, fmt.Sprintf("invalid date: %04d-%02d-%02d", year, month, day))
 			}
 			return nil, nil
 		})
@@ -536,11 +542,11 @@ func initTimeModule(rt *runtime.Runtime) {
 			month := int(mapInt(m, "month"))
 			day := int(mapInt(m, "day"))
 			if month < 1 || month > 12 {
-				return newFormatError(fmt.Sprintf("invalid month: %d", month)), nil
+				return newFormatError(ctx, fmt.Sprintf("invalid month: %d", month))
 			}
 			t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
 			if t.Day() != day || int(t.Month()) != month {
-				return newFormatError(fmt.Sprintf("invalid date: %04d-%02d-%02d", year, month, day)), nil
+				return newFormatError(ctx, fmt.Sprintf("invalid date: %04d-%02d-%02d", year, month, day))
 			}
 			return int64(t.Weekday()), nil
 		})
@@ -564,16 +570,16 @@ func initTimeModule(rt *runtime.Runtime) {
 					if abbrev, ok := abbrevVal.(string); ok && strings.ToLower(abbrev) == "z" {
 						t, _, err = civilFixedOffsetTime(m)
 					} else {
-						return newFormatError("civilTime.utcOffset must not be null"), nil
+						return newFormatError(ctx, "civilTime.utcOffset must not be null")
 					}
 				} else {
-					return newFormatError("civilTime.utcOffset must not be null"), nil
+					return newFormatError(ctx, "civilTime.utcOffset must not be null")
 				}
 			} else {
 				t, _, err = civilFixedOffsetTime(m)
 			}
 			if err != nil {
-				return newFormatError(err.Error()), nil
+				return newFormatError(ctx, err.Error())
 			}
 			return goTimeToUtc(utcTy, ctx.TypeCtx, t), nil
 		})
@@ -589,7 +595,7 @@ func initTimeModule(rt *runtime.Runtime) {
 			}
 			t, err := time.Parse(time.RFC3339Nano, parseStr)
 			if err != nil {
-				return newFormatError(fmt.Sprintf("invalid date-time string: %s", str)), nil
+				return newFormatError(ctx, fmt.Sprintf("invalid date-time string: %s", str))
 			}
 			hasSeconds := secondsHavePattern.MatchString(parseStr)
 			isUTCOnly := utcOnlyPattern.MatchString(parseStr)
@@ -605,7 +611,7 @@ func initTimeModule(rt *runtime.Runtime) {
 			m, _ := args[0].(*values.Map)
 			t, offsetSecs, err := civilToGoTime(m)
 			if err != nil {
-				return newFormatError(err.Error()), nil
+				return newFormatError(ctx, err.Error())
 			}
 			return formatRFC3339WithOffset(t, offsetSecs), nil
 		})
@@ -627,7 +633,7 @@ func initTimeModule(rt *runtime.Runtime) {
 			stripped := strings.TrimSpace(emailCommentPattern.ReplaceAllString(str, ""))
 			t, err := parseEmailDate(stripped)
 			if err != nil {
-				return newFormatError(fmt.Sprintf("invalid email date-time string: %s", str)), nil
+				return newFormatError(ctx, fmt.Sprintf("invalid email date-time string: %s", str))
 			}
 			result := buildCivilWithZone(ctx.TypeCtx, t, true, true)
 			if comment != "" {
@@ -643,7 +649,7 @@ func initTimeModule(rt *runtime.Runtime) {
 
 			t, offsetSecs, err := civilToGoTime(m)
 			if err != nil {
-				return newFormatError(err.Error()), nil
+				return newFormatError(ctx, err.Error())
 			}
 
 			sign := "+"
@@ -669,7 +675,7 @@ func initTimeModule(rt *runtime.Runtime) {
 
 			t, _, err := civilToGoTime(civil)
 			if err != nil {
-				return newFormatError(err.Error()), nil
+				return newFormatError(ctx, err.Error())
 			}
 
 			duYear := int(mapInt(duration, "years"))
@@ -711,14 +717,14 @@ func initTimeModule(rt *runtime.Runtime) {
 				case isNumericOffset(zoneIdStr):
 					offsetSecs, err := parseNumericOffset(zoneIdStr)
 					if err != nil {
-						return newFormatError(fmt.Sprintf("invalid time zone offset: %s", zoneIdStr)), nil
+						return newFormatError(ctx, fmt.Sprintf("invalid time zone offset: %s", zoneIdStr))
 					}
 					loc = time.FixedZone(zoneIdStr, offsetSecs)
 				default:
 					var err error
 					loc, err = time.LoadLocation(zoneIdStr)
 					if err != nil {
-						return newFormatError(fmt.Sprintf("invalid time zone ID: %s", zoneIdStr)), nil
+						return newFormatError(ctx, fmt.Sprintf("invalid time zone ID: %s", zoneIdStr))
 					}
 				}
 			}
@@ -754,15 +760,15 @@ func initTimeModule(rt *runtime.Runtime) {
 			civil, _ := args[1].(*values.Map)
 
 			if civil == nil {
-				return newFormatError("civil value is nil"), nil
+				return newFormatError(ctx, "civil value is nil")
 			}
 			if _, hasAbbrev := civil.Get("timeAbbrev"); !hasAbbrev {
-				return newFormatError("Abbreviation for the local time is required for the conversion"), nil
+				return newFormatError(ctx, "Abbreviation for the local time is required for the conversion")
 			}
 			loc := getStoredLocation(self)
 			t, err := civilMapToGoTimeInLocation(civil, loc)
 			if err != nil {
-				return newFormatError(err.Error()), nil
+				return newFormatError(ctx, err.Error())
 			}
 			return goTimeToUtc(utcTy, ctx.TypeCtx, t), nil
 		})
@@ -786,7 +792,7 @@ func initTimeModule(rt *runtime.Runtime) {
 			loc := getStoredLocation(self)
 			t, err := civilMapToGoTimeInLocation(civil, loc)
 			if err != nil {
-				return newFormatError(err.Error()), nil
+				return newFormatError(ctx, err.Error())
 			}
 
 			duYear := int(mapInt(duration, "years"))
@@ -15,18 +15,17 @@
 // under the License.
 
 // Error is the generic module level error.
-public type Error error;
+public type Error distinct error;
 
 // FormatError is returned when arguments are invalid or a string does not match the expected format.
-// Note: distinct error types are not yet supported; FormatError is currently an alias for error.
-public type FormatError error;
+public type FormatError distinct Error;
 
 // Seconds holds a decimal value representing seconds.
 public type Seconds decimal;
 
 // Utc is a point on the UTC time-scale represented as [int, decimal].
 // The first member is integral seconds from the UNIX epoch; the second is the fractional seconds.
-public type Utc [int, decimal];
+public type Utc readonly & [int, decimal];
 
 public const int SUNDAY = 0;
 public const int MONDAY = 1;
@@ -128,9 +127,7 @@ public enum HeaderZoneHandling {
 }
 
 // Zone is an abstract object type for handling time zones.
-// Note: jBallerina declares this as `readonly & object`; the Go-native interpreter uses a plain
-// object type because `readonly & object` type descriptors are not yet supported.
-public type Zone object {
+public type Zone readonly & object {
     # If always at a fixed offset from UTC, returns it; otherwise nil.
     # + return - The fixed zone offset or nil
     public isolated function fixedOffset() returns ZoneOffset?;
@@ -153,9 +150,7 @@ public type Zone object {
 };
 
 # Localized time zone implementation for IANA zone IDs and fixed zone offsets.
-# Note: jBallerina declares this as `readonly class`; the Go-native interpreter uses a plain class
-# because readonly classes are not yet supported.
-public class TimeZone {
+public readonly class TimeZone {
     *Zone;
 
     # Initializes a TimeZone from a zone ID (e.g. "Asia/Colombo", "+05:30") or the system default.
@@ -364,3 +359,7 @@ isolated function externUtcToEmailString(Utc utc, string zh) returns string = ex
 isolated function externCivilFromEmailString(string dateTimeString) returns Civil|Error = external;
 isolated function externCivilToEmailString(Civil civil, HeaderZoneHandling zoneHandling) returns string|Error = external;
 isolated function externCivilAddDuration(Civil civil, Duration duration) returns Civil|Error = external;
+
+// createFormatError is called by the native functions to construct a FormatError,
+// whose type-id is only known to the compiled module.
+isolated function createFormatError(string message) returns FormatError => error FormatError(message);
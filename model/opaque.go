@@ -45,9 +45,39 @@ type OpaqueFunctionSymbol struct {
 
 const (
 	// lang.array
-	OpaqueFnArrayPush = 0
+	OpaqueFnArrayPush        = 0
+	OpaqueFnArrayMap         = 1
+	OpaqueFnArrayFilter      = 2
+	OpaqueFnArrayForEach     = 3
+	OpaqueFnArrayReduce      = 4
+	OpaqueFnArraySome        = 5
+	OpaqueFnArrayEvery       = 6
+	OpaqueFnArrayIndexOf     = 7
+	OpaqueFnArrayLastIndexOf = 8
+	OpaqueFnArraySlice       = 9
+	OpaqueFnArrayReverse     = 10
+	OpaqueFnArraySort        = 11
+	OpaqueFnArrayPop         = 12
+	OpaqueFnArrayShift       = 13
+	OpaqueFnArrayUnshift     = 14
+	OpaqueFnArrayRemove      = 15
+	OpaqueFnArrayEnumerate   = 16
+	OpaqueFnArrayIterator    = 17
+	OpaqueFnArrayToStream    = 18
 	// lang.map
 	OpaqueFnMapRemove = 0
+	// lang.error
+	OpaqueFnErrorDetail = 0
+	// lang.value
+	OpaqueFnValueCloneReadOnly = 0
+	OpaqueFnValueClone         = 1
+	// lang.table
+	OpaqueFnTablePut     = 0
+	OpaqueFnTableAdd     = 1
+	OpaqueFnTableGet     = 2
+	OpaqueFnTableHasKey  = 3
+	OpaqueFnTableRemove  = 4
+	OpaqueFnTableToArray = 5
 )
 
 func newOpaqueFunctionSymbol(name string, id int) *OpaqueFunctionSymbol {
@@ -112,10 +142,48 @@ func OpaqueSymbols(pkg PackageIdentifier) []Symbol {
 		return langStringOpaqueSymbols()
 	case "lang.xml":
 		return langXMLOpaqueSymbols()
+	case "lang.regexp":
+		return []Symbol{newOpaqueTypeSymbol("RegExp", semtypes.REGEXP, 0)}
 	case "lang.array":
-		return []Symbol{newOpaqueFunctionSymbol("push", OpaqueFnArrayPush)}
+		return []Symbol{
+			newOpaqueFunctionSymbol("push", OpaqueFnArrayPush),
+			newOpaqueFunctionSymbol("map", OpaqueFnArrayMap),
+			newOpaqueFunctionSymbol("filter", OpaqueFnArrayFilter),
+			newOpaqueFunctionSymbol("forEach", OpaqueFnArrayForEach),
+			newOpaqueFunctionSymbol("reduce", OpaqueFnArrayReduce),
+			newOpaqueFunctionSymbol("some", OpaqueFnArraySome),
+			newOpaqueFunctionSymbol("every", OpaqueFnArrayEvery),
+			newOpaqueFunctionSymbol("indexOf", OpaqueFnArrayIndexOf),
+			newOpaqueFunctionSymbol("lastIndexOf", OpaqueFnArrayLastIndexOf),
+			newOpaqueFunctionSymbol("slice", OpaqueFnArraySlice),
+			newOpaqueFunctionSymbol("reverse", OpaqueFnArrayReverse),
+			newOpaqueFunctionSymbol("sort", OpaqueFnArraySort),
+			newOpaqueFunctionSymbol("pop", OpaqueFnArrayPop),
+			newOpaqueFunctionSymbol("shift", OpaqueFnArrayShift),
+			newOpaqueFunctionSymbol("unshift", OpaqueFnArrayUnshift),
+			newOpaqueFunctionSymbol("remove", OpaqueFnArrayRemove),
+			newOpaqueFunctionSymbol("enumerate", OpaqueFnArrayEnumerate),
+			newOpaqueFunctionSymbol("iterator", OpaqueFnArrayIterator),
+			newOpaqueFunctionSymbol("toStream", OpaqueFnArrayToStream),
+		}
 	case "lang.map":
 		return []Symbol{newOpaqueFunctionSymbol("remove", OpaqueFnMapRemove)}
+	case "lang.error":
+		return []Symbol{newOpaqueFunctionSymbol("detail", OpaqueFnErrorDetail)}
+	case "lang.value":
+		return []Symbol{
+			newOpaqueFunctionSymbol("cloneReadOnly", OpaqueFnValueCloneReadOnly),
+			newOpaqueFunctionSymbol("clone", OpaqueFnValueClone),
+		}
+	case "lang.table":
+		return []Symbol{
+			newOpaqueFunctionSymbol("put", OpaqueFnTablePut),
+			newOpaqueFunctionSymbol("add", OpaqueFnTableAdd),
+			newOpaqueFunctionSymbol("get", OpaqueFnTableGet),
+			newOpaqueFunctionSymbol("hasKey", OpaqueFnTableHasKey),
+			newOpaqueFunctionSymbol("remove", OpaqueFnTableRemove),
+			newOpaqueFunctionSymbol("toArray", OpaqueFnTableToArray),
+		}
 	default:
 		return nil
 	}
@@ -141,7 +209,10 @@ func langIntOpaqueSymbols() []Symbol {
 }
 
 func langStringOpaqueSymbols() []Symbol {
-	return []Symbol{newOpaqueTypeSymbol("Char", semtypes.CHAR, 0)}
+	return []Symbol{
+		newOpaqueTypeSymbol("Char", semtypes.CHAR, 0),
+		newOpaqueTypeSymbol("RegExp", semtypes.REGEXP, 1),
+	}
 }
 
 func langXMLOpaqueSymbols() []Symbol {
@@ -103,10 +103,13 @@ type DependentlyTypedFunctionSymbol interface {
 	FunctionSymbol
 	Monomorphize(ctx semtypes.Context, name string, polymorphicRef SymbolRef, argTys []semtypes.SemType) FunctionSymbol
 	ParamTypes() []semtypes.SemType
+	// RestParamType is the type of the members of the rest parameter, or never when there is none.
+	RestParamType() semtypes.SemType
 	ReturnType() TypeOp
 	NRequiredArgs() int
 	FuncFlags() FuncSymbolFlags
 	SetParamTypes(types []semtypes.SemType)
+	SetRestParamType(ty semtypes.SemType)
 	SetReturnType(op TypeOp)
 }
 
@@ -172,8 +175,36 @@ const (
 	SymbolKindParemeter
 	SymbolKindFunction
 	SymbolKindXMLNS
+	SymbolKindAnnotation
 )
 
+// AttachPoints is a set of the constructs an annotation can be attached to.
+type AttachPoints uint32
+
+const (
+	AttachPointType AttachPoints = 1 << iota
+	AttachPointClass
+	AttachPointFunction
+	AttachPointObjectMethod
+	AttachPointServiceRemoteMethod
+	AttachPointParameter
+	AttachPointReturn
+	AttachPointService
+	AttachPointField
+	AttachPointObjectField
+	AttachPointRecordField
+	AttachPointListener
+	AttachPointAnnotation
+	AttachPointExternal
+	AttachPointVar
+	AttachPointConst
+	AttachPointWorker
+)
+
+func (p AttachPoints) Has(point AttachPoints) bool {
+	return p&point != 0
+}
+
 type (
 	PackageIdentifier struct {
 		Organization string
@@ -277,6 +308,13 @@ type (
 		distinctTypeBase
 	}
 
+	// DistinctTypeSymbol is the symbol of a `distinct` type definition whose
+	// underlying type is not an object type descriptor (e.g. `distinct error`).
+	DistinctTypeSymbol struct {
+		TypeSymbol
+		distinctTypeBase
+	}
+
 	FieldDefault struct {
 		FieldName string
 		FnRef     SymbolRef
@@ -292,6 +330,15 @@ type (
 		uri string
 	}
 
+	// AnnotationSymbol lives in the annotation symbol space of a module. Its type is the type of the annotation
+	// value, which is `true` when the declaration has no type.
+	AnnotationSymbol struct {
+		symbolBase
+		attachPoints AttachPoints
+		sourceOnly   AttachPoints
+		isConst      bool
+	}
+
 	functionSymbol struct {
 		symbolBase
 		signature            FunctionSignature
@@ -320,8 +367,9 @@ type (
 		includedRecordParams *IncludedRecordParamInfo
 
 		// Populated by type resolver at stage 4.
-		paramTypes []semtypes.SemType
-		retType    TypeOp
+		paramTypes    []semtypes.SemType
+		restParamType semtypes.SemType
+		retType       TypeOp
 	}
 
 	FunctionSignature struct {
@@ -337,6 +385,8 @@ type (
 	DefaultableParam struct {
 		Symbol SymbolRef
 		Kind   DefaultableParamKind
+		// Value is the singleton type of the default of a DefaultableParamKindConstant parameter.
+		Value semtypes.SemType
 	}
 
 	DefaultableParamInfo struct {
@@ -357,6 +407,9 @@ func (ref SymbolRef) IsEmpty() bool {
 const (
 	DefaultableParamKindExpr DefaultableParamKind = iota
 	DefaultableParamKindInferredTypedesc
+	// DefaultableParamKindConstant is a parameter whose default is a simple constant. It is used by opaque functions,
+	// which have no Ballerina declaration from which a default function could be created.
+	DefaultableParamKindConstant
 )
 
 type InclusionMemberKind uint8
@@ -458,8 +511,12 @@ var (
 	_ MemberCarrier                  = &NetworkClassSymbol{}
 	_ MemberCarrier                  = &RecordSymbol{}
 	_ MemberCarrier                  = &ObjectTypeSymbol{}
+	_ Symbol                         = &DistinctTypeSymbol{}
+	_ DistinctType                   = &DistinctTypeSymbol{}
+	_ DistinctType                   = &ObjectTypeSymbol{}
 	_ Symbol                         = &ValueSymbol{}
 	_ Symbol                         = &XMLNSSymbol{}
+	_ Symbol                         = &AnnotationSymbol{}
 	_ Symbol                         = &functionSymbol{}
 	_ FunctionSymbol                 = &functionSymbol{}
 	_ DependentlyTypedFunctionSymbol = &dependentlyTypedFunctionSymbol{}
@@ -569,6 +626,10 @@ func mapToLangPrefixIfNeeded(prefix string) string {
 		return "lang.boolean"
 	case "decimal":
 		return "lang.decimal"
+	case "error":
+		return "lang.error"
+	case "float":
+		return "lang.float"
 	case "array":
 		return "lang.array"
 	case "map":
@@ -577,6 +638,12 @@ func mapToLangPrefixIfNeeded(prefix string) string {
 		return "lang.string"
 	case "xml":
 		return "lang.xml"
+	case "object":
+		return "lang.object"
+	case "regexp":
+		return "lang.regexp"
+	case "table":
+		return "lang.table"
 	default:
 		return prefix
 	}
@@ -604,6 +671,15 @@ func (ms *ModuleScope) AddAnnotationSymbol(name string, symbol Symbol) {
 	ms.Annotation.AddSymbol(name, symbol)
 }
 
+// GetPrefixedAnnotationSymbol looks up an annotation of the module imported with the given prefix.
+func (ms *ModuleScope) GetPrefixedAnnotationSymbol(prefix, name string) (SymbolRef, bool) {
+	exported, ok := ms.Prefix[prefix]
+	if !ok {
+		return SymbolRef{}, false
+	}
+	return exported.GetAnnotationSymbol(name)
+}
+
 func (ps *PackageScope) GetSymbol(name string) (SymbolRef, bool) {
 	for _, main := range ps.MainSpaces {
 		if ref, ok := main.GetSymbol(name); ok {
@@ -659,6 +735,20 @@ func (space *ExportedSymbolSpace) GetSymbol(name string) (SymbolRef, bool) {
 	return SymbolRef{}, false
 }
 
+func (space *ExportedSymbolSpace) GetAnnotationSymbol(name string) (SymbolRef, bool) {
+	for _, annotations := range space.AnnotationSpaces {
+		ref, ok := annotations.GetSymbol(name)
+		if !ok {
+			continue
+		}
+		if !annotations.SymbolAt(ref.Index).IsPublic() {
+			return SymbolRef{}, false
+		}
+		return ref, true
+	}
+	return SymbolRef{}, false
+}
+
 func (bs *BlockScopeBase) GetSymbol(name string) (SymbolRef, bool) {
 	ref, ok := bs.Main.GetSymbol(name)
 	if ok {
@@ -740,11 +830,16 @@ type MemberCarrier interface {
 	FieldDefaults() []FieldDefault
 }
 
-type ObjectType interface {
+// DistinctType is implemented by type symbols that carry type-ids.
+type DistinctType interface {
 	DistinctTypeIDs() []int
 	SetDistinctTypeIDs(typeIDs []int)
 }
 
+type ObjectType interface {
+	DistinctType
+}
+
 type ClassSymbol interface {
 	Symbol
 	MemberCarrier
@@ -862,6 +957,30 @@ func (xs *XMLNSSymbol) Copy() Symbol {
 	return &cp
 }
 
+func (as *AnnotationSymbol) Kind() SymbolKind {
+	return SymbolKindAnnotation
+}
+
+// AttachPoints returns the constructs the annotation can be attached to.
+func (as *AnnotationSymbol) AttachPoints() AttachPoints {
+	return as.attachPoints
+}
+
+// SourceOnlyAttachPoints returns the attach points declared with `source`. The value of an annotation attached at
+// one of these is not available at runtime.
+func (as *AnnotationSymbol) SourceOnlyAttachPoints() AttachPoints {
+	return as.sourceOnly
+}
+
+func (as *AnnotationSymbol) IsConst() bool {
+	return as.isConst
+}
+
+func (as *AnnotationSymbol) Copy() Symbol {
+	cp := *as
+	return &cp
+}
+
 func XMLNamespaceURI(symbol Symbol) (string, error) {
 	xmlns, ok := symbol.(*XMLNSSymbol)
 	if !ok {
@@ -950,6 +1069,12 @@ func (d *DefaultableParamInfo) SetInferredTypedesc(index int) {
 	d.params[index] = DefaultableParam{Kind: DefaultableParamKindInferredTypedesc}
 }
 
+// SetConstant makes the parameter at index default to the single value of the singleton type value.
+func (d *DefaultableParamInfo) SetConstant(index int, value semtypes.SemType) {
+	d.defaultable[index] = true
+	d.params[index] = DefaultableParam{Kind: DefaultableParamKindConstant, Value: value}
+}
+
 func NewIncludedRecordParamInfo(paramCount int) *IncludedRecordParamInfo {
 	return &IncludedRecordParamInfo{
 		params:     make([]bool, paramCount),
@@ -1020,6 +1145,15 @@ func NewTypeSymbol(name string, isPublic bool) TypeSymbol {
 	}
 }
 
+func NewAnnotationSymbol(name string, isPublic bool, isConst bool, attachPoints, sourceOnly AttachPoints) *AnnotationSymbol {
+	return &AnnotationSymbol{
+		symbolBase:   symbolBase{name: name, isPublic: isPublic},
+		attachPoints: attachPoints,
+		sourceOnly:   sourceOnly,
+		isConst:      isConst,
+	}
+}
+
 func NewXMLNSSymbol(prefix, uri string) *XMLNSSymbol {
 	return &XMLNSSymbol{
 		symbolBase: symbolBase{name: prefix, isPublic: true},
@@ -1100,6 +1234,14 @@ func NewRecordSymbol(name string, isPublic bool) RecordSymbol {
 	}
 }
 
+func NewDistinctTypeSymbol(name string, isPublic bool) DistinctTypeSymbol {
+	return DistinctTypeSymbol{
+		TypeSymbol: TypeSymbol{
+			symbolBase: symbolBase{name: name, isPublic: isPublic},
+		},
+	}
+}
+
 func NewObjectTypeSymbol(name string, isPublic bool) ObjectTypeSymbol {
 	return ObjectTypeSymbol{
 		TypeSymbol: TypeSymbol{
@@ -1123,6 +1265,7 @@ func NewDependentlyTypedFunctionSymbol(name string, paramNames []string, nRequir
 		paramNames:    paramNames,
 		nRequiredArgs: nRequiredArgs,
 		Flags:         flags,
+		restParamType: semtypes.NEVER,
 	}
 }
 
@@ -1167,33 +1310,34 @@ func (s *dependentlyTypedFunctionSymbol) Copy() Symbol {
 	return &cp
 }
 
-func (s *dependentlyTypedFunctionSymbol) ParamTypes() []semtypes.SemType { return s.paramTypes }
-func (s *dependentlyTypedFunctionSymbol) ReturnType() TypeOp             { return s.retType }
-func (s *dependentlyTypedFunctionSymbol) NRequiredArgs() int             { return s.nRequiredArgs }
-func (s *dependentlyTypedFunctionSymbol) FuncFlags() FuncSymbolFlags     { return s.Flags }
+func (s *dependentlyTypedFunctionSymbol) ParamTypes() []semtypes.SemType  { return s.paramTypes }
+func (s *dependentlyTypedFunctionSymbol) RestParamType() semtypes.SemType { return s.restParamType }
+func (s *dependentlyTypedFunctionSymbol) ReturnType() TypeOp              { return s.retType }
+func (s *dependentlyTypedFunctionSymbol) NRequiredArgs() int              { return s.nRequiredArgs }
+func (s *dependentlyTypedFunctionSymbol) FuncFlags() FuncSymbolFlags      { return s.Flags }
 
 func (s *dependentlyTypedFunctionSymbol) SetParamTypes(types []semtypes.SemType) {
 	s.paramTypes = types
 }
 
+func (s *dependentlyTypedFunctionSymbol) SetRestParamType(ty semtypes.SemType) {
+	s.restParamType = ty
+}
+
 func (s *dependentlyTypedFunctionSymbol) SetReturnType(op TypeOp) {
 	s.retType = op
 }
 
 func (s *dependentlyTypedFunctionSymbol) Monomorphize(ctx semtypes.Context, name string, origRef SymbolRef, argTys []semtypes.SemType) FunctionSymbol {
 	fixed := argTys
-	rest := semtypes.NEVER
 	if len(argTys) > s.nRequiredArgs {
 		fixed = argTys[:s.nRequiredArgs]
-		for _, each := range argTys[s.nRequiredArgs:] {
-			rest = semtypes.Union(rest, each)
-		}
 	}
 	returnType := s.retType.Apply(ctx, argTys)
 	sig := FunctionSignature{
 		ParamTypes:    fixed,
 		ParamNames:    s.paramNames,
-		RestParamType: rest,
+		RestParamType: s.restParamType,
 		ReturnType:    returnType,
 		Flags:         s.Flags,
 	}
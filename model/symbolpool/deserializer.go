@@ -161,8 +161,12 @@ func (sr *symbolReader) readSymbol(space *model.SymbolSpace, opaque []model.Symb
 		sr.readRecordSymbol(space)
 	case symTagObjectType:
 		sr.readObjectTypeSymbol(space)
+	case symTagDistinctType:
+		sr.readDistinctTypeSymbol(space)
 	case symTagValue:
 		sr.readValueSymbol(space)
+	case symTagAnnotation:
+		sr.readAnnotationSymbol(space)
 	case symTagFunction:
 		sr.readFunctionSymbol(space)
 	case symTagDependentlyTypedFunction:
@@ -212,6 +216,19 @@ func (sr *symbolReader) readObjectTypeSymbol(space *model.SymbolSpace) {
 	addDeserializedSymbol(space, name, &sym)
 }
 
+func (sr *symbolReader) readDistinctTypeSymbol(space *model.SymbolSpace) {
+	name, isPublic, ty := sr.readSymbolBase()
+	sym := model.NewDistinctTypeSymbol(name, isPublic)
+	ids := sr.readDistinctTypes(space)
+	sym.SetDistinctTypeIDs(ids)
+	if semtypes.IsSubtypeSimple(ty, semtypes.ERROR) {
+		sym.SetType(addErrorDistinctAtoms(ty, ids))
+	} else {
+		sym.SetType(addObjectDistinctAtoms(ty, ids))
+	}
+	addDeserializedSymbol(space, name, &sym)
+}
+
 func (sr *symbolReader) readDistinctTypes(space *model.SymbolSpace) []int {
 	var count int64
 	read(sr.r, &count)
@@ -233,6 +250,16 @@ func addObjectDistinctAtoms(ty semtypes.SemType, ids []int) semtypes.SemType {
 	return ty
 }
 
+func addErrorDistinctAtoms(ty semtypes.SemType, ids []int) semtypes.SemType {
+	if semtypes.IsZero(ty) {
+		return ty
+	}
+	for _, id := range ids {
+		ty = semtypes.Intersect(ty, semtypes.ErrorDistinct(id))
+	}
+	return ty
+}
+
 func (sr *symbolReader) readInclusionMembers(space *model.SymbolSpace) []model.InclusionMember {
 	var count int64
 	read(sr.r, &count)
@@ -377,6 +404,18 @@ func (sr *symbolReader) readValueSymbol(space *model.SymbolSpace) {
 	addDeserializedSymbol(space, name, &sym)
 }
 
+func (sr *symbolReader) readAnnotationSymbol(space *model.SymbolSpace) {
+	name, isPublic, ty := sr.readSymbolBase()
+	var attachPoints, sourceOnly uint32
+	var isConst bool
+	read(sr.r, &attachPoints)
+	read(sr.r, &sourceOnly)
+	read(sr.r, &isConst)
+	sym := model.NewAnnotationSymbol(name, isPublic, isConst, model.AttachPoints(attachPoints), model.AttachPoints(sourceOnly))
+	sym.SetType(ty)
+	addDeserializedSymbol(space, name, sym)
+}
+
 func (sr *symbolReader) readFunctionSymbol(space *model.SymbolSpace) {
 	name, isPublic, ty := sr.readSymbolBase()
 
@@ -432,6 +471,7 @@ func (sr *symbolReader) readDependentlyTypedFunctionSymbol(space *model.SymbolSp
 	for i := int64(0); i < paramCount; i++ {
 		paramTypes[i] = sr.readType()
 	}
+	restParamType := sr.readType()
 	var nameCount int64
 	read(sr.r, &nameCount)
 	paramNames := make([]string, nameCount)
@@ -445,6 +485,7 @@ func (sr *symbolReader) readDependentlyTypedFunctionSymbol(space *model.SymbolSp
 
 	sym := model.NewDependentlyTypedFunctionSymbol(name, paramNames, int(nRequired), model.FuncSymbolFlags(flags), isPublic)
 	sym.SetParamTypes(paramTypes)
+	sym.SetRestParamType(restParamType)
 	defaultInfo := sr.readDefaultableParams(int(paramCount), space)
 	sym.SetDefaultableParams(defaultInfo)
 	inclInfo := sr.readIncludedRecordParams(int(paramCount))
@@ -488,9 +529,13 @@ func (sr *symbolReader) readDefaultableParams(paramCount int, space *model.Symbo
 		read(sr.r, &idx)
 		var kind uint8
 		read(sr.r, &kind)
-		if model.DefaultableParamKind(kind) == model.DefaultableParamKindInferredTypedesc {
+		switch model.DefaultableParamKind(kind) {
+		case model.DefaultableParamKindInferredTypedesc:
 			info.SetInferredTypedesc(int(idx))
 			continue
+		case model.DefaultableParamKindConstant:
+			info.SetConstant(int(idx), sr.readType())
+			continue
 		}
 		ref := sr.readSymbolRef(space)
 		info.SetDefaultable(int(idx), ref)
@@ -27,7 +27,7 @@ import (
 
 const (
 	symMagic   = "\x53\x59\x4d\x42"
-	symVersion = 2
+	symVersion = 4
 )
 
 const (
@@ -41,6 +41,8 @@ const (
 	symTagNetworkClass
 	symTagResourceMethod
 	symTagOpaque
+	symTagAnnotation
+	symTagDistinctType
 )
 
 const (
@@ -205,10 +207,14 @@ func (sw *symbolWriter) writeSymbol(buf *bytes.Buffer, sym model.Symbol) error {
 		return sw.writeRecordSymbol(buf, s)
 	case *model.ObjectTypeSymbol:
 		return sw.writeObjectTypeSymbol(buf, s)
+	case *model.DistinctTypeSymbol:
+		return sw.writeDistinctTypeSymbol(buf, s)
 	case *model.TypeSymbol:
 		return sw.writeTypeSymbol(buf, s)
 	case *model.ValueSymbol:
 		return sw.writeValueSymbol(buf, s)
+	case *model.AnnotationSymbol:
+		return sw.writeAnnotationSymbol(buf, s)
 	case model.DependentlyTypedFunctionSymbol:
 		return sw.writeDependentlyTypedFunctionSymbol(buf, s)
 	case *model.ResourceMethodSymbol:
@@ -271,6 +277,20 @@ func (sw *symbolWriter) writeObjectTypeSymbol(buf *bytes.Buffer, sym *model.Obje
 	return sw.writeDistinctTypeIDs(buf, sym.DistinctTypeIDs())
 }
 
+func (sw *symbolWriter) writeDistinctTypeSymbol(buf *bytes.Buffer, sym *model.DistinctTypeSymbol) error {
+	if err := write(buf, symTagDistinctType); err != nil {
+		return err
+	}
+	writeType := sw.writeObjectDefinitionType
+	if semtypes.IsSubtypeSimple(sym.Type(), semtypes.ERROR) {
+		writeType = sw.writeErrorDefinitionType
+	}
+	if err := sw.writeSymbolBaseWithType(buf, sym, sym.Type(), writeType); err != nil {
+		return err
+	}
+	return sw.writeDistinctTypeIDs(buf, sym.DistinctTypeIDs())
+}
+
 func (sw *symbolWriter) writeDistinctTypeIDs(buf *bytes.Buffer, ids []int) error {
 	if err := write(buf, int64(len(ids))); err != nil {
 		return err
@@ -457,6 +477,22 @@ func (sw *symbolWriter) writeValueSymbol(buf *bytes.Buffer, sym *model.ValueSymb
 	return write(buf, sym.IsIsolated())
 }
 
+func (sw *symbolWriter) writeAnnotationSymbol(buf *bytes.Buffer, sym *model.AnnotationSymbol) error {
+	if err := write(buf, symTagAnnotation); err != nil {
+		return err
+	}
+	if err := sw.writeSymbolBase(buf, sym); err != nil {
+		return err
+	}
+	if err := write(buf, uint32(sym.AttachPoints())); err != nil {
+		return err
+	}
+	if err := write(buf, uint32(sym.SourceOnlyAttachPoints())); err != nil {
+		return err
+	}
+	return write(buf, sym.IsConst())
+}
+
 func (sw *symbolWriter) writeFunctionSymbol(buf *bytes.Buffer, sym model.FunctionSymbol) error {
 	if err := write(buf, symTagFunction); err != nil {
 		return err
@@ -541,6 +577,9 @@ func (sw *symbolWriter) writeDependentlyTypedFunctionSymbol(buf *bytes.Buffer, s
 			return err
 		}
 	}
+	if err := sw.writeType(buf, sym.RestParamType()); err != nil {
+		return err
+	}
 	paramNames := sym.ParamNames()
 	if err := write(buf, int64(len(paramNames))); err != nil {
 		return err
@@ -614,7 +653,13 @@ func (sw *symbolWriter) writeDefaultableParams(buf *bytes.Buffer, info *model.De
 		if err := write(buf, uint8(param.Kind)); err != nil {
 			return err
 		}
-		if param.Kind == model.DefaultableParamKindInferredTypedesc {
+		switch param.Kind {
+		case model.DefaultableParamKindInferredTypedesc:
+			continue
+		case model.DefaultableParamKindConstant:
+			if err := sw.writeType(buf, param.Value); err != nil {
+				return err
+			}
 			continue
 		}
 		if err := sw.writeSymbolRef(buf, param.Symbol); err != nil {
@@ -670,3 +715,10 @@ func (sw *symbolWriter) writeObjectDefinitionType(buf *bytes.Buffer, ty semtypes
 	}
 	return write(buf, int32(sw.tp.PutObjectDefinition(ty)))
 }
+
+func (sw *symbolWriter) writeErrorDefinitionType(buf *bytes.Buffer, ty semtypes.SemType) error {
+	if semtypes.IsZero(ty) {
+		return write(buf, int32(-1))
+	}
+	return write(buf, int32(sw.tp.PutErrorDefinition(ty)))
+}
@@ -4782,19 +4782,22 @@ func (b *BallerinaParser) getExpectedNodeKind(lookahead int) common.SyntaxKind {
 	case common.PIPE_TOKEN:
 		return b.getExpectedNodeKind(lookahead + 1)
 	case common.IDENTIFIER_TOKEN:
-		nextToken = b.peekN(lookahead + 1)
+		lookahead++
+		nextToken = b.peekN(lookahead)
 		switch nextToken.Kind() {
 		case common.GT_TOKEN:
 			break
 		case common.PIPE_TOKEN:
 			return b.getExpectedNodeKind(lookahead + 1)
 		case common.COLON_TOKEN:
-			nextToken = b.peekN(lookahead + 1)
+			lookahead++
+			nextToken = b.peekN(lookahead)
 			switch nextToken.Kind() {
 			case common.ASTERISK_TOKEN, common.GT_TOKEN:
 				return common.XML_STEP_EXPRESSION
 			case common.IDENTIFIER_TOKEN:
-				nextToken = b.peekN(lookahead + 1)
+				lookahead++
+				nextToken = b.peekN(lookahead)
 				if nextToken.Kind() == common.PIPE_TOKEN {
 					return b.getExpectedNodeKind(lookahead + 1)
 				}
@@ -8464,30 +8467,20 @@ func (b *BallerinaParser) createMissingTemplateExpressionNode(reKeyword tree.STN
 }
 
 func (b *BallerinaParser) parseTemplateContentAsRegExp() tree.STNode {
+	// The regexp lexer mode is pushed on top of the template mode started by the
+	// opening backtick. The lexer pops the regexp mode when it reads the closing
+	// backtick, and the template mode is popped here. The content is kept as
+	// template items; its regexp syntax is validated when building the AST.
 	b.tokenReader.StartMode(PARSER_MODE_REGEXP)
-	panic("Regexp parser not implemented")
-	// expressions := make([]interface{}, 0)
-	// regExpStringBuilder := nil
-	// nextToken := this.peek()
-	// for !this.isEndOfBacktickContent(nextToken.Kind()) {
-	// 	contentItem := this.parseTemplateItem()
-	// 	if contentItem.Kind() == common.TEMPLATE_STRING {
-	// 		contentToken, ok := contentItem.(STToken)
-	// 		if !ok {
-	// 			panic("parseTemplateContentAsRegExp: expected STToken")
-	// 		}
-	// 		this.regExpStringBuilder.append(contentToken.text())
-	// 	} else {
-	// 		this.regExpStringBuilder.append("${}")
-	// 		this.expressions.add(contentItem)
-	// 	}
-	// 	nextToken = this.peek()
-	// }
-	// this.this.tokenReader.endMode()
-	// charReader := this.CharReader.from(regExpStringBuilder.toString())
-	// tokenReader := nil
-	// regExpParser := nil
-	// return this.regExpParser.parse()
+	var items []tree.STNode
+	nextToken := b.peek()
+	for !b.isEndOfBacktickContent(nextToken.Kind()) {
+		contentItem := b.parseTemplateItem()
+		items = append(items, contentItem)
+		nextToken = b.peek()
+	}
+	b.tokenReader.EndMode()
+	return tree.CreateNodeList(items...)
 }
 
 func (b *BallerinaParser) parseInterpolation() tree.STNode {
@@ -601,6 +601,10 @@ func ToSourceCode(n STNode) string {
 }
 
 func writeTo(n STNode, builder *strings.Builder) {
+	if minutiae, ok := n.(*STMinutiae); ok {
+		builder.WriteString(minutiae.text)
+		return
+	}
 	tok, ok := n.(STToken)
 	if ok {
 		writeTo(tok.LeadingMinutiae(), builder)
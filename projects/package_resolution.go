@@ -230,14 +230,19 @@ var bundledLangLibs = []struct{ org, name, version string }{
 	{"ballerina", "lang.int", "0.0.1"},
 	{"ballerina", "lang.boolean", "0.0.1"},
 	{"ballerina", "lang.decimal", "0.0.1"},
-	{"ballerina", "lang.error", "0.0.1"},
 	{"ballerina", "lang.string", "0.0.1"},
 	{"ballerina", "lang.value", "0.0.1"},
 	{"ballerina", "lang.xml", "0.0.1"},
 	{"ballerina", "lang.float", "0.0.1"},
 	{"ballerina", "lang.array", "0.0.1"},
 	{"ballerina", "lang.map", "0.0.1"},
+	// lang.error uses lang.array.
+	{"ballerina", "lang.error", "0.0.1"},
+	{"ballerina", "lang.regexp", "0.0.1"},
+	{"ballerina", "lang.table", "0.0.1"},
+	{"ballerina", "lang.object", "0.0.1"},
 	{"ballerina", "lang.runtime", "0.0.1"},
+	{"ballerina", "lang.transaction", "0.0.1"},
 }
 
 // bundledLangLibModules resolves the migrated lang libraries and returns their
@@ -278,23 +283,28 @@ func bundledLangLibID(org, name, version string) string {
 	return fmt.Sprintf("%s/%s:%s", org, name, version)
 }
 
-// prependImplicitLangLibs returns the bundled lang-lib modules that are not
-// already present in resolved, so an explicitly imported lang lib (resolved via
-// normal dependency resolution) is not compiled and published a second time.
-func prependImplicitLangLibs(bundled, resolved []*moduleContext) []*moduleContext {
-	existing := make(map[ModuleID]bool, len(resolved))
-	for _, mod := range resolved {
-		existing[mod.getModuleID()] = true
-	}
-	var implicit []*moduleContext
+// prependBundledLangLibs places the bundled lang-lib modules, in their
+// bundled order, ahead of the resolved modules. A lang lib that is also
+// explicitly imported (resolved via normal dependency resolution) is moved to
+// its bundled position rather than compiled and published a second time, so
+// lang libs that depend on other lang libs always see them compiled first.
+func prependBundledLangLibs(bundled, resolved []*moduleContext) []*moduleContext {
+	existing := make(map[ModuleID]bool, len(bundled))
+	ordered := make([]*moduleContext, 0, len(bundled)+len(resolved))
 	for _, mod := range bundled {
 		if existing[mod.getModuleID()] {
 			continue
 		}
 		existing[mod.getModuleID()] = true
-		implicit = append(implicit, mod)
+		ordered = append(ordered, mod)
+	}
+	for _, mod := range resolved {
+		if existing[mod.getModuleID()] {
+			continue
+		}
+		ordered = append(ordered, mod)
 	}
-	return implicit
+	return ordered
 }
 
 // ResolvedDependencies returns the map of resolved external package dependencies.
@@ -359,7 +369,7 @@ func (r *PackageResolution) resolveDependencies() {
 	// TODO: avoid always adding implicit lang libs here. Instead we need to think of away from front end to signal
 	// to driver which implicit imports were added.
 	bundledModules := r.bundledLangLibModules()
-	sortedModuleList = append(prependImplicitLangLibs(bundledModules, sortedModuleList), sortedModuleList...)
+	sortedModuleList = prependBundledLangLibs(bundledModules, sortedModuleList)
 
 	r.topologicallySortedModuleList = sortedModuleList
 	r.diagnosticResult = NewDiagnosticResult(nil)
@@ -345,8 +345,9 @@ func TestPackageResolution_TransitiveDependency(t *testing.T) {
 	// middlepkg declares aaaleafpkg and leafpkg as direct deps; with the main
 	// project that's 4 packages, plus the always-compiled implicit lang libs
 	// (lang.int, lang.boolean, lang.decimal, lang.error, lang.string, lang.value,
-	// lang.xml, lang.float, lang.array, lang.map, lang.runtime), giving 15 packages total in the cache.
-	assert.Equal(15, env.PackageCache().Size(), "expected 15 packages in cache after compilation")
+	// lang.xml, lang.float, lang.array, lang.map, lang.regexp, lang.table, lang.object, lang.runtime, lang.transaction), giving 19 packages total
+	// in the cache.
+	assert.Equal(19, env.PackageCache().Size(), "expected 19 packages in cache after compilation")
 
 	cachedMiddle := env.PackageCache().Get("mockorg", "middlepkg", "1.0.0")
 	require.NotNil(cachedMiddle, "middlepkg should be cached after compilation")
@@ -0,0 +1,97 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package runtime
+
+import (
+	"errors"
+	"sync"
+
+	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/values"
+)
+
+// functionAnnotations holds the annotations attached to the functions of the initialized modules, keyed by the
+// function in the form `org/module:name`. A method is named `Class.method`.
+type functionAnnotations struct {
+	mu     sync.RWMutex
+	values map[string]*values.Map
+}
+
+// FunctionAnnotations returns the annotations attached to the function name of the given module, which are recorded
+// when the module is initialized. The keys of the map have the form `org/module:annotation`.
+func (rt *Runtime) FunctionAnnotations(org, module, name string) (*values.Map, bool) {
+	rt.fnAnnotations.mu.RLock()
+	defer rt.fnAnnotations.mu.RUnlock()
+	annots, ok := rt.fnAnnotations.values[configLookupKey(org, module, name)]
+	return annots, ok
+}
+
+func (rt *Runtime) annotationBuiltins() map[string]extern.NativeFunc {
+	return map[string]extern.NativeFunc{
+		langInternalLookupPrefix + "annotatedTypedesc": annotatedTypedesc,
+		langInternalLookupPrefix + "annotationValue":   annotationValue,
+		langInternalLookupPrefix + "annotateFunction":  rt.annotateFunction,
+	}
+}
+
+func annotatedTypedesc(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	if len(args) != 2 {
+		return nil, errors.New("annotatedTypedesc expects two arguments")
+	}
+	td, ok1 := args[0].(*values.TypeDesc)
+	annots, ok2 := args[1].(*values.Map)
+	if !ok1 || !ok2 {
+		return nil, errors.New("annotatedTypedesc expects a typedesc and a map")
+	}
+	return &values.TypeDesc{Type: td.Type, Annotations: annots}, nil
+}
+
+func annotationValue(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	if len(args) != 2 {
+		return nil, errors.New("annotationValue expects two arguments")
+	}
+	td, ok1 := args[0].(*values.TypeDesc)
+	key, ok2 := args[1].(string)
+	if !ok1 || !ok2 {
+		return nil, errors.New("annotationValue expects a typedesc and a string")
+	}
+	if td.Annotations == nil {
+		return nil, nil
+	}
+	value, _ := td.Annotations.Get(key)
+	return value, nil
+}
+
+func (rt *Runtime) annotateFunction(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	if len(args) != 4 {
+		return nil, errors.New("annotateFunction expects four arguments")
+	}
+	org, ok1 := args[0].(string)
+	module, ok2 := args[1].(string)
+	name, ok3 := args[2].(string)
+	annots, ok4 := args[3].(*values.Map)
+	if !ok1 || !ok2 || !ok3 || !ok4 {
+		return nil, errors.New("annotateFunction expects three strings and a map")
+	}
+	rt.fnAnnotations.mu.Lock()
+	defer rt.fnAnnotations.mu.Unlock()
+	if rt.fnAnnotations.values == nil {
+		rt.fnAnnotations.values = make(map[string]*values.Map)
+	}
+	rt.fnAnnotations.values[configLookupKey(org, module, name)] = annots
+	return nil, nil
+}
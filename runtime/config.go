@@ -0,0 +1,425 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package runtime
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"ballerina-lang-go/bir"
+	"ballerina-lang-go/common/tomlparser"
+	"ballerina-lang-go/decimal"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/values"
+)
+
+// ConfigSources holds the values given to configurable variables. A value in Args takes precedence over one in
+// TOMLs, and a value in an earlier TOML document takes precedence over one in a later document.
+type ConfigSources struct {
+	// Args are the `-C` options of the command line with the `-C` prefix removed, each in the form `key=value`.
+	// The key of a variable of the root module is its name; any variable can be keyed by `org.module.name`.
+	Args []string
+	// TOMLs are the contents of the configuration files. A variable of the root module is either a top-level key
+	// or a key of the `[org.module]` table, which is how variables of other modules are given.
+	TOMLs []string
+}
+
+// Configure resolves the values of the configurable variables of pkgs from sources. It must be called before Init
+// for any of the packages. All missing required values and invalid values are reported together.
+func (rt *Runtime) Configure(pkgs []*bir.BIRPackage, root *model.PackageID, sources ConfigSources) error {
+	args := make(map[string]string, len(sources.Args))
+	var errs []error
+	for _, arg := range sources.Args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok || key == "" {
+			errs = append(errs, fmt.Errorf("error: invalid configuration option '-C%s': expected '-Ckey=value'", arg))
+			continue
+		}
+		args[key] = value
+	}
+	tomls := make([]*tomlparser.Toml, 0, len(sources.TOMLs))
+	for _, content := range sources.TOMLs {
+		toml, err := tomlparser.ReadString(content)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error: invalid configuration file: %w", err))
+			continue
+		}
+		tomls = append(tomls, toml)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	cx := semtypes.ContextFrom(rt.env.TypeEnv)
+	configValues := make(map[string]values.BalValue)
+	knownKeys := make(map[string]bool)
+	// knownTables holds the keys of the TOML tables that may contain the variables of a module: `org`, `org.a`,
+	// `org.a.b` and so on for the module `a.b` of `org`.
+	knownTables := make(map[string]bool)
+	for _, pkg := range pkgs {
+		isRoot := pkg.PackageID.OrgName.Value() == root.OrgName.Value() && pkg.PackageID.Name.Value() == root.Name.Value()
+		for _, dcl := range sortedConfigurables(pkg) {
+			moduleKey := dcl.PkgId.OrgName.Value() + "." + dcl.PkgId.Name.Value()
+			knownKeys[moduleKey+"."+dcl.Name.Value()] = true
+			for i, c := range moduleKey {
+				if c == '.' {
+					knownTables[moduleKey[:i]] = true
+				}
+			}
+			knownTables[moduleKey] = true
+			if isRoot {
+				knownKeys[dcl.Name.Value()] = true
+			}
+			value, found, err := lookupConfigValue(cx, args, tomls, &dcl, isRoot)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if found {
+				configValues[configLookupKey(dcl.PkgId.OrgName.Value(), dcl.PkgId.Name.Value(), dcl.Name.Value())] = value
+			} else if dcl.Flags.Has(model.FlagRequired) {
+				errs = append(errs, fmt.Errorf("error: value not provided for required configurable variable '%s'", dcl.Name.Value()))
+			}
+		}
+	}
+	for _, arg := range sources.Args {
+		if key, _, _ := strings.Cut(arg, "="); !knownKeys[key] {
+			errs = append(errs, fmt.Errorf("error: invalid configuration option '-C%s': no configurable variable with key '%s'", arg, key))
+		}
+	}
+	unknownKeys := make(map[string]bool)
+	for _, toml := range tomls {
+		for _, key := range unknownTOMLKeys(toml.ToMap(), "", knownKeys, knownTables) {
+			if !unknownKeys[key] {
+				unknownKeys[key] = true
+				errs = append(errs, fmt.Errorf("error: invalid configuration file: no configurable variable with key '%s'", key))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	rt.configValues = configValues
+	return nil
+}
+
+// unknownTOMLKeys returns the sorted keys under table, whose own key is prefix (empty for the document root), that
+// neither give the value of a configurable variable nor name a table that may contain one.
+func unknownTOMLKeys(table map[string]any, prefix string, knownKeys, knownTables map[string]bool) []string {
+	var unknown []string
+	for _, name := range slices.Sorted(maps.Keys(table)) {
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+		if knownKeys[key] {
+			continue
+		}
+		if sub, ok := table[name].(map[string]any); ok && knownTables[key] {
+			unknown = append(unknown, unknownTOMLKeys(sub, key, knownKeys, knownTables)...)
+			continue
+		}
+		unknown = append(unknown, key)
+	}
+	return unknown
+}
+
+func sortedConfigurables(pkg *bir.BIRPackage) []bir.BIRGlobalVariableDcl {
+	var dcls []bir.BIRGlobalVariableDcl
+	for _, dcl := range pkg.GlobalVars {
+		if dcl.Flags.Has(model.FlagConfigurable) {
+			dcls = append(dcls, dcl)
+		}
+	}
+	slices.SortFunc(dcls, func(a, b bir.BIRGlobalVariableDcl) int {
+		return strings.Compare(a.Name.Value(), b.Name.Value())
+	})
+	return dcls
+}
+
+func lookupConfigValue(cx semtypes.Context, args map[string]string, tomls []*tomlparser.Toml, dcl *bir.BIRGlobalVariableDcl, isRoot bool) (values.BalValue, bool, error) {
+	name := dcl.Name.Value()
+	qualifiedKey := dcl.PkgId.OrgName.Value() + "." + dcl.PkgId.Name.Value() + "." + name
+	arg, ok := args[qualifiedKey]
+	if !ok && isRoot {
+		arg, ok = args[name]
+	}
+	if ok {
+		value, ok := argConfigValue(cx, arg, dcl.Type)
+		if !ok {
+			return nil, false, invalidConfigValueError(cx, dcl)
+		}
+		return value, true, nil
+	}
+	for _, toml := range tomls {
+		raw, ok := toml.Get(qualifiedKey)
+		if !ok && isRoot {
+			raw, ok = toml.Get(name)
+		}
+		if !ok {
+			continue
+		}
+		value, ok := tomlConfigValue(cx, raw, dcl.Type)
+		if !ok {
+			return nil, false, invalidConfigValueError(cx, dcl)
+		}
+		return value, true, nil
+	}
+	return nil, false, nil
+}
+
+func invalidConfigValueError(cx semtypes.Context, dcl *bir.BIRGlobalVariableDcl) error {
+	return fmt.Errorf("error: invalid value for configurable variable '%s': expected a value of type '%s'",
+		dcl.Name.Value(), semtypes.ToString(cx, dcl.Type))
+}
+
+// argConfigValue parses a command-line value as the first of int, float, decimal, boolean and string that ty allows.
+func argConfigValue(cx semtypes.Context, arg string, ty semtypes.SemType) (values.BalValue, bool) {
+	var candidates []values.BalValue
+	if i, err := strconv.ParseInt(arg, 10, 64); err == nil {
+		candidates = append(candidates, i)
+	}
+	if f, err := strconv.ParseFloat(arg, 64); err == nil {
+		candidates = append(candidates, f)
+	}
+	if d, err := decimal.FromString(arg); err == nil {
+		candidates = append(candidates, d)
+	}
+	if b, err := strconv.ParseBool(arg); err == nil && (arg == "true" || arg == "false") {
+		candidates = append(candidates, b)
+	}
+	candidates = append(candidates, arg)
+	for _, value := range candidates {
+		if semtypes.IsSubtype(cx, values.SemTypeForValue(value), ty) {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// tomlConfigValue converts a value read from a TOML document to a value belonging to ty. Arrays and tables become
+// read-only lists and maps.
+func tomlConfigValue(cx semtypes.Context, raw any, ty semtypes.SemType) (values.BalValue, bool) {
+	switch raw := raw.(type) {
+	case []any:
+		return tomlConfigList(cx, raw, ty)
+	case map[string]any:
+		return tomlConfigMap(cx, raw, ty)
+	}
+	for _, value := range tomlScalarCandidates(raw) {
+		if semtypes.IsSubtype(cx, values.SemTypeForValue(value), ty) {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// tomlScalarCandidates returns the Ballerina values a TOML scalar can stand for. An integer may be given for a float
+// or decimal, and a float for a decimal.
+func tomlScalarCandidates(raw any) []values.BalValue {
+	switch raw := raw.(type) {
+	case int64:
+		return []values.BalValue{raw, float64(raw), decimal.FromInt64(raw)}
+	case float64:
+		if d, err := decimal.FromFloat64(raw); err == nil {
+			return []values.BalValue{raw, d}
+		}
+		return []values.BalValue{raw}
+	case bool, string:
+		return []values.BalValue{raw}
+	default:
+		return nil
+	}
+}
+
+// tomlConfigList converts a TOML array using the first alternative of the list part of ty that it fits.
+func tomlConfigList(cx semtypes.Context, raw []any, ty semtypes.SemType) (values.BalValue, bool) {
+	for _, alt := range semtypes.ListAlternatives(cx, semtypes.Intersect(ty, semtypes.LIST)) {
+		atomic := alt.Pos
+		if atomic == nil || len(raw) < atomic.Members.FixedLength {
+			continue
+		}
+		if members, ok := tomlConfigListMembers(cx, raw, atomic); ok {
+			return values.NewList(alt.SemType, atomic, true, nil, 0, members), true
+		}
+	}
+	return nil, false
+}
+
+func tomlConfigListMembers(cx semtypes.Context, raw []any, atomic *semtypes.ListAtomicType) ([]values.BalValue, bool) {
+	members := make([]values.BalValue, len(raw))
+	for i, elem := range raw {
+		member, ok := tomlConfigValue(cx, elem, atomic.MemberAtInnerVal(i))
+		if !ok {
+			return nil, false
+		}
+		members[i] = member
+	}
+	return members, true
+}
+
+// tomlConfigMap converts a TOML table using the first alternative of the mapping part of ty that it fits.
+func tomlConfigMap(cx semtypes.Context, raw map[string]any, ty semtypes.SemType) (values.BalValue, bool) {
+	for _, alt := range semtypes.MappingAlternatives(cx, semtypes.Intersect(ty, semtypes.MAPPING)) {
+		atomic := alt.Pos
+		if atomic == nil {
+			continue
+		}
+		if entries, ok := tomlConfigMapEntries(cx, raw, atomic); ok {
+			return values.NewMap(alt.SemType, atomic, true, entries), true
+		}
+	}
+	return nil, false
+}
+
+// tomlConfigMapEntries converts the fields of a TOML table. Fields declared by atomic come first, in the order atomic
+// lists them, followed by any rest fields in key order. configurableValue later puts them in declaration order.
+func tomlConfigMapEntries(cx semtypes.Context, raw map[string]any, atomic *semtypes.MappingAtomicType) ([]values.MapEntry, bool) {
+	keys := make([]string, 0, len(raw))
+	for _, name := range atomic.Names {
+		if _, ok := raw[name]; ok {
+			keys = append(keys, name)
+		} else if !atomic.IsOptional(cx, name) {
+			return nil, false
+		}
+	}
+	restStart := len(keys)
+	for key := range raw {
+		if !slices.Contains(atomic.Names, key) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys[restStart:])
+	entries := make([]values.MapEntry, len(keys))
+	for i, key := range keys {
+		field, ok := tomlConfigValue(cx, raw[key], atomic.FieldInnerVal(key))
+		if !ok {
+			return nil, false
+		}
+		entries[i] = values.MapEntry{Key: key, Value: field}
+	}
+	return entries, true
+}
+
+func configLookupKey(org, module, name string) string {
+	return org + "/" + module + ":" + name
+}
+
+// The module init of a configurable variable calls these lang.__internal functions to get its configured value.
+func (rt *Runtime) configBuiltins() map[string]extern.NativeFunc {
+	return map[string]extern.NativeFunc{
+		langInternalLookupPrefix + "hasConfigurableValue": rt.hasConfigurableValue,
+		langInternalLookupPrefix + "configurableValue":    rt.configurableValue,
+	}
+}
+
+func (rt *Runtime) hasConfigurableValue(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	key, err := configurableKeyArg("hasConfigurableValue", args)
+	if err != nil {
+		return nil, err
+	}
+	_, ok := rt.configValues[key]
+	return ok, nil
+}
+
+// configurableValue returns the configured value of a variable. Its last argument lists the fields of the record
+// types of the variable in declaration order; the fields of mapping values are put in that order.
+func (rt *Runtime) configurableValue(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	if len(args) != 4 {
+		return nil, errors.New("configurableValue expects four arguments")
+	}
+	key, err := configurableKeyArg("configurableValue", args[:3])
+	if err != nil {
+		return nil, err
+	}
+	value, ok := rt.configValues[key]
+	if !ok {
+		return nil, errors.New("no value for configurable variable " + key)
+	}
+	fieldOrder, ok := args[3].(*values.List)
+	if !ok {
+		return nil, errors.New("configurableValue expects a list of field names")
+	}
+	ranks := make(map[string]int, fieldOrder.Len())
+	for i := range fieldOrder.Len() {
+		if name, ok := fieldOrder.Get(i).(string); ok {
+			ranks[name] = i
+		}
+	}
+	return orderConfigFields(ctx.TypeCtx, value, ranks), nil
+}
+
+// orderConfigFields returns v with the fields of its mappings ordered by ranks. Fields without a rank follow the
+// ranked ones in their current order.
+func orderConfigFields(cx semtypes.Context, v values.BalValue, ranks map[string]int) values.BalValue {
+	switch v := v.(type) {
+	case *values.List:
+		atomic := semtypes.ToListAtomicType(cx, v.Type)
+		if atomic == nil {
+			return v
+		}
+		members := make([]values.BalValue, v.Len())
+		for i := range members {
+			members[i] = orderConfigFields(cx, v.Get(i), ranks)
+		}
+		return values.NewList(v.Type, atomic, true, nil, 0, members)
+	case *values.Map:
+		atomic := semtypes.ToMappingAtomicType(cx, v.Type)
+		if atomic == nil {
+			return v
+		}
+		keys := v.Keys()
+		slices.SortStableFunc(keys, func(a, b string) int {
+			return cmp.Compare(configFieldRank(ranks, a), configFieldRank(ranks, b))
+		})
+		entries := make([]values.MapEntry, len(keys))
+		for i, key := range keys {
+			field, _ := v.Get(key)
+			entries[i] = values.MapEntry{Key: key, Value: orderConfigFields(cx, field, ranks)}
+		}
+		return values.NewMap(v.Type, atomic, true, entries)
+	default:
+		return v
+	}
+}
+
+func configFieldRank(ranks map[string]int, name string) int {
+	if rank, ok := ranks[name]; ok {
+		return rank
+	}
+	return len(ranks)
+}
+
+func configurableKeyArg(name string, args []values.BalValue) (string, error) {
+	if len(args) != 3 {
+		return "", errors.New(name + " expects three arguments")
+	}
+	org, ok1 := args[0].(string)
+	module, ok2 := args[1].(string)
+	varName, ok3 := args[2].(string)
+	if !ok1 || !ok2 || !ok3 {
+		return "", errors.New(name + " expects strings")
+	}
+	return configLookupKey(org, module, varName), nil
+}
@@ -22,6 +22,7 @@ import (
 
 	"ballerina-lang-go/platform/pal"
 	"ballerina-lang-go/runtime/internal/locks"
+	"ballerina-lang-go/runtime/internal/transactions"
 	"ballerina-lang-go/semtypes"
 	"ballerina-lang-go/values"
 )
@@ -37,6 +38,7 @@ type Context struct {
 	TypeCtx   semtypes.Context
 	StrandID  uint64
 	heldLocks []*locks.ReentrantMutex
+	trxStack  transactions.Stack
 }
 
 // Env represents shared state of the runtime. All operations on Env are (potentially) blocking
@@ -116,3 +118,8 @@ func (ctx *Context) ReleaseAllHeldLocks() {
 	}
 	ctx.heldLocks = ctx.heldLocks[:0]
 }
+
+// Transactions returns the transaction state of the strand.
+func (ctx *Context) Transactions() *transactions.Stack {
+	return &ctx.trxStack
+}
@@ -37,12 +37,13 @@ type FunctionHandle struct {
 // Context.Lookup*/InvokeMethod/StartMethod methods. Lookup hooks return the
 // resolved payload along with a found bool; Context methods forward both.
 type DispatchHandles struct {
-	LookupObject   func(*Context, *values.Object, string) (any, bool)
-	LookupRemote   func(*Context, *values.Object, string) (any, bool)
-	LookupResource func(*Context, *values.Object, string, []values.BalValue) (any, bool) // resourceMethodName, path
-	LookupFunction func(*Context, string, string, string) (any, bool)                    // org, module, name
-	Invoke         func(*Context, any, []values.BalValue) (values.BalValue, error)
-	Start          func(*Context, any, []values.BalValue) (<-chan values.BalValue, error)
+	LookupObject        func(*Context, *values.Object, string) (any, bool)
+	LookupRemote        func(*Context, *values.Object, string) (any, bool)
+	LookupResource      func(*Context, *values.Object, string, []values.BalValue) (any, bool) // resourceMethodName, path
+	LookupFunction      func(*Context, string, string, string) (any, bool)                    // org, module, name
+	LookupFunctionValue func(*Context, *values.Function) (any, bool)
+	Invoke              func(*Context, any, []values.BalValue) (values.BalValue, error)
+	Start               func(*Context, any, []values.BalValue) (<-chan values.BalValue, error)
 }
 
 // LookupObjectMethod resolves a regular method on obj. The second return is
@@ -62,8 +63,9 @@ func (c *Context) LookupRemoteMethod(obj *values.Object, name string) (MethodHan
 }
 
 // LookupResourceMethod resolves a resource method for given method name and path parameters.
-// The second return is false if no candidate matches or if more than one
-// candidate matches (ambiguous dispatch).
+// When several candidates match, the one with the most specific path is
+// chosen. The second return is false if no candidate matches or if there is
+// no unique most specific candidate (ambiguous dispatch).
 //
 // path carries a value for every segment of the source-level resource
 // access expression, including literal segments.
@@ -87,6 +89,14 @@ func (c *Context) LookupFunction(org, module, name string) (FunctionHandle, bool
 	return FunctionHandle{Fn: impl}, ok
 }
 
+// LookupFunctionValue resolves the function a function value refers to,
+// together with the variables it captures. The second return is false if
+// the function is not registered.
+func (c *Context) LookupFunctionValue(fn *values.Function) (FunctionHandle, bool) {
+	impl, ok := c.Env.dispatch.LookupFunctionValue(c, fn)
+	return FunctionHandle{Fn: impl}, ok
+}
+
 // InvokeFunction calls the function captured by h.
 func (c *Context) InvokeFunction(h FunctionHandle, args []values.BalValue) (values.BalValue, error) {
 	return c.Env.dispatch.Invoke(c, h.Fn, args)
@@ -16,7 +16,12 @@
 
 package exec
 
-import "ballerina-lang-go/bir"
+import (
+	"strings"
+
+	"ballerina-lang-go/bir"
+	"ballerina-lang-go/values"
+)
 
 type callStackEntry struct {
 	frame    *Frame
@@ -25,6 +30,28 @@ type callStackEntry struct {
 
 type callStack struct {
 	elements []callStackEntry
+	// worker is the name of the named worker running on the strand, and workerDepth the index of the frame of its
+	// body. That frame is reported under the worker name rather than the name of the anonymous function.
+	worker      string
+	workerDepth int
+}
+
+// startWorker records that the next frame pushed runs the body of the named worker name.
+func (cs *callStack) startWorker(name string) {
+	cs.worker = name
+	cs.workerDepth = len(cs.elements)
+}
+
+// functionKey returns the function key reported for the entry at index i.
+func (cs *callStack) functionKey(i int) string {
+	key := cs.elements[i].frame.FunctionKey()
+	if cs.worker == "" || i != cs.workerDepth {
+		return key
+	}
+	if idx := strings.LastIndex(key, ":"); idx != -1 {
+		return key[:idx+1] + cs.worker
+	}
+	return cs.worker
 }
 
 func (cs *callStack) Push(frame *Frame) {
@@ -50,6 +77,28 @@ func (cs *callStack) SetCurrentLocation(location bir.Location) {
 	cs.elements[len(cs.elements)-1].location = location
 }
 
+// StackTrace returns the frames of the call stack, innermost first.
+func (cs *callStack) StackTrace() []values.StackFrame {
+	frames := make([]values.StackFrame, len(cs.elements))
+	for i, entry := range cs.elements {
+		frame := values.StackFrame{FunctionKey: cs.functionKey(i)}
+		if loc := entry.location; !bir.IsLocationEmpty(loc) {
+			frame.FilePath = loc.FilePath()
+			frame.Line = loc.StartLine() + 1
+		}
+		frames[len(frames)-1-i] = frame
+	}
+	return frames
+}
+
+// captureStackTrace records the call stack in an error value that does not
+// have a stack trace yet, such as an error raised by a native function.
+func captureStackTrace(cs *callStack, v any) {
+	if err, ok := v.(*values.Error); ok && err.StackTrace == nil {
+		err.StackTrace = cs.StackTrace()
+	}
+}
+
 // Entries returns the current entries in the call stack from bottom to top.
 func (cs *callStack) Entries() []callStackEntry {
 	entries := make([]callStackEntry, len(cs.elements))
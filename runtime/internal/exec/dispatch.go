@@ -50,8 +50,10 @@ func LookupFunction(env *extern.Env, org, module, name string) (any, bool) {
 }
 
 // LookupResourceMethod resolves a resource method named resourceMethodName
-// on obj. The second return is false if no candidate matches or if more
-// than one candidate matches (ambiguous dispatch).
+// on obj. When several candidates match, the one with the most specific
+// path is chosen, i.e. the one whose path type is a subtype of the path
+// types of all other matching candidates. The second return is false if no
+// candidate matches or if there is no unique most specific candidate.
 //
 // path contains a value for every path segment of the source-level
 // resource access expression (literal AND computed). The matcher compares
@@ -60,10 +62,11 @@ func LookupFunction(env *extern.Env, org, module, name string) (any, bool) {
 // as constructed by buildResourceCallArgs.
 func LookupResourceMethod(ctx *extern.Context, obj *values.Object, resourceMethodName string, path []values.BalValue) (any, bool) {
 	matches := resourceFnCandidates(ctx, obj, resourceMethodName, path)
-	if len(matches) != 1 {
+	match, ok := mostSpecificResourceEntry(ctx, matches)
+	if !ok {
 		return nil, false
 	}
-	return newResourceHandle(obj, matches[0], path), true
+	return newResourceHandle(obj, match, path), true
 }
 
 // Invoke calls the closure captured by the handle returned from one of
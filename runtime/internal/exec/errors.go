@@ -21,20 +21,31 @@ import (
 	"path/filepath"
 	"strings"
 
-	"ballerina-lang-go/bir"
 	"ballerina-lang-go/values"
 )
 
 func getFormattedError(cs *callStack, r any) error {
-	message := panicMessage(r)
-	stack := formatCallStack(cs)
-	return fmt.Errorf("%s", formatRuntimePanic(message, stack))
+	if err, ok := r.(*values.Error); ok {
+		captureStackTrace(cs, err)
+		return fmt.Errorf("%s", formatUncaughtError(err))
+	}
+	return fmt.Errorf("%s", formatRuntimePanic("error", panicMessage(r), formatStackTrace(cs.StackTrace())))
+}
+
+// formatUncaughtError formats an error value together with the chain of its
+// causes, each with the call stack at the point where it was created.
+func formatUncaughtError(err *values.Error) string {
+	var b strings.Builder
+	b.WriteString(formatRuntimePanic("error", err.Message, formatStackTrace(err.StackTrace)))
+	for cause, ok := err.Cause.(*values.Error); ok; cause, ok = cause.Cause.(*values.Error) {
+		b.WriteByte('\n')
+		b.WriteString(formatRuntimePanic("cause", cause.Message, formatStackTrace(cause.StackTrace)))
+	}
+	return b.String()
 }
 
 func panicMessage(r any) string {
 	switch v := r.(type) {
-	case *values.Error:
-		return v.Message
 	case error:
 		return v.Error()
 	default:
@@ -42,31 +53,27 @@ func panicMessage(r any) string {
 	}
 }
 
-func formatCallStack(cs *callStack) []string {
-	entries := cs.Entries()
+func formatStackTrace(frames []values.StackFrame) []string {
 	const maxFrames = 32
-	out := make([]string, 0, len(entries))
-	for i := len(entries) - 1; i >= 0; i-- {
+	out := make([]string, 0, min(len(frames), maxFrames+1))
+	for _, frame := range frames {
 		if len(out) >= maxFrames {
 			out = append(out, "...")
 			break
 		}
-		entry := entries[i]
-		loc := entry.location
-		if bir.IsLocationEmpty(loc) {
-			out = append(out, fmt.Sprintf("%s(unknown)", prettyFunctionName(entry.frame.FunctionKey())))
+		if frame.FilePath == "" {
+			out = append(out, fmt.Sprintf("%s(unknown)", prettyFunctionName(frame.FunctionKey)))
 			continue
 		}
-		file := filepath.Base(loc.FilePath())
-		line := loc.StartLine() + 1
-		out = append(out, fmt.Sprintf("%s(%s:%d)", prettyFunctionName(entry.frame.FunctionKey()), file, line))
+		file := filepath.Base(frame.FilePath)
+		out = append(out, fmt.Sprintf("%s(%s:%d)", prettyFunctionName(frame.FunctionKey), file, frame.Line))
 	}
 	return out
 }
 
-func formatRuntimePanic(message string, stack []string) string {
+func formatRuntimePanic(kind string, message string, stack []string) string {
 	var b strings.Builder
-	fmt.Fprintf(&b, "error: %s\n", message)
+	fmt.Fprintf(&b, "%s: %s\n", kind, message)
 	if len(stack) > 0 {
 		fmt.Fprintf(&b, "        at %s\n", stack[0])
 		for _, line := range stack[1:] {
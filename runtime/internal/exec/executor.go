@@ -31,6 +31,15 @@ const maxRecursionDepth = 5000
 
 func executeFunction(ctx *extern.Context, birFunc *bir.BIRFunction, args []values.BalValue, parentFrame *Frame) values.BalValue {
 	frame := createFunctionFrame(ctx, birFunc, args, parentFrame)
+	defer func() {
+		// Workers of a function that panics must not wait for messages from it.
+		if r := recover(); r != nil {
+			if group, ok := frame.WorkerGroup().(*workerGroup); ok {
+				group.terminate(group.worker(defaultWorkerName), nil, r)
+			}
+			panic(r)
+		}
+	}()
 	bb := &birFunc.BasicBlocks[0]
 	if len(birFunc.ErrorTable) > 0 {
 		executeFunctionWithTrap(ctx, birFunc, bb, frame)
@@ -38,6 +47,9 @@ func executeFunction(ctx *extern.Context, birFunc *bir.BIRFunction, args []value
 		executeFunctionNoTrap(ctx, bb, frame)
 	}
 	result := frame.Local(0)
+	if group, ok := frame.WorkerGroup().(*workerGroup); ok {
+		group.join(result)
+	}
 	popFrame(ctx)
 	return result
 }
@@ -117,7 +129,8 @@ func executeFunctionWithTrap(ctx *extern.Context, birFunc *bir.BIRFunction, bb *
 			if handler == nil {
 				panic(recovered)
 			}
-			unwindCallStackToFrame(ctx, frame)
+			captureStackTrace(getCallStack(ctx), recovered)
+			unwindCallStackToFrame(ctx, frame, recovered)
 			errVal := panicValueToErrorValue(recovered)
 			currentFrame = setRecoveredError(ctx, handler.ErrorOp, nextFrame, errVal)
 			bb = &birFunc.BasicBlocks[handler.Target]
@@ -188,6 +201,8 @@ func execInstruction(ctx *extern.Context, inst bir.BIRNonTerminator, frame *Fram
 		execNewError(ctx, v, frame)
 	case *bir.NewObject:
 		execNewObject(ctx, v, frame)
+	case *bir.NewTable:
+		execNewTable(ctx, v, frame)
 	case *bir.NewStream:
 		execNewStream(ctx, v, frame)
 	case *bir.StreamNext:
@@ -212,6 +227,8 @@ func execInstruction(ctx *extern.Context, inst bir.BIRNonTerminator, frame *Fram
 			execObjectStore(ctx, v, frame)
 		case bir.INSTRUCTION_KIND_OBJECT_LOAD:
 			execObjectLoad(ctx, v, frame)
+		case bir.INSTRUCTION_KIND_TABLE_LOAD:
+			execTableLoad(ctx, v, frame)
 		default:
 			fmt.Printf("UNKNOWN_FIELD_ACCESS_KIND(%d)\n", v.GetKind())
 		}
@@ -317,30 +334,14 @@ func execTerminator(ctx *extern.Context, term bir.BIRTerminator, frame *Frame) *
 		switch v.GetKind() {
 		case bir.INSTRUCTION_KIND_CALL:
 			return execCall(ctx, v, frame)
-		case bir.INSTRUCTION_KIND_ASYNC_CALL:
-			fmt.Println("NOT IMPLEMENTED: INSTRUCTION_KIND_ASYNC_CALL")
-		case bir.INSTRUCTION_KIND_WAIT:
-			fmt.Println("NOT IMPLEMENTED: INSTRUCTION_KIND_WAIT")
 		case bir.INSTRUCTION_KIND_FP_CALL:
 			return execFpCall(ctx, v, frame)
-		case bir.INSTRUCTION_KIND_WK_RECEIVE:
-			fmt.Println("NOT IMPLEMENTED: INSTRUCTION_KIND_WK_RECEIVE")
-		case bir.INSTRUCTION_KIND_WK_SEND:
-			fmt.Println("NOT IMPLEMENTED: INSTRUCTION_KIND_WK_SEND")
-		case bir.INSTRUCTION_KIND_FLUSH:
-			fmt.Println("NOT IMPLEMENTED: INSTRUCTION_KIND_FLUSH")
 		case bir.INSTRUCTION_KIND_LOCK:
 			fmt.Println("NOT IMPLEMENTED: INSTRUCTION_KIND_LOCK")
 		case bir.INSTRUCTION_KIND_FIELD_LOCK:
 			fmt.Println("NOT IMPLEMENTED: INSTRUCTION_KIND_FIELD_LOCK")
 		case bir.INSTRUCTION_KIND_UNLOCK:
 			fmt.Println("NOT IMPLEMENTED: INSTRUCTION_KIND_UNLOCK")
-		case bir.INSTRUCTION_KIND_WAIT_ALL:
-			fmt.Println("NOT IMPLEMENTED: INSTRUCTION_KIND_WAIT_ALL")
-		case bir.INSTRUCTION_KIND_WK_ALT_RECEIVE:
-			fmt.Println("NOT IMPLEMENTED: INSTRUCTION_KIND_WK_ALT_RECEIVE")
-		case bir.INSTRUCTION_KIND_WK_MULTIPLE_RECEIVE:
-			fmt.Println("NOT IMPLEMENTED: INSTRUCTION_KIND_WK_MULTIPLE_RECEIVE")
 		default:
 			fmt.Printf("UNKNOWN_CALL_INSTRUCTION_KIND(%d)\n", v.GetKind())
 		}
@@ -354,6 +355,22 @@ func execTerminator(ctx *extern.Context, term bir.BIRTerminator, frame *Frame) *
 		return v.ThenBB
 	case *bir.ResourceFunctionCall:
 		return execResourceCall(ctx, v, frame)
+	case *bir.AsyncCall:
+		return execAsyncCall(ctx, v, frame)
+	case *bir.WorkerSend:
+		return execWorkerSend(ctx, v, frame)
+	case *bir.WorkerReceive:
+		return execWorkerReceive(ctx, v, frame)
+	case *bir.WorkerAlternateReceive:
+		return execWorkerAlternateReceive(ctx, v, frame)
+	case *bir.WorkerMultipleReceive:
+		return execWorkerMultipleReceive(ctx, v, frame)
+	case *bir.Flush:
+		return execFlush(ctx, v, frame)
+	case *bir.Wait:
+		return execWait(ctx, v, frame)
+	case *bir.WaitAll:
+		return execWaitAll(ctx, v, frame)
 	default:
 		fmt.Printf("UNKNOWN_TERMINATOR_TYPE(%T)\n", term)
 	}
@@ -403,8 +420,12 @@ func findTrapErrorEntry(birFunc *bir.BIRFunction, bbNumber int) *bir.BIRErrorEnt
 	return best
 }
 
-func unwindCallStackToFrame(ctx *extern.Context, frame *Frame) {
+func unwindCallStackToFrame(ctx *extern.Context, frame *Frame, recovered any) {
 	for callStackDepth(ctx) > 0 && getCallStack(ctx).top() != frame {
+		// Workers of the functions being unwound must not wait for messages from them.
+		if group, ok := getCallStack(ctx).top().WorkerGroup().(*workerGroup); ok {
+			group.terminate(group.worker(defaultWorkerName), nil, recovered)
+		}
 		popFrame(ctx)
 	}
 }
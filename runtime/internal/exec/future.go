@@ -0,0 +1,112 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exec
+
+import (
+	"reflect"
+
+	"ballerina-lang-go/bir"
+	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/values"
+)
+
+// strandPanic is the result of a started strand that terminated with a panic. The panic is raised again in
+// every strand that waits on the future of the started strand.
+type strandPanic struct {
+	value any
+}
+
+// startStrand runs call on a new strand and stores a future for its result in lhsOp.
+func startStrand(ctx *extern.Context, lhsOp *bir.BIROperand, frame *Frame, call func(strandCtx *extern.Context) values.BalValue) {
+	handle := &InvokableHandle{
+		invoke: func(strandCtx *extern.Context, _ []values.BalValue) (result values.BalValue, err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					strandCtx.ReleaseAllHeldLocks()
+					captureStackTrace(getCallStack(strandCtx), r)
+					result, err = strandPanic{value: r}, nil
+				}
+			}()
+			return call(strandCtx), nil
+		},
+	}
+	strand, err := StartMethod(ctx, handle, nil)
+	if err != nil {
+		panic(values.NewErrorWithMessage(err.Error()))
+	}
+	setOperandValue(ctx, lhsOp, frame, values.NewFuture(lhsOp.VariableDcl.GetType(), strand))
+}
+
+func futureResult(future *values.Future) values.BalValue {
+	result := future.Result()
+	if p, ok := result.(strandPanic); ok {
+		panic(p.value)
+	}
+	return result
+}
+
+// waitAny returns the result of the first of futures to complete with a non-error value, or the last error if
+// all of them complete with an error.
+func waitAny(futures []*values.Future) values.BalValue {
+	if len(futures) == 1 {
+		return futureResult(futures[0])
+	}
+	pending := make([]reflect.SelectCase, len(futures))
+	for i, future := range futures {
+		pending[i] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(future.Done())}
+	}
+	var lastErr values.BalValue
+	for len(pending) > 0 {
+		i, _, _ := reflect.Select(pending)
+		result := futureResult(futures[i])
+		if _, isErr := result.(*values.Error); !isErr {
+			return result
+		}
+		lastErr = result
+		pending = append(pending[:i], pending[i+1:]...)
+		futures = append(futures[:i:i], futures[i+1:]...)
+	}
+	return lastErr
+}
+
+func operandFutures(ctx *extern.Context, ops []bir.BIROperand, frame *Frame) []*values.Future {
+	futures := make([]*values.Future, len(ops))
+	for i := range ops {
+		futures[i] = getOperandValue(ctx, &ops[i], frame).(*values.Future)
+	}
+	return futures
+}
+
+func execWait(ctx *extern.Context, wait *bir.Wait, frame *Frame) *bir.BIRBasicBlock {
+	setOperandValue(ctx, wait.LhsOp, frame, waitAny(operandFutures(ctx, wait.Exprs, frame)))
+	return wait.ThenBB
+}
+
+func execWaitAll(ctx *extern.Context, wait *bir.WaitAll, frame *Frame) *bir.BIRBasicBlock {
+	futures := operandFutures(ctx, wait.Exprs, frame)
+	entries := make([]values.MapEntry, len(futures))
+	for i, future := range futures {
+		entries[i] = values.MapEntry{Key: wait.Keys[i], Value: futureResult(future)}
+	}
+	atomic := semtypes.ToMappingAtomicType(ctx.TypeCtx, wait.Type)
+	if atomic == nil {
+		panic("wait all type has no atomic representation")
+	}
+	setOperandValue(ctx, wait.LhsOp, frame, values.NewMap(wait.Type, atomic, false, entries))
+	return wait.ThenBB
+}
@@ -46,7 +46,7 @@ func newBIRHandle(fn *bir.BIRFunction, parentFrame *Frame) *InvokableHandle {
 func NewNativeHandle(fn extern.NativeFunc) *InvokableHandle {
 	return &InvokableHandle{
 		invoke: func(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
-			return fn(ctx, args)
+			return callNativeFunc(ctx, fn, args)
 		},
 	}
 }
@@ -42,9 +42,17 @@ func execNewArray(ctx *extern.Context, newArray *bir.NewArray, frame *Frame) {
 	if newArray.SizeOp != nil {
 		size = int(getOperandValue(ctx, newArray.SizeOp, frame).(int64))
 	}
-	initial := make([]values.BalValue, len(newArray.Values))
+	initial := make([]values.BalValue, 0, len(newArray.Values))
 	for i, value := range newArray.Values {
-		initial[i] = getOperandValue(ctx, value, frame)
+		val := getOperandValue(ctx, value, frame)
+		if newArray.IsSpreadMember(i) {
+			spread := val.(*values.List)
+			for j := 0; j < spread.Len(); j++ {
+				initial = append(initial, spread.Get(j))
+			}
+			continue
+		}
+		initial = append(initial, val)
 	}
 	atomic := semtypes.ToListAtomicType(ctx.TypeCtx, newArray.Type)
 	list := values.NewList(newArray.Type, atomic, newArray.IsReadonly, newArray.Filler, size, initial)
@@ -55,6 +63,15 @@ func execNewMap(ctx *extern.Context, newMap *bir.NewMap, frame *Frame) {
 	seen := make(map[string]struct{}, len(newMap.Values))
 	entries := make([]values.MapEntry, 0, len(newMap.Values)+len(newMap.Defaults))
 	for _, entry := range newMap.Values {
+		if !entry.IsKeyValuePair() {
+			spread := getOperandValue(ctx, entry.ValueOp(), frame).(*values.Map)
+			for _, key := range spread.Keys() {
+				val, _ := spread.Get(key)
+				seen[key] = struct{}{}
+				entries = append(entries, values.MapEntry{Key: key, Value: val})
+			}
+			continue
+		}
 		kv := entry.(*bir.MappingConstructorKeyValueEntry)
 		keyStr := getOperandValue(ctx, kv.KeyOp(), frame).(string)
 		valueVal := getOperandValue(ctx, kv.ValueOp(), frame)
@@ -77,6 +94,16 @@ func execNewMap(ctx *extern.Context, newMap *bir.NewMap, frame *Frame) {
 	setOperandValue(ctx, newMap.GetLhsOperand(), frame, m)
 }
 
+func execNewTable(ctx *extern.Context, newTable *bir.NewTable, frame *Frame) {
+	rows := make([]*values.Map, len(newTable.Rows))
+	for i, row := range newTable.Rows {
+		rows[i] = getOperandValue(ctx, row, frame).(*values.Map)
+	}
+	rowTy := semtypes.TableRowType(ctx.TypeCtx, newTable.Type)
+	table := values.NewTable(newTable.Type, rowTy, newTable.KeyFieldNames, newTable.IsReadonly, rows)
+	setOperandValue(ctx, newTable.LhsOp, frame, table)
+}
+
 func execNewError(ctx *extern.Context, newError *bir.NewError, frame *Frame) {
 	msgVal := getOperandValue(ctx, newError.MessageOp, frame)
 	message := msgVal.(string)
@@ -91,6 +118,7 @@ func execNewError(ctx *extern.Context, newError *bir.NewError, frame *Frame) {
 		detailMap = getOperandValue(ctx, newError.DetailOp, frame).(*values.Map)
 	}
 	errVal := values.NewError(newError.Type, message, cause, newError.TypeName, detailMap)
+	errVal.StackTrace = getCallStack(ctx).StackTrace()
 	setOperandValue(ctx, newError.GetLhsOperand(), frame, errVal)
 }
 
@@ -184,6 +212,17 @@ func execMapLoad(ctx *extern.Context, access *bir.FieldAccess, frame *Frame) {
 	setOperandValue(ctx, access.LhsOp, frame, value)
 }
 
+func execTableLoad(ctx *extern.Context, access *bir.FieldAccess, frame *Frame) {
+	table := getOperandValue(ctx, access.RhsOp, frame).(*values.Table)
+	key := getOperandValue(ctx, access.KeyOp, frame)
+	row, ok := table.Get(key)
+	if !ok {
+		setOperandValue(ctx, access.LhsOp, frame, nil)
+		return
+	}
+	setOperandValue(ctx, access.LhsOp, frame, row)
+}
+
 func execObjectStore(ctx *extern.Context, access *bir.FieldAccess, frame *Frame) {
 	obj := getOperandValue(ctx, access.LhsOp, frame).(*values.Object)
 	field := getOperandValue(ctx, access.KeyOp, frame).(string)
@@ -364,6 +403,18 @@ func xmlResultReadonly(ctx *extern.Context, op *bir.BIROperand) bool {
 
 func execEvalTemplateExpr(ctx *extern.Context, instr *bir.EvalTemplateExpr, frame *Frame) {
 	n := len(instr.Insertions)
+	if instr.Kind == bir.TemplateKindRegExp {
+		insertions := make([]string, n)
+		for i, op := range instr.Insertions {
+			insertions[i] = values.String(getOperandValue(ctx, op, frame), nil)
+		}
+		re, err := values.NewRegExpFromTemplate(instr.Strings, insertions)
+		if err != nil {
+			panic(err)
+		}
+		setOperandValue(ctx, instr.LhsOp, frame, re)
+		return
+	}
 	buf := make([]byte, 0, instr.LiteralsTotalLen+8*n)
 	for i := 0; i < n; i++ {
 		buf = append(buf, instr.Strings[i]...)
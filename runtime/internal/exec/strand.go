@@ -48,7 +48,7 @@ func snapshotSpawnFrames(cs *callStack) []callStackEntry {
 	for i, e := range src {
 		frame := &Frame{}
 		if e.frame != nil {
-			frame.SetFunctionKey(e.frame.FunctionKey())
+			frame.SetFunctionKey(cs.functionKey(i))
 		}
 		out[i] = callStackEntry{frame: frame, location: e.location}
 	}
@@ -32,7 +32,13 @@ func execBranch(ctx *extern.Context, branchTerm *bir.Branch, frame *Frame) *bir.
 }
 
 func execCall(ctx *extern.Context, callInfo *bir.Call, frame *Frame) *bir.BIRBasicBlock {
-	args := extractArgs(ctx, callInfo.Args, frame)
+	args := extractCallArgs(ctx, callInfo, frame)
+	if callInfo.IsAsync {
+		startStrand(ctx, callInfo.LhsOp, frame, func(strandCtx *extern.Context) values.BalValue {
+			return executeCall(strandCtx, callInfo, args)
+		})
+		return callInfo.ThenBB
+	}
 	result := executeCall(ctx, callInfo, args)
 	if callInfo.LhsOp != nil {
 		setOperandValue(ctx, callInfo.LhsOp, frame, result)
@@ -48,7 +54,7 @@ func executeCall(ctx *extern.Context, callInfo *bir.Call, args []values.BalValue
 		return executeFunction(ctx, callInfo.CachedBIRFunc, args, nil)
 	}
 	if callInfo.CachedNativeFunc != nil {
-		result, err := callInfo.CachedNativeFunc(ctx, args)
+		result, err := callNativeFunc(ctx, callInfo.CachedNativeFunc, args)
 		if err != nil {
 			panic(err)
 		}
@@ -75,7 +81,7 @@ func dispatchMethodCall(ctx *extern.Context, callInfo *bir.Call, args []values.B
 			return executeFunction(ctx, callInfo.CachedBIRFunc, args, nil)
 		}
 		if callInfo.CachedNativeFunc != nil {
-			result, err := callInfo.CachedNativeFunc(ctx, args)
+			result, err := callNativeFunc(ctx, callInfo.CachedNativeFunc, args)
 			if err != nil {
 				panic(err)
 			}
@@ -96,7 +102,7 @@ func dispatchMethodCall(ctx *extern.Context, callInfo *bir.Call, args []values.B
 func lookupAndExecute(ctx *extern.Context, callInfo *bir.Call, args []values.BalValue, lookupKey string) (values.BalValue, error) {
 	reg := ctx.Env.Registry.(*modules.Registry)
 	if builtin := reg.GetRuntimeBuiltin(lookupKey); builtin != nil {
-		return builtin(ctx, args)
+		return callNativeFunc(ctx, builtin, args)
 	}
 	isResourceFnCall := callInfo == nil
 	fn := reg.GetBIRFunction(lookupKey)
@@ -111,7 +117,7 @@ func lookupAndExecute(ctx *extern.Context, callInfo *bir.Call, args []values.Bal
 		if !isResourceFnCall {
 			callInfo.CachedNativeFunc = externFn.Impl
 		}
-		return externFn.Impl(ctx, args)
+		return callNativeFunc(ctx, externFn.Impl, args)
 	}
 	// In resource function case we have already validated function exists using RTable
 	panic(values.NewErrorWithMessage("function not found: " + callInfo.Name.Value()))
@@ -120,11 +126,28 @@ func lookupAndExecute(ctx *extern.Context, callInfo *bir.Call, args []values.Bal
 func execResourceCall(ctx *extern.Context, instr *bir.ResourceFunctionCall, frame *Frame) *bir.BIRBasicBlock {
 	receiver := getOperandValue(ctx, &instr.Receiver, frame).(*values.Object)
 	pathVals := extractArgs(ctx, instr.PathSegments, frame)
+	if instr.HasRestSegment {
+		restSegment := pathVals[len(pathVals)-1].(*values.List)
+		pathVals = pathVals[:len(pathVals)-1]
+		for i := 0; i < restSegment.Len(); i++ {
+			pathVals = append(pathVals, restSegment.Get(i))
+		}
+	}
 	impl, ok := LookupResourceMethod(ctx, receiver, instr.MethodName, pathVals)
 	if !ok {
 		panic(values.NewErrorWithMessage("no matching resource method"))
 	}
 	argVals := extractArgs(ctx, instr.Args, frame)
+	if instr.IsAsync {
+		startStrand(ctx, instr.LhsOp, frame, func(strandCtx *extern.Context) values.BalValue {
+			result, err := Invoke(strandCtx, impl, argVals)
+			if err != nil {
+				panic(err)
+			}
+			return result
+		})
+		return instr.ThenBB
+	}
 	result, err := Invoke(ctx, impl, argVals)
 	if err != nil {
 		panic(err)
@@ -178,6 +201,38 @@ func resourcePathMatches(ctx *extern.Context, entry *values.ResourceEntry, shape
 	return true
 }
 
+func mostSpecificResourceEntry(ctx *extern.Context, matches []*values.ResourceEntry) (*values.ResourceEntry, bool) {
+	if len(matches) == 1 {
+		return matches[0], true
+	}
+	pathTys := make([]semtypes.SemType, len(matches))
+	for i, entry := range matches {
+		pathTys[i] = resourceEntryPathType(ctx, entry)
+	}
+	for i, entry := range matches {
+		mostSpecific := true
+		for j := range matches {
+			if i != j && !semtypes.IsSubtype(ctx.TypeCtx, pathTys[i], pathTys[j]) {
+				mostSpecific = false
+				break
+			}
+		}
+		if mostSpecific {
+			return entry, true
+		}
+	}
+	return nil, false
+}
+
+func resourceEntryPathType(ctx *extern.Context, entry *values.ResourceEntry) semtypes.SemType {
+	members := make([]semtypes.SemType, len(entry.PathSegments))
+	for i, seg := range entry.PathSegments {
+		members[i] = seg.Ty
+	}
+	listDefn := semtypes.NewListDefinition()
+	return listDefn.DefineListTypeWrapped(ctx.Env.TypeEnv, members, len(members), entry.RestSegmentTy, semtypes.CellMutability_CELL_MUT_NONE)
+}
+
 func buildResourceCallArgs(ctx *extern.Context, receiver *values.Object, match *values.ResourceEntry, pathVals, argVals []values.BalValue) []values.BalValue {
 	k := len(match.PathSegments)
 	result := make([]values.BalValue, 0, 1+len(pathVals)+len(argVals))
@@ -206,36 +261,52 @@ func buildResourceCallArgs(ctx *extern.Context, receiver *values.Object, match *
 }
 
 func execFpCall(ctx *extern.Context, callInfo *bir.Call, frame *Frame) *bir.BIRBasicBlock {
-	args := extractArgs(ctx, callInfo.Args, frame)
+	args := extractCallArgs(ctx, callInfo, frame)
 	fnValue := getOperandValue(ctx, callInfo.FpOperand, frame).(*values.Function)
+	if callInfo.IsAsync {
+		startStrand(ctx, callInfo.LhsOp, frame, func(strandCtx *extern.Context) values.BalValue {
+			return executeFpCall(strandCtx, callInfo, fnValue, args)
+		})
+		return callInfo.ThenBB
+	}
+	result := executeFpCall(ctx, callInfo, fnValue, args)
+	if callInfo.LhsOp != nil {
+		setOperandValue(ctx, callInfo.LhsOp, frame, result)
+	}
+	return callInfo.ThenBB
+}
+
+func executeFpCall(ctx *extern.Context, callInfo *bir.Call, fnValue *values.Function, args []values.BalValue) values.BalValue {
 	lookupKey := fnValue.LookupKey
-	var result values.BalValue
 	var parentFrame *Frame
 	if fnValue.ParentFrame != nil {
 		parentFrame = fnValue.ParentFrame.(*Frame)
 	}
 	reg := ctx.Env.Registry.(*modules.Registry)
 	if builtin := reg.GetRuntimeBuiltin(lookupKey); builtin != nil {
-		var err error
-		result, err = builtin(ctx, args)
+		result, err := callNativeFunc(ctx, builtin, args)
 		if err != nil {
 			panic(err)
 		}
+		return result
 	} else if fn := reg.GetBIRFunction(lookupKey); fn != nil {
-		result = executeFunction(ctx, fn, args, parentFrame)
+		return executeFunction(ctx, fn, args, parentFrame)
 	} else if externFn := reg.GetNativeFunction(lookupKey); externFn != nil {
-		var err error
-		result, err = externFn.Impl(ctx, args)
+		result, err := callNativeFunc(ctx, externFn.Impl, args)
 		if err != nil {
 			panic(err)
 		}
-	} else {
-		panic("function not found: " + callInfo.Name.Value())
-	}
-	if callInfo.LhsOp != nil {
-		setOperandValue(ctx, callInfo.LhsOp, frame, result)
+		return result
 	}
-	return callInfo.ThenBB
+	panic("function not found: " + callInfo.Name.Value())
+}
+
+// callNativeFunc calls a native function. An error value it returns is given
+// the call stack of the caller, as if the caller had created it.
+func callNativeFunc(ctx *extern.Context, fn extern.NativeFunc, args []values.BalValue) (values.BalValue, error) {
+	result, err := fn(ctx, args)
+	captureStackTrace(getCallStack(ctx), result)
+	return result, err
 }
 
 func extractArgs(ctx *extern.Context, args []bir.BIROperand, frame *Frame) []values.BalValue {
@@ -246,6 +317,21 @@ func extractArgs(ctx *extern.Context, args []bir.BIROperand, frame *Frame) []val
 	return values
 }
 
+// extractCallArgs evaluates the arguments of a call, passing the members of a rest
+// argument as separate arguments.
+func extractCallArgs(ctx *extern.Context, callInfo *bir.Call, frame *Frame) []values.BalValue {
+	args := extractArgs(ctx, callInfo.Args, frame)
+	if !callInfo.HasRestArg {
+		return args
+	}
+	restArg := args[len(args)-1].(*values.List)
+	args = args[:len(args)-1]
+	for i := 0; i < restArg.Len(); i++ {
+		args = append(args, restArg.Get(i))
+	}
+	return args
+}
+
 func execPanic(ctx *extern.Context, panicTerm *bir.Panic, frame *Frame) *bir.BIRBasicBlock {
 	errVal := getOperandValue(ctx, panicTerm.ErrorOp, frame)
 	panic(errVal)
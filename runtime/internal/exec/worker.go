@@ -0,0 +1,335 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exec
+
+import (
+	"sync"
+
+	"ballerina-lang-go/bir"
+	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/values"
+)
+
+// defaultWorkerName is the name of the worker executing the function body itself.
+const defaultWorkerName = "function"
+
+// workerGroup holds the message channels between the workers of a single function invocation. Each named
+// worker runs on its own strand; all interactions are synchronized through mu and cond.
+type workerGroup struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	channels map[string]*workerChannel
+	workers  map[string]*workerState
+	// started are the named workers in the order they were started.
+	started []*workerState
+}
+
+type workerChannel struct {
+	sender, receiver string
+	value            values.BalValue
+	sent, received   bool
+}
+
+type workerState struct {
+	name   string
+	strand <-chan values.BalValue
+	done   bool
+	// result is the return value of the worker; panicValue is set instead if it terminated with a panic.
+	result     values.BalValue
+	panicValue any
+}
+
+func newWorkerGroup() *workerGroup {
+	g := &workerGroup{channels: make(map[string]*workerChannel), workers: make(map[string]*workerState)}
+	g.cond = sync.NewCond(&g.mu)
+	return g
+}
+
+// workerGroupFor returns the worker group stored in op, creating it on first use. The group is also attached
+// to the frame holding op so that the owning function can wait for its workers before returning.
+func workerGroupFor(ctx *extern.Context, op *bir.BIROperand, frame *Frame) *workerGroup {
+	if group, ok := getOperandValue(ctx, op, frame).(*workerGroup); ok {
+		return group
+	}
+	group := newWorkerGroup()
+	setOperandValue(ctx, op, frame, group)
+	resolveFrame(frame, op.Address).SetWorkerGroup(group)
+	return group
+}
+
+func (g *workerGroup) worker(name string) *workerState {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.workerLocked(name)
+}
+
+func (g *workerGroup) workerLocked(name string) *workerState {
+	w, ok := g.workers[name]
+	if !ok {
+		w = &workerState{name: name}
+		g.workers[name] = w
+	}
+	return w
+}
+
+func (g *workerGroup) channel(id, sender, receiver string) *workerChannel {
+	ch, ok := g.channels[id]
+	if !ok {
+		ch = &workerChannel{sender: sender, receiver: receiver}
+		g.channels[id] = ch
+	}
+	return ch
+}
+
+func (g *workerGroup) terminate(w *workerState, result values.BalValue, panicValue any) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if w.done {
+		return
+	}
+	w.done = true
+	w.result = result
+	w.panicValue = panicValue
+	g.cond.Broadcast()
+}
+
+// join is called by the default worker once the function body has completed. It waits for all named workers
+// and propagates the first panic among them.
+func (g *workerGroup) join(result values.BalValue) {
+	g.terminate(g.worker(defaultWorkerName), result, nil)
+	g.mu.Lock()
+	started := g.started
+	g.mu.Unlock()
+	for _, w := range started {
+		<-w.strand
+	}
+	for _, w := range started {
+		if w.panicValue != nil {
+			panic(w.panicValue)
+		}
+	}
+}
+
+// terminatedPeerResult is the result of an interaction with a worker that terminated without completing it.
+// A panic in the peer is propagated, and an error returned by the peer is returned in place of the message.
+func terminatedPeerResult(w *workerState) (values.BalValue, bool) {
+	if w.panicValue != nil {
+		panic(w.panicValue)
+	}
+	if err, ok := w.result.(*values.Error); ok {
+		return err, true
+	}
+	return nil, false
+}
+
+func (g *workerGroup) send(id, sender, receiver string, value values.BalValue, isSync bool) values.BalValue {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	ch := g.channel(id, sender, receiver)
+	ch.value = value
+	ch.sent = true
+	g.cond.Broadcast()
+	if !isSync {
+		return nil
+	}
+	for !ch.received {
+		if w := g.workers[receiver]; w != nil && w.done {
+			result, _ := terminatedPeerResult(w)
+			return result
+		}
+		g.cond.Wait()
+	}
+	return nil
+}
+
+// tryReceiveLocked returns the message on the channel id if it has been sent, or the result of the sender if it
+// has terminated without sending it. The last result is false if neither has happened yet.
+func (g *workerGroup) tryReceiveLocked(id, sender string) (values.BalValue, bool) {
+	if ch, ok := g.channels[id]; ok && ch.sent {
+		ch.received = true
+		g.cond.Broadcast()
+		return ch.value, true
+	}
+	if w := g.workers[sender]; w != nil && w.done {
+		if err, ok := terminatedPeerResult(w); ok {
+			return err, true
+		}
+		return values.NewErrorWithMessage("no message received from worker '" + sender + "'"), true
+	}
+	return nil, false
+}
+
+func (g *workerGroup) receive(id, sender string) values.BalValue {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for {
+		if value, ok := g.tryReceiveLocked(id, sender); ok {
+			return value
+		}
+		g.cond.Wait()
+	}
+}
+
+// alternateReceive returns the first non-error message from channels, or the last error if every channel
+// results in an error.
+func (g *workerGroup) alternateReceive(channels []bir.WorkerReceiveChannel) values.BalValue {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	completed := make([]bool, len(channels))
+	var lastErr values.BalValue
+	for {
+		pending := false
+		for i, ch := range channels {
+			if completed[i] {
+				continue
+			}
+			value, ok := g.tryReceiveLocked(ch.ChannelId, ch.Sender)
+			if !ok {
+				pending = true
+				continue
+			}
+			completed[i] = true
+			if _, isErr := value.(*values.Error); !isErr {
+				return value
+			}
+			lastErr = value
+		}
+		if !pending {
+			return lastErr
+		}
+		g.cond.Wait()
+	}
+}
+
+// flush waits until every message sent by sender to receivers has been received. If a receiver terminates
+// before receiving all of them, its error (if any) is returned.
+func (g *workerGroup) flush(sender string, receivers []string) values.BalValue {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, receiver := range receivers {
+		var pending []*workerChannel
+		for _, ch := range g.channels {
+			if ch.sender == sender && ch.receiver == receiver && ch.sent && !ch.received {
+				pending = append(pending, ch)
+			}
+		}
+		for _, ch := range pending {
+			for !ch.received {
+				if w := g.workers[receiver]; w != nil && w.done {
+					if err, ok := terminatedPeerResult(w); ok {
+						return err
+					}
+					break
+				}
+				g.cond.Wait()
+			}
+		}
+	}
+	return nil
+}
+
+// execAsyncCall starts a named worker. The strand of the worker is waited on when the declaring function
+// completes; the future stored in LhsOp is completed separately, so that waiting on it does not race with that.
+func execAsyncCall(ctx *extern.Context, call *bir.AsyncCall, frame *Frame) *bir.BIRBasicBlock {
+	group := workerGroupFor(ctx, call.GroupOp, frame)
+	fnValue := getOperandValue(ctx, call.FpOperand, frame).(*values.Function)
+	handle, err := NewFunctionValueHandle(ctx.Env, fnValue)
+	if err != nil {
+		panic(values.NewErrorWithMessage(err.Error()))
+	}
+	state := group.worker(call.WorkerName)
+	futureResult := make(chan values.BalValue, 1)
+	worker := &InvokableHandle{
+		invoke: func(workerCtx *extern.Context, args []values.BalValue) (result values.BalValue, err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					workerCtx.ReleaseAllHeldLocks()
+					captureStackTrace(getCallStack(workerCtx), r)
+					group.terminate(state, nil, r)
+					futureResult <- strandPanic{value: r}
+					result, err = nil, nil
+				}
+			}()
+			getCallStack(workerCtx).startWorker(call.WorkerName)
+			result, err = handle.invoke(workerCtx, args)
+			if err != nil {
+				result, err = values.NewErrorWithMessage(err.Error()), nil
+			}
+			group.terminate(state, result, nil)
+			futureResult <- result
+			return result, nil
+		},
+	}
+	if call.LhsOp != nil {
+		setOperandValue(ctx, call.LhsOp, frame, values.NewFuture(call.LhsOp.VariableDcl.GetType(), futureResult))
+	}
+	strand, err := StartMethod(ctx, worker, nil)
+	if err != nil {
+		panic(values.NewErrorWithMessage(err.Error()))
+	}
+	group.mu.Lock()
+	state.strand = strand
+	group.started = append(group.started, state)
+	group.mu.Unlock()
+	return call.ThenBB
+}
+
+func execWorkerSend(ctx *extern.Context, send *bir.WorkerSend, frame *Frame) *bir.BIRBasicBlock {
+	group := workerGroupFor(ctx, send.GroupOp, frame)
+	value := getOperandValue(ctx, send.DataOp, frame)
+	result := group.send(send.ChannelId, send.Sender, send.Receiver, value, send.IsSync)
+	setOperandValue(ctx, send.LhsOp, frame, result)
+	return send.ThenBB
+}
+
+func execWorkerReceive(ctx *extern.Context, receive *bir.WorkerReceive, frame *Frame) *bir.BIRBasicBlock {
+	group := workerGroupFor(ctx, receive.GroupOp, frame)
+	setOperandValue(ctx, receive.LhsOp, frame, group.receive(receive.ChannelId, receive.Sender))
+	return receive.ThenBB
+}
+
+func execWorkerAlternateReceive(ctx *extern.Context, receive *bir.WorkerAlternateReceive, frame *Frame) *bir.BIRBasicBlock {
+	group := workerGroupFor(ctx, receive.GroupOp, frame)
+	setOperandValue(ctx, receive.LhsOp, frame, group.alternateReceive(receive.Channels))
+	return receive.ThenBB
+}
+
+func execWorkerMultipleReceive(ctx *extern.Context, receive *bir.WorkerMultipleReceive, frame *Frame) *bir.BIRBasicBlock {
+	group := workerGroupFor(ctx, receive.GroupOp, frame)
+	entries := make([]values.MapEntry, len(receive.Channels))
+	for i, ch := range receive.Channels {
+		value := group.receive(ch.ChannelId, ch.Sender)
+		if _, isErr := value.(*values.Error); isErr {
+			setOperandValue(ctx, receive.LhsOp, frame, value)
+			return receive.ThenBB
+		}
+		entries[i] = values.MapEntry{Key: ch.Key, Value: value}
+	}
+	atomic := semtypes.ToMappingAtomicType(ctx.TypeCtx, receive.Type)
+	if atomic == nil {
+		panic("multiple receive type has no atomic representation")
+	}
+	setOperandValue(ctx, receive.LhsOp, frame, values.NewMap(receive.Type, atomic, false, entries))
+	return receive.ThenBB
+}
+
+func execFlush(ctx *extern.Context, flush *bir.Flush, frame *Frame) *bir.BIRBasicBlock {
+	group := workerGroupFor(ctx, flush.GroupOp, frame)
+	setOperandValue(ctx, flush.LhsOp, frame, group.flush(flush.Sender, flush.Receivers))
+	return flush.ThenBB
+}
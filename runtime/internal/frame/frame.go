@@ -35,6 +35,8 @@ type Frame struct {
 	functionKey string
 	parent      *Frame
 	escaped     bool
+	// workerGroup is the group of named workers started by the function owning this frame, if any.
+	workerGroup any
 }
 
 // New create a frame for holding numLocals. The backing memory may be reused from already
@@ -104,6 +106,14 @@ func (f *Frame) SetFunctionKey(functionKey string) {
 	f.functionKey = functionKey
 }
 
+func (f *Frame) WorkerGroup() any {
+	return f.workerGroup
+}
+
+func (f *Frame) SetWorkerGroup(group any) {
+	f.workerGroup = group
+}
+
 func (f *Frame) Local(idx int) values.BalValue {
 	return f.locals[idx]
 }
@@ -0,0 +1,109 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package transactions holds the per-strand state of the local transaction
+// manager. Transactions are local to a single process and are never
+// coordinated with other participants; committing a transaction only runs
+// the commit handlers registered with it.
+//
+// Each strand has a stack of transactions. A transaction statement pushes a
+// transaction when it starts and pops it when its body exits, whether
+// normally, by a failure or by a return. A transaction that is still active
+// when it is popped is rolled back. As with locks, a Go-level panic raised
+// inside a transaction body that is recovered by an outer `trap` leaves the
+// transaction on the stack; the strand keeps treating it as the current
+// transaction.
+package transactions
+
+import (
+	"strconv"
+
+	"ballerina-lang-go/values"
+)
+
+type State uint8
+
+const (
+	StateActive State = iota
+	StateCommitted
+	StateRolledBack
+)
+
+// Transaction is a transaction started by a strand.
+type Transaction struct {
+	// Info is the lang.transaction:Info record of the transaction.
+	Info  values.BalValue
+	State State
+	// RollbackOnly is the error given to lang.transaction:setRollbackOnly, or
+	// nil if the transaction can be committed.
+	RollbackOnly     values.BalValue
+	Data             values.BalValue
+	CommitHandlers   []*values.Function
+	RollbackHandlers []*values.Function
+}
+
+// Stack is the transaction state of a single strand.
+type Stack struct {
+	active []*Transaction
+	// prevAttempt is the Info of the last transaction that ended with a retry
+	// pending. It becomes the prevAttempt of the next transaction started with
+	// a non-zero retry number.
+	prevAttempt values.BalValue
+	started     uint64
+}
+
+// NewXid returns a transaction id that is unique among the transactions of
+// all strands.
+func (s *Stack) NewXid(strandID uint64) []byte {
+	s.started++
+	return []byte(strconv.FormatUint(strandID, 10) + "-" + strconv.FormatUint(s.started, 10))
+}
+
+func (s *Stack) Push(trx *Transaction) {
+	s.active = append(s.active, trx)
+}
+
+// Pop removes the current transaction from the stack and returns it.
+func (s *Stack) Pop() (*Transaction, bool) {
+	n := len(s.active)
+	if n == 0 {
+		return nil, false
+	}
+	top := s.active[n-1]
+	s.active[n-1] = nil
+	s.active = s.active[:n-1]
+	return top, true
+}
+
+// Current returns the innermost transaction of the strand.
+func (s *Stack) Current() (*Transaction, bool) {
+	n := len(s.active)
+	if n == 0 {
+		return nil, false
+	}
+	return s.active[n-1], true
+}
+
+// TakePrevAttempt returns the Info recorded by SetPrevAttempt and clears it.
+func (s *Stack) TakePrevAttempt() values.BalValue {
+	prev := s.prevAttempt
+	s.prevAttempt = nil
+	return prev
+}
+
+func (s *Stack) SetPrevAttempt(info values.BalValue) {
+	s.prevAttempt = info
+}
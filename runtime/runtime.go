@@ -44,9 +44,19 @@ func InvokeFunction(rt *Runtime, fn any, args []values.BalValue) (values.BalValu
 const onGracefulStopLookupKey = "ballerina/lang.runtime:onGracefulStop"
 
 func (rt *Runtime) runtimeBuiltins() map[string]extern.NativeFunc {
-	return map[string]extern.NativeFunc{
+	builtins := map[string]extern.NativeFunc{
 		onGracefulStopLookupKey: rt.invokeOnGracefulStop,
 	}
+	for key, fn := range rt.transactionBuiltins() {
+		builtins[key] = fn
+	}
+	for key, fn := range rt.configBuiltins() {
+		builtins[key] = fn
+	}
+	for key, fn := range rt.annotationBuiltins() {
+		builtins[key] = fn
+	}
+	return builtins
 }
 
 func (rt *Runtime) invokeOnGracefulStop(_ *extern.Context, args []values.BalValue) (values.BalValue, error) {
@@ -74,8 +84,11 @@ func (rt *Runtime) invokeOnGracefulStop(_ *extern.Context, args []values.BalValu
 // private to this package and mutated only via the methods in lifecycle.go.
 type Runtime struct {
 	lifeCycle
-	env        *extern.Env
-	ExitStatus <-chan uint8
+	env           *extern.Env
+	trxInfoTypes  transactionInfoTypes
+	configValues  map[string]values.BalValue
+	fnAnnotations functionAnnotations
+	ExitStatus    <-chan uint8
 }
 
 // ModuleInitializer is a function that can install modules (e.g. stdlibs) into
@@ -104,8 +117,16 @@ func NewRuntime(platform pal.Platform, tyEnv semtypes.Env) *Runtime {
 		LookupFunction: func(cx *extern.Context, org, module, name string) (any, bool) {
 			return exec.LookupFunction(cx.Env, org, module, name)
 		},
+		LookupFunctionValue: func(cx *extern.Context, fn *values.Function) (any, bool) {
+			handle, err := exec.NewFunctionValueHandle(cx.Env, fn)
+			if err != nil {
+				return nil, false
+			}
+			return handle, true
+		},
 	})
 	rt.env = env
+	rt.trxInfoTypes = newTransactionInfoTypes(tyEnv)
 	for _, init := range moduleInitializers {
 		init(rt)
 	}
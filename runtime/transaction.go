@@ -0,0 +1,266 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package runtime
+
+import (
+	"errors"
+
+	"ballerina-lang-go/runtime/extern"
+	"ballerina-lang-go/runtime/internal/exec"
+	"ballerina-lang-go/runtime/internal/transactions"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/values"
+)
+
+// The transaction statements are desugared into calls to the lang.__internal functions below, which together with
+// the lang.transaction functions make up the local transaction manager. See runtime/internal/transactions for the
+// state it keeps per strand.
+const (
+	langInternalLookupPrefix    = "ballerina/lang.__internal:"
+	langTransactionLookupPrefix = "ballerina/lang.transaction:"
+)
+
+// transactionInfoTypes are the types of the lang.transaction:Info records created by the transaction manager. Info
+// is a recursive type, so these are defined before the type env is frozen.
+type transactionInfoTypes struct {
+	infoTy    semtypes.SemType
+	infoAtom  *semtypes.MappingAtomicType
+	xidTy     semtypes.SemType
+	xidAtomTy *semtypes.ListAtomicType
+}
+
+func newTransactionInfoTypes(env semtypes.Env) transactionInfoTypes {
+	cx := semtypes.ContextFrom(env)
+	xidLd := semtypes.NewListDefinition()
+	xidTy := xidLd.DefineListTypeWrapped(env, nil, 0, semtypes.BYTE, semtypes.CellMutability_CELL_MUT_NONE)
+	infoMd := semtypes.NewMappingDefinition()
+	infoRef := infoMd.GetSemType(env)
+	infoTy := infoMd.DefineMappingTypeWrappedWithEnvFieldsSemTypeCellMutability(env, []semtypes.Field{
+		semtypes.FieldFrom("xid", xidTy, true, false),
+		semtypes.FieldFrom("retryNumber", semtypes.INT, true, false),
+		semtypes.FieldFrom("prevAttempt", semtypes.Union(infoRef, semtypes.NIL), true, false),
+		semtypes.FieldFrom("startTime", semtypes.INT, true, false),
+	}, semtypes.NEVER, semtypes.CellMutability_CELL_MUT_NONE)
+	return transactionInfoTypes{
+		infoTy:    infoTy,
+		infoAtom:  semtypes.ToMappingAtomicType(cx, infoTy),
+		xidTy:     xidTy,
+		xidAtomTy: semtypes.ToListAtomicType(cx, xidTy),
+	}
+}
+
+func (rt *Runtime) transactionBuiltins() map[string]extern.NativeFunc {
+	return map[string]extern.NativeFunc{
+		langInternalLookupPrefix + "startTransaction":    rt.startTransaction,
+		langInternalLookupPrefix + "commitTransaction":   commitTransaction,
+		langInternalLookupPrefix + "rollbackTransaction": rollbackTransaction,
+		langInternalLookupPrefix + "endTransaction":      endTransaction,
+		langInternalLookupPrefix + "isTransactional":     isTransactional,
+		langTransactionLookupPrefix + "info":             transactionInfo,
+		langTransactionLookupPrefix + "setRollbackOnly":  setRollbackOnly,
+		langTransactionLookupPrefix + "getRollbackOnly":  getRollbackOnly,
+		langTransactionLookupPrefix + "setData":          setTransactionData,
+		langTransactionLookupPrefix + "getData":          getTransactionData,
+		langTransactionLookupPrefix + "onCommit":         onCommit,
+		langTransactionLookupPrefix + "onRollback":       onRollback,
+	}
+}
+
+func (rt *Runtime) startTransaction(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	if len(args) != 1 {
+		return nil, errors.New("startTransaction expects one argument")
+	}
+	retryNumber, ok := args[0].(int64)
+	if !ok {
+		return nil, errors.New("startTransaction expects an int")
+	}
+	stack := ctx.Transactions()
+	prevAttempt := stack.TakePrevAttempt()
+	if retryNumber == 0 {
+		prevAttempt = nil
+	}
+	info := rt.newTransactionInfo(ctx, stack.NewXid(ctx.StrandID), retryNumber, prevAttempt)
+	stack.Push(&transactions.Transaction{Info: info, State: transactions.StateActive})
+	return nil, nil
+}
+
+func (rt *Runtime) newTransactionInfo(ctx *extern.Context, xid []byte, retryNumber int64, prevAttempt values.BalValue) values.BalValue {
+	tys := &rt.trxInfoTypes
+	xidBytes := make([]values.BalValue, len(xid))
+	for i, b := range xid {
+		xidBytes[i] = int64(b)
+	}
+	startTime := ctx.Env.Platform.Time.Now().UnixMilli()
+	return values.NewMap(tys.infoTy, tys.infoAtom, true, []values.MapEntry{
+		{Key: "xid", Value: values.NewList(tys.xidTy, tys.xidAtomTy, true, nil, 0, xidBytes)},
+		{Key: "retryNumber", Value: retryNumber},
+		{Key: "prevAttempt", Value: prevAttempt},
+		{Key: "startTime", Value: startTime},
+	})
+}
+
+func commitTransaction(ctx *extern.Context, _ []values.BalValue) (values.BalValue, error) {
+	trx := currentTransaction(ctx)
+	if trx.State != transactions.StateActive {
+		return values.NewErrorWithMessage("transaction has already ended"), nil
+	}
+	if trx.RollbackOnly != nil {
+		if err := rollback(ctx, trx, trx.RollbackOnly, false); err != nil {
+			return nil, err
+		}
+		return values.NewError(semtypes.ERROR, "transaction is marked as rollback only", trx.RollbackOnly, "", nil), nil
+	}
+	trx.State = transactions.StateCommitted
+	for _, handler := range trx.CommitHandlers {
+		if err := invokeTransactionHandler(ctx, handler, []values.BalValue{trx.Info}); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func rollbackTransaction(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	if len(args) != 1 {
+		return nil, errors.New("rollbackTransaction expects one argument")
+	}
+	trx := currentTransaction(ctx)
+	if trx.State != transactions.StateActive {
+		return nil, nil
+	}
+	return nil, rollback(ctx, trx, args[0], false)
+}
+
+// endTransaction pops the current transaction when the body of its transaction statement exits. A transaction that
+// was neither committed nor rolled back is rolled back with cause as the reason.
+func endTransaction(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	if len(args) != 2 {
+		return nil, errors.New("endTransaction expects two arguments")
+	}
+	willRetry, ok := args[1].(bool)
+	if !ok {
+		return nil, errors.New("endTransaction expects a boolean")
+	}
+	stack := ctx.Transactions()
+	trx, ok := stack.Pop()
+	if !ok {
+		return nil, errors.New("endTransaction called without a transaction")
+	}
+	if willRetry {
+		stack.SetPrevAttempt(trx.Info)
+	}
+	if trx.State == transactions.StateActive {
+		return nil, rollback(ctx, trx, args[0], willRetry)
+	}
+	return nil, nil
+}
+
+func rollback(ctx *extern.Context, trx *transactions.Transaction, cause values.BalValue, willRetry bool) error {
+	trx.State = transactions.StateRolledBack
+	for _, handler := range trx.RollbackHandlers {
+		if err := invokeTransactionHandler(ctx, handler, []values.BalValue{trx.Info, cause, willRetry}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func invokeTransactionHandler(ctx *extern.Context, handler *values.Function, args []values.BalValue) error {
+	handle, err := exec.NewFunctionValueHandle(ctx.Env, handler)
+	if err != nil {
+		return err
+	}
+	_, err = exec.Invoke(ctx, handle, args)
+	return err
+}
+
+func isTransactional(ctx *extern.Context, _ []values.BalValue) (values.BalValue, error) {
+	trx, ok := ctx.Transactions().Current()
+	return ok && trx.State == transactions.StateActive, nil
+}
+
+// currentTransaction returns the current transaction of the strand. The functions using it are only called in a
+// transactional scope, so there is always one.
+func currentTransaction(ctx *extern.Context) *transactions.Transaction {
+	trx, ok := ctx.Transactions().Current()
+	if !ok {
+		panic(values.NewErrorWithMessage("not in a transaction"))
+	}
+	return trx
+}
+
+func transactionInfo(ctx *extern.Context, _ []values.BalValue) (values.BalValue, error) {
+	return currentTransaction(ctx).Info, nil
+}
+
+func setRollbackOnly(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	if len(args) != 1 {
+		return nil, errors.New("lang.transaction:setRollbackOnly expects one argument")
+	}
+	cause := args[0]
+	if cause == nil {
+		cause = values.NewErrorWithMessage("transaction is marked as rollback only")
+	}
+	currentTransaction(ctx).RollbackOnly = cause
+	return nil, nil
+}
+
+func getRollbackOnly(ctx *extern.Context, _ []values.BalValue) (values.BalValue, error) {
+	return currentTransaction(ctx).RollbackOnly != nil, nil
+}
+
+func setTransactionData(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	if len(args) != 1 {
+		return nil, errors.New("lang.transaction:setData expects one argument")
+	}
+	currentTransaction(ctx).Data = args[0]
+	return nil, nil
+}
+
+func getTransactionData(ctx *extern.Context, _ []values.BalValue) (values.BalValue, error) {
+	return currentTransaction(ctx).Data, nil
+}
+
+func onCommit(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	handler, err := transactionHandlerArg("onCommit", args)
+	if err != nil {
+		return nil, err
+	}
+	trx := currentTransaction(ctx)
+	trx.CommitHandlers = append(trx.CommitHandlers, handler)
+	return nil, nil
+}
+
+func onRollback(ctx *extern.Context, args []values.BalValue) (values.BalValue, error) {
+	handler, err := transactionHandlerArg("onRollback", args)
+	if err != nil {
+		return nil, err
+	}
+	trx := currentTransaction(ctx)
+	trx.RollbackHandlers = append(trx.RollbackHandlers, handler)
+	return nil, nil
+}
+
+func transactionHandlerArg(name string, args []values.BalValue) (*values.Function, error) {
+	if len(args) != 1 {
+		return nil, errors.New("lang.transaction:" + name + " expects one argument")
+	}
+	handler, ok := args[0].(*values.Function)
+	if !ok {
+		return nil, errors.New("lang.transaction:" + name + " expects a function")
+	}
+	return handler, nil
+}
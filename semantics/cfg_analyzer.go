@@ -141,7 +141,10 @@ func terminalBlockHasReturnOrPanic(bb basicBlock) bool {
 	}
 	last := bb.nodes[len(bb.nodes)-1]
 	switch last.(type) {
-	case *ast.BLangReturn, *ast.BLangPanic:
+	case *ast.BLangReturn, *ast.BLangPanic, *ast.BLangFail:
+		return true
+	case *ast.BLangExprFunctionBody:
+		// An expression body returns the value of its expression.
 		return true
 	case *ast.BLangExpressionStmt:
 		// The only other way a reachable block becomes terminal is via a
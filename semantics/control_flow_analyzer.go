@@ -178,6 +178,8 @@ type functionControlFlowAnalyzer struct {
 	tyCtx semtypes.Context
 	bbs   []basicBlock
 	loops []loopControlFlowData
+	// Blocks starting the enclosing on-fail clauses, innermost last
+	onFails []bbRef
 }
 
 type loopControlFlowData struct {
@@ -224,8 +226,11 @@ func (analyzer *functionControlFlowAnalyzer) analyzeBody(body ast.FunctionBodyNo
 }
 
 func (analyzer *functionControlFlowAnalyzer) analyzeExprFunctionBody(fnBody *ast.BLangExprFunctionBody) {
-	// TODO: we need to deal with ternary expression which will have control flow so bbs
-	panic("unimplemented")
+	rootBB := basicBlock{}
+	analyzer.bbs = append(analyzer.bbs, rootBB)
+	bodyBB := analyzer.analyzeConditionalExprs(rootBB.ref(), fnBody)
+	// The body node stands for returning the value of the expression.
+	analyzer.addNode(bodyBB, fnBody)
 }
 
 func (analyzer *functionControlFlowAnalyzer) analyzeBlockFunctionBody(fnBody *ast.BLangBlockFunctionBody) {
@@ -289,10 +294,13 @@ func (analyzer *functionControlFlowAnalyzer) addNode(bb bbRef, node ast.Node) {
 
 // analyzeStatement dispatches to the appropriate handler based on statement type
 func (analyzer *functionControlFlowAnalyzer) analyzeStatement(curBB bbRef, stmt ast.StatementNode) stmtEffect {
-	ternaryChecker := &ternaryExpressionChecker{}
-	ast.Walk(ternaryChecker, stmt.(ast.BLangNode))
-	if ternaryChecker.hasTernaryExpression {
-		return createTernaryExpressionEffect(analyzer, curBB, ternaryChecker.ifTrue, ternaryChecker.ifFalse)
+	// The condition of a while loop is evaluated in the loop head, so analyzeWhile deals with it.
+	if _, isWhile := stmt.(*ast.BLangWhile); !isWhile {
+		curBB = analyzer.analyzeConditionalExprs(curBB, stmt.(ast.BLangNode))
+	}
+	// The check expressions of a statement with an on-fail clause fail to that clause.
+	if len(analyzer.onFails) > 0 && onFailClauseOf(stmt.(ast.BLangNode)) == nil && containsCheckedExpr(stmt) {
+		curBB = analyzer.analyzeFailingCheck(curBB)
 	}
 	switch s := stmt.(type) {
 	case *ast.BLangReturn:
@@ -310,11 +318,35 @@ func (analyzer *functionControlFlowAnalyzer) analyzeStatement(curBB bbRef, stmt
 	case *ast.BLangBlockStmt:
 		return analyzer.analyzeBlockStmt(curBB, s)
 	case *ast.BLangLock:
-		return analyzer.analyzeBlockStmt(curBB, &s.Body)
+		return analyzer.analyzeWithOnFailClause(curBB, s, func(bodyBB bbRef) stmtEffect {
+			return analyzer.analyzeBlockStmt(bodyBB, &s.Body)
+		})
+	case *ast.BLangDo:
+		return analyzer.analyzeWithOnFailClause(curBB, s, func(bodyBB bbRef) stmtEffect {
+			return analyzer.analyzeBlockStmt(bodyBB, &s.Body)
+		})
+	case *ast.BLangFail:
+		return analyzer.analyzeFail(curBB, s)
+	case *ast.BLangTransaction:
+		return analyzer.analyzeWithOnFailClause(curBB, s, func(bodyBB bbRef) stmtEffect {
+			return analyzer.analyzeBlockStmt(bodyBB, &s.Body)
+		})
+	case *ast.BLangRetry:
+		return analyzer.analyzeWithOnFailClause(curBB, s, func(bodyBB bbRef) stmtEffect {
+			analyzer.addNode(bodyBB, &s.RetrySpec)
+			if s.Transaction != nil {
+				return analyzer.analyzeBlockStmt(bodyBB, &s.Transaction.Body)
+			}
+			return analyzer.analyzeBlockStmt(bodyBB, &s.Body)
+		})
 	case *ast.BLangWhile:
-		return analyzer.analyzeWhile(curBB, s)
+		return analyzer.analyzeWithOnFailClause(curBB, s, func(bodyBB bbRef) stmtEffect {
+			return analyzer.analyzeWhile(bodyBB, s)
+		})
 	case *ast.BLangForeach:
-		return analyzer.analyzeForeach(curBB, s)
+		return analyzer.analyzeWithOnFailClause(curBB, s, func(bodyBB bbRef) stmtEffect {
+			return analyzer.analyzeForeach(bodyBB, s)
+		})
 	// These should be handled while handling while statement
 	case *ast.BLangBreak:
 		analyzer.addNode(curBB, stmt)
@@ -343,29 +375,95 @@ func (analyzer *functionControlFlowAnalyzer) analyzeStatement(curBB bbRef, stmt
 	}
 }
 
-func createTernaryExpressionEffect(analyzer *functionControlFlowAnalyzer, curBB bbRef, expressionNode1, expressionNode2 ast.BLangExpression) stmtEffect {
-	panic("unimplemented")
+// analyzeConditionalExprs gives each conditional expression evaluated as part of node its own basic blocks, since
+// only one of their branches is evaluated. It returns the block in which the evaluation of node continues.
+func (analyzer *functionControlFlowAnalyzer) analyzeConditionalExprs(curBB bbRef, node ast.BLangNode) bbRef {
+	collector := &conditionalExprCollector{root: node}
+	ast.Walk(collector, node)
+	for _, expr := range collector.exprs {
+		curBB = analyzer.analyzeConditionalExpr(curBB, expr)
+	}
+	return curBB
+}
+
+func (analyzer *functionControlFlowAnalyzer) analyzeConditionalExpr(curBB bbRef, expr ast.BLangExpression) bbRef {
+	var cond ast.BLangExpression
+	var branches []ast.BLangExpression
+	switch e := expr.(type) {
+	case *ast.BLangTernaryExpr:
+		cond = e.Expr
+		branches = []ast.BLangExpression{e.ThenExpr, e.ElseExpr}
+	case *ast.BLangElvisExpr:
+		// The right hand side is skipped when the left hand side is not nil.
+		cond = e.LhsExpr
+		branches = []ast.BLangExpression{e.RhsExpr, nil}
+	}
+	curBB = analyzer.analyzeSubExpr(curBB, cond)
+	finally := analyzer.createNewBB()
+	for _, branch := range branches {
+		if branch == nil {
+			analyzer.addEdge(curBB, finally)
+			continue
+		}
+		branchBB := analyzer.createNewBB()
+		analyzer.addEdge(curBB, branchBB)
+		analyzer.addEdge(analyzer.analyzeSubExpr(branchBB, branch), finally)
+	}
+	return finally
+}
+
+func (analyzer *functionControlFlowAnalyzer) analyzeSubExpr(curBB bbRef, expr ast.BLangExpression) bbRef {
+	curBB = analyzer.analyzeConditionalExprs(curBB, expr)
+	if !isConditionalExpr(expr) {
+		analyzer.addNode(curBB, expr)
+	}
+	return curBB
+}
+
+func isConditionalExpr(node ast.BLangNode) bool {
+	switch node.(type) {
+	case *ast.BLangTernaryExpr, *ast.BLangElvisExpr:
+		return true
+	default:
+		return false
+	}
+}
+
+// isConditionalExprBoundary reports whether the control flow of the conditional expressions inside node is
+// independent of that of the node containing it. Nested statements and match clauses get their own basic blocks, and
+// function bodies and queries are evaluated separately.
+func isConditionalExprBoundary(node ast.BLangNode) bool {
+	switch node.(type) {
+	case ast.StatementNode, *ast.BLangMatchClause, *ast.BLangLambdaFunction, *ast.BLangArrowFunction, *ast.BLangQueryExpr:
+		return true
+	default:
+		return false
+	}
 }
 
-type ternaryExpressionChecker struct {
-	hasTernaryExpression bool
-	ifTrue               ast.BLangExpression
-	ifFalse              ast.BLangExpression
+// conditionalExprCollector collects the outermost conditional expressions of root in evaluation order.
+type conditionalExprCollector struct {
+	root  ast.BLangNode
+	exprs []ast.BLangExpression
 }
 
-var _ ast.Visitor = &ternaryExpressionChecker{}
+var _ ast.Visitor = &conditionalExprCollector{}
 
-func (c *ternaryExpressionChecker) Visit(node ast.BLangNode) ast.Visitor {
-	if c.hasTernaryExpression {
+func (c *conditionalExprCollector) Visit(node ast.BLangNode) ast.Visitor {
+	if node == nil {
+		return nil
+	}
+	if node != c.root && isConditionalExprBoundary(node) {
 		return nil
 	}
-	if _, ok := node.(*ast.BLangElvisExpr); ok {
-		panic("unimplemented")
+	if isConditionalExpr(node) {
+		c.exprs = append(c.exprs, node.(ast.BLangExpression))
+		return nil
 	}
 	return c
 }
 
-func (c *ternaryExpressionChecker) VisitTypeData(typeData *ast.TypeData) ast.Visitor {
+func (c *conditionalExprCollector) VisitTypeData(typeData *ast.TypeData) ast.Visitor {
 	return nil
 }
 
@@ -404,6 +502,91 @@ func alwaysTerminatesViaCheck(tyCtx semtypes.Context, expr ast.BLangExpression)
 	return semtypes.IsSubtype(tyCtx, inner.GetDeterminedType(), semtypes.ERROR)
 }
 
+func (analyzer *functionControlFlowAnalyzer) analyzeFail(curBB bbRef, stmt *ast.BLangFail) stmtEffect {
+	analyzer.addNode(curBB, stmt)
+	if len(analyzer.onFails) > 0 {
+		analyzer.addEdge(curBB, analyzer.onFails[len(analyzer.onFails)-1])
+	}
+	// Without an enclosing on-fail clause fail returns the error from the function.
+	return terminatedEffect()
+}
+
+// analyzeFailingCheck adds the edge taken when a check expression of the statement about to be added to curBB fails.
+// Since the failure happens before the statement completes, the statement goes to a new block.
+func (analyzer *functionControlFlowAnalyzer) analyzeFailingCheck(curBB bbRef) bbRef {
+	analyzer.addEdge(curBB, analyzer.onFails[len(analyzer.onFails)-1])
+	nextBB := analyzer.createNewBB()
+	analyzer.addEdge(curBB, nextBB)
+	return nextBB
+}
+
+// containsCheckedExpr reports whether a check expression is evaluated as part of stmt itself, as opposed to its
+// nested statements and function bodies.
+func containsCheckedExpr(stmt ast.StatementNode) bool {
+	finder := &checkedExprFinder{root: stmt.(ast.BLangNode)}
+	ast.Walk(finder, finder.root)
+	return finder.found
+}
+
+type checkedExprFinder struct {
+	root  ast.BLangNode
+	found bool
+}
+
+var _ ast.Visitor = &checkedExprFinder{}
+
+func (f *checkedExprFinder) Visit(node ast.BLangNode) ast.Visitor {
+	if node == nil || f.found {
+		return nil
+	}
+	switch node.(type) {
+	case *ast.BLangCheckedExpr:
+		f.found = true
+		return nil
+	case ast.StatementNode, *ast.BLangOnFailClause, *ast.BLangLambdaFunction, *ast.BLangArrowFunction:
+		if node != f.root {
+			return nil
+		}
+	}
+	return f
+}
+
+func (f *checkedExprFinder) VisitTypeData(typeData *ast.TypeData) ast.Visitor {
+	return nil
+}
+
+// analyzeWithOnFailClause analyzes a statement with an on-fail clause. The clause is entered from every fail statement
+// and failing check expression of the statement, and both the statement and the clause continue after the statement.
+func (analyzer *functionControlFlowAnalyzer) analyzeWithOnFailClause(curBB bbRef, stmt ast.StatementNode, analyzeStmt func(bbRef) stmtEffect) stmtEffect {
+	clause := onFailClauseOf(stmt.(ast.BLangNode))
+	if clause == nil {
+		return analyzeStmt(curBB)
+	}
+	onFail := analyzer.createNewBB()
+	analyzer.onFails = append(analyzer.onFails, onFail)
+	if containsCheckedExpr(stmt) {
+		curBB = analyzer.analyzeFailingCheck(curBB)
+	}
+	stmtEffect := analyzeStmt(curBB)
+	analyzer.onFails = analyzer.onFails[:len(analyzer.onFails)-1]
+	if len(analyzer.bbs[onFail].parents) == 0 {
+		// Nothing in the statement can fail.
+		return stmtEffect
+	}
+	onFailEffect := analyzer.analyzeBlockStmt(onFail, clause.Body)
+	if stmtEffect.isTerminal() && onFailEffect.isTerminal() {
+		return terminatedEffect()
+	}
+	finally := analyzer.createNewBB()
+	if !stmtEffect.isTerminal() {
+		analyzer.addEdge(stmtEffect.nextBB, finally)
+	}
+	if !onFailEffect.isTerminal() {
+		analyzer.addEdge(onFailEffect.nextBB, finally)
+	}
+	return continueEffect(finally)
+}
+
 // Branching statement handlers
 
 func (analyzer *functionControlFlowAnalyzer) analyzeBlockStmt(curBB bbRef, stmt *ast.BLangBlockStmt) stmtEffect {
@@ -463,12 +646,13 @@ func (analyzer *functionControlFlowAnalyzer) analyzeWhile(curBB bbRef, stmt *ast
 	analyzer.loops = append(analyzer.loops, loopData)
 	analyzer.addEdge(curBB, loopHead)
 	expr := stmt.Expr
-	analyzer.addNode(loopHead, expr)
+	condBB := analyzer.analyzeConditionalExprs(loopHead, expr)
+	analyzer.addNode(condBB, expr)
 	if !analyzer.isFalse(expr) {
-		analyzer.addEdge(loopHead, loopBody)
+		analyzer.addEdge(condBB, loopBody)
 	}
 	if !analyzer.isTrue(expr) {
-		analyzer.addEdge(loopHead, loopEnd)
+		analyzer.addEdge(condBB, loopEnd)
 	}
 	bodyEffect := analyzer.analyzeBlockStmt(loopBody, &stmt.Body)
 	bodyEnd := bodyEffect.nextBB
@@ -490,7 +674,12 @@ func (analyzer *functionControlFlowAnalyzer) analyzeMatch(curBB bbRef, stmt *ast
 		clause := &stmt.MatchClauses[i]
 		clauseBB := analyzer.createNewBB()
 		analyzer.addEdge(curBB, clauseBB)
-		clauseEffect := analyzer.analyzeBlockStmt(clauseBB, &clause.Body)
+		bodyBB := clauseBB
+		if clause.Guard != nil {
+			bodyBB = analyzer.analyzeConditionalExprs(clauseBB, clause.Guard)
+			analyzer.addNode(bodyBB, clause.Guard)
+		}
+		clauseEffect := analyzer.analyzeBlockStmt(bodyBB, &clause.Body)
 		if !clauseEffect.isTerminal() {
 			analyzer.addEdge(clauseEffect.nextBB, finally)
 			hasIncoming = true
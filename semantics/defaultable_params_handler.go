@@ -27,32 +27,28 @@ import (
 type symbolLookup interface {
 	getSymbol(ref model.SymbolRef) model.Symbol
 	internalError(message string, loc diagnostics.Location)
-	unimplemented(message string, loc diagnostics.Location)
 }
 
 func padArgTypesForDefaults(lookup symbolLookup, symbolRef model.SymbolRef, argTys []semtypes.SemType, loc diagnostics.Location) []semtypes.SemType {
 	sym := lookup.getSymbol(symbolRef)
-	if _, ok := sym.(*model.OpaqueFunctionSymbol); ok {
-		// TODO: there are type param functions with default params need to think how to implement this
-		lookup.unimplemented("generic functions with default params not implemented", loc)
-		return argTys
-	}
 	switch fnSym := sym.(type) {
+	case model.DependentlyTypedFunctionSymbol:
+		return padDefaults(fnSym.DefaultableParams(), fnSym.ParamTypes(), argTys)
 	case model.FunctionSymbol:
-		return padFunctionDefaults(fnSym, argTys)
+		return padDefaults(fnSym.DefaultableParams(), fnSym.Signature().ParamTypes, argTys)
 	case *model.ValueSymbol:
 		// When we support lambdas we need to have a way to get a function symbol from the declaration (this means it have to be atomic) and then use the
 		// same logic
 		return argTys
 	default:
+		// Opaque functions are padded by argArray once they have been monomorphized.
 		lookup.internalError(fmt.Sprintf("unexpected symbol type %T in padArgTypesForDefaults", sym), loc)
 		return argTys
 	}
 }
 
-func padFunctionDefaults(fnSym model.FunctionSymbol, argTys []semtypes.SemType) []semtypes.SemType {
-	defaultableParams := fnSym.DefaultableParams()
-	totalParams := len(fnSym.Signature().ParamTypes)
+func padDefaults(defaultableParams *model.DefaultableParamInfo, paramTypes []semtypes.SemType, argTys []semtypes.SemType) []semtypes.SemType {
+	totalParams := len(paramTypes)
 	if len(argTys) >= totalParams {
 		return argTys
 	}
@@ -63,11 +59,18 @@ func padFunctionDefaults(fnSym model.FunctionSymbol, argTys []semtypes.SemType)
 			return argTys
 		}
 	}
-	paramTypes := fnSym.Signature().ParamTypes
 	padded := make([]semtypes.SemType, totalParams)
 	copy(padded, argTys)
 	for i := len(argTys); i < totalParams; i++ {
-		padded[i] = paramTypes[i]
+		padded[i] = defaultArgType(defaultableParams, paramTypes, i)
 	}
 	return padded
 }
+
+// defaultArgType returns the type of the argument supplied by the default of the parameter at index.
+func defaultArgType(defaultableParams *model.DefaultableParamInfo, paramTypes []semtypes.SemType, index int) semtypes.SemType {
+	if dp, ok := defaultableParams.Get(index); ok && dp.Kind == model.DefaultableParamKindConstant {
+		return dp.Value
+	}
+	return paramTypes[index]
+}
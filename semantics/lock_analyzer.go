@@ -236,8 +236,19 @@ func (v *lockBodyVisitor) Visit(n ast.BLangNode) ast.Visitor {
 		return nil
 	case *ast.BLangSimpleVariableDef:
 		v.locals[node.Var.Symbol()] = struct{}{}
+	case *ast.BLangTupleVariableDef, *ast.BLangRecordVariableDef, *ast.BLangErrorVariableDef:
+		for _, leaf := range ast.BindingVariables(node.(ast.VariableDefinitionNode).GetVariable()) {
+			if !ast.IsWildcardVariable(leaf) {
+				v.locals[leaf.Symbol()] = struct{}{}
+			}
+		}
 	case *ast.BLangAssignment:
-		v.checkAssignment(node.VarRef, node.Expr.(ast.BLangExpression), node.GetPosition())
+		for _, ref := range ast.BindingVarRefs(node.VarRef) {
+			if _, ok := ref.(*ast.BLangWildCardBindingPattern); ok {
+				continue
+			}
+			v.checkAssignment(ref, node.Expr.(ast.BLangExpression), node.GetPosition())
+		}
 	case *ast.BLangCompoundAssignment:
 		v.checkAssignment(node.VarRef.(ast.BLangExpression), node.ModifiedExpr, node.GetPosition())
 		return v
@@ -411,12 +422,24 @@ func isIsolatedExpression(a analyzer, expr ast.BLangExpression) bool {
 		return true
 	case *ast.BLangMappingConstructorExpr:
 		for _, f := range e.Fields {
-			kv, ok := f.(*ast.BLangMappingKeyValueField)
-			if !ok {
+			var valueExpr ast.BLangExpression
+			switch f := f.(type) {
+			case *ast.BLangMappingKeyValueField:
+				valueExpr = f.ValueExpr
+			case *ast.BLangMappingSpreadField:
+				valueExpr = f.Expr
+			default:
 				a.ctx().InternalError(fmt.Sprintf("unexpected mapping field kind %T", f), f.GetPosition())
 				return false
 			}
-			if !isIsolatedExpression(a, kv.ValueExpr) {
+			if !isIsolatedExpression(a, valueExpr) {
+				return false
+			}
+		}
+		return true
+	case *ast.BLangTableConstructorExpr:
+		for _, row := range e.RecordLiteralList {
+			if !isIsolatedExpression(a, row) {
 				return false
 			}
 		}
@@ -429,6 +452,10 @@ func isIsolatedExpression(a analyzer, expr ast.BLangExpression) bool {
 		return isIsolatedExpression(a, e.Expr.(ast.BLangExpression))
 	case *ast.BLangTrapExpr:
 		return isIsolatedExpression(a, e.Expr)
+	case *ast.BLangTernaryExpr:
+		return isIsolatedExpression(a, e.ThenExpr) && isIsolatedExpression(a, e.ElseExpr)
+	case *ast.BLangElvisExpr:
+		return isIsolatedExpression(a, e.LhsExpr) && isIsolatedExpression(a, e.RhsExpr)
 	}
 	return false
 }
@@ -623,6 +650,18 @@ func (visitor *isolatedFnVisitor) Visit(n ast.BLangNode) ast.Visitor {
 			Final: v.IsFinal(),
 		})
 		return visitor
+	case *ast.BLangTupleVariableDef, *ast.BLangRecordVariableDef, *ast.BLangErrorVariableDef:
+		for _, leaf := range ast.BindingVariables(node.(ast.VariableDefinitionNode).GetVariable()) {
+			if ast.IsWildcardVariable(leaf) {
+				continue
+			}
+			sym, _ := a.ctx().GetSymbol(leaf.Symbol()).(*model.ValueSymbol)
+			visitor.scope.define(leaf.Symbol(), varDeclMetadata{
+				Type:  leaf.GetDeterminedType(),
+				Final: sym != nil && sym.IsFinal(),
+			})
+		}
+		return visitor
 	case *ast.BLangInvocation:
 		if !isIsolatedInvocation(a, node) {
 			a.semanticErr("invocation of a non-isolated function", node.GetPosition())
@@ -0,0 +1,119 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semantics
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/context"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/tools/diagnostics"
+)
+
+// onFailAnalyzer analyzes the part of a statement with an on-fail clause from
+// which a failure goes to that clause. This is the body of a do, lock or
+// transaction statement, the retry spec and body of a retry statement, or the
+// condition and body of a loop. The clause itself is analyzed with the
+// enclosing analyzer.
+type onFailAnalyzer struct {
+	analyzerBase
+	stmt ast.BLangNode
+}
+
+var _ analyzer = &onFailAnalyzer{}
+
+func initializeOnFailAnalyzer(parent analyzer, stmt ast.BLangNode) *onFailAnalyzer {
+	return &onFailAnalyzer{
+		analyzerBase: analyzerBase{parent: parent},
+		stmt:         stmt,
+	}
+}
+
+func (oa *onFailAnalyzer) Visit(node ast.BLangNode) ast.Visitor {
+	if node == nil {
+		return nil
+	}
+	return visitInner(oa, node)
+}
+
+func (oa *onFailAnalyzer) VisitTypeData(_ *ast.TypeData) ast.Visitor { return oa }
+
+func (oa *onFailAnalyzer) loc() diagnostics.Location { return oa.stmt.GetPosition() }
+
+func (oa *onFailAnalyzer) ctx() *context.CompilerContext { return oa.parent.ctx() }
+func (oa *onFailAnalyzer) tyCtx() semtypes.Context       { return oa.parent.tyCtx() }
+func (oa *onFailAnalyzer) unimplementedErr(m string, l diagnostics.Location) {
+	oa.parent.ctx().Unimplemented(m, l)
+}
+
+func (oa *onFailAnalyzer) semanticErr(m string, l diagnostics.Location) {
+	oa.parent.ctx().SemanticError(m, l)
+}
+
+func (oa *onFailAnalyzer) syntaxErr(m string, l diagnostics.Location) {
+	oa.parent.ctx().SyntaxError(m, l)
+}
+
+func (oa *onFailAnalyzer) internalErr(m string, l diagnostics.Location) {
+	oa.parent.ctx().InternalError(m, l)
+}
+
+// failureIsHandled reports whether a failure at a goes to an on-fail clause
+// within the same function, rather than returning from the function.
+func failureIsHandled(a analyzer) bool {
+	for cur := a; cur != nil; cur = cur.parentAnalyzer() {
+		switch cur.(type) {
+		case *onFailAnalyzer:
+			return true
+		case *functionAnalyzer:
+			return false
+		}
+	}
+	return false
+}
+
+// validateFailureReturnType reports an error when errorTy is returned from the
+// enclosing function by a failure at a, but is not allowed by its return type.
+func validateFailureReturnType(a analyzer, errorTy semtypes.SemType, construct string, pos diagnostics.Location) {
+	if semtypes.IsEmpty(a.tyCtx(), errorTy) || failureIsHandled(a) {
+		return
+	}
+	if !semtypes.IsSubtype(a.tyCtx(), errorTy, expectedReturnType(a)) {
+		a.semanticErr("error type of "+construct+" is not a subtype of the enclosing function's return type", pos)
+	}
+}
+
+func analyzeFail[A analyzer](a A, fail *ast.BLangFail) bool {
+	if !analyzeActionOrExpression(a, fail.Expr, semtypes.ERROR) {
+		return false
+	}
+	if semtypes.IsZero(expectedReturnType(a)) {
+		a.semanticErr("fail statement not allowed outside a function", fail.GetPosition())
+		return false
+	}
+	validateFailureReturnType(a, fail.Expr.GetDeterminedType(), "fail statement", fail.GetPosition())
+	return true
+}
+
+// analyzeWithOnFailClause analyzes the parts of stmt covered by its on-fail
+// clause with inner, which must be built on an onFailAnalyzer for stmt, and
+// then the clause with a.
+func analyzeWithOnFailClause[A analyzer](a A, inner analyzer, clause *ast.BLangOnFailClause, parts ...ast.BLangNode) {
+	for _, part := range parts {
+		ast.Walk(inner, part)
+	}
+	ast.Walk(a, clause)
+}
@@ -823,6 +823,9 @@ func (ca *constantAnalyzer) Visit(node ast.BLangNode) ast.Visitor {
 	case *ast.BLangTypeDefinition:
 		// We have set the type at constructor
 		return nil
+	case *ast.BLangAnnotationAttachment:
+		analyzeAnnotationAttachment(ca, n)
+		return nil
 	case ast.BLangExpression:
 		bLangExpr := n
 		hasErrors := false
@@ -839,6 +842,22 @@ func (ca *constantAnalyzer) Visit(node ast.BLangNode) ast.Visitor {
 	return ca
 }
 
+// analyzeAnnotationAttachment checks that the value of an annotation is a constant expression.
+func analyzeAnnotationAttachment[A analyzer](a A, attachment *ast.BLangAnnotationAttachment) {
+	if attachment.Expr == nil {
+		return
+	}
+	hasErrors := false
+	validateConstantExpr(a.ctx(), attachment.Expr, func(e ast.BLangExpression) {
+		a.semanticErr("expression is not a constant expression", e.GetPosition())
+		hasErrors = true
+	})
+	if hasErrors {
+		return
+	}
+	analyzeActionOrExpression(a, attachment.Expr, attachment.Expr.GetDeterminedType())
+}
+
 func validateConstantExpr(ctx *context.CompilerContext, expr ast.BLangExpression, onNonConst func(ast.BLangExpression)) {
 	switch e := expr.(type) {
 	case *ast.BLangLiteral, *ast.BLangNumericLiteral:
@@ -859,16 +878,27 @@ func validateConstantExpr(ctx *context.CompilerContext, expr ast.BLangExpression
 		validateConstantExpr(ctx, e.LhsExpr, onNonConst)
 		validateConstantExpr(ctx, e.RhsExpr, onNonConst)
 	case *ast.BLangListConstructorExpr:
-		for _, member := range e.Exprs {
+		for i, member := range e.Exprs {
+			if e.IsSpreadMember(i) {
+				onNonConst(member)
+				continue
+			}
 			validateConstantExpr(ctx, member, onNonConst)
 		}
 	case *ast.BLangMappingConstructorExpr:
 		for _, field := range e.Fields {
-			if kv, ok := field.(*ast.BLangMappingKeyValueField); ok {
-				validateConstantExpr(ctx, kv.ValueExpr, onNonConst)
+			switch f := field.(type) {
+			case *ast.BLangMappingKeyValueField:
+				validateConstantExpr(ctx, f.ValueExpr, onNonConst)
+			case *ast.BLangMappingSpreadField:
+				onNonConst(f.Expr)
 			}
 		}
 	case *ast.BLangTemplateExpr:
+		if e.Kind == ast.TemplateExprKindRegExp || e.Kind == ast.TemplateExprKindRaw {
+			onNonConst(expr)
+			return
+		}
 		for _, ins := range e.Insertions {
 			validateConstantExpr(ctx, ins, onNonConst)
 		}
@@ -947,16 +977,28 @@ func analyzeActionOrExpression[A analyzer](a A, expr ast.BLangActionOrExpression
 	case *ast.BLangMappingConstructorExpr:
 		return analyzeMappingConstructorExpr(a, expr, expectedType)
 
+	case *ast.BLangTableConstructorExpr:
+		return analyzeTableConstructorExpr(a, expr, expectedType)
+
 	case *ast.BLangErrorConstructorExpr:
 		return analyzeErrorConstructorExpr(a, expr, expectedType)
 
 	case *ast.BLangGroupExpr:
 		return analyzeActionOrExpression(a, expr.Expression, expectedType)
 
+	case *ast.BLangTernaryExpr:
+		return analyzeTernaryExpr(a, expr, expectedType)
+
+	case *ast.BLangElvisExpr:
+		return analyzeElvisExpr(a, expr, expectedType)
+
 	case *ast.BLangQueryExpr:
 		return analyzeQueryExpr(a, expr, expectedType)
 
-	case *ast.BLangWildCardBindingPattern:
+	case *ast.BLangLetExpr:
+		return analyzeLetExpr(a, expr, expectedType)
+
+	case *ast.BLangWildCardBindingPattern, *ast.BLangTupleVarRef, *ast.BLangRecordVarRef, *ast.BLangErrorVarRef:
 		return validateResolvedType(a, expr, expectedType)
 
 	case *ast.BLangTypeConversionExpr:
@@ -972,10 +1014,14 @@ func analyzeActionOrExpression[A analyzer](a A, expr ast.BLangActionOrExpression
 		return analyzeTrapExpr(a, expr, expectedType)
 	case *ast.BLangNamedArgsExpression:
 		return analyzeActionOrExpression(a, expr.Expr, expectedType)
+	case *ast.BLangRestArgsExpression:
+		// The type resolver sets the type of a rest argument to the list type expected for
+		// the arguments it supplies.
+		return analyzeActionOrExpression(a, expr.Expr, expr.GetDeterminedType())
 	case *ast.BLangNewExpression:
 		return analyzeNewExpression(a, expr, expectedType)
 	case *ast.BLangLambdaFunction:
-		return analyzeLambdaFunction(a, expr)
+		return analyzeLambdaFunction(a, expr, expectedType)
 	case *ast.BLangRemoteMethodCallAction:
 		return analyzeInvocation(a, expr, expectedType)
 	case *ast.BLangClientResourceAccessAction:
@@ -994,6 +1040,21 @@ func analyzeActionOrExpression[A analyzer](a A, expr ast.BLangActionOrExpression
 		return validateResolvedType(a, expr, expectedType)
 	case *ast.BLangXMLSequenceLiteral, *ast.BLangXMLPILiteral, *ast.BLangXMLCommentLiteral, *ast.BLangXMLTextLiteral:
 		return validateResolvedType(a, expr, expectedType)
+	case *ast.BLangXMLFilterExpr:
+		if !analyzeActionOrExpression(a, expr.Expr, semtypes.XML) {
+			return false
+		}
+		return validateResolvedType(a, expr, expectedType)
+	case *ast.BLangXMLStepExpr:
+		if !analyzeActionOrExpression(a, expr.Expr, semtypes.XML) {
+			return false
+		}
+		if expr.Extension != nil && !analyzeActionOrExpression(a, expr.Extension, semtypes.XML) {
+			return false
+		}
+		return validateResolvedType(a, expr, expectedType)
+	case *ast.BLangXMLStepItem:
+		return validateResolvedType(a, expr, expectedType)
 	case *ast.BLangTemplateExpr:
 		return analyzeTemplateExpr(a, expr, expectedType)
 	case *ast.BLangXMLTemplateExpr:
@@ -1002,12 +1063,56 @@ func analyzeActionOrExpression[A analyzer](a A, expr ast.BLangActionOrExpression
 		// XML attributes are metadata on elements and should not be analyzed as standalone expressions
 		// Their values are already analyzed as part of XMLElement processing
 		return validateResolvedType(a, expr, expectedType)
+	case *ast.BLangWorkerAsyncSendExpr:
+		return analyzeWorkerSend(a, expr, expectedType)
+	case *ast.BLangWorkerSyncSendExpr:
+		return analyzeWorkerSend(a, expr, expectedType)
+	case *ast.BLangWorkerReceive, *ast.BLangAlternateWorkerReceive, *ast.BLangMultipleWorkerReceive, *ast.BLangWorkerFlushExpr:
+		return validateResolvedType(a, expr, expectedType)
+	case *ast.BLangWaitExpr:
+		for _, future := range expr.Exprs {
+			if !analyzeActionOrExpression(a, future, semtypes.FUTURE) {
+				return false
+			}
+		}
+		return validateResolvedType(a, expr, expectedType)
+	case *ast.BLangWaitForAllExpr:
+		for i := range expr.KeyValuePairs {
+			if !analyzeActionOrExpression(a, expr.KeyValuePairs[i].ValueExpr, semtypes.FUTURE) {
+				return false
+			}
+		}
+		return validateResolvedType(a, expr, expectedType)
+	case *ast.BLangCommitExpr, *ast.BLangTransactionalExpr:
+		return validateResolvedType(a, expr, expectedType)
+	case *ast.BLangAnnotAccessExpr:
+		if !analyzeActionOrExpression(a, expr.Expr, semtypes.TYPEDESC) {
+			return false
+		}
+		return validateResolvedType(a, expr, expectedType)
 	default:
 		a.internalErr("unexpected expression type: "+reflect.TypeOf(expr).String(), expr.GetPosition())
 		return false
 	}
 }
 
+// analyzeWorkerSend checks that the message fits the matching receive. The receive type is normally inferred
+// from the send, but when the receiver is declared before the sender it comes from the receiver's context.
+func analyzeWorkerSend[A analyzer](a A, send ast.WorkerSendExpressionNode, expectedType semtypes.SemType) bool {
+	msgExpr := send.GetExpression()
+	if !analyzeActionOrExpression(a, msgExpr, semtypes.SemType{}) {
+		return false
+	}
+	if receive := workerSendBase(send).Receive; receive != nil {
+		receiveTy := receive.GetDeterminedType()
+		if !semtypes.IsZero(receiveTy) && !semtypes.IsSubtype(a.tyCtx(), msgExpr.GetDeterminedType(), receiveTy) {
+			a.semanticErr(formatIncompatibleTypeMessage(a.tyCtx(), receiveTy, msgExpr.GetDeterminedType()), msgExpr.GetPosition())
+			return false
+		}
+	}
+	return validateResolvedType(a, send, expectedType)
+}
+
 func analyzeCheckedExpr[A analyzer](a A, expr *ast.BLangCheckedExpr, expectedType semtypes.SemType) bool {
 	if !analyzeActionOrExpression(a, expr.Expr, semtypes.SemType{}) {
 		return false
@@ -1018,20 +1123,27 @@ func analyzeCheckedExpr[A analyzer](a A, expr *ast.BLangCheckedExpr, expectedTyp
 		return false
 	}
 	exprTy := expr.Expr.GetDeterminedType()
-	errorPart := semtypes.Intersect(exprTy, semtypes.ERROR)
-	if !semtypes.IsEmpty(a.tyCtx(), errorPart) {
-		if !semtypes.IsSubtype(a.tyCtx(), errorPart, retTy) {
-			a.ctx().SemanticError("error type of check expression is not a subtype of the enclosing function's return type", expr.GetPosition())
-		}
-	}
+	validateFailureReturnType(a, semtypes.Intersect(exprTy, semtypes.ERROR), "check expression", expr.GetPosition())
 	return validateResolvedType(a, expr, expectedType)
 }
 
 var templateInsertionAllowedTypes = semtypes.Diff(semtypes.SIMPLE_OR_STRING, semtypes.NIL)
 
+// regExpTemplateInsertionAllowedTypes are the types allowed in a regexp
+// template interpolation. The string form of the value becomes part of the
+// pattern.
+var regExpTemplateInsertionAllowedTypes = semtypes.Union(templateInsertionAllowedTypes, semtypes.REGEXP)
+
 func analyzeTemplateExpr[A analyzer](a A, expr *ast.BLangTemplateExpr, expectedType semtypes.SemType) bool {
-	for _, ins := range expr.Insertions {
-		if !analyzeActionOrExpression(a, ins, templateInsertionAllowedTypes) {
+	allowed := templateInsertionAllowedTypes
+	if expr.Kind == ast.TemplateExprKindRegExp {
+		allowed = regExpTemplateInsertionAllowedTypes
+	}
+	for i, ins := range expr.Insertions {
+		if expr.Kind == ast.TemplateExprKindRaw {
+			allowed = rawTemplateInsertionType(a.tyCtx(), expr.GetDeterminedType(), i)
+		}
+		if !analyzeActionOrExpression(a, ins, allowed) {
 			return false
 		}
 	}
@@ -1066,6 +1178,25 @@ func analyzeTrapExpr[A analyzer](a A, expr *ast.BLangTrapExpr, expectedType semt
 	return validateResolvedType(a, expr, expectedType)
 }
 
+func analyzeLetExpr[A analyzer](a A, expr *ast.BLangLetExpr, expectedType semtypes.SemType) bool {
+	for _, decl := range expr.LetVarDeclarations {
+		varDef, ok := decl.(*ast.BLangSimpleVariableDef)
+		if !ok {
+			if !analyzeBindingPatternVariableDef(a, decl) {
+				return false
+			}
+			continue
+		}
+		if !analyzeSimpleVariableDef(a, varDef) {
+			return false
+		}
+	}
+	if !analyzeActionOrExpression(a, expr.Expr, expectedType) {
+		return false
+	}
+	return validateResolvedType(a, expr, expectedType)
+}
+
 func analyzeCheckPanickedExpr[A analyzer](a A, expr *ast.BLangCheckPanickedExpr, expectedType semtypes.SemType) bool {
 	if !analyzeActionOrExpression(a, expr.Expr, semtypes.SemType{}) {
 		return false
@@ -1077,6 +1208,7 @@ type queryExprAnalysisClauses struct {
 	fromClause       *ast.BLangFromClause
 	selectClause     *ast.BLangSelectClause
 	collectClause    *ast.BLangCollectClause
+	doClause         *ast.BLangDoClause
 	onConflictClause *ast.BLangOnConflictClause
 	lastClauseIndex  int
 }
@@ -1105,10 +1237,13 @@ func queryExprClausesForAnalysis[A analyzer](
 	var (
 		selectClause  *ast.BLangSelectClause
 		collectClause *ast.BLangCollectClause
+		doClause      *ast.BLangDoClause
 		ok            bool
 	)
 	if selectClause, ok = queryExpr.QueryClauseList[lastClauseIndex].(*ast.BLangSelectClause); !ok {
-		collectClause, ok = queryExpr.QueryClauseList[lastClauseIndex].(*ast.BLangCollectClause)
+		if collectClause, ok = queryExpr.QueryClauseList[lastClauseIndex].(*ast.BLangCollectClause); !ok {
+			doClause, ok = queryExpr.QueryClauseList[lastClauseIndex].(*ast.BLangDoClause)
+		}
 	}
 	if !ok {
 		a.internalErr("query expression shape should have been validated during type resolution", queryExpr.GetPosition())
@@ -1118,6 +1253,7 @@ func queryExprClausesForAnalysis[A analyzer](
 		fromClause:       fromClause,
 		selectClause:     selectClause,
 		collectClause:    collectClause,
+		doClause:         doClause,
 		onConflictClause: onConflictClause,
 		lastClauseIndex:  lastClauseIndex,
 	}, true
@@ -1151,8 +1287,14 @@ func analyzeQueryExpr[A analyzer](a A, queryExpr *ast.BLangQueryExpr, expectedTy
 				return false
 			}
 		case *ast.BLangLetClause:
-			for i := range clause.LetVarDeclarations {
-				varDef := &clause.LetVarDeclarations[i]
+			for _, decl := range clause.LetVarDeclarations {
+				varDef, ok := decl.(*ast.BLangSimpleVariableDef)
+				if !ok {
+					if !analyzeBindingPatternVariableDef(a, decl) {
+						return false
+					}
+					continue
+				}
 				if varDef.Var == nil || varDef.Var.Expr == nil {
 					a.semanticErr("let clause supports only initialized simple variable declarations", clause.GetPosition())
 					return false
@@ -1208,6 +1350,7 @@ func analyzeQueryExpr[A analyzer](a A, queryExpr *ast.BLangQueryExpr, expectedTy
 		}
 	}
 
+	// The statements of a do clause body are analyzed as the walk reaches them.
 	if clauses.selectClause != nil {
 		selectExpectedTy := querySelectExpectedType(
 			a.tyCtx(),
@@ -1221,7 +1364,7 @@ func analyzeQueryExpr[A analyzer](a A, queryExpr *ast.BLangQueryExpr, expectedTy
 		if !analyzeActionOrExpression(a, clauses.selectClause.Expression, selectExpectedTy) {
 			return false
 		}
-	} else {
+	} else if clauses.collectClause != nil {
 		if queryExpr.QueryConstructType != ast.TypeKind_NONE {
 			a.semanticErr("query construct types cannot be used with collect clause", clauses.collectClause.GetPosition())
 			return false
@@ -1232,8 +1375,8 @@ func analyzeQueryExpr[A analyzer](a A, queryExpr *ast.BLangQueryExpr, expectedTy
 	}
 
 	if clauses.onConflictClause != nil {
-		if queryExpr.QueryConstructType != ast.TypeKind_MAP {
-			a.semanticErr("on conflict clause is supported only for map query construct type", clauses.onConflictClause.GetPosition())
+		if !queryAllowsOnConflict(a.tyCtx(), queryExpr.QueryConstructType, queryExpr.GetDeterminedType()) {
+			a.semanticErr("on conflict clause is supported only for map or keyed table query construct types", clauses.onConflictClause.GetPosition())
 			return false
 		}
 		if !analyzeActionOrExpression(a, clauses.onConflictClause.Expression, semtypes.Union(semtypes.ERROR, semtypes.NIL)) {
@@ -1305,7 +1448,7 @@ func enclosingFunctionIsIsolated(a analyzer) bool {
 	return ok && fn.IsIsolated()
 }
 
-func analyzeLambdaFunction[A analyzer](a A, expr *ast.BLangLambdaFunction) bool {
+func analyzeLambdaFunction[A analyzer](a A, expr *ast.BLangLambdaFunction, expectedType semtypes.SemType) bool {
 	fa := initializeFunctionAnalyzer(a, expr.Function)
 	fn := expr.Function
 	if fn.IsIsolated() && fn.Body != nil && !enclosingFunctionIsIsolated(a) {
@@ -1324,7 +1467,7 @@ func analyzeLambdaFunction[A analyzer](a A, expr *ast.BLangLambdaFunction) bool
 	if fn.Body != nil {
 		ast.Walk(fa, fn.GetBody().(ast.BLangNode))
 	}
-	return true
+	return validateResolvedType(a, expr, expectedType)
 }
 
 func validateTypeConversionExpr[A analyzer](a A, expr *ast.BLangTypeConversionExpr, expectedType semtypes.SemType) bool {
@@ -1359,6 +1502,33 @@ func analyzeFieldBasedAccess[A analyzer](a A, expr *ast.BLangFieldBaseAccess, ex
 	return validateResolvedType(a, expr, expectedType)
 }
 
+func analyzeTernaryExpr[A analyzer](a A, expr *ast.BLangTernaryExpr, expectedType semtypes.SemType) bool {
+	if !analyzeActionOrExpression(a, expr.Expr, semtypes.BOOLEAN) {
+		return false
+	}
+	if !analyzeActionOrExpression(a, expr.ThenExpr, expectedType) {
+		return false
+	}
+	if !analyzeActionOrExpression(a, expr.ElseExpr, expectedType) {
+		return false
+	}
+	return validateResolvedType(a, expr, expectedType)
+}
+
+func analyzeElvisExpr[A analyzer](a A, expr *ast.BLangElvisExpr, expectedType semtypes.SemType) bool {
+	lhsExpectedType := expectedType
+	if !semtypes.IsZero(expectedType) {
+		lhsExpectedType = semtypes.Union(expectedType, semtypes.NIL)
+	}
+	if !analyzeActionOrExpression(a, expr.LhsExpr, lhsExpectedType) {
+		return false
+	}
+	if !analyzeActionOrExpression(a, expr.RhsExpr, expectedType) {
+		return false
+	}
+	return validateResolvedType(a, expr, expectedType)
+}
+
 func analyzeIndexBasedAccess[A analyzer](a A, expr *ast.BLangIndexBasedAccess, expectedType semtypes.SemType) bool {
 	// Validate container expression
 	containerExpr := expr.Expr
@@ -1374,8 +1544,7 @@ func analyzeIndexBasedAccess[A analyzer](a A, expr *ast.BLangIndexBasedAccess, e
 		semtypes.IsSubtype(ctx, containerExprTy, semtypes.XML) {
 		keyExprExpectedType = semtypes.INT
 	} else if semtypes.IsSubtype(ctx, containerExprTy, semtypes.TABLE) {
-		a.unimplementedErr("table not supported", expr.GetPosition())
-		return false
+		keyExprExpectedType = semtypes.TableKeyType(ctx, containerExprTy)
 	} else if semtypes.IsSubtype(ctx, containerExprTy, semtypes.Union(semtypes.NIL, semtypes.MAPPING)) {
 		keyExprExpectedType = semtypes.STRING
 	} else {
@@ -1398,19 +1567,21 @@ func analyzeListConstructorExpr[A analyzer](a A, expr *ast.BLangListConstructorE
 	memberIndex := 0
 	restMember := false
 	for i, memberExpr := range expr.Exprs {
-		memberExpectedType := lat.MemberAtInnerVal(memberIndex)
-		if restMember || expr.IsSpreadMember(i) {
-			memberExpectedType = lat.Rest()
-		}
 		if expr.IsSpreadMember(i) {
-			memberExpectedType = listOfMemberType(a.ctx().GetTypeEnv(), memberExpectedType)
+			fixedTys, spreadRestTy := listSpreadShape(a.tyCtx(), memberExpr.GetDeterminedType())
+			variableLength := !semtypes.IsNever(spreadRestTy)
+			memberExpectedType := spreadMemberExpectedType(a.ctx().GetTypeEnv(), lat, memberIndex, len(fixedTys), variableLength)
 			if !analyzeActionOrExpression(a, memberExpr, memberExpectedType) {
 				return false
 			}
-			restMember = true
-			memberIndex = lat.Members.FixedLength
+			memberIndex += len(fixedTys)
+			restMember = restMember || variableLength
 			continue
 		}
+		memberExpectedType := lat.MemberAtInnerVal(memberIndex)
+		if restMember {
+			memberExpectedType = lat.Rest()
+		}
 		if !analyzeActionOrExpression(a, memberExpr, memberExpectedType) {
 			return false
 		}
@@ -1418,7 +1589,7 @@ func analyzeListConstructorExpr[A analyzer](a A, expr *ast.BLangListConstructorE
 			memberIndex++
 		}
 	}
-	for i := memberIndex; i < lat.Members.FixedLength; i++ {
+	for i := memberIndex; !restMember && i < lat.Members.FixedLength; i++ {
 		memberTy := lat.MemberAtInnerVal(i)
 		if _, ok := semtypes.FillerValue(a.tyCtx(), memberTy); !ok {
 			a.semanticErr(fmt.Sprintf("missing required member at index %d: type '%s' has no filler value", i, semtypes.ToString(a.tyCtx(), memberTy)), expr.GetPosition())
@@ -1428,9 +1599,14 @@ func analyzeListConstructorExpr[A analyzer](a A, expr *ast.BLangListConstructorE
 	return validateResolvedType(a, expr, expectedType)
 }
 
-func listOfMemberType(env semtypes.Env, memberTy semtypes.SemType) semtypes.SemType {
-	ld := semtypes.NewListDefinition()
-	return ld.DefineListTypeWrappedWithEnvSemType(env, memberTy)
+func analyzeTableConstructorExpr[A analyzer](a A, expr *ast.BLangTableConstructorExpr, expectedType semtypes.SemType) bool {
+	rowTy := semtypes.TableRowType(a.tyCtx(), expr.GetDeterminedType())
+	for _, row := range expr.RecordLiteralList {
+		if !analyzeMappingConstructorExpr(a, row, rowTy) {
+			return false
+		}
+	}
+	return validateResolvedType(a, expr, expectedType)
 }
 
 func analyzeMappingConstructorExpr[A analyzer](a A, expr *ast.BLangMappingConstructorExpr, expectedType semtypes.SemType) bool {
@@ -1447,6 +1623,12 @@ func analyzeMappingConstructorExpr[A analyzer](a A, expr *ast.BLangMappingConstr
 		namedFields[n] = true
 	}
 	for _, f := range expr.Fields {
+		if spread, ok := f.(*ast.BLangMappingSpreadField); ok {
+			if !analyzeMappingSpreadField(a, spread, mat, seen, hasValue) {
+				return false
+			}
+			continue
+		}
 		kv := f.(*ast.BLangMappingKeyValueField)
 		keyName := recordKeyName(kv.Key)
 		if seen[keyName] {
@@ -1479,6 +1661,59 @@ func analyzeMappingConstructorExpr[A analyzer](a A, expr *ast.BLangMappingConstr
 	return validateResolvedType(a, expr, expectedType)
 }
 
+// analyzeMappingSpreadField checks that every field a spread field may contribute is
+// compatible with the corresponding field of the inherent type mat. Fields that may come
+// from the rest part of the spread type must be compatible with every named field of mat
+// not explicitly provided by the spread, and with its rest type.
+func analyzeMappingSpreadField[A analyzer](a A, spread *ast.BLangMappingSpreadField, mat semtypes.MappingAtomicType, seen, hasValue map[string]bool) bool {
+	tyCtx := a.tyCtx()
+	spreadTy := spread.Expr.GetDeterminedType()
+	if !analyzeActionOrExpression(a, spread.Expr, spreadTy) {
+		return false
+	}
+	spreadMat := semtypes.ToMappingAtomicType(tyCtx, spreadTy)
+	spreadNames := make(map[string]bool, len(spreadMat.Names))
+	for _, name := range spreadMat.Names {
+		if seen[name] {
+			a.semanticErr(fmt.Sprintf("duplicate key '%s' in mapping constructor", name), spread.GetPosition())
+			return false
+		}
+		seen[name] = true
+		spreadNames[name] = true
+		if !spreadMat.IsOptional(tyCtx, name) {
+			hasValue[name] = true
+		}
+		fieldTy := spreadMat.FieldInnerVal(name)
+		requiredTy := mat.FieldInnerVal(name)
+		if !semtypes.IsSubtype(tyCtx, fieldTy, requiredTy) {
+			a.semanticErr(fmt.Sprintf("incompatible type for field '%s' in spread field: expected %s, got %s",
+				name, semtypes.ToString(tyCtx, requiredTy), semtypes.ToString(tyCtx, fieldTy)), spread.GetPosition())
+			return false
+		}
+	}
+	restTy := spreadMat.RestInnerVal()
+	if semtypes.IsNever(restTy) {
+		return true
+	}
+	if !semtypes.IsSubtype(tyCtx, restTy, mat.RestInnerVal()) {
+		a.semanticErr(fmt.Sprintf("incompatible type for rest fields in spread field: expected %s, got %s",
+			semtypes.ToString(tyCtx, mat.RestInnerVal()), semtypes.ToString(tyCtx, restTy)), spread.GetPosition())
+		return false
+	}
+	for _, name := range mat.Names {
+		if spreadNames[name] {
+			continue
+		}
+		requiredTy := mat.FieldInnerVal(name)
+		if !semtypes.IsSubtype(tyCtx, restTy, requiredTy) {
+			a.semanticErr(fmt.Sprintf("incompatible type for field '%s' in spread field: expected %s, got %s",
+				name, semtypes.ToString(tyCtx, requiredTy), semtypes.ToString(tyCtx, restTy)), spread.GetPosition())
+			return false
+		}
+	}
+	return true
+}
+
 func analyzeErrorConstructorExpr[A analyzer](a A, expr *ast.BLangErrorConstructorExpr, expectedType semtypes.SemType) bool {
 	argCount := len(expr.PositionalArgs)
 	if argCount < 1 || argCount > 2 {
@@ -1515,9 +1750,9 @@ func analyzeErrorConstructorExpr[A analyzer](a A, expr *ast.BLangErrorConstructo
 		}
 	}
 
-	// Every field in the atom must be provided
+	// Every required field in the atom must be provided
 	for _, name := range mat.Names {
-		if !seen[name] {
+		if !seen[name] && !mat.IsOptional(tyCtx, name) {
 			a.semanticErr(fmt.Sprintf("missing required field '%s' in error constructor", name), expr.GetPosition())
 			return false
 		}
@@ -1652,6 +1887,8 @@ type invocable interface {
 	SetCallArgs([]ast.BLangExpression)
 	GetName() ast.IdentifierNode
 	SetRawSymbol(model.Symbol)
+	ParamsSymbol() model.SymbolRef
+	SetParamsSymbol(model.SymbolRef)
 }
 
 func analyzeInvocation[A analyzer](a A, inv invocable, expectedType semtypes.SemType) bool {
@@ -1668,6 +1905,9 @@ func analyzeInvocation[A analyzer](a A, inv invocable, expectedType semtypes.Sem
 	paramListTy := semtypes.FunctionParamListType(a.tyCtx(), fnTy)
 
 	fnSymbol, isDirectCall := a.ctx().GetSymbol(symbol).(model.FunctionSymbol)
+	if paramsRef := inv.ParamsSymbol(); !isDirectCall && !paramsRef.IsEmpty() {
+		fnSymbol, isDirectCall = a.ctx().GetSymbol(paramsRef).(model.FunctionSymbol)
+	}
 	// TODO: ideally we need to unify these when we no longer has restrictions on lambdas
 	if !isDirectCall {
 		if invocation, ok := inv.(*ast.BLangInvocation); ok {
@@ -1689,12 +1929,16 @@ func analyzeClientResourceAccessAction[A analyzer](a A, expr *ast.BLangClientRes
 	pathType := resolvedResourceMethodPathType(a, expr)
 	for i := range expr.Path {
 		seg := &expr.Path[i]
-		if seg.Kind != ast.ResourceAccessSegmentComputed {
-			continue
-		}
-		segExpectedTy := resourcePathSegmentExpectedType(a.tyCtx(), pathType, i)
-		if !analyzeActionOrExpression(a, seg.Expr, segExpectedTy) {
-			return false
+		switch seg.Kind {
+		case ast.ResourceAccessSegmentComputed:
+			segExpectedTy := resourcePathSegmentExpectedType(a.tyCtx(), pathType, i)
+			if !analyzeActionOrExpression(a, seg.Expr, segExpectedTy) {
+				return false
+			}
+		case ast.ResourceAccessSegmentRest:
+			if !analyzeActionOrExpression(a, seg.Expr, semtypes.LIST) {
+				return false
+			}
 		}
 	}
 	return analyzeInvocation(a, expr, expectedType)
@@ -1744,6 +1988,10 @@ func analyzeDirectInvocation[A analyzer](a A, inv invocable, fnSymbol model.Func
 			if !analyzeActionOrExpression(a, arg, semtypes.ListMemberTypeInnerVal(tyCtx, paramListTy, key)) {
 				return false
 			}
+		case *ast.BLangRestArgsExpression:
+			if !analyzeActionOrExpression(a, arg, semtypes.SemType{}) {
+				return false
+			}
 		default:
 			key := semtypes.IntConst(int64(i))
 			if !analyzeActionOrExpression(a, arg, semtypes.ListMemberTypeInnerVal(tyCtx, paramListTy, key)) {
@@ -1759,6 +2007,12 @@ func analyzeLambdaInvocation[A analyzer](a A, invocation *ast.BLangInvocation, p
 	tyCtx := a.tyCtx()
 
 	for i, arg := range invocation.ArgExprs {
+		if _, ok := arg.(*ast.BLangRestArgsExpression); ok {
+			if !analyzeActionOrExpression(a, arg, semtypes.SemType{}) {
+				return false
+			}
+			continue
+		}
 		key := semtypes.IntConst(int64(i))
 		if !analyzeActionOrExpression(a, arg, semtypes.ListMemberTypeInnerVal(tyCtx, paramListTy, key)) {
 			return false
@@ -1790,6 +2044,25 @@ func analyzeSimpleVariableDef[A analyzer](a A, simpleVariableDef *ast.BLangSimpl
 	return true
 }
 
+// analyzeBindingPatternVariableDef analyzes a variable declaration with a list, mapping or error binding
+// pattern. The type resolver has already checked that the binding pattern matches the type of the
+// initializer, so this checks the initializer and the variables declared by wildcard binding patterns.
+func analyzeBindingPatternVariableDef[A analyzer](a A, varDef ast.VariableDefinitionNode) bool {
+	variable := varDef.GetVariable()
+	for _, each := range ast.BindingVariables(variable) {
+		if ast.IsWildcardVariable(each) && !semtypes.IsSubtype(a.tyCtx(), each.GetDeterminedType(), semtypes.ANY) {
+			a.semanticErr("wildcard binding pattern type must be a subtype of 'any'", each.GetPosition())
+			return false
+		}
+	}
+	expectedType := variable.(ast.BLangNode).GetDeterminedType()
+	if !analyzeActionOrExpression(a, variable.GetInitialExpression(), expectedType) {
+		return false
+	}
+	setExpectedType(varDef.(ast.BLangNode), expectedType)
+	return true
+}
+
 func visitInner[A analyzer](a A, node ast.BLangNode) ast.Visitor {
 	switch n := node.(type) {
 	case *ast.BLangLambdaFunction:
@@ -1798,6 +2071,9 @@ func visitInner[A analyzer](a A, node ast.BLangNode) ast.Visitor {
 		// to avoid re-initializing/re-walking the same lambda body.
 		_ = n
 		return nil
+	case *ast.BLangWorker:
+		analyzeLambdaFunction(a, n.Lambda, semtypes.SemType{})
+		return nil
 	case *ast.BLangFunction:
 		if _, isDep := a.ctx().GetSymbol(n.Symbol()).(model.DependentlyTypedFunctionSymbol); isDep {
 			initializeFunctionAnalyzer(a, n)
@@ -1805,28 +2081,95 @@ func visitInner[A analyzer](a A, node ast.BLangNode) ast.Visitor {
 		}
 		return initializeFunctionAnalyzer(a, n)
 	case *ast.BLangWhile:
-		if !analyzeWhile(a, n) {
+		if n.OnFailClause.Body == nil {
+			if !analyzeWhile(a, n) {
+				return nil
+			}
+			return initializeLoopAnalyzer(a, n)
+		}
+		onFailA := initializeOnFailAnalyzer(a, n)
+		if !analyzeWhile(onFailA, n) {
 			return nil
 		}
-		return initializeLoopAnalyzer(a, n)
+		analyzeWithOnFailClause(a, initializeLoopAnalyzer(onFailA, n), &n.OnFailClause, n.Expr, &n.Body)
+		return nil
 	case *ast.BLangForeach:
-		if !validateForeach(a, n) {
+		if n.OnFailClause == nil {
+			if !validateForeach(a, n) {
+				return nil
+			}
+			return initializeLoopAnalyzer(a, n)
+		}
+		onFailA := initializeOnFailAnalyzer(a, n)
+		if !validateForeach(onFailA, n) {
 			return nil
 		}
-		return initializeLoopAnalyzer(a, n)
+		loopA := initializeLoopAnalyzer(onFailA, n)
+		analyzeWithOnFailClause(a, loopA, n.OnFailClause, n.Collection.(ast.BLangNode), n.VariableDef, &n.Body)
+		return nil
 	case *ast.BLangLock:
 		if enclosingLockAnalyzer(a) != nil {
 			a.semanticErr("lock statement cannot be nested inside another lock statement", n.GetPosition())
 			return nil
 		}
 		validateLockStmt(a, n)
-		return initializeLockAnalyzer(a, n)
+		if n.OnFailClause == nil {
+			return initializeLockAnalyzer(a, n)
+		}
+		analyzeWithOnFailClause(a, initializeLockAnalyzer(initializeOnFailAnalyzer(a, n), n), n.OnFailClause, &n.Body)
+		return nil
+	case *ast.BLangDo:
+		if n.OnFailClause.Body == nil {
+			return a
+		}
+		analyzeWithOnFailClause(a, initializeOnFailAnalyzer(a, n), &n.OnFailClause, &n.Body)
+		return nil
+	case *ast.BLangFail:
+		analyzeFail(a, n)
+		return nil
 	case *ast.BLangIf:
 		if !analyzeIf(a, n) {
 			return nil
 		}
+		if isTransactionalCondition(n.Expr) {
+			ast.Walk(initializeTransactionAnalyzer(a, n), &n.Body)
+			if n.ElseStmt != nil {
+				ast.Walk(a, n.ElseStmt.(ast.BLangNode))
+			}
+			return nil
+		}
 		return a
-	case *ast.BLangBreak, *ast.BLangContinue:
+	case *ast.BLangBreak:
+		validateLoopExit(a, n, "break")
+		return nil
+	case *ast.BLangContinue:
+		validateLoopExit(a, n, "continue")
+		return nil
+	case *ast.BLangTransaction:
+		if n.OnFailClause == nil {
+			return analyzeTransaction(a, n)
+		}
+		if v := analyzeTransaction(initializeOnFailAnalyzer(a, n), n); v != nil {
+			analyzeWithOnFailClause(a, v.(analyzer), n.OnFailClause, &n.Body)
+		}
+		return nil
+	case *ast.BLangRetry:
+		if n.OnFailClause == nil {
+			return analyzeRetry(a, n)
+		}
+		analyzeRetry(initializeOnFailAnalyzer(a, n), n)
+		ast.Walk(a, n.OnFailClause)
+		return nil
+	case *ast.BLangRollback:
+		if !analyzeRollback(a, n) {
+			return nil
+		}
+		return a
+	case *ast.BLangCommitExpr:
+		validateCommit(a, n)
+		return nil
+	case *ast.BLangAnnotationAttachment:
+		analyzeAnnotationAttachment(a, n)
 		return nil
 	case *ast.BLangXMLNS:
 		expr := n.GetNamespaceURI()
@@ -1837,6 +2180,24 @@ func visitInner[A analyzer](a A, node ast.BLangNode) ast.Visitor {
 		return nil
 	case *ast.BLangMatchStatement:
 		return a
+	case *ast.BLangMatchClause:
+		if n.Guard != nil && !analyzeActionOrExpression(a, n.Guard, semtypes.BOOLEAN) {
+			return nil
+		}
+		if fa := enclosingFunctionAnalyzer(a); fa != nil && fa.locals != nil {
+			for _, pattern := range n.Patterns {
+				for _, v := range ast.MatchPatternVariables(pattern) {
+					if !ast.SymbolIsSet(v) || ast.IsWildcardVariable(v) {
+						continue
+					}
+					fa.locals.define(v.Symbol(), varDeclMetadata{
+						Type:  v.GetDeterminedType(),
+						Final: true,
+					})
+				}
+			}
+		}
+		return a
 	case *ast.BLangSimpleVariableDef:
 		if !analyzeSimpleVariableDef(a, n) {
 			return nil
@@ -1853,6 +2214,23 @@ func visitInner[A analyzer](a A, node ast.BLangNode) ast.Visitor {
 			})
 		}
 		return a
+	case *ast.BLangTupleVariableDef, *ast.BLangRecordVariableDef, *ast.BLangErrorVariableDef:
+		varDef := n.(ast.VariableDefinitionNode)
+		if !analyzeBindingPatternVariableDef(a, varDef) {
+			return nil
+		}
+		if fa := enclosingFunctionAnalyzer(a); fa != nil && fa.locals != nil {
+			for _, v := range ast.BindingVariables(varDef.GetVariable()) {
+				if ast.IsWildcardVariable(v) {
+					continue
+				}
+				fa.locals.define(v.Symbol(), varDeclMetadata{
+					Type:  v.GetDeterminedType(),
+					Final: v.IsFinal(),
+				})
+			}
+		}
+		return a
 	case *ast.BLangAssignment:
 		if !analyzeAssignment(a, n) {
 			return nil
@@ -1873,6 +2251,12 @@ func visitInner[A analyzer](a A, node ast.BLangNode) ast.Visitor {
 			return nil
 		}
 		return a
+	case *ast.BLangInvocation:
+		validateTransactionalInvocation(a, n)
+		if !analyzeActionOrExpression(a, n, semtypes.SemType{}) {
+			return nil
+		}
+		return a
 	case ast.BLangExpression:
 		if !analyzeActionOrExpression(a, n, semtypes.SemType{}) {
 			return nil
@@ -1970,36 +2354,78 @@ type assignmentNode interface {
 
 func analyzeAssignment[A analyzer](a A, assignment assignmentNode) bool {
 	variable := assignment.GetVariable()
-	if symbolNode, ok := variable.(ast.BNodeWithSymbol); ok {
-		symbol := symbolNode.Symbol()
-		if !ast.SymbolIsSet(symbolNode) {
-			a.internalErr("unexpected nil symbol", variable.GetPosition())
-			return false
-		}
-		ctx := a.ctx()
-		switch ctx.SymbolKind(symbol) {
-		case model.SymbolKindConstant:
-			a.semanticErr("cannot assign to constant", variable.GetPosition())
-			return false
-		case model.SymbolKindParemeter:
-			a.semanticErr("cannot assign to parameter", variable.GetPosition())
-			return false
-		case model.SymbolKindFunction:
-			a.semanticErr("cannot assign to function", variable.GetPosition())
-			return false
-		case model.SymbolKindType:
-			a.semanticErr("cannot assign to type", variable.GetPosition())
+	for _, target := range ast.BindingVarRefs(variable) {
+		if !analyzeAssignmentTarget(a, target) {
 			return false
 		}
 	}
 	if !analyzeActionOrExpression(a, variable, semtypes.SemType{}) {
 		return false
 	}
+	if !analyzeReadonlyUpdate(a, variable) {
+		return false
+	}
 	expectedType := variable.GetDeterminedType()
 	expression := assignment.GetExpression()
 	return analyzeActionOrExpression(a, expression, expectedType)
 }
 
+func analyzeAssignmentTarget[A analyzer](a A, variable ast.LExpr) bool {
+	symbolNode, ok := variable.(ast.BNodeWithSymbol)
+	if !ok {
+		return true
+	}
+	symbol := symbolNode.Symbol()
+	if !ast.SymbolIsSet(symbolNode) {
+		a.internalErr("unexpected nil symbol", variable.GetPosition())
+		return false
+	}
+	ctx := a.ctx()
+	switch ctx.SymbolKind(symbol) {
+	case model.SymbolKindConstant:
+		a.semanticErr("cannot assign to constant", variable.GetPosition())
+		return false
+	case model.SymbolKindParemeter:
+		a.semanticErr("cannot assign to parameter", variable.GetPosition())
+		return false
+	case model.SymbolKindFunction:
+		a.semanticErr("cannot assign to function", variable.GetPosition())
+		return false
+	case model.SymbolKindType:
+		a.semanticErr("cannot assign to type", variable.GetPosition())
+		return false
+	}
+	if sym, ok := ctx.GetSymbol(symbol).(*model.ValueSymbol); ok && sym.IsConfigurable() {
+		a.semanticErr("cannot assign to configurable variable", variable.GetPosition())
+		return false
+	}
+	return true
+}
+
+// analyzeReadonlyUpdate rejects an assignment to a member or field of a
+// readonly value. The fields of a readonly object are set only by its init
+// method.
+func analyzeReadonlyUpdate[A analyzer](a A, variable ast.LExpr) bool {
+	var container ast.BLangExpression
+	switch v := variable.(type) {
+	case *ast.BLangFieldBaseAccess:
+		if isSelfFieldAccess(v) && inInitFunction(a) {
+			return true
+		}
+		container = v.Expr
+	case *ast.BLangIndexBasedAccess:
+		container = v.Expr
+	default:
+		return true
+	}
+	containerTy := container.GetDeterminedType()
+	if semtypes.IsNever(containerTy) || !semtypes.IsSubtype(a.tyCtx(), containerTy, semtypes.VAL_READONLY) {
+		return true
+	}
+	a.semanticErr("cannot update a readonly value", variable.GetPosition())
+	return false
+}
+
 func analyzeCompoundAssignment[A analyzer](a A, assignment *ast.BLangCompoundAssignment) bool {
 	if !analyzeAssignment(a, assignment) {
 		return false
@@ -2046,6 +2472,8 @@ func validateForeach[A analyzer](a A, foreachStmt *ast.BLangForeach) bool {
 			expectedValueType = result
 		case semtypes.IsSubtype(a.tyCtx(), collectionType, semtypes.MAPPING):
 			expectedValueType = semtypes.MappingMemberTypeInnerVal(a.tyCtx(), collectionType, semtypes.STRING)
+		case semtypes.IsSubtype(a.tyCtx(), collectionType, semtypes.TABLE):
+			expectedValueType = semtypes.TableRowType(a.tyCtx(), collectionType)
 		default:
 			tyCtx := a.tyCtx()
 			iterableTy := semtypes.CreateIterable(tyCtx)
@@ -2117,7 +2545,7 @@ func validateResourceMethodReturnType[A analyzer](a A, retTy semtypes.SemType, r
 		a.semanticErr("resource method return type must not include a function type", rm.GetPosition())
 		return
 	}
-	if !semtypes.IsEmpty(a.tyCtx(), semtypes.Intersect(retTy, semtypes.CreateClientObject(a.tyCtx()))) {
+	if semtypes.ContainsClientObject(a.tyCtx(), retTy) {
 		a.semanticErr("resource method return type must not include a client object type", rm.GetPosition())
 	}
 }
@@ -2193,6 +2621,10 @@ func isIsolatedFuncInner[A analyzer](a A, node ast.BLangNode) {
 		switch inner := inner.(type) {
 		case *ast.BLangSimpleVariableDef:
 			locals[ctx.UnnarrowedSymbol(inner.Var.Symbol())] = struct{}{}
+		case *ast.BLangTupleVariableDef, *ast.BLangRecordVariableDef, *ast.BLangErrorVariableDef:
+			for _, v := range ast.BindingVariables(inner.(ast.VariableDefinitionNode).GetVariable()) {
+				locals[ctx.UnnarrowedSymbol(v.Symbol())] = struct{}{}
+			}
 		case *ast.BLangInvocation:
 			if ast.IsStreamOperation(inner) {
 				return true
@@ -62,6 +62,7 @@ type symbolResolver interface {
 	GetSymbol(name string) (model.SymbolRef, scopeKind, bool)
 	ast.Visitor
 	GetPrefixedSymbol(prefix, name string) (model.SymbolRef, bool)
+	GetAnnotationSymbol(prefix, name string) (model.SymbolRef, bool)
 	AddSymbol(name string, symbol model.Symbol)
 	GetPkgID() model.PackageID
 	GetScope() model.Scope
@@ -82,6 +83,12 @@ type (
 		nextDefaultSymbolName() string
 	}
 
+	// defaultableParamOwner is a function or resource method whose parameters can have defaults.
+	defaultableParamOwner interface {
+		Symbol() model.SymbolRef
+		RequiredParameters() []ast.BLangSimpleVariable
+	}
+
 	prevPos struct {
 		pos      diagnostics.Location
 		reported bool
@@ -103,10 +110,14 @@ type (
 		typeDefns      map[model.SymbolRef]*ast.BLangTypeDefinition
 		classDefns     map[model.SymbolRef]*ast.BLangClassDefinition
 		packageSymbols map[string]model.SymbolRef
-		prevPos        map[string]prevPos
-		usedPrefixes   map[string]bool
-		defaultCounter int
-		varTracker     varTracker
+		// packageAnnotations holds the annotations declared in any compilation unit of the package, which live in a
+		// symbol space separate from packageSymbols.
+		packageAnnotations map[string]model.SymbolRef
+		prevPos            map[string]prevPos
+		usedPrefixes       map[string]bool
+		defaultCounter     int
+		patternCounter     int
+		varTracker         varTracker
 	}
 
 	blockSymbolResolver struct {
@@ -208,33 +219,35 @@ func (t *varTracker) getUnused() []varDeclInfo {
 func newCompilationUnitsSymbolResolver(ctx *context.CompilerContext, pkgID model.PackageID) *moduleSymbolResolver {
 	packageScope := ctx.NewModuleScope(pkgID, nil)
 	return &moduleSymbolResolver{
-		ctx:            ctx,
-		tyCtx:          semtypes.ContextFrom(ctx.GetTypeEnv()),
-		scope:          packageScope,
-		packageScope:   packageScope,
-		pkgID:          pkgID,
-		typeDefns:      make(map[model.SymbolRef]*ast.BLangTypeDefinition),
-		classDefns:     make(map[model.SymbolRef]*ast.BLangClassDefinition),
-		packageSymbols: make(map[string]model.SymbolRef),
-		prevPos:        make(map[string]prevPos),
-		usedPrefixes:   make(map[string]bool),
+		ctx:                ctx,
+		tyCtx:              semtypes.ContextFrom(ctx.GetTypeEnv()),
+		scope:              packageScope,
+		packageScope:       packageScope,
+		pkgID:              pkgID,
+		typeDefns:          make(map[model.SymbolRef]*ast.BLangTypeDefinition),
+		classDefns:         make(map[model.SymbolRef]*ast.BLangClassDefinition),
+		packageSymbols:     make(map[string]model.SymbolRef),
+		packageAnnotations: make(map[string]model.SymbolRef),
+		prevPos:            make(map[string]prevPos),
+		usedPrefixes:       make(map[string]bool),
 	}
 }
 
 func (ms *moduleSymbolResolver) forCompilationUnit(scope *model.ModuleScope) *moduleSymbolResolver {
 	return &moduleSymbolResolver{
-		ctx:            ms.ctx,
-		tyCtx:          ms.tyCtx,
-		scope:          scope,
-		packageScope:   ms.packageScope,
-		pkgID:          ms.pkgID,
-		typeDefns:      ms.typeDefns,
-		classDefns:     ms.classDefns,
-		packageSymbols: ms.packageSymbols,
-		prevPos:        ms.prevPos,
-		usedPrefixes:   make(map[string]bool),
-		defaultCounter: ms.defaultCounter,
-		varTracker:     ms.varTracker,
+		ctx:                ms.ctx,
+		tyCtx:              ms.tyCtx,
+		scope:              scope,
+		packageScope:       ms.packageScope,
+		pkgID:              ms.pkgID,
+		typeDefns:          ms.typeDefns,
+		classDefns:         ms.classDefns,
+		packageSymbols:     ms.packageSymbols,
+		packageAnnotations: ms.packageAnnotations,
+		prevPos:            ms.prevPos,
+		usedPrefixes:       make(map[string]bool),
+		defaultCounter:     ms.defaultCounter,
+		varTracker:         ms.varTracker,
 	}
 }
 
@@ -289,6 +302,15 @@ func (ms *moduleSymbolResolver) GetPrefixedSymbol(prefix, name string) (model.Sy
 	return ms.scope.GetPrefixedSymbol(prefix, name)
 }
 
+func (ms *moduleSymbolResolver) GetAnnotationSymbol(prefix, name string) (model.SymbolRef, bool) {
+	if prefix == "" {
+		ref, ok := ms.packageAnnotations[name]
+		return ref, ok
+	}
+	ms.usedPrefixes[prefix] = true
+	return ms.scope.GetPrefixedAnnotationSymbol(prefix, name)
+}
+
 func (ms *moduleSymbolResolver) AddSymbol(name string, symbol model.Symbol) {
 	ms.scope.AddSymbol(name, symbol)
 }
@@ -327,6 +349,10 @@ func (bs *blockSymbolResolver) GetPrefixedSymbol(prefix, name string) (model.Sym
 	return bs.parent.GetPrefixedSymbol(prefix, name)
 }
 
+func (bs *blockSymbolResolver) GetAnnotationSymbol(prefix, name string) (model.SymbolRef, bool) {
+	return bs.parent.GetAnnotationSymbol(prefix, name)
+}
+
 func (bs *blockSymbolResolver) AddSymbol(name string, symbol model.Symbol) {
 	bs.scope.AddSymbol(name, symbol)
 }
@@ -438,9 +464,6 @@ func (ms *moduleSymbolResolver) allocateFunctionSymbolInner(fn *ast.BLangFunctio
 		paramNames[i] = fn.RequiredParams[i].GetName().GetValue()
 	}
 	if ms.isDependentlyTyped(fn) {
-		if fn.RestParam != nil {
-			ms.ctx.Unimplemented("rest parameters are not supported on dependently-typed functions", fn.GetPosition())
-		}
 		return model.NewDependentlyTypedFunctionSymbol(name, paramNames, len(fn.RequiredParams), fn.FuncSymbolFlags(), isPublic)
 	}
 	return model.NewFunctionSymbol(name, model.FunctionSignature{}, isPublic)
@@ -553,8 +576,53 @@ func (ms *moduleSymbolResolver) allocateTopLevelSymbols(cu *ast.BLangCompilation
 			ms.allocateGlobalVarSymbol(n)
 		case *ast.BLangClassDefinition:
 			ms.allocateClassSymbol(n)
+		case *ast.BLangAnnotation:
+			ms.allocateAnnotationSymbol(n)
+		}
+	}
+}
+
+var attachPointsByName = map[ast.Point]model.AttachPoints{
+	ast.Point_TYPE:           model.AttachPointType,
+	ast.Point_CLASS:          model.AttachPointClass,
+	ast.Point_FUNCTION:       model.AttachPointFunction,
+	ast.Point_OBJECT_METHOD:  model.AttachPointObjectMethod,
+	ast.Point_SERVICE_REMOTE: model.AttachPointServiceRemoteMethod,
+	ast.Point_PARAMETER:      model.AttachPointParameter,
+	ast.Point_RETURN:         model.AttachPointReturn,
+	ast.Point_SERVICE:        model.AttachPointService,
+	ast.Point_FIELD:          model.AttachPointField,
+	ast.Point_OBJECT_FIELD:   model.AttachPointObjectField,
+	ast.Point_RECORD_FIELD:   model.AttachPointRecordField,
+	ast.Point_LISTENER:       model.AttachPointListener,
+	ast.Point_ANNOTATION:     model.AttachPointAnnotation,
+	ast.Point_EXTERNAL:       model.AttachPointExternal,
+	ast.Point_VAR:            model.AttachPointVar,
+	ast.Point_CONST:          model.AttachPointConst,
+	ast.Point_WORKER:         model.AttachPointWorker,
+}
+
+func (ms *moduleSymbolResolver) allocateAnnotationSymbol(annotation *ast.BLangAnnotation) {
+	name := annotation.Name.Value
+	var attachPoints, sourceOnly model.AttachPoints
+	for _, attachPoint := range annotation.AttachPoints {
+		point, ok := attachPointsByName[attachPoint.Point]
+		if !ok {
+			semanticError(ms, "invalid attach point '"+string(attachPoint.Point)+"'", annotation.GetPosition())
+			continue
 		}
+		attachPoints |= point
+		if attachPoint.Source {
+			sourceOnly |= point
+		}
+	}
+	if _, exists := ms.packageAnnotations[name]; exists {
+		semanticError(ms, "redeclared annotation '"+name+"'", annotation.Name.GetPosition())
+		return
 	}
+	ms.scope.AddAnnotationSymbol(name, model.NewAnnotationSymbol(name, annotation.IsPublic(), annotation.IsConst(), attachPoints, sourceOnly))
+	ref, _ := ms.scope.Annotation.GetSymbol(name)
+	ms.packageAnnotations[name] = ref
 }
 
 func (ms *moduleSymbolResolver) allocateTypeSymbol(typeDef *ast.BLangTypeDefinition) {
@@ -567,16 +635,20 @@ func (ms *moduleSymbolResolver) allocateTypeSymbol(typeDef *ast.BLangTypeDefinit
 	case *ast.BLangObjectType:
 		symbol = new(model.NewObjectTypeSymbol(name, isPublic))
 	default:
-		symbol = new(model.NewTypeSymbol(name, isPublic))
+		if typeDef.IsDistinct() {
+			symbol = new(model.NewDistinctTypeSymbol(name, isPublic))
+		} else {
+			symbol = new(model.NewTypeSymbol(name, isPublic))
+		}
 	}
 	if !addTopLevelSymbol(ms, name, symbol, typeDef.Name.GetPosition()) {
 		return
 	}
 	symRef, _, _ := ms.GetSymbol(name)
 	if typeDef.IsDistinct() {
-		carrier, ok := ms.ctx.GetSymbol(symRef).(model.ObjectType)
+		carrier, ok := ms.ctx.GetSymbol(symRef).(model.DistinctType)
 		if !ok {
-			ms.ctx.Unimplemented("distinct types are only supported for object types", typeDef.GetPosition())
+			ms.ctx.SemanticError("distinct type descriptor must be an error or object type", typeDef.GetPosition())
 		} else {
 			carrier.SetDistinctTypeIDs([]int{ms.ctx.DistinctTypeID(symRef)})
 		}
@@ -709,17 +781,21 @@ func fillinOpaqueSymbol(sym model.Symbol, space *model.SymbolSpace) {
 	fn.Lookup, fn.Store = newMonomorphizationCache()
 }
 
-// TODO: when we have cases where we have more than one type param types we need to properly
-// implement this
+// newMonomorphizationCache returns a lookup/store pair keyed by the (ordered) list of type
+// parameter types a monomorphized instance was created for.
 func newMonomorphizationCache() (func(...semtypes.SemType) (model.SymbolRef, bool), func(model.SymbolRef, ...semtypes.SemType)) {
 	var mu sync.Mutex
 	interner := semtypes.NewSemtypeInterner()
-	cache := make(map[semtypes.InternHandle]model.SymbolRef)
-	keyOf := func(keys []semtypes.SemType) semtypes.InternHandle {
-		if len(keys) != 1 {
-			panic("monomorphization cache supports a single key type")
+	cache := make(map[string]model.SymbolRef)
+	keyOf := func(keys []semtypes.SemType) string {
+		var sb strings.Builder
+		for i, key := range keys {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			fmt.Fprintf(&sb, "%d", interner.Intern(key))
 		}
-		return interner.Intern(keys[0])
+		return sb.String()
 	}
 	lookup := func(keys ...semtypes.SemType) (model.SymbolRef, bool) {
 		mu.Lock()
@@ -777,25 +853,32 @@ func isExternalFunctionBody(body ast.FunctionBodyNode) bool {
 	return ok
 }
 
-func allocateDefaultParamSymbols(alloc defaultSymbolAllocator, targetScope model.Scope, function *ast.BLangFunction) {
-	if len(function.RequiredParams) == 0 {
+func allocateDefaultParamSymbols(alloc defaultSymbolAllocator, targetScope model.Scope, function defaultableParamOwner) {
+	allocateDefaultParamSymbolsExcept(alloc, targetScope, function, nil)
+}
+
+// allocateDefaultParamSymbolsExcept is allocateDefaultParamSymbols except that the parameters in unavailable are
+// treated as required, since no function can provide their defaults.
+func allocateDefaultParamSymbolsExcept(alloc defaultSymbolAllocator, targetScope model.Scope, function defaultableParamOwner, unavailable map[int]bool) {
+	requiredParams := function.RequiredParameters()
+	if len(requiredParams) == 0 {
 		return
 	}
 	cx := alloc.GetCtx()
 	fnSymRef := function.Symbol()
 	fnSym := cx.GetSymbol(fnSymRef).(model.FunctionSymbol)
-	info := model.NewDefaultableParamInfo(len(function.RequiredParams))
+	info := model.NewDefaultableParamInfo(len(requiredParams))
 	var inclInfo *model.IncludedRecordParamInfo
-	for i := range function.RequiredParams {
-		param := &function.RequiredParams[i]
+	for i := range requiredParams {
+		param := &requiredParams[i]
 		if param.IsIncludedRecordParam() {
 			if inclInfo == nil {
-				inclInfo = model.NewIncludedRecordParamInfo(len(function.RequiredParams))
+				inclInfo = model.NewIncludedRecordParamInfo(len(requiredParams))
 			}
 			inclInfo.Set(i)
 			continue
 		}
-		if !param.IsDefaultableParam() {
+		if !param.IsDefaultableParam() || unavailable[i] {
 			continue
 		}
 		if _, ok := param.Expr.(*ast.BLangInferredTypedescDefault); ok {
@@ -813,6 +896,71 @@ func allocateDefaultParamSymbols(alloc defaultSymbolAllocator, targetScope model
 	fnSym.SetIncludedRecordParams(inclInfo)
 }
 
+// enclosingModuleResolver returns the resolver of the module enclosing resolver. The functions providing the defaults
+// of the parameters of anonymous functions are module level functions.
+func enclosingModuleResolver(resolver symbolResolver) *moduleSymbolResolver {
+	for {
+		block, ok := resolver.(*blockSymbolResolver)
+		if !ok {
+			return resolver.(*moduleSymbolResolver)
+		}
+		resolver = block.parent
+	}
+}
+
+// capturingLambdaDefaults returns the indexes of the parameters of an anonymous function whose defaults refer to
+// local variables of the enclosing functions. The module level functions providing the defaults cannot refer to
+// them, so these parameters are required.
+func capturingLambdaDefaults(ms *moduleSymbolResolver, function *ast.BLangFunction) map[int]bool {
+	checker := &lambdaDefaultChecker{ms: ms, params: make(map[model.SymbolRef]bool)}
+	var capturing map[int]bool
+	for i := range function.RequiredParams {
+		param := &function.RequiredParams[i]
+		if param.IsDefaultableParam() {
+			checker.captures = false
+			ast.Walk(checker, param.Expr.(ast.BLangNode))
+			if checker.captures {
+				if capturing == nil {
+					capturing = make(map[int]bool)
+				}
+				capturing[i] = true
+			}
+		}
+		checker.params[param.Symbol()] = true
+	}
+	return capturing
+}
+
+type lambdaDefaultChecker struct {
+	ms *moduleSymbolResolver
+	// params are the preceding parameters, which the default of a parameter can refer to.
+	params   map[model.SymbolRef]bool
+	captures bool
+}
+
+var _ ast.Visitor = &lambdaDefaultChecker{}
+
+func (c *lambdaDefaultChecker) Visit(node ast.BLangNode) ast.Visitor {
+	switch n := node.(type) {
+	case nil, *ast.BLangLambdaFunction:
+		return nil
+	case *ast.BLangSimpleVarRef:
+		sym := n.Symbol()
+		if sym.IsEmpty() || c.params[sym] {
+			return nil
+		}
+		if ref, _, ok := c.ms.GetSymbol(n.VariableName.Value); !ok || ref != sym {
+			c.captures = true
+		}
+		return nil
+	}
+	return c
+}
+
+func (c *lambdaDefaultChecker) VisitTypeData(_ *ast.TypeData) ast.Visitor {
+	return nil
+}
+
 func resolveLambdaFunction(functionResolver *blockSymbolResolver, parent *blockSymbolResolver, function *ast.BLangFunction) {
 	// Check for shadowing on parameters against the enclosing function scope
 	for i := range function.RequiredParams {
@@ -950,10 +1098,20 @@ func (bs *blockSymbolResolver) Visit(node ast.BLangNode) ast.Visitor {
 	case *ast.BLangForeach:
 		resolveForeachSymbols(bs, n)
 		return nil
+	case *ast.BLangMatchClause:
+		resolveMatchClauseSymbols(bs, n)
+		return nil
+	case *ast.BLangOnFailClause:
+		resolveOnFailClauseSymbols(bs, n)
+		return nil
 	case *ast.BLangBlockStmt, *ast.BLangDo, *ast.BLangLock:
 		return newBlockSymbolResolverWithBlockScope(bs, n)
 	case *ast.BLangSimpleVariableDef:
 		defineVariable(bs, n.GetVariable(), n.GetVariable().(*ast.BLangSimpleVariable).IsFinal())
+	case *ast.BLangTupleVariableDef, *ast.BLangRecordVariableDef, *ast.BLangErrorVariableDef:
+		defineVariable(bs, n.(ast.VariableDefinitionNode).GetVariable(), false)
+	case *ast.BLangWorker:
+		defineWorker(bs, n)
 	case *ast.BLangLambdaFunction:
 		fn := n.Function
 		name := fn.Name.Value
@@ -963,6 +1121,8 @@ func (bs *blockSymbolResolver) Visit(node ast.BLangNode) ast.Visitor {
 		functionResolver := newFunctionResolver(bs, fn)
 		fn.SetScope(functionResolver.scope)
 		resolveLambdaFunction(functionResolver, bs, fn)
+		ms := enclosingModuleResolver(bs)
+		allocateDefaultParamSymbolsExcept(ms, ms.scope, fn, capturingLambdaDefaults(ms, fn))
 		return nil
 	default:
 		return visitInnerSymbolResolver(bs, n)
@@ -977,6 +1137,9 @@ func visitInnerSymbolResolver[T symbolResolver](resolver T, node ast.BLangNode)
 		resolveXMLElementLiteralNamespaces(resolver, resolver.GetScope(), n, rootNeeds)
 		mergeNamespaces(resolver, n, rootNeeds)
 		return nil
+	case *ast.BLangXMLElementFilter:
+		resolveXMLElementFilter(resolver, resolver.GetScope(), n)
+		return nil
 	case *ast.BLangXMLTemplateExpr:
 		resolveXMLTemplateNamespaces(resolver, resolver.GetScope(), n)
 		for _, ins := range n.Insertions {
@@ -997,7 +1160,17 @@ func visitInnerSymbolResolver[T symbolResolver](resolver T, node ast.BLangNode)
 		}
 	case *ast.BLangMappingConstructorExpr:
 		return resolveMappingConstructor(resolver, n)
-	case *ast.BLangQueryExpr:
+	case *ast.BLangAnnotationAttachment:
+		resolveAnnotationRef(resolver, n, n.PkgAlias, n.AnnotationName)
+		if n.Expr != nil {
+			ast.Walk(resolver, n.Expr.(ast.BLangNode))
+		}
+		return nil
+	case *ast.BLangAnnotAccessExpr:
+		resolveAnnotationRef(resolver, n, n.PkgAlias, n.AnnotationName)
+		ast.Walk(resolver, n.Expr.(ast.BLangNode))
+		return nil
+	case *ast.BLangQueryExpr, *ast.BLangLetExpr:
 		return newBlockSymbolResolverWithBlockScope(resolver, n)
 	case *ast.BLangInvocation:
 		if n.GetExpression() != nil {
@@ -1008,7 +1181,13 @@ func visitInnerSymbolResolver[T symbolResolver](resolver T, node ast.BLangNode)
 	case *ast.BLangRemoteMethodCallAction:
 		// We are creating a deferred symbol here since without determining the type of the reciever we can't determine the actual function symbol
 		createDeferredMethodSymbol(resolver, n)
+	case *ast.BLangTupleVariable, *ast.BLangRecordVariable, *ast.BLangErrorVariable:
+		// Symbols of binding pattern variables are set when the pattern is defined.
 	case ast.VariableNode:
+		if simple, ok := n.(*ast.BLangSimpleVariable); ok && ast.IsWildcardVariable(simple) {
+			// Each wildcard has its own symbol, which can't be found by name.
+			break
+		}
 		referVariable(resolver, n.(variableNode))
 	case ast.SimpleVariableReferenceNode:
 		referSimpleVariableReference(resolver, n)
@@ -1148,6 +1327,56 @@ func referVariable[T symbolResolver](resolver T, variable variableNode) {
 	resolveSymbolRef(resolver, name, "", variable.GetPosition(), variable)
 }
 
+func nextPatternSymbolName(resolver *blockSymbolResolver) string {
+	var current symbolResolver = resolver
+	for {
+		switch r := current.(type) {
+		case *blockSymbolResolver:
+			current = r.parent
+		case *moduleSymbolResolver:
+			name := fmt.Sprintf("$pattern$%d", r.patternCounter)
+			r.patternCounter++
+			return name
+		default:
+			panic(fmt.Sprintf("unexpected symbol resolver %T", r))
+		}
+	}
+}
+
+// bindingPatternMembers returns the variables of the binding patterns directly nested in the
+// list, mapping or error binding pattern of variable.
+func bindingPatternMembers(variable ast.VariableNode) []ast.VariableNode {
+	var members []ast.VariableNode
+	switch v := variable.(type) {
+	case *ast.BLangTupleVariable:
+		members = append(members, v.Members...)
+		if v.RestVariable != nil {
+			members = append(members, v.RestVariable)
+		}
+	case *ast.BLangRecordVariable:
+		for _, field := range v.Fields {
+			members = append(members, field.Value)
+		}
+		if v.RestVariable != nil {
+			members = append(members, v.RestVariable)
+		}
+	case *ast.BLangErrorVariable:
+		if v.Message != nil {
+			members = append(members, v.Message)
+		}
+		if v.Cause != nil {
+			members = append(members, v.Cause)
+		}
+		for _, entry := range v.Detail {
+			members = append(members, entry.Value)
+		}
+		if v.RestDetail != nil {
+			members = append(members, v.RestDetail)
+		}
+	}
+	return members
+}
+
 // isShadowed checks if a name is already defined in an enclosing block scope.
 // Mapping constructor scopes contain record keys that are not real variable bindings, so they are skipped.
 func isShadowed(resolver *blockSymbolResolver, name string) bool {
@@ -1172,6 +1401,19 @@ func isShadowed(resolver *blockSymbolResolver, name string) bool {
 	return false
 }
 
+// defineWorker binds the name of a named worker to a final variable holding the future for its result. The variable
+// is not tracked as unused, since a worker need not be waited on. A worker redeclaring a name is left unbound; the
+// worker analyzer reports redeclared workers.
+func defineWorker(resolver *blockSymbolResolver, worker *ast.BLangWorker) {
+	name := worker.Name.Value
+	if isShadowed(resolver, name) {
+		return
+	}
+	symbol := model.NewValueSymbol(name, false, true, false)
+	symbol.SetFinal()
+	addSymbolAndSetOnNode(resolver, name, &symbol, worker)
+}
+
 func defineVariable(resolver *blockSymbolResolver, variable ast.VariableNode, isFinal bool) {
 	switch variable := variable.(type) {
 	case *ast.BLangSimpleVariable:
@@ -1185,6 +1427,20 @@ func defineVariable(resolver *blockSymbolResolver, variable ast.VariableNode, is
 		}
 		addSymbolAndSetOnNode(resolver, name, &symbol, variable)
 		markInit(resolver, name, variable.Symbol(), variable.GetPosition())
+	case *ast.BLangTupleVariable, *ast.BLangRecordVariable, *ast.BLangErrorVariable:
+		// The value matched by a list, mapping or error binding pattern is held by a hidden
+		// variable, so that desugar can destructure it member by member.
+		name := nextPatternSymbolName(resolver)
+		symbol := model.NewValueSymbol(name, false, true, false)
+		symbol.SetFinal()
+		addSymbolAndSetOnNode(resolver, name, &symbol, variable.(ast.BNodeWithSymbol))
+		for _, member := range bindingPatternMembers(variable) {
+			if simple, ok := member.(*ast.BLangSimpleVariable); ok {
+				defineVariable(resolver, simple, isFinal || simple.IsFinal())
+			} else {
+				defineVariable(resolver, member, isFinal)
+			}
+		}
 	default:
 		internalError(resolver, "Unsupported variable", variable.GetPosition())
 		return
@@ -1207,6 +1463,65 @@ func resolveForeachSymbols(bs *blockSymbolResolver, n *ast.BLangForeach) {
 	}
 }
 
+// resolveOnFailClauseSymbols defines the variable bound to the error of an on-fail clause in a scope enclosing the
+// body of the clause.
+func resolveOnFailClauseSymbols(bs *blockSymbolResolver, clause *ast.BLangOnFailClause) {
+	if clause.Body == nil {
+		return
+	}
+	resolver := newBlockSymbolResolverWithBlockScope(bs, clause)
+	if clause.VariableDefinitionNode != nil {
+		defineVariable(resolver, clause.VariableDefinitionNode.GetVariable(), true)
+		ast.Walk(resolver, clause.VariableDefinitionNode.Var)
+	}
+	ast.Walk(resolver, clause.Body)
+}
+
+// resolveMatchClauseSymbols defines the variables bound by the match patterns of clause in a scope enclosing its
+// guard and body. Every match pattern of the clause must bind the same variables, which share their symbols.
+func resolveMatchClauseSymbols(bs *blockSymbolResolver, clause *ast.BLangMatchClause) {
+	resolver := newBlockSymbolResolverWithBlockScope(bs, clause)
+	clause.SetScope(resolver.scope)
+	var names []string
+	for i, pattern := range clause.Patterns {
+		variables := ast.MatchPatternVariables(pattern)
+		if i == 0 {
+			for _, variable := range variables {
+				names = append(names, variable.Name.Value)
+				defineVariable(resolver, variable, true)
+			}
+			continue
+		}
+		sameNames := len(variables) == len(names)
+		var bound []string
+		for _, variable := range variables {
+			name := variable.Name.Value
+			if containsName(bound, name) {
+				semanticError(resolver, "Variable already defined: "+name, variable.GetPosition())
+				continue
+			}
+			bound = append(bound, name)
+			if containsName(names, name) {
+				continue
+			}
+			sameNames = false
+			if _, ok := resolver.scope.MainSpace().GetSymbol(name); !ok {
+				defineVariable(resolver, variable, true)
+			}
+		}
+		if !sameNames {
+			semanticError(resolver, "all match patterns of a match clause must bind the same variables", pattern.GetPosition())
+		}
+	}
+	for _, pattern := range clause.Patterns {
+		ast.Walk(resolver, pattern.(ast.BLangNode))
+	}
+	if clause.Guard != nil {
+		ast.Walk(resolver, clause.Guard)
+	}
+	ast.Walk(resolver, &clause.Body)
+}
+
 func (bs *blockSymbolResolver) VisitTypeData(typeData *ast.TypeData) ast.Visitor {
 	if typeData.TypeDescriptor == nil {
 		return nil
@@ -1291,8 +1606,18 @@ func (ms *moduleSymbolResolver) Visit(node ast.BLangNode) ast.Visitor {
 			internalError(ms, "Module level class symbol not found: "+name, n.Name.GetPosition())
 		}
 		n.SetSymbol(symRef)
+		for _, attachment := range n.GetAnnotationAttachments() {
+			ast.Walk(ms, attachment.(ast.BLangNode))
+		}
 		resolveClassDefinition(ms, n)
 		return nil
+	case *ast.BLangAnnotation:
+		symRef, ok := ms.packageAnnotations[n.Name.Value]
+		if !ok {
+			internalError(ms, "Module level annotation symbol not found: "+n.Name.Value, n.Name.GetPosition())
+		}
+		n.SetSymbol(symRef)
+		return ms
 	case *ast.BLangService:
 		resolveServiceDefinition(ms, n)
 		return nil
@@ -1307,6 +1632,7 @@ func (ms *moduleSymbolResolver) Visit(node ast.BLangNode) ast.Visitor {
 		functionResolver := newFunctionResolver(ms, fn)
 		fn.SetScope(functionResolver.scope)
 		resolveLambdaFunction(functionResolver, functionResolver, fn)
+		allocateDefaultParamSymbols(ms, ms.scope, fn)
 		return nil
 	default:
 		return visitInnerSymbolResolver(ms, n)
@@ -1605,6 +1931,7 @@ func finishResolveClassDefinition(ms *moduleSymbolResolver, blockRes *blockSymbo
 		methodResolver := newFunctionResolver(blockRes, rm)
 		rm.SetScope(methodResolver.scope)
 		resolveResourceMethod(methodResolver, rm)
+		allocateDefaultParamSymbols(ms, ms.scope, rm)
 	}
 }
 
@@ -1726,6 +2053,22 @@ func internalError[T symbolResolver](resolver T, message string, pos diagnostics
 	resolver.GetCtx().InternalError(message, pos)
 }
 
+func resolveAnnotationRef[T symbolResolver](resolver T, node ast.BNodeWithSymbol, pkgAlias, name *ast.BLangIdentifier) {
+	prefix := ""
+	if pkgAlias != nil {
+		prefix = pkgAlias.Value
+	}
+	if symRef, ok := resolver.GetAnnotationSymbol(prefix, name.Value); ok {
+		node.SetSymbol(symRef)
+		return
+	}
+	qualifiedName := name.Value
+	if prefix != "" {
+		qualifiedName = prefix + ":" + qualifiedName
+	}
+	semanticError(resolver, "undefined annotation '"+qualifiedName+"'", name.GetPosition())
+}
+
 func semanticError[T symbolResolver](resolver T, message string, pos diagnostics.Location) {
 	resolver.GetCtx().SemanticError(message, pos)
 }
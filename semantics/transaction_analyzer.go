@@ -0,0 +1,230 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semantics
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/context"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/tools/diagnostics"
+)
+
+// transactionAnalyzer analyzes the body of a transaction or retry statement, or
+// the body of an `if transactional { ... }` statement. The latter is a
+// transactional scope but not a transaction statement, so commit and rollback
+// are not allowed in it.
+type transactionAnalyzer struct {
+	analyzerBase
+	stmt ast.BLangNode
+}
+
+var _ analyzer = &transactionAnalyzer{}
+
+func initializeTransactionAnalyzer(parent analyzer, stmt ast.BLangNode) *transactionAnalyzer {
+	return &transactionAnalyzer{
+		analyzerBase: analyzerBase{parent: parent},
+		stmt:         stmt,
+	}
+}
+
+func (ta *transactionAnalyzer) Visit(node ast.BLangNode) ast.Visitor {
+	if node == nil {
+		return nil
+	}
+	return visitInner(ta, node)
+}
+
+func (ta *transactionAnalyzer) VisitTypeData(_ *ast.TypeData) ast.Visitor { return ta }
+
+func (ta *transactionAnalyzer) loc() diagnostics.Location { return ta.stmt.GetPosition() }
+
+func (ta *transactionAnalyzer) ctx() *context.CompilerContext { return ta.parent.ctx() }
+func (ta *transactionAnalyzer) tyCtx() semtypes.Context       { return ta.parent.tyCtx() }
+func (ta *transactionAnalyzer) unimplementedErr(m string, l diagnostics.Location) {
+	ta.parent.ctx().Unimplemented(m, l)
+}
+
+func (ta *transactionAnalyzer) semanticErr(m string, l diagnostics.Location) {
+	ta.parent.ctx().SemanticError(m, l)
+}
+
+func (ta *transactionAnalyzer) syntaxErr(m string, l diagnostics.Location) {
+	ta.parent.ctx().SyntaxError(m, l)
+}
+
+func (ta *transactionAnalyzer) internalErr(m string, l diagnostics.Location) {
+	ta.parent.ctx().InternalError(m, l)
+}
+
+// enclosingTransaction returns the transaction statement lexically enclosing a
+// within the same closure, or nil if there is none.
+func enclosingTransaction(a analyzer) *ast.BLangTransaction {
+	for cur := a; cur != nil; cur = cur.parentAnalyzer() {
+		if ta, ok := cur.(*transactionAnalyzer); ok {
+			if trx, ok := ta.stmt.(*ast.BLangTransaction); ok {
+				return trx
+			}
+		}
+		if _, isFn := cur.(*functionAnalyzer); isFn {
+			return nil
+		}
+	}
+	return nil
+}
+
+// inTransactionalScope reports whether a is in a transactional scope: the body
+// of a transaction statement, the body of an `if transactional` statement or
+// the body of a transactional function.
+func inTransactionalScope(a analyzer) bool {
+	for cur := a; cur != nil; cur = cur.parentAnalyzer() {
+		switch cur := cur.(type) {
+		case *transactionAnalyzer:
+			if _, isRetry := cur.stmt.(*ast.BLangRetry); !isRetry {
+				return true
+			}
+		case *functionAnalyzer:
+			fn, ok := cur.function.(interface{ IsTransactional() bool })
+			return ok && fn.IsTransactional()
+		}
+	}
+	return false
+}
+
+func analyzeTransaction[A analyzer](a A, trx *ast.BLangTransaction) ast.Visitor {
+	if inTransactionalScope(a) {
+		a.semanticErr("transaction statement cannot be used within a transactional scope", trx.GetPosition())
+		return nil
+	}
+	if !containsTransactionEnd(&trx.Body) {
+		a.semanticErr("transaction statement must contain a commit action or a rollback statement", trx.GetPosition())
+	}
+	return initializeTransactionAnalyzer(a, trx)
+}
+
+func analyzeRetry[A analyzer](a A, retry *ast.BLangRetry) ast.Visitor {
+	spec := &retry.RetrySpec
+	if spec.RetryManager != nil {
+		if !analyzeNewExpression(a, spec.RetryManager, semtypes.SemType{}) {
+			return nil
+		}
+	} else {
+		if len(spec.ArgExprs) > 1 {
+			a.semanticErr("default retry manager accepts at most one argument", spec.ArgExprs[1].GetPosition())
+			return nil
+		}
+		for _, arg := range spec.ArgExprs {
+			if !analyzeActionOrExpression(a, arg, semtypes.INT) {
+				return nil
+			}
+		}
+	}
+	if retry.Transaction != nil {
+		if v := analyzeTransaction(a, retry.Transaction); v != nil {
+			ast.Walk(v, &retry.Transaction.Body)
+		}
+		return nil
+	}
+	ast.Walk(initializeTransactionAnalyzer(a, retry), &retry.Body)
+	return nil
+}
+
+func analyzeRollback[A analyzer](a A, rollback *ast.BLangRollback) bool {
+	if enclosingTransaction(a) == nil {
+		a.semanticErr("rollback statement can only be used within a transaction statement", rollback.GetPosition())
+		return false
+	}
+	if rollback.Expr != nil {
+		return analyzeActionOrExpression(a, rollback.Expr, commitResultType)
+	}
+	return true
+}
+
+func validateCommit[A analyzer](a A, commit *ast.BLangCommitExpr) {
+	if enclosingTransaction(a) == nil {
+		a.semanticErr("commit action can only be used within a transaction statement", commit.GetPosition())
+	}
+}
+
+func validateTransactionalInvocation[A analyzer](a A, inv *ast.BLangInvocation) {
+	if ast.IsStreamOperation(inv) {
+		return
+	}
+	fnSymbol, ok := a.ctx().GetSymbol(inv.ResolvedSymbol()).(model.FunctionSymbol)
+	if !ok {
+		return
+	}
+	if sig := fnSymbol.Signature(); !sig.IsTransactional() {
+		return
+	}
+	if !inTransactionalScope(a) {
+		a.semanticErr("transactional function can only be called within a transactional scope", inv.GetPosition())
+	}
+}
+
+// validateLoopExit reports a break or continue that would leave a transaction
+// or retry statement without going through the end of its body.
+func validateLoopExit[A analyzer](a A, stmt ast.BLangNode, keyword string) {
+	for cur := analyzer(a); cur != nil; cur = cur.parentAnalyzer() {
+		switch cur := cur.(type) {
+		case *loopAnalyzer, *functionAnalyzer:
+			return
+		case *transactionAnalyzer:
+			switch cur.stmt.(type) {
+			case *ast.BLangTransaction:
+				a.semanticErr(keyword+" statement cannot be used to exit a transaction statement", stmt.GetPosition())
+				return
+			case *ast.BLangRetry:
+				a.semanticErr(keyword+" statement cannot be used to exit a retry statement", stmt.GetPosition())
+				return
+			}
+		}
+	}
+}
+
+func isTransactionalCondition(expr ast.BLangExpression) bool {
+	_, ok := expr.(*ast.BLangTransactionalExpr)
+	return ok
+}
+
+// containsTransactionEnd reports whether body contains a commit action or a
+// rollback statement outside of any nested function.
+func containsTransactionEnd(body *ast.BLangBlockStmt) bool {
+	finder := &transactionEndFinder{}
+	ast.Walk(finder, body)
+	return finder.found
+}
+
+type transactionEndFinder struct {
+	found bool
+}
+
+func (f *transactionEndFinder) Visit(node ast.BLangNode) ast.Visitor {
+	if f.found || node == nil {
+		return nil
+	}
+	switch node.(type) {
+	case *ast.BLangCommitExpr, *ast.BLangRollback:
+		f.found = true
+		return nil
+	case *ast.BLangLambdaFunction, *ast.BLangWorker:
+		return nil
+	}
+	return f
+}
+
+func (f *transactionEndFinder) VisitTypeData(_ *ast.TypeData) ast.Visitor { return nil }
@@ -19,6 +19,7 @@ package semantics
 import (
 	"fmt"
 	"maps"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -313,8 +314,11 @@ type functionTypeResolver struct {
 	mappingAtomToBType   map[*semtypes.MappingAtomicType]ast.BType
 	monoCounters         map[string]int
 	defaultFnSymbolCount int
-	scope                model.Scope
-	mappingAtomToSymRef  map[*semtypes.MappingAtomicType]model.SymbolRef
+	// defaultFnPrefix qualifies the names of default functions allocated in this resolver so that they don't
+	// clash with those of other functions.
+	defaultFnPrefix     string
+	scope               model.Scope
+	mappingAtomToSymRef map[*semtypes.MappingAtomicType]model.SymbolRef
 }
 
 func (f *functionTypeResolver) typeContext() semtypes.Context        { return f.tyCtx }
@@ -443,7 +447,7 @@ func (f *functionTypeResolver) currentScope() model.Scope     { return f.scope }
 func (f *functionTypeResolver) setCurrentScope(s model.Scope) { f.scope = s }
 
 func (f *functionTypeResolver) nextDefaultFnName() string {
-	name := fmt.Sprintf("$desugar$%d", f.defaultFnSymbolCount)
+	name := fmt.Sprintf("$desugar$%s$%d", f.defaultFnPrefix, f.defaultFnSymbolCount)
 	f.defaultFnSymbolCount++
 	return name
 }
@@ -612,9 +616,10 @@ func ResolveLocalNodes(ctx *context.CompilerContext, pkg *ast.BLangPackage, impo
 	fns := packageFunctionDecls(pkg)
 
 	allImports := make(map[string]ast.BLangImportPackage)
-	resolveFieldInitsInScope := func(scope model.Scope, fields []ast.SimpleVariableNode) {
+	resolveFieldInitsInScope := func(prefix string, scope model.Scope, fields []ast.SimpleVariableNode) {
 		ft := &functionTypeResolver{
 			parentResolver:      p,
+			defaultFnPrefix:     prefix,
 			tyCtx:               semtypes.ContextFrom(p.typeEnv()),
 			implicitImports:     make(map[string]ast.BLangImportPackage),
 			mappingAtomToBType:  make(map[*semtypes.MappingAtomicType]ast.BType),
@@ -632,11 +637,11 @@ func ResolveLocalNodes(ctx *context.CompilerContext, pkg *ast.BLangPackage, impo
 	}
 	for i := range pkg.ClassDefinitions {
 		c := &pkg.ClassDefinitions[i]
-		resolveFieldInitsInScope(c.Scope(), c.Fields)
+		resolveFieldInitsInScope(c.Name.Value, c.Scope(), c.Fields)
 	}
 	for i := range pkg.Services {
 		s := &pkg.Services[i]
-		resolveFieldInitsInScope(s.Scope(), s.Fields)
+		resolveFieldInitsInScope(fmt.Sprintf("$service$%d", i), s.Scope(), s.Fields)
 	}
 
 	resolvers := make([]*functionTypeResolver, len(fns))
@@ -653,8 +658,9 @@ func ResolveLocalNodes(ctx *context.CompilerContext, pkg *ast.BLangPackage, impo
 	for _, t := range resolvers {
 		maps.Copy(allImports, t.implicitImports)
 	}
-	for _, importNode := range allImports {
-		pkg.Imports = append(pkg.Imports, importNode)
+	// Add in name order so that the import list is deterministic.
+	for _, name := range slices.Sorted(maps.Keys(allImports)) {
+		pkg.Imports = append(pkg.Imports, allImports[name])
 	}
 }
 
@@ -747,6 +753,7 @@ func resolveInvokableSignature(t typeResolver, fn functionDecl, fnSym model.Func
 	fnType := fnDefn.Define(t.typeEnv(), paramListTy, returnTy,
 		semtypes.FunctionQualifiersFrom(t.typeEnv(), fn.IsIsolated(), fn.IsTransactional()))
 	updateSymbolType(t, fn, fnType)
+	registerFunctionParamsSymbol(t, fnType, fn.Symbol())
 	sig := fnSym.Signature()
 	sig.Flags |= fn.FuncSymbolFlags()
 	sig.ParamTypes = paramTypes
@@ -761,6 +768,29 @@ func resolveInvokableSignature(t typeResolver, fn functionDecl, fnSym model.Func
 	return fnType, paramTypes, restTy, returnTy, true
 }
 
+// registerFunctionParamsSymbol records the function symbol describing the parameters of fnType, so that calls
+// through values of that type can use named arguments and defaults.
+func registerFunctionParamsSymbol(t typeResolver, fnType semtypes.SemType, ref model.SymbolRef) {
+	if fat := semtypes.ToFunctionAtomicType(t.typeContext(), fnType); fat != nil {
+		t.compilerContext().SetFunctionParamsSymbol(fat, ref)
+	}
+}
+
+// functionParamsSymbol returns the function symbol describing the parameters of fnTy, if fnTy is a single function
+// type whose parameters are known.
+func functionParamsSymbol(t typeResolver, fnTy semtypes.SemType) (model.SymbolRef, model.FunctionSymbol, bool) {
+	fat := semtypes.ToFunctionAtomicType(t.typeContext(), fnTy)
+	if fat == nil {
+		return model.SymbolRef{}, nil, false
+	}
+	ref, ok := t.compilerContext().FunctionParamsSymbol(fat)
+	if !ok {
+		return model.SymbolRef{}, nil, false
+	}
+	fnSym, ok := t.getSymbol(ref).(model.FunctionSymbol)
+	return ref, fnSym, ok
+}
+
 func resolveFunctionBody(p *packageTypeResolver, fn functionDecl) *functionTypeResolver {
 	fnSymbol := p.getSymbol(fn.Symbol())
 	fnSym, ok := fnSymbol.(model.FunctionSymbol)
@@ -774,6 +804,7 @@ func resolveFunctionBody(p *packageTypeResolver, fn functionDecl) *functionTypeR
 		implicitImports:     make(map[string]ast.BLangImportPackage),
 		mappingAtomToBType:  make(map[*semtypes.MappingAtomicType]ast.BType),
 		monoCounters:        make(map[string]int),
+		defaultFnPrefix:     fn.GetName().GetValue(),
 		scope:               fn.Scope(),
 		mappingAtomToSymRef: make(map[*semtypes.MappingAtomicType]model.SymbolRef),
 	}
@@ -789,6 +820,7 @@ func resolveFunctionBody(p *packageTypeResolver, fn functionDecl) *functionTypeR
 	case *ast.BLangExternFunctionBody:
 		_ = body
 	case *ast.BLangBlockFunctionBody:
+		analyzeWorkers(ft, fn.Symbol(), body)
 		resolveBlockStatements(ft, nil, body.Stmts)
 		body.SetDeterminedType(semtypes.NEVER)
 	case *ast.BLangExprFunctionBody:
@@ -854,6 +886,9 @@ func (t *packageTypeResolver) resolveTopLevelTypes(pkg *ast.BLangPackage) {
 			return
 		}
 	}
+	if !resolvePackageAnnotations(t, pkg) {
+		return
+	}
 	for i := range pkg.Imports {
 		setOtherNodesAsNever(&pkg.Imports[i])
 	}
@@ -996,6 +1031,8 @@ func resolveAssignment(t typeResolver, chain *binding, s assignmentNode) (statem
 			return statementEffect{}, false
 		}
 		chain = lhsEffect.ifTrue
+	case *ast.BLangTupleVarRef, *ast.BLangRecordVarRef, *ast.BLangErrorVarRef:
+		return resolveBindingPatternAssignment(t, chain, s)
 	default:
 		var ok bool
 		lhsTy, _, ok = resolveActionOrExpression(t, nil, lhs, semtypes.SemType{})
@@ -1023,6 +1060,9 @@ func resolveStatementInner(t typeResolver, chain *binding, stmt ast.StatementNod
 	switch s := stmt.(type) {
 	case *ast.BLangSimpleVariableDef:
 		return resolveVariableDefStmt(t, chain, s)
+	case *ast.BLangTupleVariableDef, *ast.BLangRecordVariableDef, *ast.BLangErrorVariableDef:
+		effectChain, ok := resolveBindingPatternVarDef(t, chain, s.(ast.VariableDefinitionNode).GetVariable())
+		return defaultStmtEffect(effectChain), ok
 	case *ast.BLangAssignment:
 		return resolveAssignment(t, chain, s)
 	case *ast.BLangCompoundAssignment:
@@ -1064,7 +1104,6 @@ func resolveStatementInner(t typeResolver, chain *binding, stmt ast.StatementNod
 			return defaultStmtEffect(chain), false
 		}
 		s.Body.SetDeterminedType(semtypes.NEVER)
-		resolveOnFailClause(t, chain, &s.OnFailClause)
 		validateLoopAssignments(t, loopT, bodyEffect, chain)
 		result := exprEffect.ifFalse
 		for _, b := range loopT.breaks {
@@ -1073,7 +1112,7 @@ func resolveStatementInner(t typeResolver, chain *binding, stmt ast.StatementNod
 		if !bodyEffect.nonCompletion {
 			result = mergeChains(t, result, bodyEffect.binding, semtypes.Union)
 		}
-		return statementEffect{result, false}, true
+		return resolveOnFailClause(t, chain, s, &s.OnFailClause, statementEffect{result, false})
 	case *ast.BLangReturn:
 		if s.Expr != nil {
 			if _, _, ok := resolveActionOrExpression(t, chain, s.Expr, t.expectedReturnType()); !ok {
@@ -1085,8 +1124,18 @@ func resolveStatementInner(t typeResolver, chain *binding, stmt ast.StatementNod
 		return resolveBlockStatements(t, chain, s.Stmts)
 	case *ast.BLangLock:
 		effect, ok := resolveBlockStatements(t, chain, s.Body.Stmts)
+		if !ok {
+			return defaultStmtEffect(chain), false
+		}
 		s.Body.SetDeterminedType(semtypes.NEVER)
-		return effect, ok
+		return resolveOnFailClause(t, chain, s, s.OnFailClause, effect)
+	case *ast.BLangDo:
+		return resolveDo(t, chain, s)
+	case *ast.BLangFail:
+		if _, _, ok := resolveActionOrExpression(t, chain, s.Expr, semtypes.ERROR); !ok {
+			return defaultStmtEffect(chain), false
+		}
+		return statementEffect{nil, true}, true
 	case *ast.BLangForeach:
 		collectionTy, _, ok := resolveActionOrExpression(t, chain, s.Collection, semtypes.SemType{})
 		if !ok {
@@ -1110,9 +1159,6 @@ func resolveStatementInner(t typeResolver, chain *binding, stmt ast.StatementNod
 		loopT := &loopTypeResolver{parentResolver: t}
 		bodyEffect, ok := resolveBlockStatements(loopT, chain, s.Body.Stmts)
 		s.Body.SetDeterminedType(semtypes.NEVER)
-		if s.OnFailClause != nil {
-			resolveOnFailClause(t, chain, s.OnFailClause)
-		}
 		if !ok {
 			return defaultStmtEffect(chain), false
 		}
@@ -1124,7 +1170,7 @@ func resolveStatementInner(t typeResolver, chain *binding, stmt ast.StatementNod
 		if !bodyEffect.nonCompletion {
 			result = mergeChains(t, result, bodyEffect.binding, semtypes.Union)
 		}
-		return statementEffect{result, false}, true
+		return resolveOnFailClause(t, chain, s, s.OnFailClause, statementEffect{result, false})
 	case *ast.BLangPanic:
 		if _, _, ok := resolveActionOrExpression(t, chain, s.Expr, semtypes.ERROR); !ok {
 			return defaultStmtEffect(chain), false
@@ -1149,6 +1195,24 @@ func resolveStatementInner(t typeResolver, chain *binding, stmt ast.StatementNod
 	case *ast.BLangXMLNS:
 		resolveXMLNS(t, chain, s)
 		return defaultStmtEffect(chain), true
+	case *ast.BLangWorker:
+		return resolveWorker(t, chain, s)
+	case *ast.BLangFork:
+		result := chain
+		for i := range s.Workers {
+			effect, ok := resolveWorker(t, result, &s.Workers[i])
+			if !ok {
+				return defaultStmtEffect(chain), false
+			}
+			result = effect.binding
+		}
+		return defaultStmtEffect(result), true
+	case *ast.BLangTransaction:
+		return resolveTransaction(t, chain, s)
+	case *ast.BLangRetry:
+		return resolveRetry(t, chain, s)
+	case *ast.BLangRollback:
+		return resolveRollback(t, chain, s)
 	default:
 		t.internalError(fmt.Sprintf("unhandled statement type: %T", stmt), stmt.GetPosition())
 		return defaultStmtEffect(chain), false
@@ -1165,20 +1229,6 @@ func resolveXMLNS(t typeResolver, chain *binding, decl *ast.BLangXMLNS) {
 	}
 }
 
-func resolveOnFailClause(t typeResolver, chain *binding, clause *ast.BLangOnFailClause) {
-	clause.SetDeterminedType(semtypes.NEVER)
-	if clause.VariableDefinitionNode != nil {
-		varDef := clause.VariableDefinitionNode
-		variable := varDef.GetVariable().(*ast.BLangSimpleVariable)
-		resolveSimpleVariable(t, chain, variable)
-		varDef.SetDeterminedType(semtypes.NEVER)
-	}
-	if clause.Body != nil {
-		resolveBlockStatements(t, chain, clause.Body.Stmts)
-		clause.Body.SetDeterminedType(semtypes.NEVER)
-	}
-}
-
 func resolveFunctionSignature(t typeResolver, fn *ast.BLangFunction) (semtypes.SemType, bool) {
 	fnSym := t.getSymbol(fn.Symbol())
 	if depSym, ok := fnSym.(model.DependentlyTypedFunctionSymbol); ok {
@@ -1274,6 +1324,16 @@ func resolveDependentlyTypedFunctionSignature(t typeResolver, fn *ast.BLangFunct
 		paramTypes[i] = p.GetDeterminedType()
 		paramsByName[p.GetName().GetValue()] = param{index: i, ty: paramTypes[i]}
 	}
+	if fn.RestParam != nil {
+		restParam := fn.RestParam.(*ast.BLangSimpleVariable)
+		resolveSimpleVariable(t, nil, restParam)
+		elementType := restParam.GetDeterminedType()
+		listDefn := semtypes.NewListDefinition()
+		restParamListTy := listDefn.DefineListTypeWrapped(t.typeEnv(), []semtypes.SemType{}, 0, elementType, semtypes.CellMutability_CELL_MUT_NONE)
+		restParam.SetDeterminedType(restParamListTy)
+		updateSymbolType(t, restParam, restParamListTy)
+		sym.SetRestParamType(elementType)
+	}
 	retTd, ok := fn.GetReturnTypeDescriptor().(ast.BLangNode)
 	if !ok {
 		t.internalError("dependently-typed function has no return type descriptor", fn.GetPosition())
@@ -1366,8 +1426,81 @@ func buildReturnTypeOp(t typeResolver, params map[string]param, node ast.BLangNo
 	}
 }
 
-func resolveLambdaFunctionExpr(t typeResolver, chain *binding, e *ast.BLangLambdaFunction) (semtypes.SemType, expressionEffect, bool) {
-	fnType, ok := resolveFunctionSignature(t, e.Function)
+// resolveInferredFunctionSignature resolves the parameter types of an implicit anonymous function from the
+// contextually expected function type and records the return type expected by the context in the signature. The
+// function type itself is defined once the type of the body is known.
+func resolveInferredFunctionSignature(t typeResolver, fn *ast.BLangFunction, expectedType semtypes.SemType) bool {
+	cx := t.typeContext()
+	fnTy := semtypes.NEVER
+	if !semtypes.IsZero(expectedType) {
+		fnTy = semtypes.Intersect(expectedType, semtypes.FUNCTION)
+	}
+	if semtypes.IsEmpty(cx, fnTy) {
+		t.semanticError("cannot infer the type of an implicit anonymous function without a contextually expected function type", fn.GetPosition())
+		return false
+	}
+	paramCount := len(fn.RequiredParams)
+	anyArgs := make([]semtypes.SemType, paramCount)
+	for i := range anyArgs {
+		anyArgs[i] = semtypes.VAL
+	}
+	arityDefn := semtypes.NewListDefinition()
+	arityTy := arityDefn.DefineListTypeWrapped(t.typeEnv(), anyArgs, paramCount, semtypes.NEVER, semtypes.CellMutability_CELL_MUT_NONE)
+	argListTy := semtypes.Intersect(semtypes.FunctionParamListType(cx, fnTy), arityTy)
+	if semtypes.IsEmpty(cx, argListTy) {
+		t.semanticError(fmt.Sprintf("implicit anonymous function with %d parameter(s) is incompatible with the expected function type", paramCount), fn.GetPosition())
+		return false
+	}
+	if semtypes.IsSubtype(cx, fnTy, semtypes.CreateIsolatedFn(cx)) {
+		fn.SetIsolated()
+	}
+	fnSym := t.getSymbol(fn.Symbol()).(model.FunctionSymbol)
+	paramTypes := make([]semtypes.SemType, paramCount)
+	paramNames := make([]string, paramCount)
+	for i := range fn.RequiredParams {
+		param := &fn.RequiredParams[i]
+		paramTypes[i] = semtypes.ListMemberTypeInnerVal(cx, argListTy, semtypes.IntConst(int64(i)))
+		paramNames[i] = param.GetName().GetValue()
+		param.Name.SetDeterminedType(semtypes.NEVER)
+		setExpectedType(param, paramTypes[i])
+		updateSymbolType(t, param, paramTypes[i])
+	}
+	sig := fnSym.Signature()
+	sig.Flags |= fn.FuncSymbolFlags()
+	sig.ParamTypes = paramTypes
+	sig.ParamNames = paramNames
+	sig.ReturnType = semtypes.FunctionReturnType(cx, fnTy, argListTy)
+	sig.RestParamType = semtypes.NEVER
+	fnSym.SetSignature(sig)
+	return true
+}
+
+// defineInferredFunctionType defines the type of an implicit anonymous function once the type of its body is
+// known: the parameter types are those given by the context and the return type is the type of the body.
+func defineInferredFunctionType(t typeResolver, fn *ast.BLangFunction, bodyTy semtypes.SemType) semtypes.SemType {
+	fnSym := t.getSymbol(fn.Symbol()).(model.FunctionSymbol)
+	sig := fnSym.Signature()
+	sig.ReturnType = bodyTy
+	fnSym.SetSignature(sig)
+	paramListDefn := semtypes.NewListDefinition()
+	paramListTy := paramListDefn.DefineListTypeWrapped(t.typeEnv(), sig.ParamTypes, len(sig.ParamTypes), semtypes.NEVER, semtypes.CellMutability_CELL_MUT_NONE)
+	fnDefn := semtypes.NewFunctionDefinition()
+	fnType := fnDefn.Define(t.typeEnv(), paramListTy, bodyTy,
+		semtypes.FunctionQualifiersFrom(t.typeEnv(), fn.IsIsolated(), fn.IsTransactional()))
+	updateSymbolType(t, fn, fnType)
+	registerFunctionParamsSymbol(t, fnType, fn.Symbol())
+	return fnType
+}
+
+func resolveLambdaFunctionExpr(t typeResolver, chain *binding, e *ast.BLangLambdaFunction, expectedType semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
+	var fnType semtypes.SemType
+	var ok bool
+	if e.Function.IsInferred() {
+		ok = resolveInferredFunctionSignature(t, e.Function, expectedType)
+	} else {
+		fnType, ok = resolveFunctionSignature(t, e.Function)
+		ok = ok && resolveSignatureAnnotationAttachments(t, e.Function)
+	}
 	if !ok {
 		return semtypes.SemType{}, expressionEffect{}, false
 	}
@@ -1381,6 +1514,7 @@ func resolveLambdaFunctionExpr(t typeResolver, chain *binding, e *ast.BLangLambd
 		implicitImports:     make(map[string]ast.BLangImportPackage),
 		mappingAtomToBType:  make(map[*semtypes.MappingAtomicType]ast.BType),
 		monoCounters:        make(map[string]int),
+		defaultFnPrefix:     e.Function.Name.Value,
 		scope:               e.Function.Scope(),
 		mappingAtomToSymRef: make(map[*semtypes.MappingAtomicType]model.SymbolRef),
 	}
@@ -1394,14 +1528,22 @@ func resolveLambdaFunctionExpr(t typeResolver, chain *binding, e *ast.BLangLambd
 
 	switch body := e.Function.Body.(type) {
 	case *ast.BLangBlockFunctionBody:
+		// Interactions of a worker are analyzed as part of the function that declares it.
+		if !e.Function.IsWorker() {
+			analyzeWorkers(ft, e.Function.Symbol(), body)
+		}
 		resolveBlockStatements(ft, boundaryChain, body.Stmts)
 		body.SetDeterminedType(semtypes.NEVER)
 	case *ast.BLangExprFunctionBody:
-		if _, _, ok := resolveActionOrExpression(ft, boundaryChain, body.Expr, ft.retTy); !ok {
+		bodyTy, _, ok := resolveActionOrExpression(ft, boundaryChain, body.Expr, ft.retTy)
+		if !ok {
 			t.setCapturedVars(prevCaptured)
 			return semtypes.SemType{}, expressionEffect{}, false
 		}
 		body.SetDeterminedType(semtypes.NEVER)
+		if e.Function.IsInferred() {
+			fnType = defineInferredFunctionType(t, e.Function, bodyTy)
+		}
 	}
 
 	// Unnarrow all captured variables
@@ -1443,6 +1585,11 @@ func (neverVisitor) Visit(node ast.BLangNode) ast.Visitor {
 	if node == nil {
 		return nil
 	}
+	if _, ok := node.(*ast.BLangAnnotationAttachment); ok && semtypes.IsZero(node.GetDeterminedType()) {
+		// The value of an annotation is resolved against the annotation type, which may not be known yet when the
+		// signature of the annotated function is resolved. resolveAnnotationAttachments types it later.
+		return nil
+	}
 	if semtypes.IsZero(node.GetDeterminedType()) {
 		node.SetDeterminedType(semtypes.NEVER)
 	}
@@ -1525,15 +1672,78 @@ func resolveClassTypeDefinition(t typeResolver, classDef *ast.BLangClassDefiniti
 }
 
 func resolveDistinctTypeDefinition(t typeResolver, typeDef *ast.BLangTypeDefinition, semType semtypes.SemType) (semtypes.SemType, bool) {
-	objectType, ok := typeDef.GetTypeData().TypeDescriptor.(*ast.BLangObjectType)
+	typeDesc := typeDef.GetTypeData().TypeDescriptor
+	if objectType, ok := typeDesc.(*ast.BLangObjectType); ok {
+		return appendDistinctAtoms(t, semType, typeDef.Symbol(), objectType.Inclusions), true
+	}
+	if !typeDef.IsDistinct() {
+		return semType, true
+	}
+	carrier, ok := t.getSymbol(typeDef.Symbol()).(model.DistinctType)
 	if !ok {
-		if typeDef.IsDistinct() {
-			t.unimplemented("distinct types are only supported for object types", typeDef.GetPosition())
-			return semtypes.SemType{}, false
+		// Already reported by the symbol resolver.
+		return semtypes.SemType{}, false
+	}
+	distinctAtom, ok := distinctAtomFn(semType)
+	if !ok {
+		t.semanticError("distinct type descriptor must be an error or object type", typeDef.GetPosition())
+		return semtypes.SemType{}, false
+	}
+
+	// `type B distinct A` has the type-ids of A in addition to its own.
+	ids := carrier.DistinctTypeIDs()
+	if ref, ok := typeDesc.(*ast.BLangUserDefinedType); ok {
+		if inner, ok := t.getSymbol(ref.Symbol()).(model.DistinctType); ok {
+			for _, id := range inner.DistinctTypeIDs() {
+				if !slices.Contains(ids, id) {
+					ids = append(ids, id)
+				}
+			}
 		}
-		return semType, true
 	}
-	return appendDistinctAtoms(t, semType, typeDef.Symbol(), objectType.Inclusions), true
+	carrier.SetDistinctTypeIDs(ids)
+	for _, id := range ids {
+		semType = semtypes.Intersect(semType, distinctAtom(id))
+	}
+	return semType, true
+}
+
+// resolveInlineDistinctType gives an inline `distinct T` a type-id of its own.
+// Each occurrence of the descriptor gets a new type-id.
+func resolveInlineDistinctType(t typeResolver, node inlineDistinctType, semType semtypes.SemType) (semtypes.SemType, bool) {
+	distinctAtom, ok := distinctAtomFn(semType)
+	if !ok {
+		t.semanticError("distinct type descriptor must be an error or object type", node.GetPosition())
+		return semtypes.SemType{}, false
+	}
+	id, ok := node.DistinctTypeID()
+	if !ok {
+		id = t.compilerContext().NewDistinctTypeID()
+		node.SetDistinctTypeID(id)
+	}
+	return semtypes.Intersect(semType, distinctAtom(id)), true
+}
+
+type inlineDistinctType interface {
+	GetPosition() diagnostics.Location
+	IsDistinct() bool
+	DistinctTypeID() (int, bool)
+	SetDistinctTypeID(id int)
+}
+
+// distinctAtomFn returns the constructor of the distinct atom for the basic
+// type of ty, which must be either a subtype of error or of object.
+func distinctAtomFn(ty semtypes.SemType) (func(int) semtypes.SemType, bool) {
+	switch {
+	case semtypes.IsNever(ty):
+		return nil, false
+	case semtypes.IsSubtypeSimple(ty, semtypes.ERROR):
+		return semtypes.ErrorDistinct, true
+	case semtypes.IsSubtypeSimple(ty, semtypes.OBJECT):
+		return semtypes.ObjectDefinitionDistinct, true
+	default:
+		return nil, false
+	}
 }
 
 func appendDistinctAtoms(t typeResolver, semType semtypes.SemType, symbol model.SymbolRef, inclusions []model.SymbolRef) semtypes.SemType {
@@ -1876,6 +2086,10 @@ func finishResolveObjectDefinitionType(t typeResolver, od *semtypes.ObjectDefini
 		if !ok {
 			return semtypes.SemType{}, false
 		}
+		if isReadonly {
+			// Each field of a readonly class is implicitly readonly.
+			fieldTy = semtypes.Intersect(fieldTy, semtypes.VAL_READONLY)
+		}
 		setExpectedType(field, fieldTy)
 		updateSymbolType(t, field, fieldTy)
 		field.Name.SetDeterminedType(semtypes.NEVER)
@@ -2066,6 +2280,8 @@ func resolveLiteral(t typeResolver, n *ast.BLangLiteral, expectedType semtypes.S
 		ty = semtypes.StringConst(value)
 	case ast.TypeTags_NIL:
 		ty = semtypes.NIL
+	case ast.TypeTags_BYTE_ARRAY:
+		ty = byteArrayLiteralType(t, len(n.GetValue().([]byte)))
 	default:
 		t.unimplemented("unsupported literal type", n.GetPosition())
 		return false
@@ -2078,6 +2294,13 @@ func resolveLiteral(t typeResolver, n *ast.BLangLiteral, expectedType semtypes.S
 	return true
 }
 
+// byteArrayLiteralType returns `byte[length] & readonly`, the type of a byte array literal with length bytes.
+func byteArrayLiteralType(t typeResolver, length int) semtypes.SemType {
+	ld := semtypes.NewListDefinition()
+	return ld.DefineListTypeWrapped(t.typeEnv(), []semtypes.SemType{semtypes.BYTE}, length, semtypes.NEVER,
+		semtypes.CellMutability_CELL_MUT_NONE)
+}
+
 func hasFloatTypeSuffix(s string) bool {
 	if len(s) == 0 {
 		return false
@@ -2291,6 +2514,9 @@ func lookupSymbol(chain *binding, ref model.SymbolRef) model.SymbolRef {
 func resolveVariableDefStmt(t typeResolver, chain *binding, s *ast.BLangSimpleVariableDef) (statementEffect, bool) {
 	variable := s.GetVariable().(*ast.BLangSimpleVariable)
 	variable.Name.SetDeterminedType(semtypes.NEVER)
+	if !resolveAnnotationAttachments(t, variable.GetAnnotationAttachments(), model.AttachPointVar, "variable") {
+		return defaultStmtEffect(chain), false
+	}
 	typeNode := variable.TypeNode()
 	if typeNode != nil {
 		semType, ok := resolveBType(t, typeNode, 0)
@@ -2439,6 +2665,16 @@ func resolveGlobalVarType(t typeResolver, node *ast.BLangSimpleVariable) bool {
 		updateSymbolType(t, node, semtypes.NEVER)
 		return false
 	}
+	if node.IsConfigurable() {
+		if !semtypes.IsSubtype(t.typeContext(), semType, semtypes.CreateAnydata(t.typeContext())) {
+			t.semanticError("type of a configurable variable must be a subtype of anydata", typeNode.GetPosition())
+			setExpectedType(node, semtypes.NEVER)
+			updateSymbolType(t, node, semtypes.NEVER)
+			return false
+		}
+		// The value of a configurable variable is always immutable.
+		semType = semtypes.Intersect(semType, semtypes.VAL_READONLY)
+	}
 	setExpectedType(node, semType)
 	updateSymbolType(t, node, semType)
 	return true
@@ -2644,7 +2880,7 @@ func resolveExpressionInner(t typeResolver, chain *binding, expr ast.BLangAction
 	case *ast.BLangUnaryExpr:
 		return resolveUnaryExpr(t, chain, e, expectedType)
 	case *ast.BLangInvocation:
-		return resolveInvocation(t, chain, e, expectedType)
+		return resolveStartableCall(t, chain, e, expectedType, resolveInvocation)
 	case *ast.BLangIndexBasedAccess:
 		return resolveIndexBasedAccess(t, chain, e)
 	case *ast.BLangFieldBaseAccess:
@@ -2653,12 +2889,20 @@ func resolveExpressionInner(t typeResolver, chain *binding, expr ast.BLangAction
 		return resolveListConstructorExpr(t, chain, e, expectedType)
 	case *ast.BLangMappingConstructorExpr:
 		return resolveMappingConstructorExpr(t, chain, e, expectedType)
+	case *ast.BLangTableConstructorExpr:
+		return resolveTableConstructorExpr(t, chain, e, expectedType)
 	case *ast.BLangErrorConstructorExpr:
 		return resolveErrorConstructorExpr(t, chain, e, expectedType)
 	case *ast.BLangGroupExpr:
 		return resolveGroupExpr(t, chain, e, expectedType)
+	case *ast.BLangTernaryExpr:
+		return resolveTernaryExpr(t, chain, e, expectedType)
+	case *ast.BLangElvisExpr:
+		return resolveElvisExpr(t, chain, e, expectedType)
 	case *ast.BLangQueryExpr:
 		return resolveQueryExpr(t, chain, e, expectedType)
+	case *ast.BLangLetExpr:
+		return resolveLetExpr(t, chain, e, expectedType)
 	case *ast.BLangWildCardBindingPattern:
 		ty := semtypes.ANY
 		setExpectedType(e, ty)
@@ -2681,20 +2925,32 @@ func resolveExpressionInner(t typeResolver, chain *binding, expr ast.BLangAction
 		setExpectedType(e, ty)
 		e.Name.SetDeterminedType(semtypes.NEVER)
 		return ty, effect, true
+	case *ast.BLangRestArgsExpression:
+		// The rest argument takes the list type expected for the arguments it supplies, so
+		// that the analyzer can check the expression against it.
+		ty, effect, ok := resolveActionOrExpression(t, chain, e.Expr, expectedType)
+		if !ok {
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		if !semtypes.IsZero(expectedType) {
+			ty = expectedType
+		}
+		setExpectedType(e, ty)
+		return ty, effect, true
 	case *ast.BLangNewExpression:
 		return resolveNewExpr(t, chain, e, expectedType)
 	case *ast.BLangLambdaFunction:
-		return resolveLambdaFunctionExpr(t, chain, e)
+		return resolveLambdaFunctionExpr(t, chain, e, expectedType)
 	case *ast.BLangRemoteMethodCallAction:
-		return resolveRemoteMethodCallAction(t, chain, e, expectedType)
+		return resolveStartableCall(t, chain, e, expectedType, resolveRemoteMethodCallAction)
 	case *ast.BLangClientResourceAccessAction:
-		return resolveClientResourceAccessAction(t, chain, e, expectedType)
+		return resolveStartableCall(t, chain, e, expectedType, resolveClientResourceAccessAction)
 	case *ast.BLangInferredTypedescDefault:
 		return resolveInferredTypedescDefault(t, chain, e, expectedType)
 	case *ast.BLangXMLSequenceLiteral:
 		return resolveXMLSequenceLiteral(t, chain, e, expectedType)
 	case *ast.BLangTemplateExpr:
-		return resolveTemplateExpr(t, chain, e)
+		return resolveTemplateExpr(t, chain, e, expectedType)
 	case *ast.BLangXMLTemplateExpr:
 		return resolveXMLTemplateExpr(t, chain, e)
 	case *ast.BLangXMLElementLiteral:
@@ -2705,6 +2961,36 @@ func resolveExpressionInner(t typeResolver, chain *binding, expr ast.BLangAction
 		return resolveXMLCommentLiteral(t, chain, e)
 	case *ast.BLangXMLTextLiteral:
 		return resolveXMLTextLiteral(t, chain, e)
+	case *ast.BLangXMLFilterExpr:
+		return resolveXMLFilterExpr(t, chain, e)
+	case *ast.BLangXMLStepExpr:
+		return resolveXMLStepExpr(t, chain, e)
+	case *ast.BLangXMLStepItem:
+		return resolveXMLStepItem(t, chain, e)
+	case *ast.BLangWorkerAsyncSendExpr:
+		return resolveWorkerSend(t, chain, &e.BLangWorkerSendExprBase, semtypes.NIL)
+	case *ast.BLangWorkerSyncSendExpr:
+		return resolveWorkerSend(t, chain, &e.BLangWorkerSendExprBase, semtypes.Union(semtypes.NIL, peerErrorType(t, e.PeerSymbol)))
+	case *ast.BLangWorkerReceive:
+		return resolveWorkerReceive(t, chain, e, expectedType)
+	case *ast.BLangAlternateWorkerReceive:
+		return resolveAlternateWorkerReceive(t, chain, e, expectedType)
+	case *ast.BLangMultipleWorkerReceive:
+		return resolveMultipleWorkerReceive(t, chain, e)
+	case *ast.BLangWorkerFlushExpr:
+		return resolveWorkerFlush(t, chain, e)
+	case *ast.BLangWaitExpr:
+		return resolveWaitExpr(t, chain, e)
+	case *ast.BLangWaitForAllExpr:
+		return resolveWaitForAllExpr(t, chain, e)
+	case *ast.BLangCommitExpr:
+		return resolveCommitExpr(chain, e)
+	case *ast.BLangTransactionalExpr:
+		return resolveTransactionalExpr(chain, e)
+	case *ast.BLangTypedescExpr:
+		return resolveTypedescExpr(t, chain, e)
+	case *ast.BLangAnnotAccessExpr:
+		return resolveAnnotAccessExpr(t, chain, e)
 	default:
 		t.internalError(fmt.Sprintf("unsupported expression type: %T", expr), expr.GetPosition())
 		return semtypes.SemType{}, expressionEffect{}, false
@@ -2765,7 +3051,13 @@ func resolveXMLElementLiteral(t typeResolver, chain *binding, e *ast.BLangXMLEle
 	return ty, defaultExpressionEffect(chain), true
 }
 
-func resolveTemplateExpr(t typeResolver, chain *binding, e *ast.BLangTemplateExpr) (semtypes.SemType, expressionEffect, bool) {
+func resolveTemplateExpr(t typeResolver, chain *binding, e *ast.BLangTemplateExpr, expectedType semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
+	switch e.Kind {
+	case ast.TemplateExprKindRegExp:
+		return resolveRegExpTemplateExpr(t, chain, e)
+	case ast.TemplateExprKindRaw:
+		return resolveRawTemplateExpr(t, chain, e, expectedType)
+	}
 	var ty semtypes.SemType
 	if len(e.Insertions) == 0 {
 		ty = semtypes.StringConst(e.Strings[0])
@@ -2780,6 +3072,77 @@ func resolveTemplateExpr(t typeResolver, chain *binding, e *ast.BLangTemplateExp
 	return ty, defaultExpressionEffect(chain), true
 }
 
+func resolveRegExpTemplateExpr(t typeResolver, chain *binding, e *ast.BLangTemplateExpr) (semtypes.SemType, expressionEffect, bool) {
+	for _, ins := range e.Insertions {
+		if _, _, ok := resolveActionOrExpression(t, chain, ins, regExpTemplateInsertionAllowedTypes); !ok {
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+	}
+	setExpectedType(e, semtypes.REGEXP)
+	return semtypes.REGEXP, defaultExpressionEffect(chain), true
+}
+
+// resolveRawTemplateExpr types a raw template by the object part of the expected type when that is a subtype of
+// object:RawTemplate, and as object:RawTemplate otherwise. Each insertion is then resolved against the corresponding
+// member of the `insertions` field type.
+func resolveRawTemplateExpr(t typeResolver, chain *binding, e *ast.BLangTemplateExpr, expectedType semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
+	ty, ok := rawTemplateType(t, e.GetPosition())
+	if !ok {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	ctx := t.typeContext()
+	if !semtypes.IsZero(expectedType) {
+		objectPart := semtypes.Intersect(expectedType, semtypes.OBJECT)
+		if !semtypes.IsEmpty(ctx, objectPart) && semtypes.IsSubtype(ctx, objectPart, ty) {
+			ty = objectPart
+		}
+	}
+	setExpectedType(e, ty)
+	if !rawTemplateAllowsInsertionCount(t, ty, len(e.Insertions)) {
+		t.semanticError(fmt.Sprintf("invalid number of insertions in raw template: %d", len(e.Insertions)), e.GetPosition())
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	for i, ins := range e.Insertions {
+		if _, _, ok := resolveActionOrExpression(t, chain, ins, rawTemplateInsertionType(ctx, ty, i)); !ok {
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+	}
+	return ty, defaultExpressionEffect(chain), true
+}
+
+// rawTemplateAllowsInsertionCount checks whether the `insertions` field of a raw template of type ty can be a list of
+// length n.
+func rawTemplateAllowsInsertionCount(t typeResolver, ty semtypes.SemType, n int) bool {
+	members := make([]semtypes.SemType, n)
+	for i := range members {
+		members[i] = semtypes.VAL
+	}
+	ld := semtypes.NewListDefinition()
+	countTy := ld.DefineListTypeWrappedWithEnvSemTypesInt(t.typeEnv(), members, n)
+	insertionsTy := semtypes.ObjectMemberType(t.typeContext(), semtypes.StringConst("insertions"), ty)
+	return !semtypes.IsEmpty(t.typeContext(), semtypes.Intersect(countTy, insertionsTy))
+}
+
+func rawTemplateType(t typeResolver, pos diagnostics.Location) (semtypes.SemType, bool) {
+	symbolSpace, ok := t.lookupImportedSymbols("lang.object")
+	if !ok {
+		t.internalError("lang.object symbol space not found", pos)
+		return semtypes.SemType{}, false
+	}
+	ref, ok := symbolSpace.GetSymbol("RawTemplate")
+	if !ok {
+		t.internalError("lang.object:RawTemplate not found", pos)
+		return semtypes.SemType{}, false
+	}
+	return t.symbolType(ref), true
+}
+
+// rawTemplateInsertionType returns the type expected of the i-th insertion of a raw template of type ty.
+func rawTemplateInsertionType(ctx semtypes.Context, ty semtypes.SemType, i int) semtypes.SemType {
+	insertionsTy := semtypes.ObjectMemberType(ctx, semtypes.StringConst("insertions"), ty)
+	return semtypes.ListMemberTypeInnerVal(ctx, insertionsTy, semtypes.IntConst(int64(i)))
+}
+
 func resolveStringTemplateType(t typeResolver, chain *binding, e *ast.BLangTemplateExpr) (semtypes.SemType, bool) {
 	allSingleton := true
 	var sb strings.Builder
@@ -3046,6 +3409,25 @@ func resolveTrapExpr(t typeResolver, chain *binding, e *ast.BLangTrapExpr) (semt
 	return resultTy, defaultExpressionEffect(chain), true
 }
 
+// resolveLetExpr resolves the variable declarations of a let expression in order, followed by its body. Narrowings
+// done by the declarations apply only within the let expression.
+func resolveLetExpr(t typeResolver, chain *binding, e *ast.BLangLetExpr, expectedType semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
+	bodyChain := chain
+	for _, decl := range e.LetVarDeclarations {
+		effect, ok := resolveStatement(t, bodyChain, decl.(ast.StatementNode))
+		if !ok {
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		bodyChain = effect.binding
+	}
+	ty, _, ok := resolveActionOrExpression(t, bodyChain, e.Expr, expectedType)
+	if !ok {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	setExpectedType(e, ty)
+	return ty, defaultExpressionEffect(chain), true
+}
+
 func resolveCheckedExpr(t typeResolver, chain *binding, e *ast.BLangCheckedExpr, expectedType semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
 	var innerExpected semtypes.SemType
 	if !semtypes.IsZero(expectedType) {
@@ -3068,8 +3450,26 @@ func resolveMappingConstructorExpr(t typeResolver, chain *binding, e *ast.BLangM
 }
 
 func resolveMappingConstructorBottomUp(t typeResolver, chain *binding, e *ast.BLangMappingConstructorExpr) (semtypes.SemType, expressionEffect, bool) {
-	fields := make([]semtypes.Field, len(e.Fields))
-	for i, f := range e.Fields {
+	fields := make([]semtypes.Field, 0, len(e.Fields))
+	restTy := semtypes.NEVER
+	seen := make(map[string]bool, len(e.Fields))
+	for _, f := range e.Fields {
+		if spread, ok := f.(*ast.BLangMappingSpreadField); ok {
+			mat, ok := resolveMappingSpreadField(t, chain, spread)
+			if !ok {
+				return semtypes.SemType{}, expressionEffect{}, false
+			}
+			for _, name := range mat.Names {
+				if seen[name] {
+					t.semanticError(fmt.Sprintf("duplicate key '%s' in mapping constructor", name), spread.GetPosition())
+					return semtypes.SemType{}, expressionEffect{}, false
+				}
+				seen[name] = true
+				fields = append(fields, semtypes.FieldFrom(name, mat.FieldInnerVal(name), false, mat.IsOptional(t.typeContext(), name)))
+			}
+			restTy = semtypes.Union(restTy, mat.RestInnerVal())
+			continue
+		}
 		kv := f.(*ast.BLangMappingKeyValueField)
 		valueTy, _, ok := resolveActionOrExpression(t, chain, kv.ValueExpr, semtypes.SemType{})
 		if !ok {
@@ -3098,18 +3498,52 @@ func resolveMappingConstructorBottomUp(t typeResolver, chain *binding, e *ast.BL
 		}
 		kv.Key.SetDeterminedType(semtypes.NEVER)
 		kv.SetDeterminedType(semtypes.NEVER)
-		fields[i] = semtypes.FieldFrom(keyName, broadTy, false, false)
+		if seen[keyName] {
+			t.semanticError(fmt.Sprintf("duplicate key '%s' in mapping constructor", keyName), kv.Key.GetPosition())
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		seen[keyName] = true
+		fields = append(fields, semtypes.FieldFrom(keyName, broadTy, false, false))
 	}
 	md := semtypes.NewMappingDefinition()
-	mapTy := md.DefineMappingTypeWrapped(t.typeEnv(), fields, semtypes.NEVER)
+	mapTy := md.DefineMappingTypeWrapped(t.typeEnv(), fields, restTy)
 	setExpectedType(e, mapTy)
 	mat := semtypes.ToMappingAtomicType(t.typeContext(), mapTy)
 	e.AtomicType = *mat
 	return mapTy, defaultExpressionEffect(chain), true
 }
 
+// resolveMappingSpreadField resolves the expression of a spread field `...expr`, which
+// must have a mapping type with an atomic representation. The spread expression has no
+// contextually expected type; its fields are checked against the inherent type of the
+// mapping constructor by the semantic analyzer.
+func resolveMappingSpreadField(t typeResolver, chain *binding, f *ast.BLangMappingSpreadField) (*semtypes.MappingAtomicType, bool) {
+	ty, _, ok := resolveActionOrExpression(t, chain, f.Expr, semtypes.SemType{})
+	if !ok {
+		return nil, false
+	}
+	tc := t.typeContext()
+	if !semtypes.IsSubtype(tc, ty, semtypes.MAPPING) {
+		t.semanticError(fmt.Sprintf("spread field must be a mapping, got %s", semtypes.ToString(tc, ty)), f.Expr.GetPosition())
+		return nil, false
+	}
+	mat := semtypes.ToMappingAtomicType(tc, ty)
+	if mat == nil {
+		t.semanticError("spread field must have an atomic mapping type", f.Expr.GetPosition())
+		return nil, false
+	}
+	f.SetDeterminedType(semtypes.NEVER)
+	return mat, true
+}
+
 func resolveMappingConstructorWithExpectedType(t typeResolver, chain *binding, e *ast.BLangMappingConstructorExpr, expectedType semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
 	for _, f := range e.Fields {
+		if spread, ok := f.(*ast.BLangMappingSpreadField); ok {
+			if _, ok := resolveMappingSpreadField(t, chain, spread); !ok {
+				return semtypes.SemType{}, expressionEffect{}, false
+			}
+			continue
+		}
 		kv := f.(*ast.BLangMappingKeyValueField)
 		if _, _, ok := resolveActionOrExpression(t, chain, kv.ValueExpr, semtypes.SemType{}); !ok {
 			return semtypes.SemType{}, expressionEffect{}, false
@@ -3123,7 +3557,10 @@ func resolveMappingConstructorWithExpectedType(t typeResolver, chain *binding, e
 	}
 
 	for _, f := range e.Fields {
-		kv := f.(*ast.BLangMappingKeyValueField)
+		kv, ok := f.(*ast.BLangMappingKeyValueField)
+		if !ok {
+			continue
+		}
 		keyName := recordKeyName(kv.Key)
 		requiredType := mat.FieldInnerVal(keyName)
 		kv.ValueExpr.SetDeterminedType(semtypes.SemType{})
@@ -3152,6 +3589,56 @@ func resolveMappingConstructorWithExpectedType(t typeResolver, chain *binding, e
 	return resultType, defaultExpressionEffect(chain), true
 }
 
+func resolveTableConstructorExpr(t typeResolver, chain *binding, e *ast.BLangTableConstructorExpr, expectedType semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
+	tc := t.typeContext()
+	var rowTy semtypes.SemType
+	var keyFieldNames []string
+	if !semtypes.IsZero(expectedType) {
+		expectedTableTy := semtypes.Intersect(expectedType, semtypes.TABLE)
+		if semtypes.IsEmpty(tc, expectedTableTy) {
+			t.semanticError("table type not found in expected type", e.GetPosition())
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		rowTy = semtypes.TableRowType(tc, expectedTableTy)
+		keyFieldNames = semtypes.TableKeyFieldNames(tc, expectedTableTy)
+	}
+	if e.TableKeySpecifier != nil {
+		specifiedNames := e.TableKeySpecifier.GetFieldNames()
+		if keyFieldNames != nil && !slices.Equal(keyFieldNames, specifiedNames) {
+			t.semanticError("table key specifier mismatch with the expected key specifier", e.TableKeySpecifier.GetPosition())
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		keyFieldNames = specifiedNames
+	}
+
+	inferredRowTy := semtypes.NEVER
+	for _, row := range e.RecordLiteralList {
+		ty, _, ok := resolveMappingConstructorExpr(t, chain, row, rowTy)
+		if !ok {
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		inferredRowTy = semtypes.Union(inferredRowTy, ty)
+	}
+	if semtypes.IsZero(rowTy) {
+		rowTy = inferredRowTy
+		if semtypes.IsNever(rowTy) {
+			rowTy = semtypes.MAPPING
+		}
+	}
+
+	var tableTy semtypes.SemType
+	if len(keyFieldNames) > 0 {
+		if !validateTableKeyFields(t, rowTy, keyFieldNames, e.GetPosition()) {
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		tableTy = semtypes.TableContainingKeySpecifier(tc, rowTy, keyFieldNames)
+	} else {
+		tableTy = semtypes.TableContaining(t.typeEnv(), rowTy)
+	}
+	setExpectedType(e, tableTy)
+	return tableTy, defaultExpressionEffect(chain), true
+}
+
 func resolveMappingKey(t typeResolver, kv *ast.BLangMappingKeyValueField) {
 	switch keyExpr := kv.Key.Expr.(type) {
 	case *ast.BLangLiteral:
@@ -3184,10 +3671,19 @@ func selectMappingInherentType(t typeResolver, expr *ast.BLangMappingConstructor
 	alts := semtypes.MappingAlternatives(tc, expectedType)
 	var validAlts []semtypes.MappingAlternative
 
-	fields := make([]semtypes.MappingFieldInfo, len(expr.Fields))
-	for i, f := range expr.Fields {
-		kv := f.(*ast.BLangMappingKeyValueField)
-		fields[i] = semtypes.MappingFieldInfo{Name: recordKeyName(kv.Key), Ty: kv.ValueExpr.GetDeterminedType()}
+	fields := make([]semtypes.MappingFieldInfo, 0, len(expr.Fields))
+	for _, f := range expr.Fields {
+		switch f := f.(type) {
+		case *ast.BLangMappingKeyValueField:
+			fields = append(fields, semtypes.MappingFieldInfo{Name: recordKeyName(f.Key), Ty: f.ValueExpr.GetDeterminedType()})
+		case *ast.BLangMappingSpreadField:
+			spreadMat := semtypes.ToMappingAtomicType(tc, f.Expr.GetDeterminedType())
+			for _, name := range spreadMat.Names {
+				if !spreadMat.IsOptional(tc, name) {
+					fields = append(fields, semtypes.MappingFieldInfo{Name: name, Ty: spreadMat.FieldInnerVal(name)})
+				}
+			}
+		}
 	}
 	sort.Slice(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
 
@@ -3331,6 +3827,52 @@ func resolveGroupExpr(t typeResolver, chain *binding, expr *ast.BLangGroupExpr,
 	return innerTy, effect, true
 }
 
+// resolveTernaryExpr resolves the branches of a conditional expression with the bindings narrowed by the condition
+// being true and false respectively.
+func resolveTernaryExpr(t typeResolver, chain *binding, expr *ast.BLangTernaryExpr, expectedType semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
+	_, condEffect, ok := resolveActionOrExpression(t, chain, expr.Expr, semtypes.BOOLEAN)
+	if !ok {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	thenTy, _, ok := resolveActionOrExpression(t, condEffect.ifTrue, expr.ThenExpr, expectedType)
+	if !ok {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	elseTy, _, ok := resolveActionOrExpression(t, condEffect.ifFalse, expr.ElseExpr, expectedType)
+	if !ok {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	resultTy := semtypes.Union(thenTy, elseTy)
+	setExpectedType(expr, resultTy)
+	return resultTy, defaultExpressionEffect(chain), true
+}
+
+// resolveElvisExpr resolves `lhs ?: rhs`. The right hand side is only evaluated when the left hand side is nil, so
+// when the left hand side is a variable it is narrowed to nil there.
+func resolveElvisExpr(t typeResolver, chain *binding, expr *ast.BLangElvisExpr, expectedType semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
+	lhsExpectedType := expectedType
+	if !semtypes.IsZero(expectedType) {
+		lhsExpectedType = semtypes.Union(expectedType, semtypes.NIL)
+	}
+	lhsTy, _, ok := resolveActionOrExpression(t, chain, expr.LhsExpr, lhsExpectedType)
+	if !ok {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	rhsChain := chain
+	if ref, isVarRef := varRefExp(chain, expr.LhsExpr); isVarRef {
+		nilTy := semtypes.Intersect(t.symbolType(ref), semtypes.NIL)
+		ref = t.unnarrowedSymbol(ref)
+		rhsChain = &binding{ref: ref, narrowedSymbol: narrowSymbol(t, ref, nilTy), prev: chain}
+	}
+	rhsTy, _, ok := resolveActionOrExpression(t, rhsChain, expr.RhsExpr, expectedType)
+	if !ok {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	resultTy := semtypes.Union(semtypes.Diff(lhsTy, semtypes.NIL), rhsTy)
+	setExpectedType(expr, resultTy)
+	return resultTy, defaultExpressionEffect(chain), true
+}
+
 func resolveQueryExpr(
 	t typeResolver,
 	chain *binding,
@@ -3364,22 +3906,28 @@ func resolveQueryExpr(
 	var (
 		selectClause  *ast.BLangSelectClause
 		collectClause *ast.BLangCollectClause
+		doClause      *ast.BLangDoClause
 		finalOK       bool
 	)
 	if selectClause, finalOK = expr.QueryClauseList[lastClauseIndex].(*ast.BLangSelectClause); finalOK {
 		selectClause.SetDeterminedType(semtypes.NEVER)
 	} else if collectClause, finalOK = expr.QueryClauseList[lastClauseIndex].(*ast.BLangCollectClause); finalOK {
 		collectClause.SetDeterminedType(semtypes.NEVER)
+	} else if doClause, finalOK = expr.QueryClauseList[lastClauseIndex].(*ast.BLangDoClause); finalOK {
+		doClause.SetDeterminedType(semtypes.NEVER)
 	} else {
 		t.semanticError("query expression requires a select or collect clause", expr.GetPosition())
 		return semtypes.SemType{}, expressionEffect{}, false
 	}
+	if selectClause != nil && expr.QueryConstructType == ast.TypeKind_NONE {
+		expr.QueryConstructType = inferQueryConstructType(t.typeContext(), expectedType)
+	}
 
 	collectionTy, _, ok := resolveActionOrExpression(t, chain, fromClause.Collection, semtypes.SemType{})
 	if !ok {
 		return semtypes.SemType{}, expressionEffect{}, false
 	}
-	elementTy, ok := resolveQueryCollectionElementType(t, collectionTy, fromClause.GetPosition())
+	elementTy, ok := resolveQueryCollectionElementType(t, &fromClause.BLangInputClause, collectionTy)
 	if !ok {
 		return semtypes.SemType{}, expressionEffect{}, false
 	}
@@ -3417,10 +3965,28 @@ func resolveQueryExpr(
 		return semtypes.SemType{}, expressionEffect{}, false
 	}
 
-	var queryTy semtypes.SemType
-	if selectClause != nil {
-		selectExpectedTy := querySelectExpectedType(
-			t.typeContext(),
+	// Errors with which iterating the input collections can complete are
+	// the result of the query, except for a stream, which completes with
+	// them instead.
+	completionErrorTy := semtypes.NEVER
+	for _, clause := range expr.QueryClauseList[:lastClauseIndex] {
+		switch clause := clause.(type) {
+		case *ast.BLangFromClause:
+			completionErrorTy = semtypes.Union(completionErrorTy, clause.CompletionType)
+		case *ast.BLangJoinClause:
+			completionErrorTy = semtypes.Union(completionErrorTy, clause.CompletionType)
+		}
+	}
+
+	var queryTy semtypes.SemType
+	if doClause != nil {
+		if !resolveQueryDoClause(t, queryChain, doClause) {
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		queryTy = semtypes.NIL
+	} else if selectClause != nil {
+		selectExpectedTy := querySelectExpectedType(
+			t.typeContext(),
 			t.typeEnv(),
 			expr.QueryConstructType,
 			expectedType,
@@ -3445,6 +4011,33 @@ func resolveQueryExpr(
 			valueTy := semtypes.ListMemberTypeInnerVal(t.typeContext(), selectTy, semtypes.IntConst(1))
 			md := semtypes.NewMappingDefinition()
 			queryTy = md.DefineMappingTypeWrapped(t.typeEnv(), nil, valueTy)
+		case ast.TypeKind_TABLE:
+			queryTy, ok = resolveTableQueryType(t, expr, selectClause, selectTy, expectedType)
+			if !ok {
+				return semtypes.SemType{}, expressionEffect{}, false
+			}
+		case ast.TypeKind_STREAM:
+			sd := semtypes.NewStreamDefinition()
+			queryTy = sd.Define(t.typeEnv(), selectTy, semtypes.Union(semtypes.NIL, completionErrorTy))
+			completionErrorTy = semtypes.NEVER
+		case ast.TypeKind_STRING:
+			if !semtypes.IsSubtype(t.typeContext(), selectTy, semtypes.STRING) {
+				t.semanticError(
+					formatIncompatibleTypeMessage(t.typeContext(), semtypes.STRING, selectTy),
+					selectClause.GetPosition(),
+				)
+				return semtypes.SemType{}, expressionEffect{}, false
+			}
+			queryTy = semtypes.STRING
+		case ast.TypeKind_XML:
+			if !semtypes.IsSubtype(t.typeContext(), selectTy, semtypes.XML) {
+				t.semanticError(
+					formatIncompatibleTypeMessage(t.typeContext(), semtypes.XML, selectTy),
+					selectClause.GetPosition(),
+				)
+				return semtypes.SemType{}, expressionEffect{}, false
+			}
+			queryTy = semtypes.XMLSequence(selectTy)
 		default:
 			t.unimplemented("query construct type is not supported yet", expr.GetPosition())
 			return semtypes.SemType{}, expressionEffect{}, false
@@ -3475,8 +4068,8 @@ func resolveQueryExpr(
 	}
 
 	if onConflictClause != nil {
-		if expr.QueryConstructType != ast.TypeKind_MAP {
-			t.semanticError("on conflict clause is supported only for map query construct type",
+		if !queryAllowsOnConflict(t.typeContext(), expr.QueryConstructType, queryTy) {
+			t.semanticError("on conflict clause is supported only for map or keyed table query construct types",
 				onConflictClause.GetPosition())
 			return semtypes.SemType{}, expressionEffect{}, false
 		}
@@ -3493,31 +4086,166 @@ func resolveQueryExpr(
 			queryTy = semtypes.Union(queryTy, errorTy)
 		}
 	}
+	if !semtypes.IsEmpty(t.typeContext(), completionErrorTy) {
+		queryTy = semtypes.Union(queryTy, completionErrorTy)
+	}
 	setExpectedType(expr, queryTy)
 	return queryTy, defaultExpressionEffect(chain), true
 }
 
+// resolveTableQueryType gives the type of a query constructing a table from
+// rows of selectTy. As with a table constructor, the row type and key fields
+// come from the expected type when there is one.
+func resolveTableQueryType(
+	t typeResolver,
+	expr *ast.BLangQueryExpr,
+	selectClause *ast.BLangSelectClause,
+	selectTy semtypes.SemType,
+	expectedType semtypes.SemType,
+) (semtypes.SemType, bool) {
+	tc := t.typeContext()
+	rowTy := selectTy
+	var keyFieldNames []string
+	if expectedTableTy := semtypes.Intersect(expectedType, semtypes.TABLE); !semtypes.IsZero(expectedType) && !semtypes.IsEmpty(tc, expectedTableTy) {
+		rowTy = semtypes.TableRowType(tc, expectedTableTy)
+		keyFieldNames = semtypes.TableKeyFieldNames(tc, expectedTableTy)
+	}
+	if expr.TableKeySpecifier != nil {
+		specifiedNames := expr.TableKeySpecifier.GetFieldNames()
+		if keyFieldNames != nil && !slices.Equal(keyFieldNames, specifiedNames) {
+			t.semanticError("table key specifier mismatch with the expected key specifier", expr.TableKeySpecifier.GetPosition())
+			return semtypes.SemType{}, false
+		}
+		keyFieldNames = specifiedNames
+	}
+	if !semtypes.IsSubtype(tc, selectTy, semtypes.MAPPING) {
+		t.semanticError(formatIncompatibleTypeMessage(tc, semtypes.MAPPING, selectTy), selectClause.GetPosition())
+		return semtypes.SemType{}, false
+	}
+	if !semtypes.IsSubtype(tc, selectTy, rowTy) {
+		t.semanticError(formatIncompatibleTypeMessage(tc, rowTy, selectTy), selectClause.GetPosition())
+		return semtypes.SemType{}, false
+	}
+	if len(keyFieldNames) == 0 {
+		return semtypes.TableContaining(t.typeEnv(), rowTy), true
+	}
+	if !validateTableKeyFields(t, rowTy, keyFieldNames, expr.GetPosition()) {
+		return semtypes.SemType{}, false
+	}
+	return semtypes.TableContainingKeySpecifier(tc, rowTy, keyFieldNames), true
+}
+
+// queryAllowsOnConflict reports whether a query of type queryTy constructs a
+// value whose members are identified by key: a map or a table with a key
+// sequence.
+// inferQueryConstructType gives the construct type of a query without a
+// query construct type keyword. Unless a list is expected, a table, stream,
+// string or xml is constructed when the expected type (ignoring error) is one.
+func inferQueryConstructType(tc semtypes.Context, expectedType semtypes.SemType) ast.TypeKind {
+	if semtypes.IsZero(expectedType) {
+		return ast.TypeKind_NONE
+	}
+	expectedTy := semtypes.Diff(expectedType, semtypes.ERROR)
+	if semtypes.IsEmpty(tc, expectedTy) || !semtypes.IsEmpty(tc, semtypes.Intersect(expectedTy, semtypes.LIST)) {
+		return ast.TypeKind_NONE
+	}
+	switch {
+	case semtypes.IsSubtype(tc, expectedTy, semtypes.TABLE):
+		return ast.TypeKind_TABLE
+	case semtypes.IsSubtype(tc, expectedTy, semtypes.STREAM):
+		return ast.TypeKind_STREAM
+	case semtypes.IsSubtype(tc, expectedTy, semtypes.STRING):
+		return ast.TypeKind_STRING
+	case semtypes.IsSubtype(tc, expectedTy, semtypes.XML):
+		return ast.TypeKind_XML
+	default:
+		return ast.TypeKind_NONE
+	}
+}
+
+func queryAllowsOnConflict(tc semtypes.Context, constructType ast.TypeKind, queryTy semtypes.SemType) bool {
+	switch constructType {
+	case ast.TypeKind_MAP:
+		return true
+	case ast.TypeKind_TABLE:
+		return len(semtypes.TableKeyFieldNames(tc, semtypes.Intersect(queryTy, semtypes.TABLE))) > 0
+	default:
+		return false
+	}
+}
+
+// resolveQueryDoClause resolves the body of the do clause of a query action.
+// Like a foreach body, the body may run any number of times, so it must not
+// assign to a variable narrowed outside it. break and continue cannot leave
+// the body, so the body is resolved outside of any enclosing loop.
+func resolveQueryDoClause(t typeResolver, chain *binding, doClause *ast.BLangDoClause) bool {
+	for {
+		loopT, ok := t.(*loopTypeResolver)
+		if !ok {
+			break
+		}
+		t = loopT.parentResolver
+	}
+	bodyEffect, ok := resolveBlockStatements(t, chain, doClause.Body.Stmts)
+	doClause.Body.SetDeterminedType(semtypes.NEVER)
+	if !ok {
+		return false
+	}
+	if !bodyEffect.nonCompletion {
+		reportOutsideLoopAssignments(t, []*binding{bodyEffect.binding}, chain)
+	}
+	return true
+}
+
+// resolveQueryCollectionElementType gives the type of the members of the
+// collection of an input clause and sets the type of the errors with which
+// iterating it can complete.
 func resolveQueryCollectionElementType(
 	t typeResolver,
+	clause *ast.BLangInputClause,
 	collectionTy semtypes.SemType,
-	pos diagnostics.Location,
 ) (semtypes.SemType, bool) {
+	ctx := t.typeContext()
+	clause.CompletionType = semtypes.NEVER
 	switch {
-	case semtypes.IsSubtype(t.typeContext(), collectionTy, semtypes.LIST):
-		memberTypes := semtypes.ListAllMemberTypesInner(t.typeContext(), collectionTy)
+	case semtypes.IsSubtype(ctx, collectionTy, semtypes.LIST):
+		memberTypes := semtypes.ListAllMemberTypesInner(ctx, collectionTy)
 		result := semtypes.NEVER
 		for _, each := range memberTypes.SemTypes {
 			result = semtypes.Union(result, each)
 		}
 		return result, true
-	case semtypes.IsSubtype(t.typeContext(), collectionTy, semtypes.MAPPING):
-		return semtypes.MappingMemberTypeInnerValProj(t.typeContext(), collectionTy, semtypes.STRING), true
+	case semtypes.IsSubtype(ctx, collectionTy, semtypes.MAPPING):
+		return semtypes.MappingMemberTypeInnerValProj(ctx, collectionTy, semtypes.STRING), true
+	case semtypes.IsSubtype(ctx, collectionTy, semtypes.TABLE):
+		return semtypes.TableRowType(ctx, collectionTy), true
+	case semtypes.IsSubtype(ctx, collectionTy, semtypes.STRING):
+		return semtypes.STRING_CHAR, true
+	case semtypes.IsSubtype(ctx, collectionTy, semtypes.XML):
+		return semtypes.Intersect(collectionTy, xmlSingletonTypes()), true
+	case semtypes.IsSubtype(ctx, collectionTy, semtypes.STREAM):
+		clause.CompletionType = semtypes.Intersect(semtypes.StreamCompletionType(ctx, collectionTy), semtypes.ERROR)
+		return semtypes.StreamValueType(ctx, collectionTy), true
+	case semtypes.IsSubtype(ctx, collectionTy, semtypes.OBJECT):
+		nextReturnTy, ok := resolveIteratorNextReturnType(t, "query", clause.Collection, collectionTy)
+		if !ok {
+			return semtypes.SemType{}, false
+		}
+		clause.CompletionType = semtypes.Intersect(nextReturnTy, semtypes.ERROR)
+		return iteratorNextValueType(ctx, nextReturnTy), true
 	default:
-		t.unimplemented("query from clause currently supports only list or map collections", pos)
+		t.semanticError("query collection is not iterable", clause.GetPosition())
 		return semtypes.SemType{}, false
 	}
 }
 
+func xmlSingletonTypes() semtypes.SemType {
+	return semtypes.Union(
+		semtypes.Union(semtypes.XML_ELEMENT, semtypes.XML_COMMENT),
+		semtypes.Union(semtypes.XML_PI, semtypes.XML_TEXT),
+	)
+}
+
 func resolveForeachVariableType(t typeResolver, collection ast.BLangActionOrExpression, collectionTy semtypes.SemType) (semtypes.SemType, bool) {
 	if binaryExpr, ok := collection.(*ast.BLangBinaryExpr); ok && isRangeExpr(binaryExpr) {
 		return semtypes.INT, true
@@ -3528,24 +4256,43 @@ func resolveForeachVariableType(t typeResolver, collection ast.BLangActionOrExpr
 		return semtypes.ListMemberTypeInnerVal(ctx, collectionTy, semtypes.INT), true
 	case semtypes.IsSubtype(ctx, collectionTy, semtypes.MAPPING):
 		return semtypes.MappingMemberTypeInnerVal(ctx, collectionTy, semtypes.STRING), true
+	case semtypes.IsSubtype(ctx, collectionTy, semtypes.TABLE):
+		return semtypes.TableRowType(ctx, collectionTy), true
 	default:
-		ld := semtypes.NewListDefinition()
-		emptyListTy := ld.DefineListTypeWrapped(t.typeEnv(), nil, 0, semtypes.NEVER, semtypes.CellMutability_CELL_MUT_NONE)
-		iteratorFnTy := semtypes.ObjectMemberType(ctx, semtypes.StringConst("iterator"), collectionTy)
-		if semtypes.IsZero(iteratorFnTy) || !semtypes.IsSubtype(ctx, iteratorFnTy, semtypes.FUNCTION) {
-			t.semanticError("foreach collection is not iterable", collection.GetPosition())
-			return semtypes.SemType{}, false
-		}
-		iteratorTy := semtypes.FunctionReturnType(ctx, iteratorFnTy, emptyListTy)
-		nextFnTy := semtypes.ObjectMemberType(ctx, semtypes.StringConst("next"), iteratorTy)
-		if semtypes.IsZero(nextFnTy) || !semtypes.IsSubtype(ctx, nextFnTy, semtypes.FUNCTION) {
-			t.semanticError("foreach iterator does not have a next method", collection.GetPosition())
+		nextReturnTy, ok := resolveIteratorNextReturnType(t, "foreach", collection, collectionTy)
+		if !ok {
 			return semtypes.SemType{}, false
 		}
-		nextReturnTy := semtypes.FunctionReturnType(ctx, nextFnTy, emptyListTy)
-		valueRecordTy := semtypes.Diff(semtypes.Diff(nextReturnTy, semtypes.NIL), semtypes.ERROR)
-		return semtypes.MappingMemberTypeInnerVal(ctx, valueRecordTy, semtypes.StringConst("value")), true
+		return iteratorNextValueType(ctx, nextReturnTy), true
+	}
+}
+
+// resolveIteratorNextReturnType gives the return type of the next method of
+// the iterator of the iterable object type collectionTy. construct names the
+// iterating construct in the errors reported.
+func resolveIteratorNextReturnType(t typeResolver, construct string, collection ast.BLangActionOrExpression, collectionTy semtypes.SemType) (semtypes.SemType, bool) {
+	ctx := t.typeContext()
+	ld := semtypes.NewListDefinition()
+	emptyListTy := ld.DefineListTypeWrapped(t.typeEnv(), nil, 0, semtypes.NEVER, semtypes.CellMutability_CELL_MUT_NONE)
+	iteratorFnTy := semtypes.ObjectMemberType(ctx, semtypes.StringConst("iterator"), collectionTy)
+	if semtypes.IsZero(iteratorFnTy) || !semtypes.IsSubtype(ctx, iteratorFnTy, semtypes.FUNCTION) {
+		t.semanticError(construct+" collection is not iterable", collection.GetPosition())
+		return semtypes.SemType{}, false
+	}
+	iteratorTy := semtypes.FunctionReturnType(ctx, iteratorFnTy, emptyListTy)
+	nextFnTy := semtypes.ObjectMemberType(ctx, semtypes.StringConst("next"), iteratorTy)
+	if semtypes.IsZero(nextFnTy) || !semtypes.IsSubtype(ctx, nextFnTy, semtypes.FUNCTION) {
+		t.semanticError(construct+" iterator does not have a next method", collection.GetPosition())
+		return semtypes.SemType{}, false
 	}
+	return semtypes.FunctionReturnType(ctx, nextFnTy, emptyListTy), true
+}
+
+// iteratorNextValueType gives the type of the value field of the records
+// returned by an iterator next method of type nextReturnTy.
+func iteratorNextValueType(ctx semtypes.Context, nextReturnTy semtypes.SemType) semtypes.SemType {
+	valueRecordTy := semtypes.Diff(semtypes.Diff(nextReturnTy, semtypes.NIL), semtypes.ERROR)
+	return semtypes.MappingMemberTypeInnerVal(ctx, valueRecordTy, semtypes.StringConst("value"))
 }
 
 func mapQuerySelectExpectedType(env semtypes.Env) semtypes.SemType {
@@ -3563,11 +4310,37 @@ func querySelectExpectedType(
 		return listQuerySelectExpectedType(ctx, expectedType)
 	case ast.TypeKind_MAP:
 		return mapQuerySelectExpectedTypeFromQueryExpectedType(ctx, env, expectedType)
+	case ast.TypeKind_TABLE:
+		return queryMemberExpectedType(ctx, expectedType, semtypes.TABLE, semtypes.TableRowType)
+	case ast.TypeKind_STREAM:
+		return queryMemberExpectedType(ctx, expectedType, semtypes.STREAM, semtypes.StreamValueType)
+	case ast.TypeKind_STRING:
+		return semtypes.STRING
+	case ast.TypeKind_XML:
+		return semtypes.XML
 	default:
 		return semtypes.SemType{}
 	}
 }
 
+// queryMemberExpectedType gives the member type of the part of expectedType
+// belonging to basicTy, using memberType to project it.
+func queryMemberExpectedType(
+	ctx semtypes.Context,
+	expectedType semtypes.SemType,
+	basicTy semtypes.SemType,
+	memberType func(semtypes.Context, semtypes.SemType) semtypes.SemType,
+) semtypes.SemType {
+	if semtypes.IsZero(expectedType) {
+		return semtypes.SemType{}
+	}
+	ty := semtypes.Intersect(expectedType, basicTy)
+	if semtypes.IsEmpty(ctx, ty) {
+		return semtypes.SemType{}
+	}
+	return memberType(ctx, ty)
+}
+
 func listQuerySelectExpectedType(ctx semtypes.Context, expectedType semtypes.SemType) semtypes.SemType {
 	if semtypes.IsZero(expectedType) {
 		return semtypes.SemType{}
@@ -3626,8 +4399,8 @@ func queryVariablesBeforeClause(queryExpr *ast.BLangQueryExpr, endIndex int) []q
 		case *ast.BLangJoinClause:
 			variables = appendQueryVariableInfo(variables, seen, clause.VariableDefinitionNode)
 		case *ast.BLangLetClause:
-			for i := range clause.LetVarDeclarations {
-				variables = appendQueryVariableInfo(variables, seen, &clause.LetVarDeclarations[i])
+			for _, decl := range clause.LetVarDeclarations {
+				variables = appendQueryVariableInfo(variables, seen, decl)
 			}
 		case *ast.BLangGroupByClause:
 			for i := range clause.GroupingKeyList {
@@ -3659,23 +4432,31 @@ func appendQueryVariableInfo(
 	seen map[model.SymbolRef]bool,
 	variableDef ast.VariableDefinitionNode,
 ) []queryVariableInfo {
-	varDef, ok := variableDef.(*ast.BLangSimpleVariableDef)
-	if !ok || varDef == nil || varDef.Var == nil || !ast.SymbolIsSet(varDef.Var) {
+	if varDef, ok := variableDef.(*ast.BLangSimpleVariableDef); ok && (varDef == nil || varDef.Var == nil) {
 		return variables
 	}
-	symbol := varDef.Var.Symbol()
-	if seen[symbol] {
+	if variableDef == nil {
 		return variables
 	}
-	seen[symbol] = true
-	name := ""
-	if varDef.Var.Name != nil {
-		name = varDef.Var.Name.Value
+	for _, variable := range ast.BindingVariables(variableDef.GetVariable()) {
+		if !ast.SymbolIsSet(variable) || ast.IsWildcardVariable(variable) {
+			continue
+		}
+		symbol := variable.Symbol()
+		if seen[symbol] {
+			continue
+		}
+		seen[symbol] = true
+		name := ""
+		if variable.Name != nil {
+			name = variable.Name.Value
+		}
+		variables = append(variables, queryVariableInfo{
+			name:   name,
+			symbol: symbol,
+		})
 	}
-	return append(variables, queryVariableInfo{
-		name:   name,
-		symbol: symbol,
-	})
+	return variables
 }
 
 func queryAggregatedListType(env semtypes.Env, elemTy semtypes.SemType, nonEmpty bool) semtypes.SemType {
@@ -3814,7 +4595,7 @@ func resolveQueryIntermediateClauses(t typeResolver, chain *binding, queryExpr *
 			if !ok {
 				return nil, false
 			}
-			elementTy, ok := resolveQueryCollectionElementType(t, collectionTy, clause.GetPosition())
+			elementTy, ok := resolveQueryCollectionElementType(t, &clause.BLangInputClause, collectionTy)
 			if !ok {
 				return nil, false
 			}
@@ -3868,14 +4649,20 @@ func resolveQueryIntermediateClauses(t typeResolver, chain *binding, queryExpr *
 			}
 		case *ast.BLangLetClause:
 			clause.SetDeterminedType(semtypes.NEVER)
-			for i := range clause.LetVarDeclarations {
-				varDef := &clause.LetVarDeclarations[i]
+			for _, decl := range clause.LetVarDeclarations {
+				decl.(ast.BLangNode).SetDeterminedType(semtypes.NEVER)
+				varDef, ok := decl.(*ast.BLangSimpleVariableDef)
+				if !ok {
+					if _, ok := resolveBindingPatternVarDef(t, currentChain, decl.GetVariable()); !ok {
+						return nil, false
+					}
+					continue
+				}
 				if varDef.Var == nil {
 					t.unimplemented("only simple variable declarations are supported in let clause",
 						clause.GetPosition())
 					return nil, false
 				}
-				varDef.SetDeterminedType(semtypes.NEVER)
 				if varDef.Var.Expr == nil {
 					t.semanticError("let clause variable declaration requires an initializer",
 						varDef.GetPosition())
@@ -3967,6 +4754,10 @@ func resolveSimpleVarRef(t typeResolver, chain *binding, expr *ast.BLangSimpleVa
 		return semtypes.SemType{}, defaultExpressionEffect(chain), false
 	}
 	ty := t.symbolType(sym)
+	if t.getSymbol(sym).Kind() == model.SymbolKindType {
+		// A type name used as an expression evaluates to the typedesc of the type.
+		ty = semtypes.TypedescContaining(t.typeEnv(), ty)
+	}
 	setExpectedType(expr, ty)
 	setVarRefIdentifierTypes(expr)
 	return ty, defaultExpressionEffect(chain), true
@@ -4009,10 +4800,22 @@ func resolveListConstructorInner(t typeResolver, chain *binding, expr *ast.BLang
 			return semtypes.SemType{}, expressionEffect{}, false
 		}
 		if isSpread {
+			if !checkListSpreadMember(t, memberExpr, memberTy) {
+				return semtypes.SemType{}, expressionEffect{}, false
+			}
 			spreadMembers[i] = true
-			spreadMemberTy := semtypes.ListProj(t.typeContext(), memberTy, semtypes.INT)
-			restTy = semtypes.Union(restTy, widenedListMemberType(spreadMemberTy))
-			hasSpread = true
+			fixedTys, spreadRestTy := listSpreadShape(t.typeContext(), memberTy)
+			for _, fixedTy := range fixedTys {
+				if hasSpread {
+					restTy = semtypes.Union(restTy, widenedListMemberType(fixedTy))
+				} else {
+					memberTypes = append(memberTypes, widenedListMemberType(fixedTy))
+				}
+			}
+			if !semtypes.IsNever(spreadRestTy) {
+				restTy = semtypes.Union(restTy, widenedListMemberType(spreadRestTy))
+				hasSpread = true
+			}
 			continue
 		}
 		broadTy := widenedListMemberType(memberTy)
@@ -4034,11 +4837,41 @@ func resolveListConstructorInner(t typeResolver, chain *binding, expr *ast.BLang
 	return listTy, defaultExpressionEffect(chain), true
 }
 
+// checkListSpreadMember reports a spread member `...expr` of a list constructor whose type ty
+// is not a list type.
+func checkListSpreadMember(t typeResolver, expr ast.BLangExpression, ty semtypes.SemType) bool {
+	tc := t.typeContext()
+	if !semtypes.IsSubtype(tc, ty, semtypes.LIST) {
+		t.semanticError(fmt.Sprintf("spread member must be a list, got %s", semtypes.ToString(tc, ty)), expr.GetPosition())
+		return false
+	}
+	return true
+}
+
+// listSpreadShape returns the types of the members a spread member `...expr` of type ty
+// contributes: the types of its fixed members, followed by the type of its remaining
+// members, which is never when the spread list has a fixed length.
+func listSpreadShape(tc semtypes.Context, ty semtypes.SemType) ([]semtypes.SemType, semtypes.SemType) {
+	lat := semtypes.ToListAtomicType(tc, ty)
+	if lat == nil {
+		return nil, semtypes.ListProj(tc, ty, semtypes.INT)
+	}
+	fixedTys := make([]semtypes.SemType, lat.Members.FixedLength)
+	for i := range fixedTys {
+		fixedTys[i] = lat.MemberAtInnerVal(i)
+	}
+	return fixedTys, lat.Rest()
+}
+
 func resolveListConstructorWithExpectedType(t typeResolver, chain *binding, expr *ast.BLangListConstructorExpr, expectedType semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
 	spreadMembers := make([]bool, len(expr.Exprs))
 	for i, memberExpr := range expr.Exprs {
 		spreadMembers[i] = expr.IsSpreadMember(i) || isQueryAggregatedVariableReference(chain, memberExpr)
-		if _, _, ok := resolveActionOrExpression(t, chain, memberExpr, semtypes.SemType{}); !ok {
+		memberTy, _, ok := resolveActionOrExpression(t, chain, memberExpr, semtypes.SemType{})
+		if !ok {
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		if spreadMembers[i] && !checkListSpreadMember(t, memberExpr, memberTy) {
 			return semtypes.SemType{}, expressionEffect{}, false
 		}
 	}
@@ -4049,32 +4882,51 @@ func resolveListConstructorWithExpectedType(t typeResolver, chain *binding, expr
 		return semtypes.SemType{}, expressionEffect{}, false
 	}
 
+	tc := t.typeContext()
 	memberIndex := 0
 	restMember := false
 	for i, memberExpr := range expr.Exprs {
-		isSpread := expr.IsSpreadMember(i)
+		if expr.IsSpreadMember(i) {
+			fixedTys, spreadRestTy := listSpreadShape(tc, memberExpr.GetDeterminedType())
+			variableLength := !semtypes.IsNever(spreadRestTy)
+			for j := range fixedTys {
+				if semtypes.IsNever(lat.MemberAtInnerVal(memberIndex + j)) {
+					t.semanticError("too many members in list constructor", expr.GetPosition())
+					return semtypes.SemType{}, expressionEffect{}, false
+				}
+			}
+			if variableLength && !restMember {
+				if semtypes.IsNever(lat.Rest()) {
+					if isQueryAggregatedVariableReference(chain, memberExpr) {
+						t.semanticError("aggregated variable reference cannot be used as a spread member for a fixed-length list constructor", memberExpr.GetPosition())
+					} else {
+						t.semanticError("spread member of variable length cannot be used in a fixed-length list constructor", memberExpr.GetPosition())
+					}
+					return semtypes.SemType{}, expressionEffect{}, false
+				}
+				if memberIndex+len(fixedTys) < lat.Members.FixedLength {
+					t.semanticError(fmt.Sprintf("spread member of variable length cannot be used for member at index %d of a list constructor", memberIndex+len(fixedTys)), memberExpr.GetPosition())
+					return semtypes.SemType{}, expressionEffect{}, false
+				}
+			}
+			spreadExpectedType := spreadMemberExpectedType(t.typeEnv(), lat, memberIndex, len(fixedTys), variableLength)
+			memberExpr.SetDeterminedType(semtypes.SemType{})
+			if _, _, ok := resolveActionOrExpression(t, chain, memberExpr, spreadExpectedType); !ok {
+				return semtypes.SemType{}, expressionEffect{}, false
+			}
+			memberIndex += len(fixedTys)
+			restMember = restMember || variableLength
+			continue
+		}
 		requiredType := lat.MemberAtInnerVal(memberIndex)
-		if restMember || isSpread {
+		if restMember {
 			requiredType = lat.Rest()
 		}
 		if semtypes.IsNever(requiredType) {
-			if isSpread {
-				t.semanticError("aggregated variable reference cannot be used as a spread member for a fixed-length list constructor", memberExpr.GetPosition())
-				return semtypes.SemType{}, expressionEffect{}, false
-			}
 			t.semanticError("too many members in list constructor", expr.GetPosition())
 			return semtypes.SemType{}, expressionEffect{}, false
 		}
 		memberExpr.SetDeterminedType(semtypes.SemType{})
-		if isSpread {
-			spreadExpectedType := queryAggregatedListType(t.typeEnv(), requiredType, false)
-			if _, _, ok := resolveActionOrExpression(t, chain, memberExpr, spreadExpectedType); !ok {
-				return semtypes.SemType{}, expressionEffect{}, false
-			}
-			restMember = true
-			memberIndex = lat.Members.FixedLength
-			continue
-		}
 		if _, _, ok := resolveActionOrExpression(t, chain, memberExpr, requiredType); !ok {
 			return semtypes.SemType{}, expressionEffect{}, false
 		}
@@ -4088,6 +4940,23 @@ func resolveListConstructorWithExpectedType(t typeResolver, chain *binding, expr
 	return resultType, defaultExpressionEffect(chain), true
 }
 
+// spreadMemberExpectedType returns the list type a spread member must belong to when its
+// members are placed at memberIndex of a list constructor with inherent type lat: one
+// member for each of its fixedCount fixed members, followed by the rest type of lat when
+// the spread has a variable length.
+func spreadMemberExpectedType(env semtypes.Env, lat semtypes.ListAtomicType, memberIndex, fixedCount int, variableLength bool) semtypes.SemType {
+	memberTys := make([]semtypes.SemType, fixedCount)
+	for j := range memberTys {
+		memberTys[j] = lat.MemberAtInnerVal(memberIndex + j)
+	}
+	restTy := semtypes.NEVER
+	if variableLength {
+		restTy = lat.Rest()
+	}
+	ld := semtypes.NewListDefinition()
+	return ld.DefineListTypeWrapped(env, memberTys, fixedCount, restTy, semtypes.CellMutability_CELL_MUT_LIMITED)
+}
+
 func setListConstructorSpreadMembers(expr *ast.BLangListConstructorExpr, spreadMembers []bool) {
 	for _, isSpread := range spreadMembers {
 		if isSpread {
@@ -4130,9 +4999,20 @@ func selectListInherentType(t typeResolver, expr *ast.BLangListConstructorExpr,
 
 	alts := semtypes.ListAlternatives(tc, expectedListType)
 
-	members := make([]semtypes.ListMemberInfo, len(expr.Exprs))
-	for i, expr := range expr.Exprs {
-		members[i] = semtypes.ListMemberInfo{Index: i, ValType: expr.GetDeterminedType()}
+	members := make([]semtypes.ListMemberInfo, 0, len(expr.Exprs))
+	for i, memberExpr := range expr.Exprs {
+		if !expr.IsSpreadMember(i) {
+			members = append(members, semtypes.ListMemberInfo{Index: len(members), ValType: memberExpr.GetDeterminedType()})
+			continue
+		}
+		// Only the members known statically take part in selecting the inherent type.
+		fixedTys, spreadRestTy := listSpreadShape(tc, memberExpr.GetDeterminedType())
+		for _, fixedTy := range fixedTys {
+			members = append(members, semtypes.ListMemberInfo{Index: len(members), ValType: fixedTy})
+		}
+		if !semtypes.IsNever(spreadRestTy) {
+			break
+		}
 	}
 
 	var validAlts []semtypes.ListAlternative
@@ -4180,7 +5060,9 @@ func resolveErrorConstructorExpr(t typeResolver, chain *binding, expr *ast.BLang
 	}
 
 	if !semtypes.IsZero(expectedType) && semtypes.IsSameType(t.typeContext(), errorTy, semtypes.ERROR) {
-		errorPart := semtypes.Intersect(expectedType, semtypes.ERROR)
+		// The contextually expected type cannot supply type-ids: a distinct
+		// error can only be constructed by naming its type.
+		errorPart := semtypes.StripErrorDistinctAtoms(semtypes.Intersect(expectedType, semtypes.ERROR))
 		if !semtypes.IsEmpty(t.typeContext(), errorPart) {
 			errorTy = errorPart
 		}
@@ -4913,6 +5795,31 @@ func resolveIndexBasedAccess(t typeResolver, chain *binding, expr *ast.BLangInde
 		resultTy = memberTy
 	} else if semtypes.IsSubtype(tyCtx, containerExprTy, semtypes.STRING) {
 		resultTy = semtypes.STRING
+	} else if semtypes.IsSubtype(tyCtx, containerExprTy, semtypes.TABLE) {
+		if expr.IsLexpr {
+			t.unimplemented("table member access as an lvalue", expr.GetPosition())
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		keyTy := semtypes.TableKeyType(tyCtx, containerExprTy)
+		if semtypes.IsNever(keyTy) {
+			t.semanticError("member access is not supported for a keyless table", expr.GetPosition())
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		if _, isListKey := keyExpr.(*ast.BLangListConstructorExpr); isListKey {
+			// Multiple key expressions form a tuple; re-resolve against the key type so the
+			// members are typed like a key rather than inferred bottom-up.
+			keyExpr.SetDeterminedType(semtypes.SemType{})
+			if _, _, ok := resolveActionOrExpression(t, chain, keyExpr, keyTy); !ok {
+				return semtypes.SemType{}, expressionEffect{}, false
+			}
+		}
+		resultTy = semtypes.Union(semtypes.TableRowType(tyCtx, containerExprTy), semtypes.NIL)
+	} else if semtypes.IsSubtype(tyCtx, containerExprTy, semtypes.XML) {
+		if expr.IsLexpr {
+			t.semanticError("cannot update an xml sequence using member access", expr.GetPosition())
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		resultTy = xmlMemberType(containerExprTy)
 	} else {
 		t.semanticError("unsupported container type for index based access", expr.GetPosition())
 		return semtypes.SemType{}, expressionEffect{}, false
@@ -4927,6 +5834,9 @@ func resolveFieldBaseAccess(t typeResolver, chain *binding, expr *ast.BLangField
 	if !ok {
 		return semtypes.SemType{}, expressionEffect{}, false
 	}
+	if expr.OptionalFieldAccess {
+		return resolveOptionalFieldAccess(t, chain, expr, containerExprTy)
+	}
 	key := expr.Field.Value
 	tyCtx := t.typeContext()
 
@@ -4971,6 +5881,36 @@ func resolveFieldBaseAccess(t typeResolver, chain *binding, expr *ast.BLangField
 	return memberTy, defaultExpressionEffect(chain), true
 }
 
+// resolveOptionalFieldAccess resolves `x?.k`, which is nil when x is nil or is a mapping without a field k. When x
+// is json it may also be some other json value, in which case the result is an error, or an error, which is
+// passed through so that accesses can be chained.
+func resolveOptionalFieldAccess(t typeResolver, chain *binding, expr *ast.BLangFieldBaseAccess, containerExprTy semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
+	tyCtx := t.typeContext()
+	key := expr.Field.Value
+	mappingTy := semtypes.Intersect(containerExprTy, semtypes.MAPPING)
+	nonMappingTy := semtypes.Diff(containerExprTy, semtypes.Union(semtypes.MAPPING, semtypes.NIL))
+	isLax := semtypes.IsSubtype(tyCtx, containerExprTy, semtypes.Union(semtypes.CreateJSON(tyCtx), semtypes.ERROR))
+	if semtypes.IsEmpty(tyCtx, mappingTy) || (!semtypes.IsEmpty(tyCtx, nonMappingTy) && !isLax) {
+		t.semanticError(fmt.Sprintf("unsupported container type '%s' for optional field access", semtypes.ToString(tyCtx, containerExprTy)), expr.GetPosition())
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	memberTy := semtypes.MappingMemberTypeInner(tyCtx, mappingTy, semtypes.StringConst(key))
+	if semtypes.IsEmpty(tyCtx, semtypes.Diff(memberTy, semtypes.UNDEF)) {
+		t.semanticError(fmt.Sprintf("no field named '%s' in type '%s'", key, semtypes.ToString(tyCtx, mappingTy)), expr.GetPosition())
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	resultTy := semtypes.Diff(memberTy, semtypes.UNDEF)
+	if semtypes.ContainsUndef(memberTy) || semtypes.ContainsBasicType(containerExprTy, semtypes.NIL) {
+		resultTy = semtypes.Union(resultTy, semtypes.NIL)
+	}
+	if !semtypes.IsEmpty(tyCtx, nonMappingTy) {
+		resultTy = semtypes.Union(resultTy, semtypes.ERROR)
+	}
+	setExpectedType(expr, resultTy)
+	expr.Field.SetDeterminedType(semtypes.NEVER)
+	return resultTy, defaultExpressionEffect(chain), true
+}
+
 func fieldBaseAccessMappingType(tyCtx semtypes.Context, containerExprTy semtypes.SemType, key string, isLexpr bool) (semtypes.SemType, bool) {
 	keyTy := semtypes.StringConst(key)
 	memberTy := semtypes.MappingMemberTypeInner(tyCtx, containerExprTy, keyTy)
@@ -5035,28 +5975,36 @@ func resolveMethodCall(t typeResolver, chain *binding, expr *ast.BLangInvocation
 	if semtypes.IsSubtypeSimple(recieverTy, semtypes.STREAM) {
 		return resolveStreamOperation(t, chain, expr, methodSymbol, expectedType)
 	}
-	var symbolRef model.SymbolRef
-	var pkgAlias ast.BLangIdentifier
+	var pkgName string
 	switch {
 	case semtypes.IsSubtype(t.typeContext(), recieverTy, semtypes.LIST):
-		symbolRef, pkgAlias, ok = resolveLangLibImport(t, "lang.array", methodSymbol.name, expr)
+		pkgName = "lang.array"
 	case semtypes.IsSubtype(t.typeContext(), recieverTy, semtypes.INT):
-		symbolRef, pkgAlias, ok = resolveLangLibImport(t, "lang.int", methodSymbol.name, expr)
+		pkgName = "lang.int"
 	case semtypes.IsSubtype(t.typeContext(), recieverTy, semtypes.DECIMAL):
-		symbolRef, pkgAlias, ok = resolveLangLibImport(t, "lang.decimal", methodSymbol.name, expr)
+		pkgName = "lang.decimal"
 	case semtypes.IsSubtype(t.typeContext(), recieverTy, semtypes.FLOAT):
-		symbolRef, pkgAlias, ok = resolveLangLibImport(t, "lang.float", methodSymbol.name, expr)
+		pkgName = "lang.float"
 	case semtypes.IsSubtype(t.typeContext(), recieverTy, semtypes.MAPPING):
-		symbolRef, pkgAlias, ok = resolveLangLibImport(t, "lang.map", methodSymbol.name, expr)
+		pkgName = "lang.map"
+	case semtypes.IsSubtype(t.typeContext(), recieverTy, semtypes.TABLE):
+		pkgName = "lang.table"
 	case semtypes.IsSubtype(t.typeContext(), recieverTy, semtypes.ERROR):
-		symbolRef, pkgAlias, ok = resolveLangLibImport(t, "lang.error", methodSymbol.name, expr)
+		pkgName = "lang.error"
 	case semtypes.IsSubtype(t.typeContext(), recieverTy, semtypes.STRING):
-		symbolRef, pkgAlias, ok = resolveLangLibImport(t, "lang.string", methodSymbol.name, expr)
+		pkgName = "lang.string"
 	case semtypes.IsSubtype(t.typeContext(), recieverTy, semtypes.XML):
-		symbolRef, pkgAlias, ok = resolveLangLibImport(t, "lang.xml", methodSymbol.name, expr)
+		pkgName = "lang.xml"
+	case semtypes.IsSubtypeSimple(recieverTy, semtypes.REGEXP):
+		pkgName = "lang.regexp"
 	default:
-		symbolRef, pkgAlias, ok = resolveLangLibImport(t, "lang.value", methodSymbol.name, expr)
+		pkgName = "lang.value"
+	}
+	if pkgName != "lang.value" && !langLibHasSymbol(t, pkgName, methodSymbol.name) {
+		// Functions of lang.value apply to values of every type.
+		pkgName = "lang.value"
 	}
+	symbolRef, pkgAlias, ok := resolveLangLibImport(t, pkgName, methodSymbol.name, expr)
 	if !ok {
 		return semtypes.SemType{}, expressionEffect{}, false
 	}
@@ -5137,10 +6085,19 @@ func finishResolveMethodCall(t typeResolver, chain *binding, receiverTy semtypes
 		t.internalError("empty function param list ty", node.GetPosition())
 		return model.SymbolRef{}, semtypes.SemType{}, expressionEffect{}, false
 	}
+	if _, paramsSym, ok := functionParamsSymbol(t, fnTy); ok {
+		if inv, isInvocable := node.(invocable); isInvocable {
+			return finishResolveDescribedMethodCall(t, chain, methodName, methodSymbol, fnTy, paramsSym, inv)
+		}
+	}
 	argTys := make([]semtypes.SemType, len(argExprs))
 	for i, arg := range argExprs {
 		if _, namedParam := arg.(*ast.BLangNamedArgsExpression); namedParam {
-			t.unimplemented("named parameters not supported for non atomic method calls", arg.GetPosition())
+			t.semanticError("named arguments require a method type with named parameters", arg.GetPosition())
+			return model.SymbolRef{}, semtypes.SemType{}, expressionEffect{}, false
+		}
+		if _, restArg := arg.(*ast.BLangRestArgsExpression); restArg {
+			t.unimplemented("rest arguments not supported for non atomic method calls", arg.GetPosition())
 			return model.SymbolRef{}, semtypes.SemType{}, expressionEffect{}, false
 		}
 		key := semtypes.IntConst(int64(i))
@@ -5160,6 +6117,31 @@ func finishResolveMethodCall(t typeResolver, chain *binding, receiverTy semtypes
 	return symbolRef, retTy, defaultExpressionEffect(chain), true
 }
 
+// finishResolveDescribedMethodCall resolves a call to a method whose type has named or defaultable parameters. The
+// method symbol carries the parameter names and defaults so that the call can be desugared like a direct call.
+func finishResolveDescribedMethodCall(t typeResolver, chain *binding, methodName string, methodSymbol *deferredMethodSymbol,
+	fnTy semtypes.SemType, paramsSym model.FunctionSymbol, inv invocable,
+) (model.SymbolRef, semtypes.SemType, expressionEffect, bool) {
+	paramsSig := paramsSym.Signature()
+	argTys, chain, ok := argArray(t, paramsSym, paramsSig.ParamTypes, paramsSig.RestParamType, chain, inv, semtypes.SemType{})
+	if !ok {
+		return model.SymbolRef{}, semtypes.SemType{}, expressionEffect{}, false
+	}
+	argLd := semtypes.NewListDefinition()
+	argListTy := argLd.DefineListTypeWrapped(t.typeEnv(), argTys, len(argTys), semtypes.NEVER, semtypes.CellMutability_CELL_MUT_NONE)
+	retTy := semtypes.FunctionReturnType(t.typeContext(), fnTy, argListTy)
+	sig := model.FunctionSignature{
+		ParamTypes:    paramsSig.ParamTypes,
+		ParamNames:    paramsSig.ParamNames,
+		RestParamType: paramsSig.RestParamType,
+		ReturnType:    retTy,
+	}
+	symbolRef := t.createFunctionSymbol(methodSymbol.space, methodName, sig, fnTy)
+	t.getSymbol(symbolRef).(model.FunctionSymbol).SetDefaultableParams(*paramsSym.DefaultableParams())
+	setExpectedType(inv, retTy)
+	return symbolRef, retTy, defaultExpressionEffect(chain), true
+}
+
 func resolveResourceMethodSignature(t typeResolver, isClient bool, isService bool, method *ast.BLangResourceMethod) bool {
 	if !isClient && !isService {
 		t.semanticError("resource methods are only allowed in client or service classes", method.GetPosition())
@@ -5177,8 +6159,12 @@ func resolveResourceMethodSignature(t typeResolver, isClient bool, isService boo
 	sym.SetPathListType(pathTy)
 	sym.SetPathParams(pathParamRefs)
 
-	_, _, _, _, ok = resolveInvokableSignature(t, method, sym, method.RequiredParams)
-	return ok
+	_, paramTypes, _, _, ok := resolveInvokableSignature(t, method, sym, method.RequiredParams)
+	if !ok {
+		return false
+	}
+	setDefaultableParamFnSignatures(t, sym.DefaultableParams(), paramTypes)
+	return true
 }
 
 func resolveResourcePathType(t typeResolver, method *ast.BLangResourceMethod) (semtypes.SemType, []model.SymbolRef, bool) {
@@ -5243,32 +6229,76 @@ func resolveClientResourceAccessAction(t typeResolver, chain *binding, expr *ast
 		t.semanticError("resource access action is only allowed on client objects", expr.GetPosition())
 		return semtypes.SemType{}, expressionEffect{}, false
 	}
-	atomicType := semtypes.ToObjectAtomicType(t.typeContext(), receiverTy)
-	if atomicType == nil {
-		t.unimplemented("non-atomic receiver for resource access action", expr.GetPosition())
-		return semtypes.SemType{}, expressionEffect{}, false
-	}
-	classRef, ok := t.getClassAtomSymbol(atomicType)
-	if !ok {
-		t.internalError("failed to find class definition for receiver type", expr.GetPosition())
-		return semtypes.SemType{}, expressionEffect{}, false
-	}
-	networkSym, ok := t.getSymbol(classRef).(*model.NetworkClassSymbol)
+	networkSyms, ok := receiverNetworkClassSymbols(t, receiverTy, expr.GetPosition())
 	if !ok {
-		t.internalError("client reciever must have network class symbol", expr.GetPosition())
 		return semtypes.SemType{}, expressionEffect{}, false
 	}
 	argPathTy, _, ok := resolveResourceAccessPathType(t, chain, expr)
 	if !ok {
 		return semtypes.SemType{}, expressionEffect{}, false
 	}
+	// For a union of client classes the method is dispatched on the runtime class of the
+	// receiver, so every class must have a matching method and all of them must agree on
+	// the function type the call is checked against.
+	var methodRef model.SymbolRef
+	for i, networkSym := range networkSyms {
+		ref, ok := selectResourceMethod(t, networkSym, expr, argPathTy)
+		if !ok {
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		if i == 0 {
+			methodRef = ref
+			continue
+		}
+		if !semtypes.IsSameType(t.typeContext(), t.symbolType(methodRef), t.symbolType(ref)) {
+			t.semanticError(fmt.Sprintf("resource methods '%s' of the receiver types have incompatible signatures", expr.MethodName), expr.GetPosition())
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+	}
+	expr.SetMethodSymbol(methodRef)
+	return resolveFunctionCall(t, chain, expr, methodRef, expectedType)
+}
+
+// receiverNetworkClassSymbols returns the class symbol of each client class the
+// receiver type is made of: a single class or a union of classes.
+func receiverNetworkClassSymbols(t typeResolver, receiverTy semtypes.SemType, pos diagnostics.Location) ([]*model.NetworkClassSymbol, bool) {
+	var networkSyms []*model.NetworkClassSymbol
+	for _, atoms := range semtypes.ObjectDisjunctAtomicTypes(t.typeContext(), receiverTy) {
+		var classRef model.SymbolRef
+		found := false
+		for _, atom := range atoms {
+			if classRef, found = t.getClassAtomSymbol(atom); found {
+				break
+			}
+		}
+		if !found {
+			t.semanticError("resource access action requires a receiver whose type is a client class or a union of client classes", pos)
+			return nil, false
+		}
+		networkSym, ok := t.getSymbol(classRef).(*model.NetworkClassSymbol)
+		if !ok {
+			t.internalError("client reciever must have network class symbol", pos)
+			return nil, false
+		}
+		networkSyms = append(networkSyms, networkSym)
+	}
+	if len(networkSyms) == 0 {
+		t.internalError("failed to find class definition for receiver type", pos)
+		return nil, false
+	}
+	return networkSyms, true
+}
+
+// selectResourceMethod selects the resource method of networkSym that the
+// resource access action expr with path type argPathTy dispatches to.
+func selectResourceMethod(t typeResolver, networkSym *model.NetworkClassSymbol, expr *ast.BLangClientResourceAccessAction, argPathTy semtypes.SemType) (model.SymbolRef, bool) {
 	methodName := expr.MethodName
 	var matches []model.SymbolRef
 	for _, rmRef := range networkSym.ResourceMethods() {
 		rmSym, ok := t.getSymbol(rmRef).(*model.ResourceMethodSymbol)
 		if !ok {
 			t.internalError("expected resource method symbol", expr.GetPosition())
-			return semtypes.SemType{}, expressionEffect{}, false
+			return model.SymbolRef{}, false
 		}
 		if rmSym.MethodName() != methodName || !semtypes.IsSubtype(t.typeContext(), argPathTy, rmSym.PathListType()) {
 			continue
@@ -5277,18 +6307,42 @@ func resolveClientResourceAccessAction(t typeResolver, chain *binding, expr *ast
 	}
 	if len(matches) == 0 {
 		t.semanticError(fmt.Sprintf("no matching resource method '%s'", methodName), expr.GetPosition())
-		return semtypes.SemType{}, expressionEffect{}, false
+		return model.SymbolRef{}, false
 	}
-	if len(matches) > 1 {
-		t.unimplemented("ambiguous resource method dispatch", expr.GetPosition())
-		return semtypes.SemType{}, expressionEffect{}, false
+	methodRef, ok := mostSpecificResourceMethod(t, matches)
+	if !ok {
+		t.semanticError(fmt.Sprintf("ambiguous resource method dispatch for '%s'", methodName), expr.GetPosition())
+		return model.SymbolRef{}, false
+	}
+	return methodRef, true
+}
+
+// mostSpecificResourceMethod picks the candidate whose path type is a subtype
+// of the path types of every other candidate. It returns false if there is no
+// unique such candidate.
+func mostSpecificResourceMethod(t typeResolver, candidates []model.SymbolRef) (model.SymbolRef, bool) {
+	pathTys := make([]semtypes.SemType, len(candidates))
+	for i, ref := range candidates {
+		pathTys[i] = t.getSymbol(ref).(*model.ResourceMethodSymbol).PathListType()
 	}
-	expr.SetMethodSymbol(matches[0])
-	return resolveFunctionCall(t, chain, expr, matches[0], expectedType)
+	for i, ref := range candidates {
+		mostSpecific := true
+		for j := range candidates {
+			if i != j && !semtypes.IsSubtype(t.typeContext(), pathTys[i], pathTys[j]) {
+				mostSpecific = false
+				break
+			}
+		}
+		if mostSpecific {
+			return ref, true
+		}
+	}
+	return model.SymbolRef{}, false
 }
 
 func resolveResourceAccessPathType(t typeResolver, chain *binding, expr *ast.BLangClientResourceAccessAction) (semtypes.SemType, int, bool) {
 	var members []semtypes.SemType
+	restMember := semtypes.NEVER
 	for i := range expr.Path {
 		seg := &expr.Path[i]
 		switch seg.Kind {
@@ -5300,10 +6354,20 @@ func resolveResourceAccessPathType(t typeResolver, chain *binding, expr *ast.BLa
 				return semtypes.SemType{}, 0, false
 			}
 			members = append(members, segTy)
+		case ast.ResourceAccessSegmentRest:
+			segTy, _, ok := resolveActionOrExpression(t, chain, seg.Expr, semtypes.SemType{})
+			if !ok {
+				return semtypes.SemType{}, 0, false
+			}
+			if !semtypes.IsSubtype(t.typeContext(), segTy, semtypes.LIST) {
+				t.semanticError("resource access rest segment must be a list", seg.GetPosition())
+				return semtypes.SemType{}, 0, false
+			}
+			restMember = semtypes.ListMemberTypeInnerVal(t.typeContext(), segTy, semtypes.INT)
 		}
 	}
 	listDefn := semtypes.NewListDefinition()
-	pathTy := listDefn.DefineListTypeWrapped(t.typeEnv(), members, len(members), semtypes.NEVER, semtypes.CellMutability_CELL_MUT_NONE)
+	pathTy := listDefn.DefineListTypeWrapped(t.typeEnv(), members, len(members), restMember, semtypes.CellMutability_CELL_MUT_NONE)
 	return pathTy, len(members), true
 }
 
@@ -5334,6 +6398,16 @@ func resolveRemoteMethodCallAction(t typeResolver, chain *binding, expr *ast.BLa
 	return retTy, effect, ok
 }
 
+// langLibHasSymbol reports whether the langlib module pkgName defines name.
+func langLibHasSymbol(t typeResolver, pkgName string, name string) bool {
+	symbolSpace, ok := t.lookupImportedSymbols(pkgName)
+	if !ok {
+		return false
+	}
+	_, ok = symbolSpace.GetSymbol(name)
+	return ok
+}
+
 func resolveLangLibImport(t typeResolver, pkgName string, methodName string, expr *ast.BLangInvocation) (model.SymbolRef, ast.BLangIdentifier, bool) {
 	symbolSpace, ok := t.lookupImportedSymbols(pkgName)
 	if !ok {
@@ -5369,7 +6443,7 @@ func resolveFunctionCallArgs(t typeResolver, chain *binding, inv invocable, fnSy
 	baseSymbol := t.getSymbol(fnSymbol)
 	switch sym := baseSymbol.(type) {
 	case model.DependentlyTypedFunctionSymbol:
-		argTys, chain, ok := argArray(t, sym, sym.ParamTypes(), semtypes.SemType{}, chain, inv, expectedType)
+		argTys, chain, ok := argArray(t, sym, sym.ParamTypes(), sym.RestParamType(), chain, inv, expectedType)
 		if !ok {
 			return nil, fnSymbol, chain, false
 		}
@@ -5428,6 +6502,12 @@ func resolveFunctionCallArgs(t typeResolver, chain *binding, inv invocable, fnSy
 			return nil, narrowedSymbol, chain, false
 		}
 
+		if paramsRef, paramsSym, ok := functionParamsSymbol(t, fnTy); ok {
+			sig := paramsSym.Signature()
+			argTys, chain, ok := argArray(t, paramsSym, sig.ParamTypes, sig.RestParamType, chain, inv, expectedType)
+			inv.SetParamsSymbol(paramsRef)
+			return argTys, narrowedSymbol, chain, ok
+		}
 		paramListTy := semtypes.FunctionParamListType(t.typeContext(), fnTy)
 		if semtypes.IsZero(paramListTy) {
 			// I don't think this can happen given we have already checked fnTy to be subtype of function
@@ -5435,11 +6515,34 @@ func resolveFunctionCallArgs(t typeResolver, chain *binding, inv invocable, fnSy
 			return nil, narrowedSymbol, chain, false
 		}
 		var argTys []semtypes.SemType
-		for i, arg := range inv.CallArgs() {
+		args := inv.CallArgs()
+		for i, arg := range args {
 			if _, namedParam := arg.(*ast.BLangNamedArgsExpression); namedParam {
-				t.unimplemented("named parameters not supported for lambdas", arg.GetPosition())
+				t.semanticError("named arguments require a function type with named parameters", arg.GetPosition())
 				return nil, narrowedSymbol, chain, false
 			}
+			if restArg, ok := arg.(*ast.BLangRestArgsExpression); ok {
+				if i != len(args)-1 {
+					t.semanticError("rest argument must be the last argument", arg.GetPosition())
+					return nil, narrowedSymbol, chain, false
+				}
+				lat := semtypes.ToListAtomicType(t.typeContext(), paramListTy)
+				if lat == nil {
+					t.unimplemented("rest arguments not supported for function values with non-atomic parameter types", arg.GetPosition())
+					return nil, narrowedSymbol, chain, false
+				}
+				var paramTys []semtypes.SemType
+				for j := i; j < lat.Members.FixedLength; j++ {
+					paramTys = append(paramTys, lat.MemberAtInnerVal(j))
+				}
+				memberTys, argEffect, ok := resolveRestArg(t, chain, restArg, paramTys, lat.Rest())
+				if !ok {
+					return nil, narrowedSymbol, chain, false
+				}
+				chain = argEffect.ifTrue
+				argTys = append(argTys, memberTys...)
+				continue
+			}
 			key := semtypes.IntConst(int64(i))
 			paramTy := semtypes.ListMemberTypeInnerVal(t.typeContext(), paramListTy, key)
 			argTy, argEffect, ok := resolveActionOrExpression(t, chain, arg, paramTy)
@@ -5471,11 +6574,17 @@ type mappingSlot struct {
 	synthesized *ast.BLangMappingConstructorExpr
 }
 
+// restMemberSlot is a parameter supplied by a member of a rest argument.
+type restMemberSlot struct {
+	ty semtypes.SemType
+}
+
 // marker interface for slots
 type argSlot interface{ isArgSlot() }
 
-func (*valueSlot) isArgSlot()   {}
-func (*mappingSlot) isArgSlot() {}
+func (*valueSlot) isArgSlot()      {}
+func (*mappingSlot) isArgSlot()    {}
+func (*restMemberSlot) isArgSlot() {}
 
 func argArray(t typeResolver, sym model.FunctionSymbol, paramTypes []semtypes.SemType, restParamTy semtypes.SemType, chain *binding, inv invocable, callExpectedType semtypes.SemType) ([]semtypes.SemType, *binding, bool) {
 	args := inv.CallArgs()
@@ -5489,9 +6598,23 @@ func argArray(t typeResolver, sym model.FunctionSymbol, paramTypes []semtypes.Se
 	namedArgsByIndex := make(map[int]*ast.BLangNamedArgsExpression)
 	seen := make(map[string]bool)
 	var restArgs []ast.BLangExpression
+	var spreadArg *ast.BLangRestArgsExpression
+	spreadArgIndex := 0
 
 	for i, arg := range args {
 		switch a := arg.(type) {
+		case *ast.BLangRestArgsExpression:
+			if i != len(args)-1 {
+				t.semanticError("rest argument must be the last argument", a.GetPosition())
+				return nil, chain, false
+			}
+			if len(seen) > 0 {
+				t.semanticError("rest argument cannot be used together with named arguments", a.GetPosition())
+				return nil, chain, false
+			}
+			spreadArg = a
+			spreadArgIndex = i
+
 		case *ast.BLangNamedArgsExpression:
 			name := a.Name.Value
 			if seen[name] {
@@ -5569,6 +6692,23 @@ func argArray(t typeResolver, sym model.FunctionSymbol, paramTypes []semtypes.Se
 		}
 	}
 
+	var spreadTailTys []semtypes.SemType
+	if spreadArg != nil {
+		var paramTys []semtypes.SemType
+		if spreadArgIndex < nRequired {
+			paramTys = paramTypes[spreadArgIndex:nRequired]
+		}
+		memberTys, effect, ok := resolveRestArg(t, chain, spreadArg, paramTys, restParamTy)
+		if !ok {
+			return nil, chain, false
+		}
+		chain = effect.ifTrue
+		for j := range spreadArg.ParamMembers {
+			slots[spreadArgIndex+j] = &restMemberSlot{ty: memberTys[j]}
+		}
+		spreadTailTys = memberTys[spreadArg.ParamMembers:]
+	}
+
 	if inclInfo != nil {
 		for i := 0; i < inclInfo.Len(); i++ {
 			if !inclInfo.IsIncluded(i) {
@@ -5604,7 +6744,7 @@ func argArray(t typeResolver, sym model.FunctionSymbol, paramTypes []semtypes.Se
 				tys = append(tys, semtypes.TypedescContaining(t.typeEnv(), S))
 				continue
 			}
-			tys = append(tys, paramTypes[i])
+			tys = append(tys, defaultArgType(sym.DefaultableParams(), paramTypes, i))
 
 		case *valueSlot:
 			ty, effect, ok := resolveActionOrExpression(t, chain, s.expr, paramTypes[i])
@@ -5626,6 +6766,9 @@ func argArray(t typeResolver, sym model.FunctionSymbol, paramTypes []semtypes.Se
 			chain = effect.ifTrue
 			s.synthesized = mc
 			tys = append(tys, paramTypes[i])
+
+		case *restMemberSlot:
+			tys = append(tys, s.ty)
 		}
 	}
 
@@ -5638,12 +6781,65 @@ func argArray(t typeResolver, sym model.FunctionSymbol, paramTypes []semtypes.Se
 		tys = append(tys, ty)
 	}
 
+	tys = append(tys, spreadTailTys...)
+
 	if inclInfo != nil {
 		rewriteCallArgsForIncludedRecords(inv, args, slots, paramNames, inclInfo)
 	}
 	return tys, chain, true
 }
 
+// resolveRestArg resolves a rest argument `...expr` that supplies the parameters with types
+// paramTys, followed by a rest parameter whose members have type restParamTy (zero or never
+// when there is none). It returns the types of the fixed members of the argument. Parameters
+// not covered by a fixed member are left to their defaults, so a rest argument of variable
+// length must cover all of paramTys with its fixed members.
+func resolveRestArg(t typeResolver, chain *binding, arg *ast.BLangRestArgsExpression, paramTys []semtypes.SemType, restParamTy semtypes.SemType) ([]semtypes.SemType, expressionEffect, bool) {
+	tc := t.typeContext()
+	argTy, _, ok := resolveActionOrExpression(t, chain, arg.Expr, semtypes.SemType{})
+	if !ok {
+		return nil, expressionEffect{}, false
+	}
+	if !semtypes.IsSubtype(tc, argTy, semtypes.LIST) {
+		t.semanticError(fmt.Sprintf("rest argument must be a list, got %s", semtypes.ToString(tc, argTy)), arg.Expr.GetPosition())
+		return nil, expressionEffect{}, false
+	}
+	fixedTys, spreadRestTy := listSpreadShape(tc, argTy)
+	variableLength := !semtypes.IsNever(spreadRestTy)
+	paramMembers := min(len(fixedTys), len(paramTys))
+	if variableLength && paramMembers < len(paramTys) {
+		t.semanticError("rest argument of variable length cannot be used for a required parameter", arg.GetPosition())
+		return nil, expressionEffect{}, false
+	}
+	hasRestParam := !semtypes.IsZero(restParamTy) && !semtypes.IsNever(restParamTy)
+	if (variableLength || len(fixedTys) > paramMembers) && !hasRestParam {
+		t.semanticError("too many arguments in rest argument", arg.GetPosition())
+		return nil, expressionEffect{}, false
+	}
+	memberTys := make([]semtypes.SemType, len(fixedTys))
+	for i := range memberTys {
+		if i < paramMembers {
+			memberTys[i] = paramTys[i]
+		} else {
+			memberTys[i] = restParamTy
+		}
+	}
+	restTy := semtypes.NEVER
+	if variableLength {
+		restTy = restParamTy
+	}
+	ld := semtypes.NewListDefinition()
+	expectedTy := ld.DefineListTypeWrapped(t.typeEnv(), memberTys, len(memberTys), restTy, semtypes.CellMutability_CELL_MUT_LIMITED)
+	arg.Expr.SetDeterminedType(semtypes.SemType{})
+	_, effect, ok := resolveActionOrExpression(t, chain, arg, expectedTy)
+	if !ok {
+		return nil, expressionEffect{}, false
+	}
+	arg.ParamMembers = paramMembers
+	fixedTys, _ = listSpreadShape(tc, arg.Expr.GetDeterminedType())
+	return fixedTys, effect, true
+}
+
 func includedRecordArgIndex(t typeResolver, inclInfo *model.IncludedRecordParamInfo, paramTypes []semtypes.SemType, name string, argTy semtypes.SemType, pos diagnostics.Location) (int, bool) {
 	var explicitMatches []int
 	var restMatches []int
@@ -5818,7 +7014,7 @@ func methodMemberType(t typeResolver, methodRef model.SymbolRef) semtypes.SemTyp
 	sig := model.FunctionSignature{
 		ParamTypes:    paramTypes,
 		ReturnType:    retTy,
-		RestParamType: semtypes.NEVER,
+		RestParamType: depSym.RestParamType(),
 		Flags:         depSym.FuncFlags(),
 	}
 	return typeFromFunctionSignature(t, sig)
@@ -5872,6 +7068,12 @@ func resolveBType(t typeResolver, btype ast.BType, depth int) (semtypes.SemType,
 	if !ok {
 		return semtypes.SemType{}, false
 	}
+	if node, isInline := btype.(inlineDistinctType); isInline && node.IsDistinct() {
+		res, ok = resolveInlineDistinctType(t, node, res)
+		if !ok {
+			return semtypes.SemType{}, false
+		}
+	}
 	bLangNode.SetDeterminedType(res)
 	typeData := btype.GetTypeData()
 	typeData.Type = res
@@ -5888,6 +7090,57 @@ func resolveTypeDataPair(t typeResolver, typeData *ast.TypeData, depth int) (sem
 	return ty, true
 }
 
+func resolveTableType(t typeResolver, ty *ast.BLangTableType, depth int) (semtypes.SemType, bool) {
+	rowTy, ok := resolveTypeDataPair(t, &ty.Constraint, depth+1)
+	if !ok {
+		return semtypes.SemType{}, false
+	}
+	tc := t.typeContext()
+	if !semtypes.IsSubtype(tc, rowTy, semtypes.MAPPING) {
+		t.semanticError("table row type must be a subtype of map<any|error>", ty.Constraint.TypeDescriptor.GetPosition())
+		return semtypes.SemType{}, false
+	}
+	switch {
+	case ty.KeySpecifier != nil && len(ty.KeySpecifier.FieldNameIdentifierList) > 0:
+		fieldNames := ty.KeySpecifier.GetFieldNames()
+		if !validateTableKeyFields(t, rowTy, fieldNames, ty.KeySpecifier.GetPosition()) {
+			return semtypes.SemType{}, false
+		}
+		return semtypes.TableContainingKeySpecifier(tc, rowTy, fieldNames), true
+	case ty.KeyTypeConstraint != nil:
+		keyTy, ok := resolveTypeDataPair(t, &ty.KeyTypeConstraint.KeyType, depth+1)
+		if !ok {
+			return semtypes.SemType{}, false
+		}
+		return semtypes.TableContainingKeyConstraint(tc, rowTy, keyTy), true
+	default:
+		return semtypes.TableContaining(t.typeEnv(), rowTy), true
+	}
+}
+
+// validateTableKeyFields checks that every field named in a key specifier is a required field of
+// rowTy whose type is a subtype of readonly & anydata.
+func validateTableKeyFields(t typeResolver, rowTy semtypes.SemType, fieldNames []string, pos diagnostics.Location) bool {
+	tc := t.typeContext()
+	keyFieldTy := semtypes.Intersect(semtypes.CreateAnydata(tc), semtypes.VAL_READONLY)
+	for i, name := range fieldNames {
+		if slices.Contains(fieldNames[:i], name) {
+			t.semanticError(fmt.Sprintf("duplicate field name '%s' in table key specifier", name), pos)
+			return false
+		}
+		fieldTy := semtypes.MappingMemberTypeInner(tc, rowTy, semtypes.StringConst(name))
+		if semtypes.ContainsUndef(fieldTy) {
+			t.semanticError(fmt.Sprintf("field '%s' used in table key specifier must be a required field", name), pos)
+			return false
+		}
+		if !semtypes.IsSubtype(tc, fieldTy, keyFieldTy) {
+			t.semanticError(fmt.Sprintf("field '%s' used in table key specifier must be a subtype of readonly & anydata", name), pos)
+			return false
+		}
+	}
+	return true
+}
+
 func resolveBTypeInner(t typeResolver, btype ast.BType, depth int) (semtypes.SemType, bool) {
 	switch ty := btype.(type) {
 	case *ast.BLangValueType:
@@ -5979,9 +7232,6 @@ func resolveBTypeInner(t typeResolver, btype ast.BType, depth int) (semtypes.Sem
 		}
 		return result, true
 	case *ast.BLangErrorTypeNode:
-		if ty.IsDistinct() {
-			panic("distinct error types not supported")
-		}
 		if ty.IsTop() {
 			return semtypes.ERROR, true
 		} else {
@@ -6047,6 +7297,12 @@ func resolveBTypeInner(t typeResolver, btype ast.BType, depth int) (semtypes.Sem
 					return semtypes.SemType{}, false
 				}
 				return semtypes.XMLSequence(constraint), true
+			case ast.TypeKind_FUTURE:
+				constraint, ok := resolveTypeDataPair(t, &ty.Constraint, depth+1)
+				if !ok {
+					return semtypes.SemType{}, false
+				}
+				return semtypes.FutureContaining(t.typeEnv(), constraint), true
 			default:
 				t.unimplemented("unsupported base type kind", diagnostics.Location{})
 				return semtypes.SemType{}, false
@@ -6068,9 +7324,10 @@ func resolveBTypeInner(t typeResolver, btype ast.BType, depth int) (semtypes.Sem
 			return semtypes.XML, true
 		case ast.TypeKind_STREAM:
 			return semtypes.STREAM, true
-		case ast.TypeKind_TABLE, ast.TypeKind_FUTURE:
-			t.unimplemented("unsupported builtin type kind: "+string(ty.TypeKind), ty.GetPosition())
-			return semtypes.SemType{}, false
+		case ast.TypeKind_FUTURE:
+			return semtypes.FUTURE, true
+		case ast.TypeKind_TABLE:
+			return semtypes.TABLE, true
 		default:
 			t.internalError("Unexpected builtin type kind", ty.GetPosition())
 		}
@@ -6097,6 +7354,8 @@ func resolveBTypeInner(t typeResolver, btype ast.BType, depth int) (semtypes.Sem
 		d := semtypes.NewStreamDefinition()
 		ty.Definition = &d
 		return d.Define(t.typeEnv(), valueTy, completionTy), true
+	case *ast.BLangTableType:
+		return resolveTableType(t, ty, depth)
 	case *ast.BLangTupleTypeNode:
 		defn := ty.Definition
 		if defn == nil {
@@ -6250,6 +7509,14 @@ func resolveBTypeInner(t typeResolver, btype ast.BType, depth int) (semtypes.Sem
 		transactional := ty.IsTransactional()
 		fnType := fd.Define(t.typeEnv(), paramListTy, returnTy,
 			semtypes.FunctionQualifiersFrom(t.typeEnv(), isolated, transactional))
+		sig := model.FunctionSignature{
+			ParamTypes:    paramTypes,
+			RestParamType: restTy,
+			ReturnType:    returnTy,
+		}
+		if !resolveFunctionTypeParams(t, ty, fnType, sig) {
+			return semtypes.SemType{}, false
+		}
 		return fnType, true
 	case *ast.BLangObjectType:
 		return resolveObjectType(t, ty, depth)
@@ -6259,6 +7526,47 @@ func resolveBTypeInner(t typeResolver, btype ast.BType, depth int) (semtypes.Sem
 	}
 }
 
+// resolveFunctionTypeParams resolves the defaults of the parameters of a function type descriptor. When a parameter
+// is named or has a default, it records a function symbol describing the parameters, so that calls through values of
+// the type can use named arguments and defaults.
+func resolveFunctionTypeParams(t typeResolver, ty *ast.BLangFunctionType, fnType semtypes.SemType, sig model.FunctionSignature) bool {
+	defaultable := model.NewDefaultableParamInfo(len(ty.RequiredParams))
+	sig.ParamNames = make([]string, len(ty.RequiredParams))
+	described := false
+	for i := range ty.RequiredParams {
+		param := &ty.RequiredParams[i]
+		if param.Name != nil {
+			sig.ParamNames[i] = param.Name.Value
+			described = true
+		}
+		if param.InitExpr == nil {
+			continue
+		}
+		described = true
+		if _, isTypedesc := param.InitExpr.(*ast.BLangInferredTypedescDefault); isTypedesc {
+			t.unimplemented("inferred typedesc defaults are not supported in function types", param.InitExpr.GetPosition())
+			return false
+		}
+		if _, _, ok := resolveActionOrExpression(t, nil, param.InitExpr, sig.ParamTypes[i]); !ok {
+			return false
+		}
+		param.DefaultFnRef = allocateDefaultFnSymbol(t, sig.ParamTypes[i])
+		defaultable.SetDefaultable(i, param.DefaultFnRef)
+	}
+	if !described {
+		return true
+	}
+	fnName := t.nextDefaultFnName()
+	fnSymbol := model.NewFunctionSymbol(fnName, sig, false)
+	fnSymbol.SetDefaultableParams(defaultable)
+	scope := t.currentScope()
+	scope.AddSymbol(fnName, fnSymbol)
+	ref, _ := scope.GetSymbol(fnName)
+	t.setSymbolType(ref, fnType)
+	registerFunctionParamsSymbol(t, fnType, ref)
+	return true
+}
+
 func resolveObjectType(t typeResolver, ty *ast.BLangObjectType, depth int) (semtypes.SemType, bool) {
 	defn := ty.Definition
 	if defn != nil {
@@ -6281,9 +7589,12 @@ func resolveObjectType(t typeResolver, ty *ast.BLangObjectType, depth int) (semt
 		includedMembers[member.Name] = append(includedMembers[member.Name], member)
 	}
 
-	// Step 2: Build direct members and validate overrides
+	// Step 2: Build direct members and validate overrides. Members are resolved in name order, since resolving a
+	// method type can allocate symbols named in sequence.
 	var directMembers []directMember
-	for m := range ty.Members() {
+	for _, m := range slices.SortedFunc(ty.Members(), func(a, b ast.ObjectMember) int {
+		return strings.Compare(a.Name(), b.Name())
+	}) {
 		if m.MemberKind() == ast.ObjectMemberKindRemoteMethod {
 			if ty.NetworkQuals != ast.ObjectNetworkQualsClient && ty.NetworkQuals != ast.ObjectNetworkQualsService {
 				t.semanticError("remote methods are only allowed in client or service object types", ty.GetPosition())
@@ -6294,6 +7605,9 @@ func resolveObjectType(t typeResolver, ty *ast.BLangObjectType, depth int) (semt
 		if !ok {
 			return semtypes.SemType{}, false
 		}
+		if ty.Readonly && m.MemberKind() == ast.ObjectMemberKindField {
+			valueTy = semtypes.Intersect(valueTy, semtypes.VAL_READONLY)
+		}
 		directMembers = append(directMembers, directMember{
 			name:       m.Name(),
 			valueTy:    valueTy,
@@ -6311,7 +7625,7 @@ func resolveObjectType(t typeResolver, ty *ast.BLangObjectType, depth int) (semt
 
 	// Step 3: Create semtype
 	networkQual := semtypeNetworkQualifier(ty.NetworkQuals)
-	qualifiers := semtypes.ObjectQualifiersFrom(ty.Isolated, false, networkQual)
+	qualifiers := semtypes.ObjectQualifiersFrom(ty.Isolated, ty.Readonly, networkQual)
 	semType := od.Define(t.typeEnv(), qualifiers, members)
 	return semType, true
 }
@@ -6477,13 +7791,11 @@ func resolveMatchStatement(t typeResolver, chain *binding, stmt *ast.BLangMatchS
 			t.semanticError("unreachable match clause", clause.GetPosition())
 		}
 
-		var bodyChain *binding
-		var ok bool
-		clause.AcceptedType, bodyChain, ok = matchClauseAcceptedType(t, chain, clause, remainingType)
+		acceptedTy, coveredTy, ok := matchClauseTypes(t, chain, clause, remainingType)
 		if !ok {
 			return defaultStmtEffect(chain), false
 		}
-		clauseAcceptedType := semtypes.Intersect(remainingType, clause.AcceptedType)
+		clauseAcceptedType := semtypes.Intersect(remainingType, acceptedTy)
 
 		clauseIsEmpty := semtypes.IsEmpty(tyCtx, clauseAcceptedType)
 		if clauseIsEmpty {
@@ -6492,15 +7804,8 @@ func resolveMatchStatement(t typeResolver, chain *binding, stmt *ast.BLangMatchS
 
 		clause.AcceptedType = clauseAcceptedType
 
-		if clauseIsEmpty {
-			_, ok := resolveMatchClause(t, bodyChain, clause)
-			if !ok {
-				return defaultStmtEffect(chain), false
-			}
-			continue
-		}
-
-		if isVarRef {
+		bodyChain := chain
+		if isVarRef && !clauseIsEmpty {
 			baseRef := t.unnarrowedSymbol(exprRef)
 			narrowedSym := narrowSymbol(t, baseRef, clauseAcceptedType)
 			bodyChain = &binding{
@@ -6509,6 +7814,22 @@ func resolveMatchStatement(t typeResolver, chain *binding, stmt *ast.BLangMatchS
 				prev:           bodyChain,
 			}
 		}
+		// The guard sees the matched value narrowed to the clause, and the body sees it narrowed by the guard.
+		if clause.Guard != nil {
+			_, guardEffect, ok := resolveActionOrExpression(t, bodyChain, clause.Guard, semtypes.BOOLEAN)
+			if !ok {
+				return defaultStmtEffect(chain), false
+			}
+			bodyChain = guardEffect.ifTrue
+		}
+
+		if clauseIsEmpty {
+			_, ok := resolveMatchClause(t, bodyChain, clause)
+			if !ok {
+				return defaultStmtEffect(chain), false
+			}
+			continue
+		}
 
 		bodyEffect, ok := resolveMatchClause(t, bodyChain, clause)
 		if !ok {
@@ -6519,7 +7840,10 @@ func resolveMatchStatement(t typeResolver, chain *binding, stmt *ast.BLangMatchS
 			allNonCompletion = false
 		}
 
-		remainingType = semtypes.Diff(remainingType, clause.AcceptedType)
+		// A clause with a guard may not match the values its match patterns match.
+		if clause.Guard == nil {
+			remainingType = semtypes.Diff(remainingType, coveredTy)
+		}
 	}
 
 	stmt.IsExhaustive = semtypes.IsEmpty(tyCtx, remainingType)
@@ -6544,31 +7868,6 @@ func resolveMatchStatement(t typeResolver, chain *binding, stmt *ast.BLangMatchS
 	return statementEffect{result, false}, true
 }
 
-func matchClauseAcceptedType(t typeResolver, chain *binding, clause *ast.BLangMatchClause, remainingType semtypes.SemType) (semtypes.SemType, *binding, bool) {
-	tyCtx := semtypes.ContextFrom(t.typeEnv())
-	acceptedTy := semtypes.NEVER
-	patternRemaining := remainingType
-	for i, pattern := range clause.Patterns {
-		patternTy, ok := resolveMatchPattern(t, chain, pattern, remainingType)
-		if !ok {
-			return semtypes.SemType{}, nil, false
-		}
-		if i > 0 && semtypes.IsEmpty(tyCtx, semtypes.Intersect(patternTy, patternRemaining)) {
-			t.semanticError("unmatchable match pattern", pattern.GetPosition())
-		}
-		patternRemaining = semtypes.Diff(patternRemaining, patternTy)
-		acceptedTy = semtypes.Union(acceptedTy, patternTy)
-	}
-	if clause.Guard != nil {
-		_, guardEffect, ok := resolveActionOrExpression(t, chain, clause.Guard, remainingType)
-		if !ok {
-			return semtypes.SemType{}, nil, false
-		}
-		return acceptedTy, guardEffect.ifTrue, true
-	}
-	return acceptedTy, chain, true
-}
-
 func resolveObjectMemberType(t typeResolver, m ast.ObjectMember, depth int) (semtypes.SemType, bool) {
 	switch m := m.(type) {
 	case *ast.BObjectField:
@@ -6612,31 +7911,6 @@ func isValidConstPatternExpr(t typeResolver, expr ast.BLangExpression) bool {
 	return sym != nil && sym.Kind() == model.SymbolKindConstant
 }
 
-func resolveMatchPattern(t typeResolver, chain *binding, pattern ast.BLangMatchPattern, expectedTy semtypes.SemType) (semtypes.SemType, bool) {
-	switch p := pattern.(type) {
-	case *ast.BLangConstPattern:
-		ty, _, ok := resolveActionOrExpression(t, chain, p.Expr, expectedTy)
-		if !ok {
-			return semtypes.SemType{}, false
-		}
-		if !isValidConstPatternExpr(t, p.Expr) {
-			t.semanticError("match pattern variable reference must refer to a constant", p.Expr.GetPosition())
-			return semtypes.SemType{}, false
-		}
-		p.SetAcceptedType(ty)
-		p.SetDeterminedType(semtypes.NEVER)
-		return ty, true
-	case *ast.BLangWildCardMatchPattern:
-		ty := semtypes.ANY
-		p.SetAcceptedType(ty)
-		p.SetDeterminedType(semtypes.NEVER)
-		return ty, true
-	default:
-		t.internalError(fmt.Sprintf("unexpected match pattern type: %T", pattern), pattern.GetPosition())
-		return semtypes.NEVER, false
-	}
-}
-
 func semtypeMemberKind(kind ast.ObjectMemberKind) semtypes.MemberKind {
 	switch kind {
 	case ast.ObjectMemberKindField:
@@ -6723,15 +7997,51 @@ type opaqueFnMonomorphizer func(t typeResolver, sym *model.OpaqueFunctionSymbol,
 var (
 	arrayOpaqueMonomorphizers []opaqueFnMonomorphizer
 	mapOpaqueMonomorphizers   []opaqueFnMonomorphizer
+	errorOpaqueMonomorphizers []opaqueFnMonomorphizer
+	valueOpaqueMonomorphizers []opaqueFnMonomorphizer
+	tableOpaqueMonomorphizers []opaqueFnMonomorphizer
 )
 
 func init() {
 	arrayOpaqueMonomorphizers = []opaqueFnMonomorphizer{
-		model.OpaqueFnArrayPush: monomorphizeArrayPush,
+		model.OpaqueFnArrayPush:        monomorphizeArrayPush,
+		model.OpaqueFnArrayMap:         arrayMonomorphizer(arrayMapSignature),
+		model.OpaqueFnArrayFilter:      arrayMonomorphizer(arrayCallbackSignature("func", semtypes.BOOLEAN, arrayResultList)),
+		model.OpaqueFnArrayForEach:     arrayMonomorphizer(arrayCallbackSignature("func", semtypes.NIL, arrayResultNil)),
+		model.OpaqueFnArrayReduce:      arrayMonomorphizer(arrayReduceSignature),
+		model.OpaqueFnArraySome:        arrayMonomorphizer(arrayCallbackSignature("func", semtypes.BOOLEAN, arrayResultBoolean)),
+		model.OpaqueFnArrayEvery:       arrayMonomorphizer(arrayCallbackSignature("func", semtypes.BOOLEAN, arrayResultBoolean)),
+		model.OpaqueFnArrayIndexOf:     arrayMonomorphizer(arrayIndexOfSignature),
+		model.OpaqueFnArrayLastIndexOf: arrayMonomorphizer(arrayLastIndexOfSignature),
+		model.OpaqueFnArraySlice:       arrayMonomorphizer(arraySliceSignature),
+		model.OpaqueFnArrayReverse:     arrayMonomorphizer(arrayUnarySignature(arrayResultList)),
+		model.OpaqueFnArraySort:        arrayMonomorphizer(arraySortSignature),
+		model.OpaqueFnArrayPop:         arrayMonomorphizer(arrayUnarySignature(arrayResultMember)),
+		model.OpaqueFnArrayShift:       arrayMonomorphizer(arrayUnarySignature(arrayResultMember)),
+		model.OpaqueFnArrayUnshift:     arrayMonomorphizer(arrayUnshiftSignature),
+		model.OpaqueFnArrayRemove:      arrayMonomorphizer(arrayRemoveSignature),
+		model.OpaqueFnArrayEnumerate:   arrayMonomorphizer(arrayUnarySignature(arrayResultEnumeration)),
+		model.OpaqueFnArrayIterator:    arrayMonomorphizer(arrayUnarySignature(arrayResultIterator)),
+		model.OpaqueFnArrayToStream:    arrayMonomorphizer(arrayUnarySignature(arrayResultStream)),
 	}
 	mapOpaqueMonomorphizers = []opaqueFnMonomorphizer{
 		model.OpaqueFnMapRemove: monomorphizeMapRemove,
 	}
+	errorOpaqueMonomorphizers = []opaqueFnMonomorphizer{
+		model.OpaqueFnErrorDetail: monomorphizeErrorDetail,
+	}
+	valueOpaqueMonomorphizers = []opaqueFnMonomorphizer{
+		model.OpaqueFnValueCloneReadOnly: valueCloneMonomorphizer(true),
+		model.OpaqueFnValueClone:         valueCloneMonomorphizer(false),
+	}
+	tableOpaqueMonomorphizers = []opaqueFnMonomorphizer{
+		model.OpaqueFnTablePut:     tableMonomorphizer(false, tableRowParamSignature),
+		model.OpaqueFnTableAdd:     tableMonomorphizer(false, tableRowParamSignature),
+		model.OpaqueFnTableGet:     tableMonomorphizer(true, tableKeyParamSignature(false)),
+		model.OpaqueFnTableHasKey:  tableMonomorphizer(true, tableKeyParamSignature(true)),
+		model.OpaqueFnTableRemove:  tableMonomorphizer(true, tableKeyParamSignature(false)),
+		model.OpaqueFnTableToArray: tableMonomorphizer(false, tableToArraySignature),
+	}
 }
 
 // opaqueFunctionMonomorphizerFor selects the monomorphizer for a generic
@@ -6746,6 +8056,12 @@ func opaqueFunctionMonomorphizerFor(org, pkg string, id int) (opaqueFnMonomorphi
 		monomorphizers = arrayOpaqueMonomorphizers
 	case "lang.map":
 		monomorphizers = mapOpaqueMonomorphizers
+	case "lang.error":
+		monomorphizers = errorOpaqueMonomorphizers
+	case "lang.value":
+		monomorphizers = valueOpaqueMonomorphizers
+	case "lang.table":
+		monomorphizers = tableOpaqueMonomorphizers
 	default:
 		return nil, false
 	}
@@ -6790,8 +8106,8 @@ func containerArgExpr(args []ast.BLangExpression, paramName string) (ast.BLangEx
 }
 
 // storeMonomorphizedOpaqueFn builds the monomorphic symbol for sig, adds it to
-// the opaque symbol's space, sets its type, and caches it under containerTy.
-func storeMonomorphizedOpaqueFn(t typeResolver, sym *model.OpaqueFunctionSymbol, polymorphicRef model.SymbolRef, sig model.FunctionSignature, containerTy semtypes.SemType) model.SymbolRef {
+// the opaque symbol's space, sets its type, and caches it under keys.
+func storeMonomorphizedOpaqueFn(t typeResolver, sym *model.OpaqueFunctionSymbol, polymorphicRef model.SymbolRef, sig model.FunctionSignature, keys ...semtypes.SemType) model.SymbolRef {
 	mono := &monomorphicOpaqueFn{FunctionSymbol: model.NewFunctionSymbol(sym.Name(), sig, true), poly: polymorphicRef}
 	mono.SetType(typeFromFunctionSignature(t, sig))
 	space := sym.SymbolSpace
@@ -6799,7 +8115,7 @@ func storeMonomorphizedOpaqueFn(t typeResolver, sym *model.OpaqueFunctionSymbol,
 	mono.name = fmt.Sprintf("%s$mono$%d", sym.Name(), idx)
 	ref := space.RefAt(idx)
 	if sym.Store != nil {
-		sym.Store(ref, containerTy)
+		sym.Store(ref, keys...)
 	}
 	return ref
 }
@@ -6863,3 +8179,144 @@ func monomorphizeMapRemove(t typeResolver, sym *model.OpaqueFunctionSymbol, poly
 	}
 	return storeMonomorphizedOpaqueFn(t, sym, polymorphicRef, sig, containerTy), true
 }
+
+// monomorphizeErrorDetail types error:detail as returning the detail mapping
+// type of its argument.
+func monomorphizeErrorDetail(t typeResolver, sym *model.OpaqueFunctionSymbol, polymorphicRef model.SymbolRef, chain *binding, args []ast.BLangExpression, pos diagnostics.Location) (model.SymbolRef, bool) {
+	containerExpr, ok := containerArgExpr(args, "e")
+	if !ok {
+		t.semanticError("missing container argument", pos)
+		return model.SymbolRef{}, false
+	}
+	containerTy, _, ok := resolveActionOrExpression(t, chain, containerExpr, semtypes.SemType{})
+	if !ok {
+		return model.SymbolRef{}, false
+	}
+	if sym.Lookup != nil {
+		if ref, ok := sym.Lookup(containerTy); ok {
+			return ref, true
+		}
+	}
+	if !semtypes.IsSubtype(t.typeContext(), containerTy, semtypes.ERROR) {
+		t.semanticError("expect first argument to be a subtype of error", pos)
+		return model.SymbolRef{}, false
+	}
+	sig := model.FunctionSignature{
+		ParamTypes:    []semtypes.SemType{containerTy},
+		RestParamType: semtypes.NEVER,
+		ReturnType:    semtypes.ErrorDetailType(containerTy),
+		Flags:         model.FuncSymbolFlagIsolated,
+	}
+	return storeMonomorphizedOpaqueFn(t, sym, polymorphicRef, sig, containerTy), true
+}
+
+// valueCloneMonomorphizer builds the monomorphizer for value:clone and
+// value:cloneReadOnly. Both return the type of their argument, narrowed to
+// its readonly part when readonly is set.
+func valueCloneMonomorphizer(readonly bool) opaqueFnMonomorphizer {
+	return func(t typeResolver, sym *model.OpaqueFunctionSymbol, polymorphicRef model.SymbolRef, chain *binding, args []ast.BLangExpression, pos diagnostics.Location) (model.SymbolRef, bool) {
+		valueExpr, ok := containerArgExpr(args, "v")
+		if !ok {
+			t.semanticError("missing value argument", pos)
+			return model.SymbolRef{}, false
+		}
+		valueTy, _, ok := resolveActionOrExpression(t, chain, valueExpr, semtypes.SemType{})
+		if !ok {
+			return model.SymbolRef{}, false
+		}
+		if sym.Lookup != nil {
+			if ref, ok := sym.Lookup(valueTy); ok {
+				return ref, true
+			}
+		}
+		cx := t.typeContext()
+		if !semtypes.IsSubtype(cx, valueTy, semtypes.CreateCloneable(cx)) {
+			t.semanticError("expect first argument to be a subtype of value:Cloneable", pos)
+			return model.SymbolRef{}, false
+		}
+		returnTy := valueTy
+		if readonly {
+			returnTy = semtypes.Intersect(valueTy, semtypes.VAL_READONLY)
+		}
+		sig := model.FunctionSignature{
+			ParamTypes:    []semtypes.SemType{valueTy},
+			RestParamType: semtypes.NEVER,
+			ReturnType:    returnTy,
+			Flags:         model.FuncSymbolFlagIsolated,
+		}
+		return storeMonomorphizedOpaqueFn(t, sym, polymorphicRef, sig, valueTy), true
+	}
+}
+
+// tableMonomorphizer builds the monomorphizer for a generic lang.table
+// function whose signature depends only on the table type. When needsKey is
+// set the table must have a key specifier or key type constraint.
+func tableMonomorphizer(needsKey bool, signature func(t typeResolver, containerTy semtypes.SemType) model.FunctionSignature) opaqueFnMonomorphizer {
+	return func(t typeResolver, sym *model.OpaqueFunctionSymbol, polymorphicRef model.SymbolRef, chain *binding, args []ast.BLangExpression, pos diagnostics.Location) (model.SymbolRef, bool) {
+		containerExpr, ok := containerArgExpr(args, "t")
+		if !ok {
+			t.semanticError("missing container argument", pos)
+			return model.SymbolRef{}, false
+		}
+		containerTy, _, ok := resolveActionOrExpression(t, chain, containerExpr, semtypes.SemType{})
+		if !ok {
+			return model.SymbolRef{}, false
+		}
+		if sym.Lookup != nil {
+			if ref, ok := sym.Lookup(containerTy); ok {
+				return ref, true
+			}
+		}
+		cx := t.typeContext()
+		if !semtypes.IsSubtype(cx, containerTy, semtypes.TABLE) {
+			t.semanticError("expect first argument to be a subtype of table<map<any|error>>", pos)
+			return model.SymbolRef{}, false
+		}
+		if needsKey && semtypes.IsNever(semtypes.TableKeyType(cx, containerTy)) {
+			t.semanticError("expect first argument to be a table with a key", pos)
+			return model.SymbolRef{}, false
+		}
+		return storeMonomorphizedOpaqueFn(t, sym, polymorphicRef, signature(t, containerTy), containerTy), true
+	}
+}
+
+func tableRowParamSignature(t typeResolver, containerTy semtypes.SemType) model.FunctionSignature {
+	return model.FunctionSignature{
+		ParamTypes:    []semtypes.SemType{containerTy, semtypes.TableRowType(t.typeContext(), containerTy)},
+		ParamNames:    []string{"t", "row"},
+		RestParamType: semtypes.NEVER,
+		ReturnType:    semtypes.NIL,
+		Flags:         model.FuncSymbolFlagIsolated,
+	}
+}
+
+// tableKeyParamSignature returns the signature builder for functions taking a
+// key: they return the row, or whether a row exists when returnsBoolean is set.
+func tableKeyParamSignature(returnsBoolean bool) func(t typeResolver, containerTy semtypes.SemType) model.FunctionSignature {
+	return func(t typeResolver, containerTy semtypes.SemType) model.FunctionSignature {
+		cx := t.typeContext()
+		returnTy := semtypes.TableRowType(cx, containerTy)
+		if returnsBoolean {
+			returnTy = semtypes.BOOLEAN
+		}
+		return model.FunctionSignature{
+			ParamTypes:    []semtypes.SemType{containerTy, semtypes.TableKeyType(cx, containerTy)},
+			ParamNames:    []string{"t", "k"},
+			RestParamType: semtypes.NEVER,
+			ReturnType:    returnTy,
+			Flags:         model.FuncSymbolFlagIsolated,
+		}
+	}
+}
+
+func tableToArraySignature(t typeResolver, containerTy semtypes.SemType) model.FunctionSignature {
+	ld := semtypes.NewListDefinition()
+	rowArrayTy := ld.DefineListTypeWrappedWithEnvSemType(t.typeEnv(), semtypes.TableRowType(t.typeContext(), containerTy))
+	return model.FunctionSignature{
+		ParamTypes:    []semtypes.SemType{containerTy},
+		ParamNames:    []string{"t"},
+		RestParamType: semtypes.NEVER,
+		ReturnType:    rowArrayTy,
+		Flags:         model.FuncSymbolFlagIsolated,
+	}
+}
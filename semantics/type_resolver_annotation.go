@@ -0,0 +1,234 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semantics
+
+import (
+	"fmt"
+
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+)
+
+// resolvePackageAnnotations resolves the types of the annotations declared in pkg and then type-checks every
+// annotation attached to a module-level construct. An attachment must be resolved before the construct it is
+// attached to is walked by setOtherNodesAsNever.
+func resolvePackageAnnotations(t typeResolver, pkg *ast.BLangPackage) bool {
+	for i := range pkg.Annotations {
+		if !resolveAnnotationDeclaration(t, &pkg.Annotations[i]) {
+			return false
+		}
+	}
+	ok := true
+	for i := range pkg.Annotations {
+		ok = resolveAnnotationAttachments(t, pkg.Annotations[i].GetAnnotationAttachments(), model.AttachPointAnnotation, "annotation") && ok
+	}
+	for i := range pkg.TypeDefinitions {
+		ok = resolveAnnotationAttachments(t, pkg.TypeDefinitions[i].GetAnnotationAttachments(), model.AttachPointType, "type definition") && ok
+	}
+	for i := range pkg.ClassDefinitions {
+		classDef := &pkg.ClassDefinitions[i]
+		ok = resolveAnnotationAttachments(t, classDef.GetAnnotationAttachments(), model.AttachPointType|model.AttachPointClass, "class definition") && ok
+		ok = resolveMethodAnnotationAttachments(t, classDef.InitFunction, classDef.Methods, classDef.ResourceMethods) && ok
+	}
+	for i := range pkg.Services {
+		s := &pkg.Services[i]
+		ok = resolveMethodAnnotationAttachments(t, s.InitFunction, s.Methods, s.ResourceMethods) && ok
+	}
+	for i := range pkg.Functions {
+		ok = resolveAnnotationAttachments(t, pkg.Functions[i].GetAnnotationAttachments(), model.AttachPointFunction, "function") && ok
+		ok = resolveSignatureAnnotationAttachments(t, &pkg.Functions[i]) && ok
+	}
+	if pkg.InitFunction != nil {
+		ok = resolveAnnotationAttachments(t, pkg.InitFunction.GetAnnotationAttachments(), model.AttachPointFunction, "function") && ok
+		ok = resolveSignatureAnnotationAttachments(t, pkg.InitFunction) && ok
+	}
+	for i := range pkg.GlobalVars {
+		ok = resolveAnnotationAttachments(t, pkg.GlobalVars[i].GetAnnotationAttachments(), model.AttachPointVar, "module variable") && ok
+	}
+	for i := range pkg.Constants {
+		ok = resolveAnnotationAttachments(t, pkg.Constants[i].GetAnnotationAttachments(), model.AttachPointConst, "constant") && ok
+	}
+	return ok
+}
+
+func resolveMethodAnnotationAttachments(t typeResolver, initFn *ast.BLangFunction, methods map[string]*ast.BLangFunction, resourceMethods []*ast.BLangResourceMethod) bool {
+	ok := true
+	if initFn != nil {
+		ok = resolveAnnotationAttachments(t, initFn.GetAnnotationAttachments(), model.AttachPointFunction|model.AttachPointObjectMethod, "method")
+		ok = resolveSignatureAnnotationAttachments(t, initFn) && ok
+	}
+	for _, method := range methods {
+		points := model.AttachPointFunction | model.AttachPointObjectMethod
+		if method.IsRemote() {
+			points |= model.AttachPointServiceRemoteMethod
+		}
+		ok = resolveAnnotationAttachments(t, method.GetAnnotationAttachments(), points, "method") && ok
+		ok = resolveSignatureAnnotationAttachments(t, method) && ok
+	}
+	for _, method := range resourceMethods {
+		ok = resolveSignatureAnnotationAttachments(t, method) && ok
+	}
+	return ok
+}
+
+// resolveSignatureAnnotationAttachments type-checks the annotations attached to the parameters and to the return type
+// of fn.
+func resolveSignatureAnnotationAttachments(t typeResolver, fn ast.InvokableNode) bool {
+	ok := true
+	for _, param := range fn.GetParameters() {
+		ok = resolveAnnotationAttachments(t, param.GetAnnotationAttachments(), model.AttachPointParameter, "parameter") && ok
+	}
+	if restParam := fn.GetRestParam(); restParam != nil {
+		ok = resolveAnnotationAttachments(t, restParam.GetAnnotationAttachments(), model.AttachPointParameter, "parameter") && ok
+	}
+	return resolveAnnotationAttachments(t, fn.GetReturnTypeAnnotationAttachments(), model.AttachPointReturn, "return type") && ok
+}
+
+// annotationTypeBound returns `true|map<anydata>|map<anydata>[]`, the type every annotation type must belong to.
+func annotationTypeBound(t typeResolver) semtypes.SemType {
+	env := t.typeEnv()
+	md := semtypes.NewMappingDefinition()
+	mapOfAnydata := md.DefineMappingTypeWrapped(env, nil, semtypes.CreateAnydata(t.typeContext()))
+	ld := semtypes.NewListDefinition()
+	listOfMaps := ld.DefineListTypeWrapped(env, nil, 0, mapOfAnydata, semtypes.CellMutability_CELL_MUT_LIMITED)
+	return semtypes.Union(semtypes.BooleanConst(true), semtypes.Union(mapOfAnydata, listOfMaps))
+}
+
+func resolveAnnotationDeclaration(t typeResolver, decl *ast.BLangAnnotation) bool {
+	setOtherNodesAsNever(decl.Name)
+	annotTy := semtypes.BooleanConst(true)
+	if td := decl.GetTypeDescriptor(); td != nil {
+		ty, ok := resolveBType(t, td.(ast.BType), 0)
+		if !ok {
+			return false
+		}
+		if !semtypes.IsSubtype(t.typeContext(), ty, annotationTypeBound(t)) {
+			t.semanticError("annotation type must be a subtype of 'true', 'map<anydata>' or 'map<anydata>[]'", td.(ast.BLangNode).GetPosition())
+			return false
+		}
+		annotTy = ty
+	}
+	sym := t.getSymbol(decl.Symbol()).(*model.AnnotationSymbol)
+	if sym.SourceOnlyAttachPoints() != 0 && !sym.IsConst() {
+		t.semanticError("annotation with a 'source' attach point must be declared 'const'", decl.GetPosition())
+		return false
+	}
+	t.setSymbolType(decl.Symbol(), annotTy)
+	decl.SetDeterminedType(semtypes.NEVER)
+	return true
+}
+
+// resolveAnnotationAttachments type-checks the annotations attached to a construct that may be annotated at any of
+// points. Only an annotation whose type is an array may be attached more than once.
+func resolveAnnotationAttachments(t typeResolver, attachments []ast.AnnotationAttachmentNode, points model.AttachPoints, construct string) bool {
+	ok := true
+	attached := make(map[model.SymbolRef]bool, len(attachments))
+	for _, each := range attachments {
+		attachment := each.(*ast.BLangAnnotationAttachment)
+		ok = resolveAnnotationAttachment(t, attachment, points, construct, attached) && ok
+		// setOtherNodesAsNever leaves an attachment alone until it has a type, see neverVisitor.
+		attachment.SetDeterminedType(semtypes.NEVER)
+		setOtherNodesAsNever(attachment)
+	}
+	return ok
+}
+
+func resolveAnnotationAttachment(t typeResolver, attachment *ast.BLangAnnotationAttachment, points model.AttachPoints, construct string, attached map[model.SymbolRef]bool) bool {
+	cx := t.typeContext()
+	ref := attachment.Symbol()
+	sym := t.getSymbol(ref).(*model.AnnotationSymbol)
+	name := annotationDisplayName(attachment.PkgAlias, attachment.AnnotationName)
+	if sym.AttachPoints()&points == 0 {
+		t.semanticError(fmt.Sprintf("annotation '%s' is not allowed on a %s", name, construct), attachment.GetPosition())
+		return false
+	}
+	annotTy := t.symbolType(ref)
+	valueTy := annotTy
+	if semtypes.IsSubtype(cx, annotTy, semtypes.LIST) {
+		valueTy = semtypes.ListMemberTypeInnerVal(cx, annotTy, semtypes.INT)
+	} else if attached[ref] {
+		t.semanticError(fmt.Sprintf("annotation '%s' is attached more than once", name), attachment.GetPosition())
+		return false
+	}
+	attached[ref] = true
+	if attachment.Expr == nil {
+		if semtypes.IsSubtype(cx, semtypes.BooleanConst(true), valueTy) {
+			return true
+		}
+		if !isEmptyMappingAllowed(cx, valueTy) {
+			t.semanticError(fmt.Sprintf("missing value for annotation '%s'", name), attachment.GetPosition())
+			return false
+		}
+		// An omitted value of a mapping annotation stands for `{}`, which gets the defaults of the record fields.
+		emptyMapping := &ast.BLangMappingConstructorExpr{}
+		emptyMapping.SetPosition(attachment.GetPosition())
+		attachment.Expr = emptyMapping
+	}
+	_, _, ok := resolveActionOrExpression(t, nil, attachment.Expr, valueTy)
+	return ok
+}
+
+func isEmptyMappingAllowed(cx semtypes.Context, ty semtypes.SemType) bool {
+	mat := semtypes.ToMappingAtomicType(cx, semtypes.Intersect(ty, semtypes.MAPPING))
+	if mat == nil {
+		return false
+	}
+	for _, name := range mat.Names {
+		if !mat.IsOptional(cx, name) {
+			return false
+		}
+	}
+	return true
+}
+
+func resolveAnnotAccessExpr(t typeResolver, chain *binding, expr *ast.BLangAnnotAccessExpr) (semtypes.SemType, expressionEffect, bool) {
+	cx := t.typeContext()
+	exprTy, _, ok := resolveActionOrExpression(t, chain, expr.Expr, semtypes.SemType{})
+	if !ok {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	if !semtypes.IsSubtype(cx, exprTy, semtypes.TYPEDESC) {
+		t.semanticError(formatIncompatibleTypeMessage(cx, semtypes.TYPEDESC, exprTy), expr.Expr.GetPosition())
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	setOtherNodesAsNever(expr.AnnotationName)
+	if expr.PkgAlias != nil {
+		setOtherNodesAsNever(expr.PkgAlias)
+	}
+	ty := semtypes.Union(t.symbolType(expr.Symbol()), semtypes.NIL)
+	setExpectedType(expr, ty)
+	return ty, defaultExpressionEffect(chain), true
+}
+
+func resolveTypedescExpr(t typeResolver, chain *binding, expr *ast.BLangTypedescExpr) (semtypes.SemType, expressionEffect, bool) {
+	constraint, ok := resolveBType(t, expr.GetTypeDescriptor().(ast.BType), 0)
+	if !ok {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	expr.Constraint = constraint
+	ty := semtypes.TypedescContaining(t.typeEnv(), constraint)
+	setExpectedType(expr, ty)
+	return ty, defaultExpressionEffect(chain), true
+}
+
+func annotationDisplayName(pkgAlias, name *ast.BLangIdentifier) string {
+	if pkgAlias != nil && pkgAlias.Value != "" {
+		return pkgAlias.Value + ":" + name.Value
+	}
+	return name.Value
+}
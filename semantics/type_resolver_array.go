@@ -0,0 +1,320 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semantics
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/tools/diagnostics"
+)
+
+// arrayFnInstance is the monomorphic instance of a generic lang.array function at a call site.
+type arrayFnInstance struct {
+	sig model.FunctionSignature
+	// defaults maps the index of a parameter with a constant default to the singleton type of the default.
+	defaults map[int]semtypes.SemType
+	// keys are the type parameters other than the container type that determine sig.
+	keys []semtypes.SemType
+}
+
+// arraySignatureFn builds the instance of a lang.array function for a container of type containerTy whose members
+// are of type memberTy. It may resolve the remaining arguments when the signature depends on them.
+type arraySignatureFn func(t typeResolver, chain *binding, containerTy, memberTy semtypes.SemType, args []ast.BLangExpression, pos diagnostics.Location) (arrayFnInstance, bool)
+
+// arrayResultFn gives the return type of a lang.array function in terms of the member type of its container.
+type arrayResultFn func(t typeResolver, memberTy semtypes.SemType) semtypes.SemType
+
+// arrayMonomorphizer builds the monomorphizer for a generic lang.array function whose first parameter is the array.
+func arrayMonomorphizer(signature arraySignatureFn) opaqueFnMonomorphizer {
+	return func(t typeResolver, sym *model.OpaqueFunctionSymbol, polymorphicRef model.SymbolRef, chain *binding, args []ast.BLangExpression, pos diagnostics.Location) (model.SymbolRef, bool) {
+		containerExpr, ok := containerArgExpr(args, "arr")
+		if !ok {
+			t.semanticError("missing container argument", pos)
+			return model.SymbolRef{}, false
+		}
+		containerTy, _, ok := resolveActionOrExpression(t, chain, containerExpr, semtypes.SemType{})
+		if !ok {
+			return model.SymbolRef{}, false
+		}
+		cx := t.typeContext()
+		if !semtypes.IsSubtype(cx, containerTy, semtypes.LIST) {
+			t.semanticError("expect first argument to be a subtype of (any|error)[]", pos)
+			return model.SymbolRef{}, false
+		}
+		memberTy := semtypes.ListProj(cx, containerTy, semtypes.INT)
+		inst, ok := signature(t, chain, containerTy, memberTy, args, pos)
+		if !ok {
+			return model.SymbolRef{}, false
+		}
+		keys := append([]semtypes.SemType{containerTy}, inst.keys...)
+		if sym.Lookup != nil {
+			if ref, ok := sym.Lookup(keys...); ok {
+				return ref, true
+			}
+		}
+		ref := storeMonomorphizedOpaqueFn(t, sym, polymorphicRef, inst.sig, keys...)
+		if len(inst.defaults) > 0 {
+			defaultable := model.NewDefaultableParamInfo(len(inst.sig.ParamTypes))
+			for i, value := range inst.defaults {
+				defaultable.SetConstant(i, value)
+			}
+			t.getSymbol(ref).(model.FunctionSymbol).SetDefaultableParams(defaultable)
+		}
+		return ref, true
+	}
+}
+
+// opaqueArgExpr returns the expression bound to the parameter at index, which is named name. It is not found when
+// the argument is supplied by a rest argument.
+func opaqueArgExpr(args []ast.BLangExpression, index int, name string) (ast.BLangExpression, bool) {
+	for i, arg := range args {
+		switch arg := arg.(type) {
+		case *ast.BLangNamedArgsExpression:
+			if arg.Name.Value == name {
+				return arg.Expr, true
+			}
+		case *ast.BLangRestArgsExpression:
+			return nil, false
+		default:
+			if i == index {
+				return arg, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// opaqueArgProvided reports whether the call supplies the parameter at index, which is named name.
+func opaqueArgProvided(args []ast.BLangExpression, index int, name string) bool {
+	for i, arg := range args {
+		switch arg := arg.(type) {
+		case *ast.BLangNamedArgsExpression:
+			if arg.Name.Value == name {
+				return true
+			}
+		case *ast.BLangRestArgsExpression:
+			return true
+		default:
+			if i == index {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func arrayFunctionSignature(params []semtypes.SemType, names []string, returnTy semtypes.SemType) model.FunctionSignature {
+	return model.FunctionSignature{
+		ParamTypes:    params,
+		ParamNames:    names,
+		RestParamType: semtypes.NEVER,
+		ReturnType:    returnTy,
+		Flags:         model.FuncSymbolFlagIsolated,
+	}
+}
+
+// callbackType returns the type of a function taking params and returning returnTy.
+func callbackType(t typeResolver, returnTy semtypes.SemType, isolated bool, params ...semtypes.SemType) semtypes.SemType {
+	sig := model.FunctionSignature{ParamTypes: params, RestParamType: semtypes.NEVER, ReturnType: returnTy}
+	if isolated {
+		sig.Flags = model.FuncSymbolFlagIsolated
+	}
+	return typeFromFunctionSignature(t, sig)
+}
+
+func arrayOf(t typeResolver, memberTy semtypes.SemType) semtypes.SemType {
+	ld := semtypes.NewListDefinition()
+	return ld.DefineListTypeWrappedWithEnvSemType(t.typeEnv(), memberTy)
+}
+
+func arrayResultList(t typeResolver, memberTy semtypes.SemType) semtypes.SemType {
+	return arrayOf(t, memberTy)
+}
+
+func arrayResultMember(_ typeResolver, memberTy semtypes.SemType) semtypes.SemType {
+	return memberTy
+}
+
+func arrayResultNil(typeResolver, semtypes.SemType) semtypes.SemType {
+	return semtypes.NIL
+}
+
+func arrayResultBoolean(typeResolver, semtypes.SemType) semtypes.SemType {
+	return semtypes.BOOLEAN
+}
+
+func arrayResultEnumeration(t typeResolver, memberTy semtypes.SemType) semtypes.SemType {
+	ld := semtypes.NewListDefinition()
+	return arrayOf(t, ld.TupleTypeWrapped(t.typeEnv(), semtypes.INT, memberTy))
+}
+
+func arrayResultIterator(t typeResolver, memberTy semtypes.SemType) semtypes.SemType {
+	return semtypes.CreateIteratorType(t.typeEnv(), memberTy, semtypes.NIL)
+}
+
+func arrayResultStream(t typeResolver, memberTy semtypes.SemType) semtypes.SemType {
+	sd := semtypes.NewStreamDefinition()
+	return sd.Define(t.typeEnv(), memberTy, semtypes.NIL)
+}
+
+// arrayUnarySignature returns the signature builder for functions whose only parameter is the array.
+func arrayUnarySignature(result arrayResultFn) arraySignatureFn {
+	return func(t typeResolver, _ *binding, containerTy, memberTy semtypes.SemType, _ []ast.BLangExpression, _ diagnostics.Location) (arrayFnInstance, bool) {
+		sig := arrayFunctionSignature([]semtypes.SemType{containerTy}, []string{"arr"}, result(t, memberTy))
+		return arrayFnInstance{sig: sig}, true
+	}
+}
+
+// arrayCallbackSignature returns the signature builder for functions that apply a function returning callbackReturnTy
+// to each member.
+func arrayCallbackSignature(name string, callbackReturnTy semtypes.SemType, result arrayResultFn) arraySignatureFn {
+	return func(t typeResolver, _ *binding, containerTy, memberTy semtypes.SemType, _ []ast.BLangExpression, _ diagnostics.Location) (arrayFnInstance, bool) {
+		fnTy := callbackType(t, callbackReturnTy, false, memberTy)
+		sig := arrayFunctionSignature([]semtypes.SemType{containerTy, fnTy}, []string{"arr", name}, result(t, memberTy))
+		return arrayFnInstance{sig: sig}, true
+	}
+}
+
+// arrayMapSignature types map by the return type of the mapping function, which is resolved against a function
+// accepting the member type so that the parameter of an implicit anonymous function is inferred.
+func arrayMapSignature(t typeResolver, chain *binding, containerTy, memberTy semtypes.SemType, args []ast.BLangExpression, _ diagnostics.Location) (arrayFnInstance, bool) {
+	cx := t.typeContext()
+	resultTy := semtypes.VAL
+	if fnExpr, ok := opaqueArgExpr(args, 1, "func"); ok {
+		expectedFnTy := callbackType(t, semtypes.VAL, false, memberTy)
+		fnTy, _, ok := resolveActionOrExpression(t, chain, fnExpr, expectedFnTy)
+		if !ok {
+			return arrayFnInstance{}, false
+		}
+		if semtypes.IsSubtype(cx, fnTy, expectedFnTy) {
+			resultTy = semtypes.FunctionReturnType(cx, fnTy, semtypes.FunctionParamListType(cx, expectedFnTy))
+		}
+	}
+	fnTy := callbackType(t, resultTy, false, memberTy)
+	sig := arrayFunctionSignature([]semtypes.SemType{containerTy, fnTy}, []string{"arr", "func"}, arrayOf(t, resultTy))
+	return arrayFnInstance{sig: sig, keys: []semtypes.SemType{resultTy}}, true
+}
+
+// arrayReduceSignature types reduce by the type of the accumulator. It is the return type of the combining function,
+// unless that is an implicit anonymous function, whose parameter types are then inferred from the (widened) type of
+// the initial value.
+func arrayReduceSignature(t typeResolver, chain *binding, containerTy, memberTy semtypes.SemType, args []ast.BLangExpression, _ diagnostics.Location) (arrayFnInstance, bool) {
+	cx := t.typeContext()
+	accumTy := semtypes.VAL
+	fnExpr, hasFn := opaqueArgExpr(args, 1, "func")
+	if lambda, ok := fnExpr.(*ast.BLangLambdaFunction); hasFn && !(ok && lambda.Function.IsInferred()) {
+		fnTy, _, ok := resolveActionOrExpression(t, chain, fnExpr, semtypes.SemType{})
+		if !ok {
+			return arrayFnInstance{}, false
+		}
+		if semtypes.IsSubtype(cx, fnTy, semtypes.FUNCTION) {
+			accumTy = semtypes.FunctionReturnType(cx, fnTy, semtypes.FunctionParamListType(cx, fnTy))
+		}
+	} else if initialExpr, ok := opaqueArgExpr(args, 2, "initial"); ok {
+		initialTy, _, ok := resolveActionOrExpression(t, chain, initialExpr, semtypes.SemType{})
+		if !ok {
+			return arrayFnInstance{}, false
+		}
+		accumTy = widenedListMemberType(initialTy)
+	}
+	if semtypes.IsZero(accumTy) {
+		accumTy = semtypes.VAL
+	}
+	fnTy := callbackType(t, accumTy, false, accumTy, memberTy)
+	sig := arrayFunctionSignature([]semtypes.SemType{containerTy, fnTy, accumTy}, []string{"arr", "func", "initial"}, accumTy)
+	return arrayFnInstance{sig: sig, keys: []semtypes.SemType{accumTy}}, true
+}
+
+// requireAnydataMembers reports a semantic error unless the members of the array are anydata, as needed to compare
+// them for equality.
+func requireAnydataMembers(t typeResolver, memberTy semtypes.SemType, pos diagnostics.Location) bool {
+	cx := t.typeContext()
+	if !semtypes.IsSubtype(cx, memberTy, semtypes.CreateAnydata(cx)) {
+		t.semanticError("expect first argument to be a subtype of anydata[]", pos)
+		return false
+	}
+	return true
+}
+
+func arrayIndexOfSignature(t typeResolver, _ *binding, containerTy, memberTy semtypes.SemType, _ []ast.BLangExpression, pos diagnostics.Location) (arrayFnInstance, bool) {
+	if !requireAnydataMembers(t, memberTy, pos) {
+		return arrayFnInstance{}, false
+	}
+	sig := arrayFunctionSignature([]semtypes.SemType{containerTy, memberTy, semtypes.INT}, []string{"arr", "val", "startIndex"},
+		semtypes.Union(semtypes.INT, semtypes.NIL))
+	return arrayFnInstance{sig: sig, defaults: map[int]semtypes.SemType{2: semtypes.IntConst(0)}}, true
+}
+
+// The defaults of lastIndexOf and slice depend on the length of the array, so the start or end index is a parameter
+// only when the call supplies it; the implementation uses the length of the array otherwise.
+
+func arrayLastIndexOfSignature(t typeResolver, _ *binding, containerTy, memberTy semtypes.SemType, args []ast.BLangExpression, pos diagnostics.Location) (arrayFnInstance, bool) {
+	if !requireAnydataMembers(t, memberTy, pos) {
+		return arrayFnInstance{}, false
+	}
+	params := []semtypes.SemType{containerTy, memberTy}
+	names := []string{"arr", "val"}
+	if opaqueArgProvided(args, 2, "startIndex") {
+		params = append(params, semtypes.INT)
+		names = append(names, "startIndex")
+	}
+	sig := arrayFunctionSignature(params, names, semtypes.Union(semtypes.INT, semtypes.NIL))
+	return arrayFnInstance{sig: sig, keys: []semtypes.SemType{semtypes.IntConst(int64(len(params)))}}, true
+}
+
+func arraySliceSignature(t typeResolver, _ *binding, containerTy, memberTy semtypes.SemType, args []ast.BLangExpression, _ diagnostics.Location) (arrayFnInstance, bool) {
+	params := []semtypes.SemType{containerTy, semtypes.INT}
+	names := []string{"arr", "startIndex"}
+	if opaqueArgProvided(args, 2, "endIndex") {
+		params = append(params, semtypes.INT)
+		names = append(names, "endIndex")
+	}
+	sig := arrayFunctionSignature(params, names, arrayOf(t, memberTy))
+	return arrayFnInstance{sig: sig, keys: []semtypes.SemType{semtypes.IntConst(int64(len(params)))}}, true
+}
+
+var sortDirectionType = semtypes.Union(semtypes.StringConst("ascending"), semtypes.StringConst("descending"))
+
+// arraySortSignature types sort. Without a key function the members themselves are compared, so they must be
+// ordered.
+func arraySortSignature(t typeResolver, _ *binding, containerTy, memberTy semtypes.SemType, args []ast.BLangExpression, pos diagnostics.Location) (arrayFnInstance, bool) {
+	cx := t.typeContext()
+	orderedTy := semtypes.CreateOrdered(cx)
+	if !opaqueArgProvided(args, 2, "key") && !semtypes.IsSubtype(cx, memberTy, orderedTy) {
+		t.semanticError("expect first argument to be an array of ordered members when no key function is given", pos)
+		return arrayFnInstance{}, false
+	}
+	keyTy := semtypes.Union(callbackType(t, orderedTy, true, memberTy), semtypes.NIL)
+	sig := arrayFunctionSignature([]semtypes.SemType{containerTy, sortDirectionType, keyTy}, []string{"arr", "direction", "key"},
+		arrayOf(t, memberTy))
+	return arrayFnInstance{sig: sig, defaults: map[int]semtypes.SemType{
+		1: semtypes.StringConst("ascending"),
+		2: semtypes.NIL,
+	}}, true
+}
+
+func arrayUnshiftSignature(t typeResolver, _ *binding, containerTy, memberTy semtypes.SemType, _ []ast.BLangExpression, _ diagnostics.Location) (arrayFnInstance, bool) {
+	sig := arrayFunctionSignature([]semtypes.SemType{containerTy}, []string{"arr"}, semtypes.NIL)
+	sig.RestParamType = memberTy
+	return arrayFnInstance{sig: sig}, true
+}
+
+func arrayRemoveSignature(t typeResolver, _ *binding, containerTy, memberTy semtypes.SemType, _ []ast.BLangExpression, _ diagnostics.Location) (arrayFnInstance, bool) {
+	sig := arrayFunctionSignature([]semtypes.SemType{containerTy, semtypes.INT}, []string{"arr", "index"}, memberTy)
+	return arrayFnInstance{sig: sig}, true
+}
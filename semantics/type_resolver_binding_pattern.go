@@ -0,0 +1,425 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semantics
+
+import (
+	"fmt"
+
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/semtypes"
+	"ballerina-lang-go/tools/diagnostics"
+)
+
+// resolveBindingPatternVarDef resolves a variable declaration with a list, mapping or error binding pattern.
+// The declared type, or the type of the initializer when declared with `var`, is projected onto the binding
+// pattern to give the types of the variables it declares.
+func resolveBindingPatternVarDef(t typeResolver, chain *binding, variable ast.VariableNode) (*binding, bool) {
+	var declaredTy semtypes.SemType
+	if typeNode := variable.(interface{ TypeNode() ast.BType }).TypeNode(); typeNode != nil {
+		var ok bool
+		declaredTy, ok = resolveBType(t, typeNode, 0)
+		if !ok {
+			return chain, false
+		}
+	}
+	expr := variable.GetInitialExpression()
+	if expr == nil {
+		t.semanticError("variable declared with a binding pattern requires an initializer", variable.GetPosition())
+		return chain, false
+	}
+	exprTy, effect, ok := resolveActionOrExpression(t, chain, expr, declaredTy)
+	if !ok {
+		return chain, false
+	}
+	ty := declaredTy
+	if semtypes.IsZero(ty) {
+		ty = exprTy
+	}
+	if !resolveBindingPatternVariable(t, variable, ty) {
+		return chain, false
+	}
+	return mergeChains(t, effect.ifTrue, effect.ifFalse, semtypes.Union), true
+}
+
+// resolveBindingPatternVariable gives each variable declared by the binding pattern of variable its part of ty.
+func resolveBindingPatternVariable(t typeResolver, variable ast.VariableNode, ty semtypes.SemType) bool {
+	setExpectedType(variable.(ast.BLangNode), ty)
+	updateSymbolType(t, variable.(ast.BLangNode), ty)
+	switch v := variable.(type) {
+	case *ast.BLangSimpleVariable:
+		v.Name.SetDeterminedType(semtypes.NEVER)
+		return true
+	case *ast.BLangTupleVariable:
+		memberTys, restTy, ok := listBindingPatternTypes(t, ty, len(v.Members), v.RestVariable != nil, v.GetPosition())
+		if !ok {
+			return false
+		}
+		for i, member := range v.Members {
+			if !resolveBindingPatternVariable(t, member, memberTys[i]) {
+				return false
+			}
+		}
+		return v.RestVariable == nil || resolveBindingPatternVariable(t, v.RestVariable, restTy)
+	case *ast.BLangRecordVariable:
+		if !isBindingPatternTypeOf(t, ty, semtypes.MAPPING, "mapping", v.GetPosition()) {
+			return false
+		}
+		names := make([]string, len(v.Fields))
+		for i, field := range v.Fields {
+			names[i] = field.Key.Value
+			field.Key.SetDeterminedType(semtypes.NEVER)
+			fieldTy, ok := mappingBindingPatternFieldType(t, ty, names[i], field.Key.GetPosition())
+			if !ok || !resolveBindingPatternVariable(t, field.Value, fieldTy) {
+				return false
+			}
+		}
+		return v.RestVariable == nil || resolveBindingPatternVariable(t, v.RestVariable, mappingBindingPatternRestType(t, ty, names))
+	case *ast.BLangErrorVariable:
+		if !resolveErrorBindingPatternType(t, ty, v.ErrorTypeReference, v.GetPosition()) {
+			return false
+		}
+		if v.Message != nil && !resolveBindingPatternVariable(t, v.Message, semtypes.STRING) {
+			return false
+		}
+		if v.Cause != nil && !resolveBindingPatternVariable(t, v.Cause, semtypes.Union(semtypes.ERROR, semtypes.NIL)) {
+			return false
+		}
+		names := make([]string, len(v.Detail))
+		for i, entry := range v.Detail {
+			names[i] = entry.Key.Value
+			entry.Key.SetDeterminedType(semtypes.NEVER)
+			detailTy, ok := errorBindingPatternDetailType(t, ty, names[i], entry.Key.GetPosition())
+			if !ok || !resolveBindingPatternVariable(t, entry.Value, detailTy) {
+				return false
+			}
+		}
+		return v.RestDetail == nil || resolveBindingPatternVariable(t, v.RestDetail, errorBindingPatternRestDetailType(t, ty, names))
+	default:
+		t.internalError(fmt.Sprintf("unexpected binding pattern variable %T", variable), variable.GetPosition())
+		return false
+	}
+}
+
+// resolveBindingPatternAssignment resolves a destructuring assignment. The types of the variables referenced by
+// the binding pattern give the expected type of the right-hand side, whose type is then projected onto the
+// binding pattern and checked against the type of each referenced variable.
+func resolveBindingPatternAssignment(t typeResolver, chain *binding, s assignmentNode) (statementEffect, bool) {
+	ref := s.GetVariable()
+	patternTy, ok := resolveBindingPatternRefType(t, ref)
+	if !ok {
+		return statementEffect{}, false
+	}
+	rhsTy, _, ok := resolveActionOrExpression(t, chain, s.GetExpression(), patternTy)
+	if !ok {
+		return statementEffect{}, false
+	}
+	if !resolveBindingPatternRef(t, ref, rhsTy) {
+		return statementEffect{}, false
+	}
+	for _, leaf := range ast.BindingVarRefs(ref) {
+		if expr, ok := leaf.(ast.NodeWithSymbol); ok {
+			chain = unnarrowSymbolAt(t, chain, expr.Symbol(), leaf.GetPosition()).binding
+		}
+	}
+	return defaultStmtEffect(chain), true
+}
+
+// resolveBindingPatternRefType returns the type of the values that can be assigned to the binding pattern ref.
+func resolveBindingPatternRefType(t typeResolver, ref ast.LExpr) (semtypes.SemType, bool) {
+	cx := t.typeContext()
+	switch r := ref.(type) {
+	case *ast.BLangTupleVarRef:
+		memberTys := make([]semtypes.SemType, len(r.Expressions))
+		for i, member := range r.Expressions {
+			ty, ok := resolveBindingPatternRefType(t, member)
+			if !ok {
+				return semtypes.SemType{}, false
+			}
+			memberTys[i] = ty
+		}
+		restTy := semtypes.NEVER
+		if r.RestParam != nil {
+			ty, ok := resolveBindingPatternRefType(t, r.RestParam)
+			if !ok {
+				return semtypes.SemType{}, false
+			}
+			restTy = semtypes.ListMemberTypeInnerVal(cx, ty, semtypes.INT)
+		}
+		ld := semtypes.NewListDefinition()
+		return ld.DefineListTypeWrapped(t.typeEnv(), memberTys, len(memberTys), restTy, semtypes.CellMutability_CELL_MUT_LIMITED), true
+	case *ast.BLangRecordVarRef:
+		fields := make([]semtypes.Field, len(r.RecordRefFields))
+		for i, field := range r.RecordRefFields {
+			ty, ok := resolveBindingPatternRefType(t, field.VariableReference)
+			if !ok {
+				return semtypes.SemType{}, false
+			}
+			fields[i] = semtypes.FieldFrom(field.VariableName.Value, ty, false, false)
+		}
+		restTy := semtypes.VAL
+		if r.RestParam != nil {
+			ty, ok := resolveBindingPatternRefType(t, r.RestParam)
+			if !ok {
+				return semtypes.SemType{}, false
+			}
+			restTy = semtypes.MappingMemberTypeInnerVal(cx, ty, semtypes.STRING)
+		}
+		md := semtypes.NewMappingDefinition()
+		return md.DefineMappingTypeWrapped(t.typeEnv(), fields, restTy), true
+	case *ast.BLangErrorVarRef:
+		// The message, cause and detail of an error are projected from the type of the right-hand side, which
+		// doesn't depend on the variables they are assigned to.
+		refs := []ast.LExpr{r.Message, r.Cause, r.RestVar}
+		for _, entry := range r.Detail {
+			refs = append(refs, entry.Expr.(ast.LExpr))
+		}
+		for _, each := range refs {
+			if each == nil {
+				continue
+			}
+			if _, ok := resolveBindingPatternRefType(t, each); !ok {
+				return semtypes.SemType{}, false
+			}
+		}
+		if r.ErrorTypeReference != nil {
+			return resolveBType(t, r.ErrorTypeReference, 0)
+		}
+		return semtypes.ERROR, true
+	default:
+		ty, _, ok := resolveActionOrExpression(t, nil, ref, semtypes.SemType{})
+		return ty, ok
+	}
+}
+
+// resolveBindingPatternRef checks that each part of ty can be assigned to the variable the binding pattern ref
+// references for it.
+func resolveBindingPatternRef(t typeResolver, ref ast.LExpr, ty semtypes.SemType) bool {
+	switch r := ref.(type) {
+	case *ast.BLangTupleVarRef:
+		setExpectedType(r, ty)
+		memberTys, restTy, ok := listBindingPatternTypes(t, ty, len(r.Expressions), r.RestParam != nil, r.GetPosition())
+		if !ok {
+			return false
+		}
+		for i, member := range r.Expressions {
+			if !resolveBindingPatternRef(t, member, memberTys[i]) {
+				return false
+			}
+		}
+		return r.RestParam == nil || resolveBindingPatternRef(t, r.RestParam, restTy)
+	case *ast.BLangRecordVarRef:
+		setExpectedType(r, ty)
+		if !isBindingPatternTypeOf(t, ty, semtypes.MAPPING, "mapping", r.GetPosition()) {
+			return false
+		}
+		names := make([]string, len(r.RecordRefFields))
+		for i, field := range r.RecordRefFields {
+			names[i] = field.VariableName.Value
+			field.VariableName.SetDeterminedType(semtypes.NEVER)
+			fieldTy, ok := mappingBindingPatternFieldType(t, ty, names[i], field.VariableName.GetPosition())
+			if !ok || !resolveBindingPatternRef(t, field.VariableReference, fieldTy) {
+				return false
+			}
+		}
+		return r.RestParam == nil || resolveBindingPatternRef(t, r.RestParam, mappingBindingPatternRestType(t, ty, names))
+	case *ast.BLangErrorVarRef:
+		setExpectedType(r, ty)
+		if !resolveErrorBindingPatternType(t, ty, r.ErrorTypeReference, r.GetPosition()) {
+			return false
+		}
+		if r.Message != nil && !resolveBindingPatternRef(t, r.Message, semtypes.STRING) {
+			return false
+		}
+		if r.Cause != nil && !resolveBindingPatternRef(t, r.Cause, semtypes.Union(semtypes.ERROR, semtypes.NIL)) {
+			return false
+		}
+		names := make([]string, len(r.Detail))
+		for i := range r.Detail {
+			entry := &r.Detail[i]
+			names[i] = entry.Name.Value
+			entry.Name.SetDeterminedType(semtypes.NEVER)
+			detailTy, ok := errorBindingPatternDetailType(t, ty, names[i], entry.Name.GetPosition())
+			if !ok || !resolveBindingPatternRef(t, entry.Expr.(ast.LExpr), detailTy) {
+				return false
+			}
+			setExpectedType(entry, detailTy)
+		}
+		return r.RestVar == nil || resolveBindingPatternRef(t, r.RestVar, errorBindingPatternRestDetailType(t, ty, names))
+	default:
+		refTy := ref.GetDeterminedType()
+		if !semtypes.IsSubtype(t.typeContext(), ty, refTy) {
+			t.semanticError(formatIncompatibleTypeMessage(t.typeContext(), refTy, ty), ref.GetPosition())
+			return false
+		}
+		return true
+	}
+}
+
+func isBindingPatternTypeOf(t typeResolver, ty, basicTy semtypes.SemType, kind string, pos diagnostics.Location) bool {
+	if semtypes.IsNever(ty) || !semtypes.IsSubtype(t.typeContext(), ty, basicTy) {
+		t.semanticError(fmt.Sprintf("invalid %s binding pattern for type '%s'", kind, semtypes.ToString(t.typeContext(), ty)), pos)
+		return false
+	}
+	return true
+}
+
+// listBindingPatternTypes returns the member types of a list binding pattern with memberCount members, and the
+// type of its rest binding pattern if it has one. ty must only contain lists with at least memberCount members,
+// and no more unless the binding pattern has a rest binding pattern.
+func listBindingPatternTypes(t typeResolver, ty semtypes.SemType, memberCount int, hasRest bool, pos diagnostics.Location) ([]semtypes.SemType, semtypes.SemType, bool) {
+	cx := t.typeContext()
+	if !isBindingPatternTypeOf(t, ty, semtypes.LIST, "list", pos) {
+		return nil, semtypes.SemType{}, false
+	}
+	shapeMembers := make([]semtypes.SemType, memberCount)
+	for i := range shapeMembers {
+		shapeMembers[i] = semtypes.VAL
+	}
+	shapeRest := semtypes.NEVER
+	if hasRest {
+		shapeRest = semtypes.VAL
+	}
+	ld := semtypes.NewListDefinition()
+	shape := ld.DefineListTypeWrapped(t.typeEnv(), shapeMembers, memberCount, shapeRest, semtypes.CellMutability_CELL_MUT_LIMITED)
+	if !semtypes.IsSubtype(cx, ty, shape) {
+		t.semanticError(fmt.Sprintf("invalid list binding pattern with %d members for type '%s'", memberCount, semtypes.ToString(cx, ty)), pos)
+		return nil, semtypes.SemType{}, false
+	}
+	memberTys := make([]semtypes.SemType, memberCount)
+	for i := range memberTys {
+		memberTys[i] = semtypes.ListMemberTypeInnerVal(cx, ty, semtypes.IntConst(int64(i)))
+	}
+	if !hasRest {
+		return memberTys, semtypes.SemType{}, true
+	}
+	return memberTys, listBindingPatternRestType(t, ty, memberCount), true
+}
+
+// listBindingPatternRestType returns the type of the list of the members of ty from index start onwards.
+func listBindingPatternRestType(t typeResolver, ty semtypes.SemType, start int) semtypes.SemType {
+	cx := t.typeContext()
+	ld := semtypes.NewListDefinition()
+	atomic := semtypes.ToListAtomicType(cx, ty)
+	if atomic == nil {
+		return ld.DefineListTypeWrappedWithEnvSemType(t.typeEnv(), semtypes.ListMemberTypeInnerVal(cx, ty, semtypes.INT))
+	}
+	var memberTys []semtypes.SemType
+	for i := start; i < atomic.Members.FixedLength; i++ {
+		memberTys = append(memberTys, atomic.MemberAtInnerVal(i))
+	}
+	return ld.DefineListTypeWrapped(t.typeEnv(), memberTys, len(memberTys), atomic.Rest(), semtypes.CellMutability_CELL_MUT_LIMITED)
+}
+
+// mappingBindingPatternFieldType returns the type of the variable bound to the field name of a mapping of type ty.
+// A field that may be absent binds nil.
+func mappingBindingPatternFieldType(t typeResolver, ty semtypes.SemType, name string, pos diagnostics.Location) (semtypes.SemType, bool) {
+	cx := t.typeContext()
+	fieldTy := semtypes.MappingMemberTypeInner(cx, ty, semtypes.StringConst(name))
+	if semtypes.IsNever(semtypes.Diff(fieldTy, semtypes.UNDEF)) {
+		t.semanticError(fmt.Sprintf("no field named '%s' in type '%s'", name, semtypes.ToString(cx, ty)), pos)
+		return semtypes.SemType{}, false
+	}
+	if semtypes.ContainsUndef(fieldTy) {
+		return semtypes.Union(semtypes.Diff(fieldTy, semtypes.UNDEF), semtypes.NIL), true
+	}
+	return fieldTy, true
+}
+
+// mappingBindingPatternRestType returns the type of the mapping of the fields of ty other than names.
+func mappingBindingPatternRestType(t typeResolver, ty semtypes.SemType, names []string) semtypes.SemType {
+	cx := t.typeContext()
+	md := semtypes.NewMappingDefinition()
+	atomic := semtypes.ToMappingAtomicType(cx, ty)
+	if atomic == nil {
+		return md.DefineMappingTypeWrapped(t.typeEnv(), nil, semtypes.MappingMemberTypeInnerVal(cx, ty, semtypes.STRING))
+	}
+	return md.DefineMappingTypeWrapped(t.typeEnv(), remainingMappingFields(cx, atomic, names), atomic.RestInnerVal())
+}
+
+func remainingMappingFields(cx semtypes.Context, atomic *semtypes.MappingAtomicType, names []string) []semtypes.Field {
+	var fields []semtypes.Field
+	for _, fieldName := range atomic.Names {
+		if containsName(names, fieldName) {
+			continue
+		}
+		fields = append(fields, semtypes.FieldFrom(fieldName, atomic.FieldInnerVal(fieldName), false, atomic.IsOptional(cx, fieldName)))
+	}
+	return fields
+}
+
+func containsName(names []string, name string) bool {
+	for _, each := range names {
+		if each == name {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveErrorBindingPatternType checks that ty only contains errors, and errors of the error type named by the
+// binding pattern if it names one.
+func resolveErrorBindingPatternType(t typeResolver, ty semtypes.SemType, typeRef *ast.BLangUserDefinedType, pos diagnostics.Location) bool {
+	if !isBindingPatternTypeOf(t, ty, semtypes.ERROR, "error", pos) {
+		return false
+	}
+	if typeRef == nil {
+		return true
+	}
+	refTy, ok := resolveBType(t, typeRef, 0)
+	if !ok {
+		return false
+	}
+	if !semtypes.IsSubtype(t.typeContext(), ty, refTy) {
+		t.semanticError(formatIncompatibleTypeMessage(t.typeContext(), refTy, ty), pos)
+		return false
+	}
+	return true
+}
+
+// errorBindingPatternDetailType returns the type of the variable bound to the detail field name of an error of
+// type ty. A field that may be absent binds nil.
+func errorBindingPatternDetailType(t typeResolver, ty semtypes.SemType, name string, pos diagnostics.Location) (semtypes.SemType, bool) {
+	cx := t.typeContext()
+	detail, ok := semtypes.ErrorDetailAtomicType(cx, ty)
+	if !ok {
+		return semtypes.Union(errorDetailValueType(cx), semtypes.NIL), true
+	}
+	fieldTy := detail.FieldInnerVal(name)
+	if semtypes.IsNever(fieldTy) {
+		t.semanticError(fmt.Sprintf("no detail field named '%s' in type '%s'", name, semtypes.ToString(cx, ty)), pos)
+		return semtypes.SemType{}, false
+	}
+	if detail.IsOptional(cx, name) {
+		return semtypes.Union(fieldTy, semtypes.NIL), true
+	}
+	return fieldTy, true
+}
+
+// errorBindingPatternRestDetailType returns the type of the mapping of the detail fields of ty other than names.
+func errorBindingPatternRestDetailType(t typeResolver, ty semtypes.SemType, names []string) semtypes.SemType {
+	cx := t.typeContext()
+	md := semtypes.NewMappingDefinition()
+	detail, ok := semtypes.ErrorDetailAtomicType(cx, ty)
+	if !ok {
+		return md.DefineMappingTypeWrapped(t.typeEnv(), nil, errorDetailValueType(cx))
+	}
+	return md.DefineMappingTypeWrapped(t.typeEnv(), remainingMappingFields(cx, &detail, names), detail.RestInnerVal())
+}
+
+func errorDetailValueType(cx semtypes.Context) semtypes.SemType {
+	return semtypes.Intersect(semtypes.CreateCloneable(cx), semtypes.VAL_READONLY)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semantics
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/semtypes"
+)
+
+type startableCall interface {
+	ast.BLangActionOrExpression
+	IsAsync() bool
+}
+
+type callResolver[E startableCall] func(t typeResolver, chain *binding, call E, expectedType semtypes.SemType) (semtypes.SemType, expressionEffect, bool)
+
+// resolveStartableCall resolves a call that may be made by a start action. An async call is resolved as usual
+// against the constraint of the expected future type, and then evaluates to a future for the result of the call.
+func resolveStartableCall[E startableCall](t typeResolver, chain *binding, call E, expectedType semtypes.SemType, resolve callResolver[E]) (semtypes.SemType, expressionEffect, bool) {
+	if !call.IsAsync() {
+		return resolve(t, chain, call, expectedType)
+	}
+	var retExpectedTy semtypes.SemType
+	if !semtypes.IsZero(expectedType) {
+		retExpectedTy = semtypes.FutureConstraint(t.typeContext(), expectedType)
+	}
+	retTy, _, ok := resolve(t, chain, call, retExpectedTy)
+	if !ok {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	ty := semtypes.FutureContaining(t.typeEnv(), retTy)
+	setExpectedType(call, ty)
+	return ty, defaultExpressionEffect(chain), true
+}
+
+// resolveWaitFuture resolves a future waited on by a wait action and returns the type of its result.
+func resolveWaitFuture(t typeResolver, chain *binding, expr ast.BLangExpression) (semtypes.SemType, bool) {
+	ty, _, ok := resolveActionOrExpression(t, chain, expr, semtypes.SemType{})
+	if !ok {
+		return semtypes.SemType{}, false
+	}
+	if !semtypes.IsSubtype(t.typeContext(), ty, semtypes.FUTURE) {
+		t.semanticError(formatIncompatibleTypeMessage(t.typeContext(), semtypes.FUTURE, ty), expr.GetPosition())
+		return semtypes.SemType{}, false
+	}
+	return semtypes.FutureConstraint(t.typeContext(), ty), true
+}
+
+// resolveWaitExpr gives `wait f1|f2` the union of the result types of the futures.
+func resolveWaitExpr(t typeResolver, chain *binding, e *ast.BLangWaitExpr) (semtypes.SemType, expressionEffect, bool) {
+	resultTy := semtypes.NEVER
+	for _, expr := range e.Exprs {
+		ty, ok := resolveWaitFuture(t, chain, expr)
+		if !ok {
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		resultTy = semtypes.Union(resultTy, ty)
+	}
+	setExpectedType(e, resultTy)
+	return resultTy, defaultExpressionEffect(chain), true
+}
+
+// resolveWaitForAllExpr gives `wait {k1: f1, k2: f2}` a closed record type with a field per future.
+func resolveWaitForAllExpr(t typeResolver, chain *binding, e *ast.BLangWaitForAllExpr) (semtypes.SemType, expressionEffect, bool) {
+	fields := make([]semtypes.Field, len(e.KeyValuePairs))
+	for i := range e.KeyValuePairs {
+		field := &e.KeyValuePairs[i]
+		ty, ok := resolveWaitFuture(t, chain, field.ValueExpr)
+		if !ok {
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		field.Key.SetDeterminedType(semtypes.NEVER)
+		fields[i] = semtypes.FieldFrom(field.Key.Value, ty, false, false)
+	}
+	resultTy := createClosedRecordType(t.typeEnv(), fields, semtypes.NEVER)
+	setExpectedType(e, resultTy)
+	return resultTy, defaultExpressionEffect(chain), true
+}
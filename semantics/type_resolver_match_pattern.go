@@ -0,0 +1,342 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semantics
+
+import (
+	"fmt"
+
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+)
+
+// matchClauseTypes resolves the match patterns of clause against remainingType, the type of the values not matched
+// by the previous clauses. It returns the type of the values the clause may match and the type of the values it is
+// certain to match, ignoring its guard. The variables bound by the match patterns are typed with the part of
+// remainingType their match pattern matches.
+func matchClauseTypes(t typeResolver, chain *binding, clause *ast.BLangMatchClause, remainingType semtypes.SemType) (semtypes.SemType, semtypes.SemType, bool) {
+	cx := t.typeContext()
+	acceptedTy := semtypes.NEVER
+	coveredTy := semtypes.NEVER
+	patternRemaining := remainingType
+	variableTys := make(map[model.SymbolRef]semtypes.SemType)
+	for i, pattern := range clause.Patterns {
+		patternTy, patternCoveredTy, ok := resolveMatchPattern(t, chain, pattern, remainingType)
+		if !ok {
+			return semtypes.SemType{}, semtypes.SemType{}, false
+		}
+		matchedTy := semtypes.Intersect(patternTy, patternRemaining)
+		if i > 0 && semtypes.IsEmpty(cx, matchedTy) {
+			t.semanticError("unmatchable match pattern", pattern.GetPosition())
+		}
+		variablesTy := matchedTy
+		if semtypes.IsEmpty(cx, matchedTy) {
+			// Type the variables of a pattern that can't match as if it could, so its clause still type checks.
+			variablesTy = patternTy
+		}
+		resolveMatchPatternVariables(t, pattern, variablesTy, variableTys)
+		patternRemaining = semtypes.Diff(patternRemaining, patternCoveredTy)
+		acceptedTy = semtypes.Union(acceptedTy, patternTy)
+		coveredTy = semtypes.Union(coveredTy, patternCoveredTy)
+	}
+	for _, pattern := range clause.Patterns {
+		for _, variable := range ast.MatchPatternVariables(pattern) {
+			if !ast.SymbolIsSet(variable) {
+				continue
+			}
+			ty, ok := variableTys[variable.Symbol()]
+			if !ok {
+				continue
+			}
+			setExpectedType(variable, ty)
+			updateSymbolType(t, variable, ty)
+			variable.Name.SetDeterminedType(semtypes.NEVER)
+		}
+	}
+	return acceptedTy, coveredTy, true
+}
+
+// resolveMatchPattern returns the type of the values pattern may match, and the type of the values it is certain
+// to match. The two differ when whether a value matches depends on something its type doesn't describe, such as
+// the message of an error.
+func resolveMatchPattern(t typeResolver, chain *binding, pattern ast.BLangMatchPattern, expectedTy semtypes.SemType) (semtypes.SemType, semtypes.SemType, bool) {
+	var accepted, covered semtypes.SemType
+	switch p := pattern.(type) {
+	case *ast.BLangConstPattern:
+		ty, _, ok := resolveActionOrExpression(t, chain, p.Expr, expectedTy)
+		if !ok {
+			return semtypes.SemType{}, semtypes.SemType{}, false
+		}
+		if !isValidConstPatternExpr(t, p.Expr) {
+			t.semanticError("match pattern variable reference must refer to a constant", p.Expr.GetPosition())
+			return semtypes.SemType{}, semtypes.SemType{}, false
+		}
+		accepted, covered = ty, ty
+	case *ast.BLangWildCardMatchPattern:
+		accepted, covered = semtypes.ANY, semtypes.ANY
+	case *ast.BLangCaptureMatchPattern:
+		accepted, covered = semtypes.VAL, semtypes.VAL
+	case *ast.BLangListMatchPattern:
+		var ok bool
+		accepted, covered, ok = resolveListMatchPattern(t, chain, p, expectedTy)
+		if !ok {
+			return semtypes.SemType{}, semtypes.SemType{}, false
+		}
+	case *ast.BLangMappingMatchPattern:
+		var ok bool
+		accepted, covered, ok = resolveMappingMatchPattern(t, chain, p, expectedTy)
+		if !ok {
+			return semtypes.SemType{}, semtypes.SemType{}, false
+		}
+	case *ast.BLangErrorMatchPattern:
+		var ok bool
+		accepted, covered, ok = resolveErrorMatchPattern(t, chain, p, expectedTy)
+		if !ok {
+			return semtypes.SemType{}, semtypes.SemType{}, false
+		}
+	default:
+		t.internalError(fmt.Sprintf("unexpected match pattern type: %T", pattern), pattern.GetPosition())
+		return semtypes.SemType{}, semtypes.SemType{}, false
+	}
+	pattern.SetAcceptedType(accepted)
+	pattern.(ast.BLangNode).SetDeterminedType(semtypes.NEVER)
+	return accepted, covered, true
+}
+
+func resolveListMatchPattern(t typeResolver, chain *binding, p *ast.BLangListMatchPattern, expectedTy semtypes.SemType) (semtypes.SemType, semtypes.SemType, bool) {
+	cx := t.typeContext()
+	memberCount := len(p.MatchPatterns)
+	acceptedMembers := make([]semtypes.SemType, memberCount)
+	coveredMembers := make([]semtypes.SemType, memberCount)
+	for i, member := range p.MatchPatterns {
+		memberExpectedTy := matchPatternExpectedType(semtypes.ListMemberTypeInnerVal(cx, semtypes.Intersect(expectedTy, semtypes.LIST), semtypes.IntConst(int64(i))))
+		accepted, covered, ok := resolveMatchPattern(t, chain, member, memberExpectedTy)
+		if !ok {
+			return semtypes.SemType{}, semtypes.SemType{}, false
+		}
+		acceptedMembers[i] = accepted
+		coveredMembers[i] = covered
+	}
+	rest := semtypes.NEVER
+	if p.RestMatchPattern != nil {
+		rest = semtypes.VAL
+		p.RestMatchPattern.SetDeterminedType(semtypes.NEVER)
+	}
+	env := t.typeEnv()
+	return matchPatternListType(env, acceptedMembers, rest), matchPatternListType(env, coveredMembers, rest), true
+}
+
+func resolveMappingMatchPattern(t typeResolver, chain *binding, p *ast.BLangMappingMatchPattern, expectedTy semtypes.SemType) (semtypes.SemType, semtypes.SemType, bool) {
+	cx := t.typeContext()
+	var names []string
+	var acceptedFields, coveredFields []semtypes.Field
+	for i := range p.FieldMatchPatterns {
+		field := &p.FieldMatchPatterns[i]
+		name := field.FieldName.Value
+		fieldExpectedTy := matchPatternExpectedType(semtypes.MappingMemberTypeInnerVal(cx, semtypes.Intersect(expectedTy, semtypes.MAPPING), semtypes.StringConst(name)))
+		accepted, covered, ok := resolveMatchPattern(t, chain, field.MatchPattern, fieldExpectedTy)
+		if !ok {
+			return semtypes.SemType{}, semtypes.SemType{}, false
+		}
+		field.SetDeterminedType(semtypes.NEVER)
+		field.FieldName.SetDeterminedType(semtypes.NEVER)
+		if containsName(names, name) {
+			// Already reported as a duplicate field.
+			continue
+		}
+		names = append(names, name)
+		acceptedFields = append(acceptedFields, semtypes.FieldFrom(name, accepted, false, false))
+		coveredFields = append(coveredFields, semtypes.FieldFrom(name, covered, false, false))
+	}
+	if p.RestMatchPattern != nil {
+		p.RestMatchPattern.SetDeterminedType(semtypes.NEVER)
+	}
+	env := t.typeEnv()
+	return matchPatternMappingType(env, acceptedFields), matchPatternMappingType(env, coveredFields), true
+}
+
+// resolveErrorMatchPattern returns the types of an error match pattern. The type of an error doesn't describe its
+// message or cause, so an error match pattern is only certain to match the errors of its type when its message
+// and cause match patterns match any message and cause.
+func resolveErrorMatchPattern(t typeResolver, chain *binding, p *ast.BLangErrorMatchPattern, expectedTy semtypes.SemType) (semtypes.SemType, semtypes.SemType, bool) {
+	cx := t.typeContext()
+	errorTy := semtypes.ERROR
+	if p.ErrorTypeReference != nil {
+		refTy, ok := resolveBType(t, p.ErrorTypeReference, 0)
+		if !ok {
+			return semtypes.SemType{}, semtypes.SemType{}, false
+		}
+		if !semtypes.IsSubtype(cx, refTy, semtypes.ERROR) {
+			t.semanticError(fmt.Sprintf("invalid error match pattern type '%s'", semtypes.ToString(cx, refTy)), p.ErrorTypeReference.GetPosition())
+			return semtypes.SemType{}, semtypes.SemType{}, false
+		}
+		errorTy = refTy
+	}
+	matchesAny := true
+	isCovering := true
+	if p.MessageMatchPattern != nil {
+		accepted, covered, ok := resolveMatchPattern(t, chain, p.MessageMatchPattern, semtypes.STRING)
+		if !ok {
+			return semtypes.SemType{}, semtypes.SemType{}, false
+		}
+		matchesAny = !semtypes.IsEmpty(cx, semtypes.Intersect(accepted, semtypes.STRING))
+		isCovering = semtypes.IsSubtype(cx, semtypes.STRING, covered)
+	}
+	if p.CauseMatchPattern != nil {
+		causeTy := semtypes.Union(semtypes.ERROR, semtypes.NIL)
+		accepted, covered, ok := resolveMatchPattern(t, chain, p.CauseMatchPattern, causeTy)
+		if !ok {
+			return semtypes.SemType{}, semtypes.SemType{}, false
+		}
+		matchesAny = matchesAny && !semtypes.IsEmpty(cx, semtypes.Intersect(accepted, causeTy))
+		isCovering = isCovering && semtypes.IsSubtype(cx, causeTy, covered)
+	}
+	var names []string
+	var acceptedFields, coveredFields []semtypes.Field
+	for i := range p.FieldMatchPatterns {
+		field := &p.FieldMatchPatterns[i]
+		name := field.ArgName.Value
+		accepted, covered, ok := resolveMatchPattern(t, chain, field.MatchPattern, errorMatchPatternDetailExpectedType(cx, expectedTy, name))
+		if !ok {
+			return semtypes.SemType{}, semtypes.SemType{}, false
+		}
+		field.SetDeterminedType(semtypes.NEVER)
+		field.ArgName.SetDeterminedType(semtypes.NEVER)
+		if containsName(names, name) {
+			// Already reported as a duplicate field.
+			continue
+		}
+		names = append(names, name)
+		acceptedFields = append(acceptedFields, semtypes.FieldFrom(name, accepted, false, false))
+		coveredFields = append(coveredFields, semtypes.FieldFrom(name, covered, false, false))
+	}
+	if p.RestMatchPattern != nil {
+		p.RestMatchPattern.SetDeterminedType(semtypes.NEVER)
+	}
+	if !matchesAny {
+		return semtypes.NEVER, semtypes.NEVER, true
+	}
+	env := t.typeEnv()
+	accepted := errorTy
+	covered := errorTy
+	if len(acceptedFields) > 0 {
+		accepted = semtypes.Intersect(errorTy, semtypes.ErrorWithDetail(matchPatternMappingType(env, acceptedFields)))
+		covered = semtypes.Intersect(errorTy, semtypes.ErrorWithDetail(matchPatternMappingType(env, coveredFields)))
+	}
+	if !isCovering {
+		covered = semtypes.NEVER
+	}
+	return accepted, covered, true
+}
+
+func matchPatternListType(env semtypes.Env, members []semtypes.SemType, rest semtypes.SemType) semtypes.SemType {
+	ld := semtypes.NewListDefinition()
+	return ld.DefineListTypeWrapped(env, members, len(members), rest, semtypes.CellMutability_CELL_MUT_LIMITED)
+}
+
+// matchPatternMappingType returns the type of the mappings with fields, which may also have any other fields.
+func matchPatternMappingType(env semtypes.Env, fields []semtypes.Field) semtypes.SemType {
+	md := semtypes.NewMappingDefinition()
+	return md.DefineMappingTypeWrapped(env, fields, semtypes.VAL)
+}
+
+// matchPatternExpectedType returns the type expected of the value matched by a nested match pattern, given ty, the
+// projection of the type expected of the enclosing match pattern. A nested const match pattern that can't match any
+// value of the projection is still typed on its own.
+func matchPatternExpectedType(ty semtypes.SemType) semtypes.SemType {
+	ty = semtypes.Diff(ty, semtypes.UNDEF)
+	if semtypes.IsNever(ty) {
+		return semtypes.VAL
+	}
+	return ty
+}
+
+func errorMatchPatternDetailExpectedType(cx semtypes.Context, expectedTy semtypes.SemType, name string) semtypes.SemType {
+	detail, ok := semtypes.ErrorDetailAtomicType(cx, semtypes.Intersect(expectedTy, semtypes.ERROR))
+	if !ok {
+		return semtypes.VAL
+	}
+	return matchPatternExpectedType(detail.FieldInnerVal(name))
+}
+
+// resolveMatchPatternVariables adds the types of the variables bound by pattern to variableTys, given ty, the type
+// of the values the match pattern matches. A variable bound by more than one match pattern of a match clause has
+// the union of the types.
+func resolveMatchPatternVariables(t typeResolver, pattern ast.BLangMatchPattern, ty semtypes.SemType, variableTys map[model.SymbolRef]semtypes.SemType) {
+	cx := t.typeContext()
+	addVariableTy := func(variable *ast.BLangSimpleVariable, ty semtypes.SemType) {
+		if !ast.SymbolIsSet(variable) {
+			return
+		}
+		if prev, ok := variableTys[variable.Symbol()]; ok {
+			ty = semtypes.Union(prev, ty)
+		}
+		variableTys[variable.Symbol()] = ty
+	}
+	switch p := pattern.(type) {
+	case *ast.BLangCaptureMatchPattern:
+		addVariableTy(p.Variable, ty)
+	case *ast.BLangListMatchPattern:
+		for i, member := range p.MatchPatterns {
+			memberTy := semtypes.ListMemberTypeInnerVal(cx, ty, semtypes.IntConst(int64(i)))
+			resolveMatchPatternVariables(t, member, semtypes.Intersect(memberTy, member.GetAcceptedType()), variableTys)
+		}
+		if p.RestMatchPattern != nil {
+			addVariableTy(p.RestMatchPattern.Variable, listBindingPatternRestType(t, ty, len(p.MatchPatterns)))
+		}
+	case *ast.BLangMappingMatchPattern:
+		var names []string
+		for _, field := range p.FieldMatchPatterns {
+			name := field.FieldName.Value
+			names = append(names, name)
+			fieldTy := semtypes.Diff(semtypes.MappingMemberTypeInner(cx, ty, semtypes.StringConst(name)), semtypes.UNDEF)
+			resolveMatchPatternVariables(t, field.MatchPattern, semtypes.Intersect(fieldTy, field.MatchPattern.GetAcceptedType()), variableTys)
+		}
+		if p.RestMatchPattern != nil {
+			addVariableTy(p.RestMatchPattern.Variable, mappingBindingPatternRestType(t, ty, names))
+		}
+	case *ast.BLangErrorMatchPattern:
+		if p.MessageMatchPattern != nil {
+			resolveMatchPatternVariables(t, p.MessageMatchPattern, semtypes.Intersect(semtypes.STRING, p.MessageMatchPattern.GetAcceptedType()), variableTys)
+		}
+		if p.CauseMatchPattern != nil {
+			causeTy := semtypes.Union(semtypes.ERROR, semtypes.NIL)
+			resolveMatchPatternVariables(t, p.CauseMatchPattern, semtypes.Intersect(causeTy, p.CauseMatchPattern.GetAcceptedType()), variableTys)
+		}
+		var names []string
+		for _, field := range p.FieldMatchPatterns {
+			name := field.ArgName.Value
+			names = append(names, name)
+			resolveMatchPatternVariables(t, field.MatchPattern, semtypes.Intersect(errorMatchPatternDetailType(cx, ty, name), field.MatchPattern.GetAcceptedType()), variableTys)
+		}
+		if p.RestMatchPattern != nil {
+			addVariableTy(p.RestMatchPattern.Variable, errorBindingPatternRestDetailType(t, ty, names))
+		}
+	}
+}
+
+func errorMatchPatternDetailType(cx semtypes.Context, ty semtypes.SemType, name string) semtypes.SemType {
+	detail, ok := semtypes.ErrorDetailAtomicType(cx, ty)
+	if !ok {
+		return errorDetailValueType(cx)
+	}
+	fieldTy := detail.FieldInnerVal(name)
+	if semtypes.IsNever(fieldTy) {
+		return errorDetailValueType(cx)
+	}
+	return fieldTy
+}
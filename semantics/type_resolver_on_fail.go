@@ -0,0 +1,138 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semantics
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/semtypes"
+)
+
+// resolveDo resolves a do statement. Control reaches the end of the statement either by completing the body or by
+// completing the on-fail clause after the body fails.
+func resolveDo(t typeResolver, chain *binding, stmt *ast.BLangDo) (statementEffect, bool) {
+	bodyEffect, ok := resolveBlockStatements(t, chain, stmt.Body.Stmts)
+	if !ok {
+		return defaultStmtEffect(chain), false
+	}
+	stmt.Body.SetDeterminedType(semtypes.NEVER)
+	return resolveOnFailClause(t, chain, stmt, &stmt.OnFailClause, bodyEffect)
+}
+
+// resolveOnFailClause resolves the on-fail clause of stmt, given the effect of the rest of stmt, and returns the
+// effect of the whole statement. The clause is entered with the bindings in effect before stmt, since it can be
+// reached from any point in stmt where it may fail. A clause without a body is treated as absent.
+func resolveOnFailClause(t typeResolver, chain *binding, stmt ast.BLangNode, clause *ast.BLangOnFailClause, stmtEffect statementEffect) (statementEffect, bool) {
+	if clause == nil || clause.Body == nil {
+		return stmtEffect, true
+	}
+	clause.SetDeterminedType(semtypes.NEVER)
+	if varDef := clause.VariableDefinitionNode; varDef != nil {
+		tyCtx := t.typeContext()
+		failTy := onFailErrorType(tyCtx, stmt, clause)
+		variable := varDef.GetVariable().(*ast.BLangSimpleVariable)
+		if clause.IsDeclaredWithVar() {
+			variable.Name.SetDeterminedType(semtypes.NEVER)
+			setExpectedType(variable, failTy)
+			updateSymbolType(t, variable, failTy)
+		} else {
+			if !resolveSimpleVariable(t, chain, variable) {
+				return defaultStmtEffect(chain), false
+			}
+			declaredTy := variable.GetDeterminedType()
+			if !semtypes.IsSubtype(tyCtx, declaredTy, semtypes.ERROR) {
+				t.semanticError(formatIncompatibleTypeMessage(tyCtx, semtypes.ERROR, declaredTy), varDef.GetPosition())
+				return defaultStmtEffect(chain), false
+			}
+			if !semtypes.IsSubtype(tyCtx, failTy, declaredTy) {
+				t.semanticError(formatIncompatibleTypeMessage(tyCtx, declaredTy, failTy), varDef.GetPosition())
+				return defaultStmtEffect(chain), false
+			}
+		}
+		varDef.SetDeterminedType(semtypes.NEVER)
+	}
+	clauseEffect, ok := resolveBlockStatements(t, chain, clause.Body.Stmts)
+	if !ok {
+		return defaultStmtEffect(chain), false
+	}
+	clause.Body.SetDeterminedType(semtypes.NEVER)
+	return mergeStatementEffects(t, stmtEffect, clauseEffect), true
+}
+
+// onFailErrorType returns the union of the error types with which stmt may fail to its on-fail clause. Failures
+// within a nested statement with an on-fail clause of its own go to that clause, and a failure within a function
+// expression fails that function instead.
+func onFailErrorType(tyCtx semtypes.Context, stmt ast.BLangNode, clause *ast.BLangOnFailClause) semtypes.SemType {
+	collector := &failErrorTypeCollector{tyCtx: tyCtx, root: stmt, clause: clause, ty: semtypes.NEVER}
+	ast.Walk(collector, stmt)
+	return collector.ty
+}
+
+type failErrorTypeCollector struct {
+	tyCtx  semtypes.Context
+	root   ast.BLangNode
+	clause *ast.BLangOnFailClause
+	ty     semtypes.SemType
+}
+
+func (c *failErrorTypeCollector) Visit(node ast.BLangNode) ast.Visitor {
+	if node == nil {
+		return nil
+	}
+	if clause, ok := node.(*ast.BLangOnFailClause); ok && clause == c.clause {
+		return nil
+	}
+	if node != c.root {
+		if clause := onFailClauseOf(node); clause != nil {
+			ast.Walk(c, clause)
+			return nil
+		}
+	}
+	switch n := node.(type) {
+	case *ast.BLangFail:
+		c.ty = semtypes.Union(c.ty, n.Expr.GetDeterminedType())
+	case *ast.BLangCheckedExpr:
+		c.ty = semtypes.Union(c.ty, semtypes.Intersect(n.Expr.GetDeterminedType(), semtypes.ERROR))
+	case *ast.BLangLambdaFunction, *ast.BLangArrowFunction:
+		return nil
+	}
+	return c
+}
+
+func (c *failErrorTypeCollector) VisitTypeData(_ *ast.TypeData) ast.Visitor { return nil }
+
+// onFailClauseOf returns the on-fail clause of a statement, or nil if the statement doesn't have one.
+func onFailClauseOf(node ast.BLangNode) *ast.BLangOnFailClause {
+	var clause *ast.BLangOnFailClause
+	switch n := node.(type) {
+	case *ast.BLangDo:
+		clause = &n.OnFailClause
+	case *ast.BLangWhile:
+		clause = &n.OnFailClause
+	case *ast.BLangForeach:
+		clause = n.OnFailClause
+	case *ast.BLangLock:
+		clause = n.OnFailClause
+	case *ast.BLangTransaction:
+		clause = n.OnFailClause
+	case *ast.BLangRetry:
+		clause = n.OnFailClause
+	}
+	if clause == nil || clause.Body == nil {
+		return nil
+	}
+	return clause
+}
@@ -79,7 +79,7 @@ func TestResolveQueryExprErrorCases(t *testing.T) {
 				newFromClause(newIntLiteral(42), nil, true),
 				newSelectClause(newIntLiteral(1)),
 			),
-			diagSub: "query from clause currently supports only list or map collections",
+			diagSub: "query collection is not iterable",
 		},
 		{
 			name: "from binding variable is nil",
@@ -256,7 +256,7 @@ func TestResolveQueryIntermediateClauseErrorCases(t *testing.T) {
 				false,
 				newOnClause(newIntLiteral(1), newIntLiteral(1)),
 			),
-			diagSub: "query from clause currently supports only list or map collections",
+			diagSub: "query collection is not iterable",
 		},
 		{
 			name: "outer join without var",
@@ -696,7 +696,7 @@ func TestResolveQueryExprOnConflictClauseErrors(t *testing.T) {
 		if ok {
 			t.Fatalf("expected resolveQueryExpr to fail for non-map on conflict")
 		}
-		assertDiagnosticContains(t, cx, "on conflict clause is supported only for map query construct type")
+		assertDiagnosticContains(t, cx, "on conflict clause is supported only for map or keyed table query construct types")
 	})
 
 	t.Run("on conflict expression must be error?", func(t *testing.T) {
@@ -831,9 +831,9 @@ func newSelectClause(expr ast.BLangExpression) *ast.BLangSelectClause {
 }
 
 func newLetClause(defs ...*ast.BLangSimpleVariableDef) *ast.BLangLetClause {
-	decls := make([]ast.BLangSimpleVariableDef, len(defs))
+	decls := make([]ast.VariableDefinitionNode, len(defs))
 	for i, def := range defs {
-		decls[i] = *def
+		decls[i] = def
 	}
 	letClause := &ast.BLangLetClause{
 		LetVarDeclarations: decls,
@@ -0,0 +1,112 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semantics
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/semtypes"
+)
+
+var commitResultType = semtypes.Union(semtypes.ERROR, semtypes.NIL)
+
+func resolveTransaction(t typeResolver, chain *binding, s *ast.BLangTransaction) (statementEffect, bool) {
+	effect, ok := resolveBlockStatements(t, chain, s.Body.Stmts)
+	if !ok {
+		return defaultStmtEffect(chain), false
+	}
+	s.Body.SetDeterminedType(semtypes.NEVER)
+	return resolveOnFailClause(t, chain, s, s.OnFailClause, effect)
+}
+
+// resolveRetry resolves the retry spec and the body of a retry statement. A failed attempt either exits the
+// statement or runs the body again from the start, so the statement completes normally only when the body does.
+func resolveRetry(t typeResolver, chain *binding, s *ast.BLangRetry) (statementEffect, bool) {
+	if !resolveRetrySpec(t, chain, &s.RetrySpec) {
+		return defaultStmtEffect(chain), false
+	}
+	var effect statementEffect
+	var ok bool
+	if s.Transaction != nil {
+		effect, ok = resolveTransaction(t, chain, s.Transaction)
+		s.Transaction.SetDeterminedType(semtypes.NEVER)
+	} else {
+		effect, ok = resolveBlockStatements(t, chain, s.Body.Stmts)
+		s.Body.SetDeterminedType(semtypes.NEVER)
+	}
+	if !ok {
+		return defaultStmtEffect(chain), false
+	}
+	return resolveOnFailClause(t, chain, s, s.OnFailClause, effect)
+}
+
+func resolveRetrySpec(t typeResolver, chain *binding, spec *ast.BLangRetrySpec) bool {
+	spec.SetDeterminedType(semtypes.NEVER)
+	if spec.RetryManager != nil {
+		ty, _, ok := resolveNewExpr(t, chain, spec.RetryManager, semtypes.SemType{})
+		if !ok {
+			return false
+		}
+		if !isRetryManagerType(t, ty) {
+			t.semanticError("retry manager type must be an object type with a 'shouldRetry(error) returns boolean' method", spec.RetryManager.GetPosition())
+			return false
+		}
+		return true
+	}
+	for _, arg := range spec.ArgExprs {
+		if _, _, ok := resolveActionOrExpression(t, chain, arg, semtypes.INT); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// isRetryManagerType reports whether ty has a `shouldRetry` method that can be called with an error and returns a
+// boolean.
+func isRetryManagerType(t typeResolver, ty semtypes.SemType) bool {
+	cx := t.typeContext()
+	if !semtypes.IsSubtype(cx, ty, semtypes.OBJECT) {
+		return false
+	}
+	methodTy := semtypes.ObjectMemberType(cx, semtypes.StringConst("shouldRetry"), ty)
+	if semtypes.IsEmpty(cx, methodTy) {
+		return false
+	}
+	ld := semtypes.NewListDefinition()
+	paramListTy := ld.DefineListTypeWrapped(t.typeEnv(), []semtypes.SemType{semtypes.ERROR}, 1, semtypes.NEVER, semtypes.CellMutability_CELL_MUT_NONE)
+	fd := semtypes.NewFunctionDefinition()
+	shouldRetryTy := fd.Define(t.typeEnv(), paramListTy, semtypes.BOOLEAN, semtypes.FunctionQualifiersFrom(t.typeEnv(), false, false))
+	return semtypes.IsSubtype(cx, methodTy, shouldRetryTy)
+}
+
+func resolveRollback(t typeResolver, chain *binding, s *ast.BLangRollback) (statementEffect, bool) {
+	if s.Expr != nil {
+		if _, _, ok := resolveActionOrExpression(t, chain, s.Expr, commitResultType); !ok {
+			return defaultStmtEffect(chain), false
+		}
+	}
+	return defaultStmtEffect(chain), true
+}
+
+func resolveCommitExpr(chain *binding, e *ast.BLangCommitExpr) (semtypes.SemType, expressionEffect, bool) {
+	setExpectedType(e, commitResultType)
+	return commitResultType, defaultExpressionEffect(chain), true
+}
+
+func resolveTransactionalExpr(chain *binding, e *ast.BLangTransactionalExpr) (semtypes.SemType, expressionEffect, bool) {
+	setExpectedType(e, semtypes.BOOLEAN)
+	return semtypes.BOOLEAN, defaultExpressionEffect(chain), true
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semantics
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+)
+
+// resolveWorker resolves the body of a named worker and gives the worker name the type future<T>, where T is the
+// return type of the worker.
+func resolveWorker(t typeResolver, chain *binding, worker *ast.BLangWorker) (statementEffect, bool) {
+	worker.Name.SetDeterminedType(semtypes.NEVER)
+	_, effect, ok := resolveActionOrExpression(t, chain, worker.Lambda, semtypes.SemType{})
+	if !ok {
+		return defaultStmtEffect(chain), false
+	}
+	if ast.SymbolIsSet(worker) {
+		fnSym := t.getSymbol(worker.Lambda.Function.Symbol()).(model.FunctionSymbol)
+		t.setSymbolType(worker.Symbol(), semtypes.FutureContaining(t.typeEnv(), fnSym.Signature().ReturnType))
+	}
+	worker.SetDeterminedType(semtypes.NEVER)
+	return defaultStmtEffect(effect.ifTrue), true
+}
+
+// resolveWorkerSend resolves the message expression of a send. resultTy is the type of the send action
+// itself: nil for an async send, and nil or the error the receiver may return for a sync send.
+func resolveWorkerSend(t typeResolver, chain *binding, send *ast.BLangWorkerSendExprBase, resultTy semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
+	exprTy, _, ok := resolveActionOrExpression(t, chain, send.Expr, semtypes.SemType{})
+	if !ok {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	if !semtypes.IsSubtype(t.typeContext(), exprTy, semtypes.CreateAnydata(t.typeContext())) {
+		t.semanticError("worker send expression must be a subtype of anydata", send.Expr.GetPosition())
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	send.WorkerIdentifier.SetDeterminedType(semtypes.NEVER)
+	setExpectedType(send, resultTy)
+	return resultTy, defaultExpressionEffect(chain), true
+}
+
+// resolveWorkerReceive determines the type of a receive from the matching send. When the send has not been
+// resolved yet (the sender is declared after the receiver) the expected type is used instead, and the
+// semantic analyzer later checks that the message type fits.
+func resolveWorkerReceive(t typeResolver, chain *binding, receive *ast.BLangWorkerReceive, expectedType semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
+	receive.WorkerIdentifier.SetDeterminedType(semtypes.NEVER)
+	var msgTy semtypes.SemType
+	if receive.Send != nil {
+		msgTy = receive.Send.GetExpression().GetDeterminedType()
+	}
+	if semtypes.IsZero(msgTy) {
+		msgTy = semtypes.CreateAnydata(t.typeContext())
+		if !semtypes.IsZero(expectedType) {
+			msgTy = semtypes.Intersect(expectedType, msgTy)
+		}
+	}
+	resultTy := msgTy
+	if receive.Channel != nil {
+		resultTy = semtypes.Union(resultTy, peerErrorType(t, receive.PeerSymbol))
+		if workerSendBase(receive.Send).NoMessagePossible {
+			resultTy = semtypes.Union(resultTy, semtypes.ERROR)
+		}
+	}
+	setExpectedType(receive, resultTy)
+	return resultTy, defaultExpressionEffect(chain), true
+}
+
+func resolveAlternateWorkerReceive(t typeResolver, chain *binding, e *ast.BLangAlternateWorkerReceive, expectedType semtypes.SemType) (semtypes.SemType, expressionEffect, bool) {
+	resultTy := semtypes.NEVER
+	for i := range e.WorkerReceives {
+		ty, _, ok := resolveWorkerReceive(t, chain, &e.WorkerReceives[i], expectedType)
+		if !ok {
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		resultTy = semtypes.Union(resultTy, ty)
+	}
+	setExpectedType(e, resultTy)
+	return resultTy, defaultExpressionEffect(chain), true
+}
+
+// resolveMultipleWorkerReceive gives `<- {k1: w1, k2: w2}` a closed record type with a field per receive. If any
+// of the receives may result in an error, the whole expression may result in that error instead.
+func resolveMultipleWorkerReceive(t typeResolver, chain *binding, e *ast.BLangMultipleWorkerReceive) (semtypes.SemType, expressionEffect, bool) {
+	fields := make([]semtypes.Field, len(e.ReceiveFields))
+	errTy := semtypes.NEVER
+	for i := range e.ReceiveFields {
+		field := &e.ReceiveFields[i]
+		ty, _, ok := resolveWorkerReceive(t, chain, &field.Receive, semtypes.SemType{})
+		if !ok {
+			return semtypes.SemType{}, expressionEffect{}, false
+		}
+		field.Key.SetDeterminedType(semtypes.NEVER)
+		fields[i] = semtypes.FieldFrom(field.Key.Value, semtypes.Diff(ty, semtypes.ERROR), false, false)
+		errTy = semtypes.Union(errTy, semtypes.Intersect(ty, semtypes.ERROR))
+	}
+	resultTy := semtypes.Union(createClosedRecordType(t.typeEnv(), fields, semtypes.NEVER), errTy)
+	setExpectedType(e, resultTy)
+	return resultTy, defaultExpressionEffect(chain), true
+}
+
+func resolveWorkerFlush(t typeResolver, chain *binding, e *ast.BLangWorkerFlushExpr) (semtypes.SemType, expressionEffect, bool) {
+	if e.WorkerIdentifier != nil {
+		e.WorkerIdentifier.SetDeterminedType(semtypes.NEVER)
+	}
+	resultTy := semtypes.NIL
+	for _, peer := range e.PeerSymbols {
+		resultTy = semtypes.Union(resultTy, peerErrorType(t, peer))
+	}
+	setExpectedType(e, resultTy)
+	return resultTy, defaultExpressionEffect(chain), true
+}
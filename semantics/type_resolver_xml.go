@@ -0,0 +1,93 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semantics
+
+import (
+	"fmt"
+
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/semtypes"
+)
+
+var xmlElementSequence = semtypes.XMLSequence(semtypes.XML_ELEMENT)
+
+func resolveXMLFilterExpr(t typeResolver, chain *binding, e *ast.BLangXMLFilterExpr) (semtypes.SemType, expressionEffect, bool) {
+	if !resolveXMLNavigationOperand(t, chain, e.Expr) {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	setExpectedType(e, xmlElementSequence)
+	return xmlElementSequence, defaultExpressionEffect(chain), true
+}
+
+// resolveXMLStepExpr resolves `x/*`, `x/<p>` and `x/**/<p>`. When there are step extensions, they are resolved
+// against the result of the step on a single element of x, and the expression is the concatenation of their results.
+func resolveXMLStepExpr(t typeResolver, chain *binding, e *ast.BLangXMLStepExpr) (semtypes.SemType, expressionEffect, bool) {
+	if !resolveXMLNavigationOperand(t, chain, e.Expr) {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	stepTy := xmlElementSequence
+	if e.Kind == ast.XMLStepKindChildren {
+		stepTy = semtypes.XML
+	}
+	if e.Extension == nil {
+		setExpectedType(e, stepTy)
+		return stepTy, defaultExpressionEffect(chain), true
+	}
+	setExpectedType(e.Item, stepTy)
+	extensionTy, _, ok := resolveActionOrExpression(t, chain, e.Extension, semtypes.SemType{})
+	if !ok {
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	if !semtypes.IsSubtype(t.typeContext(), extensionTy, semtypes.XML) {
+		t.semanticError(fmt.Sprintf("expected xml value, got %s", semtypes.ToString(t.typeContext(), extensionTy)), e.Extension.GetPosition())
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	ty := semtypes.XMLSequence(xmlItemType(extensionTy))
+	setExpectedType(e, ty)
+	return ty, defaultExpressionEffect(chain), true
+}
+
+func resolveXMLStepItem(t typeResolver, chain *binding, e *ast.BLangXMLStepItem) (semtypes.SemType, expressionEffect, bool) {
+	ty := e.GetDeterminedType()
+	if semtypes.IsZero(ty) {
+		t.internalError("xml step item resolved outside of its step expression", e.GetPosition())
+		return semtypes.SemType{}, expressionEffect{}, false
+	}
+	return ty, defaultExpressionEffect(chain), true
+}
+
+func resolveXMLNavigationOperand(t typeResolver, chain *binding, operand ast.BLangExpression) bool {
+	operandTy, _, ok := resolveActionOrExpression(t, chain, operand, semtypes.SemType{})
+	if !ok {
+		return false
+	}
+	if !semtypes.IsSubtype(t.typeContext(), operandTy, semtypes.XML) {
+		t.semanticError(fmt.Sprintf("expected xml value, got %s", semtypes.ToString(t.typeContext(), operandTy)), operand.GetPosition())
+		return false
+	}
+	return true
+}
+
+// xmlItemType is the type of the items of the xml sequences belonging to ty.
+func xmlItemType(ty semtypes.SemType) semtypes.SemType {
+	return semtypes.Intersect(ty, semtypes.XMLSingleton(semtypes.XML_PRIMITIVE_SINGLETON))
+}
+
+// xmlMemberType is the type of `x[i]` for x of type ty: the item at i, or the empty sequence when i is out of range.
+func xmlMemberType(ty semtypes.SemType) semtypes.SemType {
+	return semtypes.Union(xmlItemType(ty), semtypes.XMLSequence(semtypes.NEVER))
+}
@@ -190,7 +190,8 @@ func analyzeUninitializedGlobalVars(ctx *context.CompilerContext, pkg *ast.BLang
 	globalSymbols := make(map[model.SymbolRef]bool)
 	var varsNeedingInit []string
 	for i := range pkg.GlobalVars {
-		if pkg.GlobalVars[i].Expr == nil {
+		// A required configurable gets its value from the configuration instead of an initializer.
+		if pkg.GlobalVars[i].Expr == nil && !pkg.GlobalVars[i].IsConfigurable() {
 			varsNeedingInit = append(varsNeedingInit, pkg.GlobalVars[i].Name.Value)
 			globalSymbols[pkg.GlobalVars[i].Symbol()] = true
 		}
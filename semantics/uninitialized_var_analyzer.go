@@ -221,6 +221,15 @@ func (a *uninitVarAnalyzer) analyzeNode(node ast.Node, state *varInitState) {
 		} else if !state.isInitialized(symRef) {
 			state.markUninitialized(symRef)
 		}
+	case *ast.BLangTupleVariableDef, *ast.BLangRecordVariableDef, *ast.BLangErrorVariableDef:
+		variable := n.(ast.VariableDefinitionNode).GetVariable()
+		a.checkExpression(variable.GetInitialExpression(), state)
+		state.markInitialized(variable.(ast.BNodeWithSymbol).Symbol())
+		for _, leaf := range ast.BindingVariables(variable) {
+			if !ast.IsWildcardVariable(leaf) {
+				state.markInitialized(leaf.Symbol())
+			}
+		}
 	case *ast.BLangAssignment:
 		a.checkExpression(n.Expr, state)
 		a.markAssignmentTarget(n.VarRef, state)
@@ -232,7 +241,7 @@ func (a *uninitVarAnalyzer) analyzeNode(node ast.Node, state *varInitState) {
 		// Expression nodes (like conditions in while loops) need to be checked
 		a.checkExpression(n, state)
 	case ast.BLangNode:
-		checker := &varRefChecker{analyzer: a, state: state}
+		checker := &varRefChecker{analyzer: a, state: state, root: n}
 		ast.Walk(checker, n)
 	default:
 		a.ctx.InternalError("unexpected node", node.GetPosition())
@@ -246,6 +255,15 @@ func (a *uninitVarAnalyzer) markAssignmentTarget(expr ast.BLangExpression, state
 		return
 	}
 
+	// For binding pattern refs, mark each variable the pattern assigns
+	switch expr.(type) {
+	case *ast.BLangTupleVarRef, *ast.BLangRecordVarRef, *ast.BLangErrorVarRef:
+		for _, ref := range ast.BindingVarRefs(expr.(ast.LExpr)) {
+			a.markAssignmentTarget(ref, state)
+		}
+		return
+	}
+
 	// For simple variable references, mark as initialized
 	if nodeWithSymbol, ok := expr.(ast.NodeWithSymbol); ok {
 		symRef := nodeWithSymbol.Symbol()
@@ -282,6 +300,11 @@ func (a *uninitVarAnalyzer) checkVariableReference(symRef model.SymbolRef, node
 type varRefChecker struct {
 	analyzer *uninitVarAnalyzer
 	state    *varInitState
+	// root is the CFG node being checked, if it is a statement.
+	root ast.BLangNode
+	// nested is set inside nested statements and function bodies, whose conditional expressions are not split
+	// into basic blocks of their own.
+	nested bool
 }
 
 var _ ast.Visitor = &varRefChecker{}
@@ -290,6 +313,16 @@ func (v *varRefChecker) Visit(node ast.BLangNode) ast.Visitor {
 	if node == nil {
 		return nil
 	}
+	if !v.nested {
+		// The parts of a conditional expression are nodes of the basic blocks created for it.
+		if isConditionalExpr(node) {
+			return nil
+		}
+		if node != v.root && isConditionalExprBoundary(node) {
+			nested := &varRefChecker{analyzer: v.analyzer, state: v.state, nested: true}
+			return nested.Visit(node)
+		}
+	}
 
 	// Check if this node is a variable reference
 	if inv, ok := node.(*ast.BLangInvocation); ok && ast.IsStreamOperation(inv) {
@@ -0,0 +1,320 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semantics
+
+import (
+	"slices"
+
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+)
+
+// defaultWorkerName is the name used to refer to the default worker of a function (`-> function`).
+const defaultWorkerName = "function"
+
+// workerInfo holds the interactions of a single worker of a function, in the order they appear in the
+// worker body.
+type workerInfo struct {
+	name     string
+	symbol   model.SymbolRef
+	sends    []ast.WorkerSendExpressionNode
+	receives []*ast.BLangWorkerReceive
+	flushes  []*ast.BLangWorkerFlushExpr
+	// sendTargets are the names of the workers this worker sends to, in order of first send.
+	sendTargets []string
+}
+
+// workerInteractionCollector tracks the state shared by all statements of a single worker body.
+type workerInteractionCollector struct {
+	analyzer *workerAnalyzer
+	worker   *workerInfo
+	// mayExit is set once the collector has seen a statement that may terminate the worker early. Any send
+	// after that point may not be executed.
+	mayExit bool
+}
+
+// workerInteractionVisitor collects the sends, receives and flushes of a worker. Nested worker declarations
+// (fork statements) are registered with the analyzer but not descended into, and neither are other anonymous
+// functions since they are analyzed as separate functions.
+type workerInteractionVisitor struct {
+	collector   *workerInteractionCollector
+	conditional bool
+	inLoop      bool
+	inLock      bool
+}
+
+type workerAnalyzer struct {
+	t       typeResolver
+	workers map[string]*workerInfo
+	order   []*workerInfo
+}
+
+var _ ast.Visitor = &workerInteractionVisitor{}
+
+// analyzeWorkers pairs the worker sends and receives in the body of the function fnSymbol. This must run
+// before the statements of the body are resolved since the types of receives depend on the matching sends.
+func analyzeWorkers(t typeResolver, fnSymbol model.SymbolRef, body *ast.BLangBlockFunctionBody) {
+	if !hasWorkerInteractions(body) {
+		return
+	}
+	analyzer := &workerAnalyzer{t: t, workers: make(map[string]*workerInfo)}
+	defaultWorker := analyzer.addWorker(defaultWorkerName, fnSymbol)
+	analyzer.collect(defaultWorker, body.Stmts)
+	analyzer.pairInteractions()
+}
+
+func (a *workerAnalyzer) addWorker(name string, symbol model.SymbolRef) *workerInfo {
+	worker := &workerInfo{name: name, symbol: symbol}
+	a.workers[name] = worker
+	a.order = append(a.order, worker)
+	return worker
+}
+
+func (a *workerAnalyzer) collect(worker *workerInfo, stmts []ast.StatementNode) {
+	collector := &workerInteractionCollector{analyzer: a, worker: worker}
+	for _, stmt := range stmts {
+		ast.Walk(&workerInteractionVisitor{collector: collector}, stmt.(ast.BLangNode))
+	}
+}
+
+func (a *workerAnalyzer) declareWorker(worker *ast.BLangWorker) {
+	name := worker.Name.Value
+	if _, exists := a.workers[name]; exists {
+		a.t.semanticError("redeclared worker '"+name+"'", worker.Name.GetPosition())
+		return
+	}
+	fn := worker.Lambda.Function
+	// Receives from this worker need its return type, so the signature is resolved up front.
+	if _, ok := resolveFunctionSignature(a.t, fn); !ok {
+		return
+	}
+	info := a.addWorker(name, fn.Symbol())
+	a.collect(info, fn.Body.(*ast.BLangBlockFunctionBody).Stmts)
+}
+
+func (v *workerInteractionVisitor) Visit(node ast.BLangNode) ast.Visitor {
+	if node == nil {
+		return nil
+	}
+	collector := v.collector
+	switch n := node.(type) {
+	case *ast.BLangWorker:
+		collector.analyzer.declareWorker(n)
+		return nil
+	case *ast.BLangLambdaFunction:
+		return nil
+	case *ast.BLangWhile, *ast.BLangForeach:
+		return &workerInteractionVisitor{collector: collector, conditional: true, inLoop: true, inLock: v.inLock}
+	case *ast.BLangLock:
+		return &workerInteractionVisitor{collector: collector, conditional: true, inLoop: v.inLoop, inLock: true}
+	case *ast.BLangIf, *ast.BLangMatchStatement, *ast.BLangBlockStmt, *ast.BLangDo:
+		return &workerInteractionVisitor{collector: collector, conditional: true, inLoop: v.inLoop, inLock: v.inLock}
+	case *ast.BLangReturn, *ast.BLangCheckedExpr:
+		collector.mayExit = true
+	case ast.WorkerSendExpressionNode:
+		v.visitSend(n)
+		return nil
+	case *ast.BLangWorkerReceive:
+		if v.checkPosition(n) {
+			collector.worker.receives = append(collector.worker.receives, n)
+		}
+		return nil
+	case *ast.BLangWorkerFlushExpr:
+		if v.checkPosition(n) {
+			n.Sender = collector.worker.name
+			collector.worker.flushes = append(collector.worker.flushes, n)
+		}
+		return nil
+	}
+	return v
+}
+
+func (v *workerInteractionVisitor) VisitTypeData(_ *ast.TypeData) ast.Visitor {
+	return nil
+}
+
+func (v *workerInteractionVisitor) visitSend(send ast.WorkerSendExpressionNode) {
+	// The expression is evaluated before the message is sent.
+	ast.Walk(v, send.GetExpression())
+	if !v.checkPosition(send) {
+		return
+	}
+	collector := v.collector
+	workerSendBase(send).NoMessagePossible = v.conditional || collector.mayExit
+	collector.worker.sends = append(collector.worker.sends, send)
+}
+
+func (v *workerInteractionVisitor) checkPosition(node ast.BLangNode) bool {
+	if v.inLoop {
+		v.collector.analyzer.t.semanticError("worker interactions are not allowed inside loops", node.GetPosition())
+		return false
+	}
+	if v.inLock {
+		v.collector.analyzer.t.semanticError("worker interactions are not allowed inside lock statements", node.GetPosition())
+		return false
+	}
+	return true
+}
+
+// pairInteractions matches the k-th send from worker X to worker Y with the k-th receive in Y from X.
+func (a *workerAnalyzer) pairInteractions() {
+	for _, sender := range a.order {
+		sendCounts := make(map[string]int)
+		for _, send := range sender.sends {
+			base := workerSendBase(send)
+			receiver, ok := a.lookupPeer(sender, base.WorkerIdentifier)
+			if !ok {
+				continue
+			}
+			base.PeerSymbol = receiver.symbol
+			if !slices.Contains(sender.sendTargets, receiver.name) {
+				sender.sendTargets = append(sender.sendTargets, receiver.name)
+			}
+			index := sendCounts[receiver.name]
+			sendCounts[receiver.name] = index + 1
+			receive, ok := receiver.nthReceiveFrom(sender.name, index)
+			if !ok {
+				a.t.semanticError("no matching receive in worker '"+receiver.name+"'", send.GetPosition())
+				continue
+			}
+			channel := &ast.Channel{Sender: sender.name, Receiver: receiver.name, EventIndex: index}
+			base.Channel = channel
+			base.Receive = receive
+			receive.Channel = channel
+			receive.Send = send
+		}
+	}
+	for _, receiver := range a.order {
+		for _, receive := range receiver.receives {
+			sender, ok := a.lookupPeer(receiver, receive.WorkerIdentifier)
+			if !ok {
+				continue
+			}
+			receive.PeerSymbol = sender.symbol
+			if receive.Channel == nil {
+				a.t.semanticError("no matching send in worker '"+sender.name+"'", receive.GetPosition())
+			}
+		}
+		for _, flush := range receiver.flushes {
+			a.resolveFlushTargets(receiver, flush)
+		}
+	}
+}
+
+func (a *workerAnalyzer) resolveFlushTargets(worker *workerInfo, flush *ast.BLangWorkerFlushExpr) {
+	if flush.WorkerIdentifier != nil {
+		peer, ok := a.lookupPeer(worker, flush.WorkerIdentifier)
+		if !ok {
+			return
+		}
+		flush.Receivers = []string{peer.name}
+		flush.PeerSymbols = []model.SymbolRef{peer.symbol}
+		return
+	}
+	for _, name := range worker.sendTargets {
+		flush.Receivers = append(flush.Receivers, name)
+		flush.PeerSymbols = append(flush.PeerSymbols, a.workers[name].symbol)
+	}
+}
+
+func (a *workerAnalyzer) lookupPeer(worker *workerInfo, peerName *ast.BLangIdentifier) (*workerInfo, bool) {
+	peer, ok := a.workers[peerName.Value]
+	if !ok {
+		a.t.semanticError("undefined worker '"+peerName.Value+"'", peerName.GetPosition())
+		return nil, false
+	}
+	if peer == worker {
+		a.t.semanticError("worker '"+peerName.Value+"' cannot interact with itself", peerName.GetPosition())
+		return nil, false
+	}
+	return peer, true
+}
+
+func (w *workerInfo) nthReceiveFrom(sender string, n int) (*ast.BLangWorkerReceive, bool) {
+	for _, receive := range w.receives {
+		if receive.WorkerIdentifier.Value != sender {
+			continue
+		}
+		if n == 0 {
+			return receive, true
+		}
+		n--
+	}
+	return nil, false
+}
+
+// hasWorkerInteractions reports whether body declares workers or interacts with other workers, ignoring
+// nested anonymous functions.
+func hasWorkerInteractions(body *ast.BLangBlockFunctionBody) bool {
+	finder := &workerInteractionFinder{}
+	for _, stmt := range body.Stmts {
+		ast.Walk(finder, stmt.(ast.BLangNode))
+		if finder.found {
+			return true
+		}
+	}
+	return false
+}
+
+type workerInteractionFinder struct {
+	found bool
+}
+
+func (f *workerInteractionFinder) Visit(node ast.BLangNode) ast.Visitor {
+	if node == nil || f.found {
+		return nil
+	}
+	switch node.(type) {
+	case *ast.BLangLambdaFunction:
+		return nil
+	case *ast.BLangWorker, *ast.BLangFork, *ast.BLangWorkerAsyncSendExpr, *ast.BLangWorkerSyncSendExpr,
+		*ast.BLangWorkerReceive, *ast.BLangWorkerFlushExpr:
+		f.found = true
+		return nil
+	}
+	return f
+}
+
+func (f *workerInteractionFinder) VisitTypeData(_ *ast.TypeData) ast.Visitor {
+	return nil
+}
+
+// peerErrorType returns the error part of the return type of the given worker. A worker that returns an error
+// does so in place of any message it has not yet sent.
+func peerErrorType(t typeResolver, peer model.SymbolRef) semtypes.SemType {
+	fnSym, ok := t.getSymbol(peer).(model.FunctionSymbol)
+	if !ok {
+		return semtypes.NEVER
+	}
+	retTy := fnSym.Signature().ReturnType
+	if semtypes.IsZero(retTy) {
+		return semtypes.NEVER
+	}
+	return semtypes.Intersect(retTy, semtypes.ERROR)
+}
+
+func workerSendBase(send ast.WorkerSendExpressionNode) *ast.BLangWorkerSendExprBase {
+	switch send := send.(type) {
+	case *ast.BLangWorkerAsyncSendExpr:
+		return &send.BLangWorkerSendExprBase
+	case *ast.BLangWorkerSyncSendExpr:
+		return &send.BLangWorkerSendExprBase
+	default:
+		panic("unexpected worker send expression")
+	}
+}
@@ -260,6 +260,17 @@ func resolveXMLNameRef[T symbolResolver](resolver T, scope model.Scope, name str
 	return ref
 }
 
+// resolveXMLElementFilter checks that the prefix of a name pattern in an xml filter or step expression is declared.
+func resolveXMLElementFilter[T symbolResolver](resolver T, scope model.Scope, filter *ast.BLangXMLElementFilter) {
+	if filter.Prefix == "" || filter.Prefix == model.XMLNSReservedPrefix {
+		return
+	}
+	ensurePrefixMap(resolver, scope)
+	if _, _, ok := lookupXMLNS(scope, filter.Prefix); !ok {
+		semanticError(resolver, "undefined XML namespace prefix '"+filter.Prefix+"'", filter.GetPosition())
+	}
+}
+
 func stripInlineXMLNSAttrs[T symbolResolver](resolver T, childScope model.Scope, e *ast.BLangXMLElementLiteral) []ast.BLangXMLAttribute {
 	kept := make([]ast.BLangXMLAttribute, 0, len(e.Attrs))
 	for i := range e.Attrs {
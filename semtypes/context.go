@@ -165,7 +165,7 @@ func (c *context) listMemo() map[bddKey]*bddMemo {
 	return c._listMemo
 }
 
-func (c *context) FunctionAtomType(atom atom) *functionAtomicType {
+func (c *context) FunctionAtomType(atom atom) *FunctionAtomicType {
 	return c._env.functionAtomType(atom)
 }
 
@@ -59,7 +59,7 @@ func Diff(t1, t2 SemType) SemType {
 	if IsNever(t1) {
 		return t1
 	}
-	if some2 == 0 && all2 == ValueTypeMask {
+	if some2 == 0 && (all1|some1)&^all2 == 0 {
 		return NEVER
 	}
 	all := all1 & ^(all2 | some2)
@@ -84,6 +84,9 @@ func Diff(t1, t2 SemType) SemType {
 		} else {
 			data = ops[code.Code()].Diff(data1, data2)
 		}
+		if isBddNothing(data) {
+			continue
+		}
 		if allOrNothing, ok := data.(allOrNothingSubtype); !ok {
 			subtypes = append(subtypes, basicSubtypeFrom(code, data.(ProperSubtypeData)))
 		} else if allOrNothing.IsAllSubtype() {
@@ -97,6 +100,13 @@ func Diff(t1, t2 SemType) SemType {
 	return createComplexSemType(all, subtypes...)
 }
 
+// isBddNothing reports whether a bdd based subtype operation produced the empty
+// bdd, which must not be kept as a proper subtype.
+func isBddNothing(data SubtypeData) bool {
+	b, ok := data.(*bddAllOrNothing)
+	return ok && b.IsNothing()
+}
+
 func getComplexSubtypeData(t SemType, code BasicTypeCode) SubtypeData {
 	c := basicTypeBitSet(1 << code.Code())
 	if (t.all() & c) != 0 {
@@ -197,6 +207,9 @@ func Intersect(t1, t2 SemType) SemType {
 		} else {
 			data = ops[code.Code()].Intersect(data1, data2)
 		}
+		if isBddNothing(data) {
+			continue
+		}
 		if allOrNothing, ok := data.(allOrNothingSubtype); !ok || allOrNothing.IsAllSubtype() {
 			subtypes = append(subtypes, basicSubtypeFrom(code, data.(ProperSubtypeData)))
 		}
@@ -487,6 +500,31 @@ func ToObjectAtomicType(cx Context, t SemType) *MappingAtomicType {
 	return ToMappingAtomicType(cx, mappingTy)
 }
 
+// ObjectDisjunctAtomicTypes returns the positive atoms of each disjunct of the
+// object type t, such as one disjunct per class of a union of classes. It
+// returns nil if t is not a non-empty object type.
+func ObjectDisjunctAtomicTypes(cx Context, t SemType) [][]*MappingAtomicType {
+	mappingTy := convertObjectToMappingTy(cx, t)
+	if IsZero(mappingTy) {
+		return nil
+	}
+	if mappingTy.some() == 0 {
+		mappingAtomicInner := MAPPING_ATOMIC_INNER
+		return [][]*MappingAtomicType{{&mappingAtomicInner}}
+	}
+	var disjuncts [][]*MappingAtomicType
+	bdd := getComplexSubtypeData(mappingTy, BTMapping).(Bdd)
+	bddEvery(cx, bdd, conjunctionNil, conjunctionNil, func(cx Context, pos conjunctionHandle, _ conjunctionHandle) bool {
+		var atoms []*MappingAtomicType
+		for h := pos; h != conjunctionNil; h = cx.conjunctionNext(h) {
+			atoms = append(atoms, cx.MappingAtomType(cx.conjunctionAtom(h)))
+		}
+		disjuncts = append(disjuncts, atoms)
+		return true
+	})
+	return disjuncts
+}
+
 func ToMappingAtomicType(cx Context, t SemType) *MappingAtomicType {
 	mappingAtomicInner := MAPPING_ATOMIC_INNER
 	if t.some() == 0 {
@@ -501,16 +539,30 @@ func ToMappingAtomicType(cx Context, t SemType) *MappingAtomicType {
 	return bddMappingAtomicType(cx, getComplexSubtypeData(t, BTMapping).(Bdd))
 }
 
+// bddMappingAtomicType returns the atomic type of a mapping type that is a
+// single atom, or the intersection of atoms such as `readonly & R`.
 func bddMappingAtomicType(cx Context, bdd Bdd) *MappingAtomicType {
 	var result *MappingAtomicType
 	pathCount := 0
 	valid := bddEveryPositive(cx, bdd, conjunctionNil, conjunctionNil,
 		func(cx Context, pos conjunctionHandle, neg conjunctionHandle) bool {
 			pathCount++
-			if pathCount > 1 || neg != conjunctionNil || pos == conjunctionNil || cx.conjunctionNext(pos) != conjunctionNil {
+			if pathCount > 1 || neg != conjunctionNil || pos == conjunctionNil {
 				return false
 			}
-			result = cx.MappingAtomType(cx.conjunctionAtom(pos))
+			var atoms []*MappingAtomicType
+			for ; pos != conjunctionNil; pos = cx.conjunctionNext(pos) {
+				atom := cx.MappingAtomType(cx.conjunctionAtom(pos))
+				if atom == nil {
+					return false
+				}
+				atoms = append(atoms, atom)
+			}
+			if len(atoms) == 1 {
+				result = atoms[0]
+				return true
+			}
+			_, result, _ = intersectMappingAtoms(cx.Env(), atoms)
 			return result != nil
 		})
 	if !valid || pathCount != 1 {
@@ -550,9 +602,30 @@ func ToListAtomicType(cx Context, t SemType) *ListAtomicType {
 	if !IsSubtypeSimple(t, LIST) {
 		return nil
 	}
-	return bddListAtomicType(env,
-		getComplexSubtypeData(t, BTList).(Bdd),
-		listAtomicInner)
+	bdd := getComplexSubtypeData(t, BTList).(Bdd)
+	if result := bddListAtomicType(env, bdd, listAtomicInner); result != nil {
+		return result
+	}
+	return bddListAtomicTypeIntersection(cx, bdd)
+}
+
+// bddListAtomicTypeIntersection returns the atomic type of a list type that
+// is the intersection of atoms, such as `readonly & [int, decimal]`.
+func bddListAtomicTypeIntersection(cx Context, bdd Bdd) *ListAtomicType {
+	var paths []bddPath
+	bddPaths(bdd, &paths, bddPathFrom())
+	if len(paths) != 1 || len(paths[0].neg) != 0 || len(paths[0].pos) == 0 {
+		return nil
+	}
+	atoms := make([]*ListAtomicType, len(paths[0].pos))
+	for i, a := range paths[0].pos {
+		atoms[i] = cx.ListAtomType(a)
+	}
+	_, result, ok := intersectListAtoms(cx.Env(), atoms)
+	if !ok {
+		return nil
+	}
+	return &result
 }
 
 func bddListAtomicType(env Env, bdd Bdd, top ListAtomicType) *ListAtomicType {
@@ -541,3 +541,15 @@ func TestStringSubtypeSingleValue(t *testing.T) {
 	// extraction) is already tested by the assertions above.
 	_ = intersect3 // Suppress unused variable warning
 }
+
+// TestDiffValKeepsUndef tests that subtracting VAL from a type that has both
+// a proper subtype and UNDEF keeps the UNDEF part
+func TestDiffValKeepsUndef(t *testing.T) {
+	env := CreateTypeEnv()
+	cx := ContextFrom(env)
+	md := NewMappingDefinition()
+	mapOfInt := md.DefineMappingTypeWrapped(env, nil, INT)
+	ty := Union(Union(INT, mapOfInt), UNDEF)
+	assertFalse(t, IsEmpty(cx, Diff(ty, VAL)))
+	assertTrue(t, IsEmpty(cx, Diff(Union(INT, mapOfInt), VAL)))
+}
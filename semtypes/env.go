@@ -52,7 +52,7 @@ type env struct {
 	recMappingAtoms      []*MappingAtomicType
 	recMappingAtomsMutex sync.Mutex
 
-	recFunctionAtoms      []*functionAtomicType
+	recFunctionAtoms      []*FunctionAtomicType
 	recFunctionAtomsMutex sync.Mutex
 
 	// populatedRecAtoms counts the number of recursive atom slots  that have been filled
@@ -142,14 +142,14 @@ func (e *env) recFunctionAtom() recAtom {
 	return createRecAtom(result)
 }
 
-func (e *env) setRecFunctionAtomType(rec recAtom, atomicType *functionAtomicType) {
+func (e *env) setRecFunctionAtomType(rec recAtom, atomicType *FunctionAtomicType) {
 	e.recFunctionAtomsMutex.Lock()
 	defer e.recFunctionAtomsMutex.Unlock()
 	e.recFunctionAtoms[rec.index()] = atomicType
 	atomic.AddInt32(&e.populatedRecAtoms, 1)
 }
 
-func (e *env) getRecFunctionAtomType(rec recAtom) *functionAtomicType {
+func (e *env) getRecFunctionAtomType(rec recAtom) *FunctionAtomicType {
 	e.recFunctionAtomsMutex.Lock()
 	defer e.recFunctionAtomsMutex.Unlock()
 	return e.recFunctionAtoms[rec.index()]
@@ -163,7 +163,7 @@ func (e *env) mappingAtom(atomicType *MappingAtomicType) *typeAtom {
 	return e.typeAtom(atomicType)
 }
 
-func (e *env) functionAtom(atomicType *functionAtomicType) *typeAtom {
+func (e *env) functionAtom(atomicType *FunctionAtomicType) *typeAtom {
 	return e.typeAtom(atomicType)
 }
 
@@ -235,11 +235,11 @@ func (e *env) listAtomType(atom atom) *ListAtomicType {
 	return atom.(*typeAtom).AtomicType.(*ListAtomicType)
 }
 
-func (e *env) functionAtomType(atom atom) *functionAtomicType {
+func (e *env) functionAtomType(atom atom) *FunctionAtomicType {
 	if recAtom, ok := atom.(*recAtom); ok {
 		return e.getRecFunctionAtomType(*recAtom)
 	}
-	return atom.(*typeAtom).AtomicType.(*functionAtomicType)
+	return atom.(*typeAtom).AtomicType.(*FunctionAtomicType)
 }
 
 func (e *env) mappingAtomType(atom atom) *MappingAtomicType {
@@ -23,6 +23,8 @@ func ErrorDetailAtomicType(ctx Context, errorType SemType) (MappingAtomicType, b
 	if IsNever(errorType) || !IsSubtype(ctx, errorType, ERROR) {
 		return MappingAtomicType{}, false
 	}
+	// Type-ids do not constrain the detail record.
+	errorType = StripErrorDistinctAtoms(errorType)
 
 	if IsSameType(ctx, errorType, ERROR) {
 		return mappingAtomicTypeFrom(nil, nil, cellContaining(ctx.Env(), CreateCloneable(ctx))), true
@@ -54,7 +56,7 @@ func errorDetailBddWithoutDistinctAtoms(bdd Bdd) Bdd {
 	return stripDistinctAtomsFromBdd(bdd)
 }
 
-func stripErrorDistinctAtoms(ty SemType) SemType {
+func StripErrorDistinctAtoms(ty SemType) SemType {
 	return stripDistinctAtomsFromSemType(ty, BTError, stripDistinctAtomsFromBdd)
 }
 
@@ -87,8 +89,21 @@ func ErrorWithDetail(detail SemType) SemType {
 	return getBasicSubtype(BTError, sd.(ProperSubtypeData))
 }
 
-func errorDistinct(distinctId int) SemType {
+func ErrorDistinct(distinctId int) SemType {
 	common.Assert(distinctId >= 0)
 	bdd := bddAtom(new(createDistinctRecAtom(((-distinctId) - 1))))
 	return getBasicSubtype(BTError, bdd)
 }
+
+// ErrorDetailType returns the type of the detail mapping of errorType.
+// Type-ids do not constrain the detail, so they are ignored.
+func ErrorDetailType(errorType SemType) SemType {
+	sd := subtypeData(StripErrorDistinctAtoms(Intersect(errorType, ERROR)), BTError)
+	if allOrNothing, ok := sd.(allOrNothingSubtype); ok {
+		if allOrNothing.IsAllSubtype() {
+			return MAPPING_RO
+		}
+		return NEVER
+	}
+	return getBasicSubtype(BTMapping, sd.(ProperSubtypeData))
+}
@@ -16,27 +16,27 @@
 
 package semtypes
 
-type functionAtomicType struct {
+type FunctionAtomicType struct {
 	ParamType  SemType
 	RetType    SemType
 	Qualifiers SemType
 	IsGeneric  bool
 }
 
-var _ atomicType = &functionAtomicType{}
+var _ atomicType = &FunctionAtomicType{}
 
-func functionAtomicTypeFrom(paramType SemType, rest SemType, qualifiers SemType) functionAtomicType {
+func functionAtomicTypeFrom(paramType SemType, rest SemType, qualifiers SemType) FunctionAtomicType {
 
 	return newFunctionAtomicType(paramType, rest, qualifiers, false)
 }
 
-func functionAtomicTypeGenericFrom(paramType SemType, rest SemType, qualifiers SemType) functionAtomicType {
+func functionAtomicTypeGenericFrom(paramType SemType, rest SemType, qualifiers SemType) FunctionAtomicType {
 
 	return newFunctionAtomicType(paramType, rest, qualifiers, true)
 }
 
-func newFunctionAtomicType(paramType SemType, retType SemType, qualifiers SemType, isGeneric bool) functionAtomicType {
-	this := functionAtomicType{}
+func newFunctionAtomicType(paramType SemType, retType SemType, qualifiers SemType, isGeneric bool) FunctionAtomicType {
+	this := FunctionAtomicType{}
 	this.ParamType = paramType
 	this.RetType = retType
 	this.Qualifiers = qualifiers
@@ -44,6 +44,6 @@ func newFunctionAtomicType(paramType SemType, retType SemType, qualifiers SemTyp
 	return this
 }
 
-func (f *functionAtomicType) atomKind() kind {
+func (f *FunctionAtomicType) atomKind() kind {
 	return kind_FUNCTION_ATOM
 }
@@ -54,7 +54,7 @@ func (f *FunctionDefinition) DefineGeneric(env Env, args SemType, ret SemType, q
 	return f.defineInternal(env, atomicType)
 }
 
-func (f *FunctionDefinition) defineInternal(env Env, atomicType functionAtomicType) SemType {
+func (f *FunctionDefinition) defineInternal(env Env, atomicType FunctionAtomicType) SemType {
 	var a atom
 	rec := f.rec
 	if rec != nil {
@@ -145,6 +145,24 @@ func functionParamListTypeInner(cx Context, accumTy SemType, bdd Bdd) SemType {
 			functionParamListTypeInner(cx, accumTy, bn.right())))
 }
 
+// ToFunctionAtomicType returns the atomic type of a function type that consists of a
+// single atom, such as the type of a function definition, and nil otherwise.
+func ToFunctionAtomicType(cx Context, t SemType) *FunctionAtomicType {
+	if t.some() == 0 || !IsSubtypeSimple(t, FUNCTION) {
+		return nil
+	}
+	bn, ok := getComplexSubtypeData(t, BTFunction).(bddNode)
+	if !ok || !isBddAll(bn.left()) || !isBddNothing(bn.middle()) || !isBddNothing(bn.right()) {
+		return nil
+	}
+	return cx.FunctionAtomType(bn.atom())
+}
+
+func isBddAll(b Bdd) bool {
+	allOrNothing, ok := b.(*bddAllOrNothing)
+	return ok && allOrNothing.IsAll()
+}
+
 // Corresponds to apply^? in AMK tutorial.
 func FunctionReturnType(cx Context, fnTy SemType, argList SemType) SemType {
 	domain := FunctionParamListType(cx, fnTy)
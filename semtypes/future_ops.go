@@ -17,7 +17,7 @@
 package semtypes
 
 type futureOps struct {
-	CommonOps
+	commonOpsBase
 }
 
 var _ BasicTypeOps = &futureOps{}
@@ -27,6 +27,10 @@ func newFutureOps() futureOps {
 	return this
 }
 
+func (f *futureOps) complement(t SubtypeData) SubtypeData {
+	return bddComplement(t.(Bdd))
+}
+
 func (f *futureOps) IsEmpty(cx Context, t SubtypeData) bool {
 	return mappingSubtypeIsEmpty(cx, t)
 }
@@ -16,7 +16,8 @@
 
 package semtypes
 
-func futureContaining(env Env, constraint SemType) SemType {
+// FutureContaining returns future<constraint>.
+func FutureContaining(env Env, constraint SemType) SemType {
 	if sameSemType(VAL, constraint) {
 		return FUTURE
 	}
@@ -26,3 +27,16 @@ func futureContaining(env Env, constraint SemType) SemType {
 	bdd := subtypeData(mappingType, BTMapping).(Bdd)
 	return createBasicSemType(BTFuture, bdd)
 }
+
+// FutureConstraint extracts the constraint T from a future<T>.
+// Returns VAL when ft is the unconstrained future, nil if ft is not a future.
+func FutureConstraint(ctx Context, ft SemType) SemType {
+	if !IsSubtypeSimple(ft, FUTURE) {
+		return SemType{}
+	}
+	if ft.some() == 0 {
+		return VAL
+	}
+	bdd := subtypeData(ft, BTFuture)
+	return MappingMemberTypeInnerVal(ctx, createBasicSemType(BTMapping, bdd), STRING)
+}
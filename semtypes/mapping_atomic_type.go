@@ -49,6 +49,10 @@ func (m *MappingAtomicType) FieldInnerVal(name string) SemType {
 	return cellInnerVal(m.Rest)
 }
 
+func (m *MappingAtomicType) RestInnerVal() SemType {
+	return cellInnerVal(m.Rest)
+}
+
 func (m *MappingAtomicType) IsOptional(cx Context, name string) bool {
 	for i, n := range m.Names {
 		if n == name {
@@ -103,6 +103,9 @@ func (o *ObjectDefinition) Define(env Env, qualifiers ObjectQualifiers, members
 	}
 	var memberStream []CellField
 	for _, member := range members {
+		if qualifiers.readonly {
+			member.Immutable = true
+		}
 		memberStream = append(memberStream, memberField(env, &member, mut))
 	}
 	qualifierStream := []CellField{qualifiers.Field(env)}
@@ -81,6 +81,27 @@ func IsServiceObject(ctx Context, ty SemType) bool {
 	return objectHasNetworkQualifier(ctx, ty, networkQualifierServiceTag)
 }
 
+// ContainsClientObject reports whether some member of ty is a client object
+// type. Unlike a non-empty intersection with the client object type, this is
+// false for object types that merely do not exclude client objects, such as
+// non-client classes.
+func ContainsClientObject(ctx Context, ty SemType) bool {
+	objectTy := convertObjectToMappingTy(ctx, ty)
+	if IsZero(objectTy) {
+		return false
+	}
+	return mappingAtomsMatch(ctx, objectTy, matchAny, func(ctx Context, atom *MappingAtomicType) bool {
+		for i, name := range atom.Names {
+			if name != "$qualifiers" {
+				continue
+			}
+			networkTy := mappingMemberTypeInner(ctx, cellInner(atom.Types[i]), StringConst("network"))
+			return !IsZero(networkTy) && IsSubtype(ctx, networkTy, networkQualifierClientTag)
+		}
+		return false
+	})
+}
+
 func objectHasNetworkQualifier(ctx Context, ty SemType, qualifierTag SemType) bool {
 	objectTy := convertObjectToMappingTy(ctx, ty)
 	if IsZero(objectTy) {
@@ -118,7 +139,7 @@ func defaultQualifiers() ObjectQualifiers {
 // ObjectQualifiersFrom creates an ObjectQualifiers instance with the given parameters
 // Migrated from ObjectQualifiers.java:51
 func ObjectQualifiersFrom(isolated bool, readonly bool, networkQualifier NetworkQualifier) ObjectQualifiers {
-	if networkQualifier == NetworkQualifierNone && !isolated {
+	if networkQualifier == NetworkQualifierNone && !isolated && !readonly {
 		return defaultQualifiers()
 	}
 	return ObjectQualifiers{isolated: isolated, readonly: readonly, networkQualifier: networkQualifier}
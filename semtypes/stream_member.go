@@ -80,6 +80,20 @@ func CreateStreamImplementorType(cx Context, valueTy, completionTy SemType) SemT
 	return result
 }
 
+// CreateIteratorType returns the type of an iterator object whose next method
+// returns a record holding a member of valueTy for each member and then
+// completionTy.
+func CreateIteratorType(env Env, valueTy, completionTy SemType) SemType {
+	nextRecordDefn := NewMappingDefinition()
+	nextRecord := nextRecordDefn.DefineMappingTypeWrapped(env,
+		[]Field{FieldFrom("value", valueTy, false, false)}, NEVER)
+	nextFnTy := streamMethodFunctionType(env, Union(nextRecord, completionTy))
+	od := NewObjectDefinition()
+	return od.Define(env, ObjectQualifiersFrom(false, false, NetworkQualifierNone), []Member{
+		streamPublicIsolatedMethod("next", nextFnTy),
+	})
+}
+
 func streamMethodFunctionType(env Env, returnTy SemType) SemType {
 	paramListDefn := NewListDefinition()
 	paramList := paramListDefn.DefineListTypeWrapped(env, nil, 0, NEVER, CellMutability_CELL_MUT_NONE)
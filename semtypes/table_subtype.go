@@ -23,6 +23,88 @@ func newTableSubtype() tableSubtype {
 	return this
 }
 
+// TableContaining returns table<tableConstraint> without a key.
+func TableContaining(env Env, tableConstraint SemType) SemType {
+	return tableContainingDefault(env, tableConstraint)
+}
+
+// TableContainingKeyConstraint returns table<tableConstraint> key<keyConstraint>.
+func TableContainingKeyConstraint(cx Context, tableConstraint SemType, keyConstraint SemType) SemType {
+	return tableContainingKeyConstraint(cx, tableConstraint, keyConstraint)
+}
+
+// TableContainingKeySpecifier returns table<tableConstraint> key(fieldNames...).
+func TableContainingKeySpecifier(cx Context, tableConstraint SemType, fieldNames []string) SemType {
+	return tableContainingKeySpecifier(cx, tableConstraint, fieldNames)
+}
+
+// TableRowType returns R from table<R>, or nil if tableTy is not a subtype of TABLE.
+func TableRowType(cx Context, tableTy SemType) SemType {
+	if !IsSubtypeSimple(tableTy, TABLE) {
+		return SemType{}
+	}
+	if tableTy.some() == 0 {
+		return MAPPING
+	}
+	rowArray := ListMemberTypeInnerVal(cx, convertTableToListTy(cx, tableTy), IntConst(0))
+	return ListMemberTypeInnerVal(cx, rowArray, INT)
+}
+
+// TableKeyType returns the type of the key of tableTy. For a single field key
+// specifier this is the type of that field, for multiple fields it is a tuple.
+// Returns NEVER when tableTy has no key, nil if tableTy is not a subtype of TABLE.
+func TableKeyType(cx Context, tableTy SemType) SemType {
+	if !IsSubtypeSimple(tableTy, TABLE) {
+		return SemType{}
+	}
+	if tableTy.some() == 0 {
+		return NEVER
+	}
+	kc := ListMemberTypeInnerVal(cx, convertTableToListTy(cx, tableTy), IntConst(1))
+	if IsSameType(cx, kc, VAL) {
+		return NEVER
+	}
+	return kc
+}
+
+// TableKeyFieldNames returns the field names of the key specifier of tableTy,
+// or nil if tableTy does not have a (single) key specifier.
+func TableKeyFieldNames(cx Context, tableTy SemType) []string {
+	if !IsSubtypeSimple(tableTy, TABLE) || tableTy.some() == 0 {
+		return nil
+	}
+	ks := ListMemberTypeInnerVal(cx, convertTableToListTy(cx, tableTy), IntConst(2))
+	if IsSameType(cx, ks, VAL) {
+		return nil
+	}
+	lat := ToListAtomicType(cx, ks)
+	if lat == nil {
+		return nil
+	}
+	names := make([]string, lat.Members.FixedLength)
+	for i := range names {
+		shape := SingleShape(listMemberAtInnerVal(lat.Members, lat.rest, i))
+		if shape.IsEmpty() {
+			return nil
+		}
+		name, ok := shape.Get().Value.(string)
+		if !ok {
+			return nil
+		}
+		names[i] = name
+	}
+	return names
+}
+
+func convertTableToListTy(cx Context, ty SemType) SemType {
+	tableTy := Intersect(ty, TABLE)
+	if IsEmpty(cx, tableTy) {
+		return SemType{}
+	}
+	bdd := subtypeData(tableTy, BTTable)
+	return createBasicSemType(BTList, bdd)
+}
+
 func tableContainingKeyConstraint(cx Context, tableConstraint SemType, keyConstraint SemType) SemType {
 	var normalizedKc SemType
 	lat := ToListAtomicType(cx, keyConstraint)
@@ -23,11 +23,18 @@ import (
 
 type toStringState struct {
 	cx      Context
-	visited map[atomKey]bool
+	visited map[visitedAtom]bool
+}
+
+// visitedAtom identifies an atom being printed. Atom indices are only unique
+// within the atom table of a kind, so the kind is part of the key.
+type visitedAtom struct {
+	kind kind
+	key  atomKey
 }
 
 func newToStringState(cx Context) *toStringState {
-	return &toStringState{cx: cx, visited: make(map[atomKey]bool)}
+	return &toStringState{cx: cx, visited: make(map[visitedAtom]bool)}
 }
 
 func ToString(cx Context, ty SemType) string {
@@ -135,6 +142,10 @@ func (s *toStringState) subtypeToString(sub basicSubtype) string {
 			return s.bddObjectToString(st)
 		case BTTypeDesc:
 			return s.bddTypedescToString(st)
+		case BTFuture:
+			return s.bddFutureToString(st)
+		case BTTable:
+			return s.bddTableToString(st)
 		default:
 			name := strings.TrimPrefix(sub.BasicTypeCode.String(), "BT_")
 			return strings.ToLower(name)
@@ -176,7 +187,7 @@ func (s *toStringState) listAtomToString(atom atom) string {
 	if recAtom, ok := atom.(*recAtom); ok && recAtom.index() == BDD_REC_ATOM_READONLY {
 		return "readonly"
 	}
-	key := atom.canonicalKey()
+	key := visitedAtom{kind: kind_LIST_ATOM, key: atom.canonicalKey()}
 	if s.visited[key] {
 		return "..."
 	}
@@ -228,7 +239,7 @@ func (s *toStringState) bddFunctionToString(bdd Bdd) string {
 }
 
 func (s *toStringState) functionAtomToString(atom atom) string {
-	key := atom.canonicalKey()
+	key := visitedAtom{kind: kind_FUNCTION_ATOM, key: atom.canonicalKey()}
 	if s.visited[key] {
 		return "..."
 	}
@@ -286,6 +297,33 @@ func (s *toStringState) bddTypedescToString(bdd Bdd) string {
 	return "typedesc<" + s.semTypeToString(constraint) + ">"
 }
 
+func (s *toStringState) bddFutureToString(bdd Bdd) string {
+	mappingTy := createBasicSemType(BTMapping, bdd)
+	constraint := MappingMemberTypeInnerVal(s.cx, mappingTy, STRING)
+	if IsSameType(s.cx, constraint, VAL) {
+		return "future"
+	}
+	return "future<" + s.semTypeToString(constraint) + ">"
+}
+
+func (s *toStringState) bddTableToString(bdd Bdd) string {
+	tableTy := createBasicSemType(BTTable, bdd)
+	rowTy := TableRowType(s.cx, tableTy)
+	// Tables whose rows cover every readonly anydata mapping, such as the
+	// tables of anydata and cloneable, have no readable row type.
+	if IsSubtype(s.cx, Intersect(CreateAnydata(s.cx), Intersect(VAL_READONLY, MAPPING)), rowTy) {
+		return "table"
+	}
+	result := "table<" + s.semTypeToString(rowTy) + ">"
+	if names := TableKeyFieldNames(s.cx, tableTy); len(names) > 0 {
+		return result + " key(" + strings.Join(names, ", ") + ")"
+	}
+	if keyTy := TableKeyType(s.cx, tableTy); !IsNever(keyTy) {
+		return result + " key<" + s.semTypeToString(keyTy) + ">"
+	}
+	return result
+}
+
 func (s *toStringState) bddMappingToString(bdd Bdd) string {
 	var formulas []string
 	bddEvery(s.cx, bdd, conjunctionNil, conjunctionNil, func(cx Context, pos conjunctionHandle, neg conjunctionHandle) bool {
@@ -311,10 +349,16 @@ func (s *toStringState) bddMappingToString(bdd Bdd) string {
 }
 
 func (s *toStringState) mappingAtomToString(atom atom) string {
-	if recAtom, ok := atom.(*recAtom); ok && recAtom.index() == BDD_REC_ATOM_READONLY {
-		return "readonly"
+	if recAtom, ok := atom.(*recAtom); ok {
+		if recAtom.index() < 0 {
+			// Type-id of a distinct error
+			return "distinct"
+		}
+		if recAtom.index() == BDD_REC_ATOM_READONLY {
+			return "readonly"
+		}
 	}
-	key := atom.canonicalKey()
+	key := visitedAtom{kind: kind_MAPPING_ATOM, key: atom.canonicalKey()}
 	if s.visited[key] {
 		return "..."
 	}
@@ -367,7 +411,7 @@ func (s *toStringState) objectAtomToString(atom atom) string {
 			return "readonly"
 		}
 	}
-	key := atom.canonicalKey()
+	key := visitedAtom{kind: kind_MAPPING_ATOM, key: atom.canonicalKey()}
 	if s.visited[key] {
 		return "..."
 	}
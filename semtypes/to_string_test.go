@@ -753,3 +753,36 @@ func TestXMLNeverSequence(t *testing.T) {
 		t.Errorf("got %q expected %q", actual, expected)
 	}
 }
+
+func TestTableWithoutKey(t *testing.T) {
+	env := CreateTypeEnv()
+	cx := ContextFrom(env)
+	md := NewMappingDefinition()
+	row := md.DefineMappingTypeWrapped(env, []Field{{Name: "id", Ty: INT}}, NEVER)
+	ty := TableContaining(env, row)
+	actual := ToString(cx, ty)
+	expected := "table<{| id: int, never... |}>"
+	if actual != expected {
+		t.Errorf("got %q expected %q", actual, expected)
+	}
+}
+
+func TestTableKeySpecifier(t *testing.T) {
+	env := CreateTypeEnv()
+	cx := ContextFrom(env)
+	md := NewMappingDefinition()
+	fields := []Field{
+		{Name: "id", Ty: INT, Ro: true},
+		{Name: "name", Ty: STRING},
+	}
+	row := md.DefineMappingTypeWrapped(env, fields, NEVER)
+	ty := TableContainingKeySpecifier(cx, row, []string{"id"})
+	actual := ToString(cx, ty)
+	expected := "table<{| id: int, name: string, never... |}> key(id)"
+	if actual != expected {
+		t.Errorf("got %q expected %q", actual, expected)
+	}
+	if keyTy := TableKeyType(cx, ty); !IsSameType(cx, keyTy, INT) {
+		t.Errorf("got key type %s expected int", ToString(cx, keyTy))
+	}
+}
@@ -71,7 +71,7 @@ func (pool *TypePool) PutObjectDefinition(ty SemType) TypePoolIndex {
 }
 
 func (pool *TypePool) PutErrorDefinition(ty SemType) TypePoolIndex {
-	return pool.Put(stripErrorDistinctAtoms(ty))
+	return pool.Put(StripErrorDistinctAtoms(ty))
 }
 
 func fromTypePool(pool *TypePool, env Env) binaryPool {
@@ -126,6 +126,12 @@ func fromTypePool(pool *TypePool, env Env) binaryPool {
 				case BTStream:
 					entry = subtypeDataEntry{kind: streamBddSubtypeData, index: uint32(len(bp.streamBdds))}
 					bp.streamBdds = append(bp.streamBdds, sc.serializeListBdd(data))
+				case BTFuture:
+					entry = subtypeDataEntry{kind: futureBddSubtypeData, index: uint32(len(bp.futureBdds))}
+					bp.futureBdds = append(bp.futureBdds, sc.serializeMappingBdd(data))
+				case BTTypeDesc:
+					entry = subtypeDataEntry{kind: typedescBddSubtypeData, index: uint32(len(bp.typedescBdds))}
+					bp.typedescBdds = append(bp.typedescBdds, sc.serializeMappingBdd(data))
 				default:
 					panic(fmt.Sprintf("unsupported BDD basic type code: %v", bs.BasicTypeCode))
 				}
@@ -154,6 +160,8 @@ func fromTypePool(pool *TypePool, env Env) binaryPool {
 	bp.nTableBdds = uint32(len(bp.tableBdds))
 	bp.nObjectBdds = uint32(len(bp.objectBdds))
 	bp.nStreamBdds = uint32(len(bp.streamBdds))
+	bp.nFutureBdds = uint32(len(bp.futureBdds))
+	bp.nTypedescBdds = uint32(len(bp.typedescBdds))
 	bp.nXmlAtomicTypes = uint32(len(bp.xmlAtomicTypes))
 	bp.nXmlSubtypes = uint32(len(bp.xmlSubtypes))
 	bp.nListAtomicTypes = uint32(len(bp.listAtomicTypes))
@@ -208,6 +216,8 @@ func MarshalTypePool(pool *TypePool, env Env) []byte {
 	write(buf, bp.nTableBdds)
 	write(buf, bp.nObjectBdds)
 	write(buf, bp.nStreamBdds)
+	write(buf, bp.nFutureBdds)
+	write(buf, bp.nTypedescBdds)
 	for _, entry := range bp.listBdds {
 		marshalBddDnf(buf, entry)
 	}
@@ -229,6 +239,12 @@ func MarshalTypePool(pool *TypePool, env Env) []byte {
 	for _, entry := range bp.streamBdds {
 		marshalBddDnf(buf, entry)
 	}
+	for _, entry := range bp.futureBdds {
+		marshalBddDnf(buf, entry)
+	}
+	for _, entry := range bp.typedescBdds {
+		marshalBddDnf(buf, entry)
+	}
 
 	write(buf, bp.nListAtomicTypes)
 	write(buf, bp.nMappingAtomicTypes)
@@ -297,6 +313,8 @@ func UnmarshalTypePool(data []byte, env Env) *TypePool {
 	read(r, &bp.nTableBdds)
 	read(r, &bp.nObjectBdds)
 	read(r, &bp.nStreamBdds)
+	read(r, &bp.nFutureBdds)
+	read(r, &bp.nTypedescBdds)
 	bp.listBdds = make([]unionOfIntersections, bp.nListBdds)
 	for i := range bp.listBdds {
 		bp.listBdds[i] = unmarshalBddDnf(r)
@@ -325,6 +343,14 @@ func UnmarshalTypePool(data []byte, env Env) *TypePool {
 	for i := range bp.streamBdds {
 		bp.streamBdds[i] = unmarshalBddDnf(r)
 	}
+	bp.futureBdds = make([]unionOfIntersections, bp.nFutureBdds)
+	for i := range bp.futureBdds {
+		bp.futureBdds[i] = unmarshalBddDnf(r)
+	}
+	bp.typedescBdds = make([]unionOfIntersections, bp.nTypedescBdds)
+	for i := range bp.typedescBdds {
+		bp.typedescBdds[i] = unmarshalBddDnf(r)
+	}
 
 	read(r, &bp.nListAtomicTypes)
 	read(r, &bp.nMappingAtomicTypes)
@@ -380,6 +406,8 @@ type binaryPool struct {
 	nTableBdds    uint32
 	nObjectBdds   uint32
 	nStreamBdds   uint32
+	nFutureBdds   uint32
+	nTypedescBdds uint32
 	listBdds      []unionOfIntersections
 	mappingBdds   []unionOfIntersections
 	functionBdds  []unionOfIntersections
@@ -387,6 +415,8 @@ type binaryPool struct {
 	tableBdds     []unionOfIntersections
 	objectBdds    []unionOfIntersections
 	streamBdds    []unionOfIntersections
+	futureBdds    []unionOfIntersections
+	typedescBdds  []unionOfIntersections
 
 	nListAtomicTypes     uint32
 	nMappingAtomicTypes  uint32
@@ -454,6 +484,8 @@ const (
 	xmlSubtypeData
 	objectBddSubtypeData
 	streamBddSubtypeData
+	futureBddSubtypeData
+	typedescBddSubtypeData
 )
 
 func marshalSubtypeData(buf *bytes.Buffer, entries []subtypeDataEntry) {
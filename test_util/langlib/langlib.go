@@ -128,6 +128,30 @@ var migratedLangLibs = []bundledLib{
 		balPath:    "ballerina/lang.map/0.0.1/any/lang.map.bal",
 		version:    "0.0.1",
 	},
+	{
+		org:        "ballerina",
+		nameComps:  []string{"lang", "regexp"},
+		implicitID: "lang.regexp",
+		srcFS:      langlibs.FS,
+		balPath:    "ballerina/lang.regexp/0.0.1/any/lang.regexp.bal",
+		version:    "0.0.1",
+	},
+	{
+		org:        "ballerina",
+		nameComps:  []string{"lang", "table"},
+		implicitID: "lang.table",
+		srcFS:      langlibs.FS,
+		balPath:    "ballerina/lang.table/0.0.1/any/lang.table.bal",
+		version:    "0.0.1",
+	},
+	{
+		org:        "ballerina",
+		nameComps:  []string{"lang", "object"},
+		implicitID: "lang.object",
+		srcFS:      langlibs.FS,
+		balPath:    "ballerina/lang.object/0.0.1/any/lang.object.bal",
+		version:    "0.0.1",
+	},
 	{
 		org:       "ballerina",
 		nameComps: []string{"lang", "runtime"},
@@ -135,6 +159,13 @@ var migratedLangLibs = []bundledLib{
 		balPath:   "ballerina/lang.runtime/0.0.1/any/lang.runtime.bal",
 		version:   "0.0.1",
 	},
+	{
+		org:       "ballerina",
+		nameComps: []string{"lang", "transaction"},
+		srcFS:     langlibs.FS,
+		balPath:   "ballerina/lang.transaction/0.0.1/any/lang.transaction.bal",
+		version:   "0.0.1",
+	},
 }
 
 var bundledStdlibs = []bundledLib{
@@ -165,7 +196,7 @@ func Build(cx *context.CompilerContext, publicSymbols map[semantics.PackageIdent
 
 	cache := make(map[string]model.ExportedSymbolSpace)
 	for _, lib := range migratedLangLibs {
-		space, err := compileBundledLib(cx, cache, lib)
+		space, err := compileBundledLib(cx, cache, semantics.GetImplicitImports(cx), lib)
 		if err != nil {
 			return nil, err
 		}
@@ -178,7 +209,7 @@ func Build(cx *context.CompilerContext, publicSymbols map[semantics.PackageIdent
 		}] = space
 	}
 	for _, lib := range bundledStdlibs {
-		space, err := compileBundledLib(cx, cache, lib)
+		space, err := compileBundledLib(cx, cache, implicitImports, lib)
 		if err != nil {
 			return nil, err
 		}
@@ -214,10 +245,10 @@ func SeedPublicSymbols(cx *context.CompilerContext, publicSymbols map[semantics.
 	return symbols.PublicSymbols, nil
 }
 
-// compileBundledLib compiles a single bundled library's source into cx and
-// returns its exported symbol space, reusing a previous compilation in the same
-// build if present.
-func compileBundledLib(cx *context.CompilerContext, cache map[string]model.ExportedSymbolSpace, lib bundledLib) (model.ExportedSymbolSpace, error) {
+// compileBundledLib compiles a single bundled library's source into cx against
+// the given implicit imports and returns its exported symbol space, reusing a
+// previous compilation in the same build if present.
+func compileBundledLib(cx *context.CompilerContext, cache map[string]model.ExportedSymbolSpace, implicitImports map[string]model.ExportedSymbolSpace, lib bundledLib) (model.ExportedSymbolSpace, error) {
 	if cached, ok := cache[lib.balPath]; ok {
 		return cached, nil
 	}
@@ -243,9 +274,10 @@ func compileBundledLib(cx *context.CompilerContext, cache map[string]model.Expor
 	cu.SetPackageID(pkgID)
 	compilationUnits := []*ast.BLangCompilationUnit{cu}
 
-	// lang libraries do not themselves import migrated libs, so the
-	// still-intrinsic implicit imports are sufficient here.
-	importedByCU := semantics.ResolveCompilationUnitImports(cx, compilationUnits, semantics.GetImplicitImports(cx),
+	// lang libraries do not themselves import migrated libs, so they are
+	// compiled against the still-intrinsic implicit imports; bundled stdlibs
+	// may use migrated langlibs (e.g. object:RawTemplate in ballerina/io).
+	importedByCU := semantics.ResolveCompilationUnitImports(cx, compilationUnits, implicitImports,
 		make(map[semantics.PackageIdentifier]model.ExportedSymbolSpace), lib.org)
 	pkgScope, exported := semantics.ResolveSymbols(cx, *pkgID, importedByCU)
 	pkg := ast.ToPackageFromCompilationUnits(compilationUnits)
@@ -0,0 +1,312 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package values
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"ballerina-lang-go/decimal"
+	"ballerina-lang-go/semtypes"
+)
+
+// ToBalString returns the `lang.value:toBalString` representation of v: a
+// string that looks like a Ballerina expression evaluating to v. Cyclic
+// references are rendered as "...".
+func ToBalString(v BalValue) string {
+	var b strings.Builder
+	writeBalString(&b, v, make(map[uintptr]bool))
+	return b.String()
+}
+
+func writeBalString(b *strings.Builder, v BalValue, visited map[uintptr]bool) {
+	switch t := v.(type) {
+	case nil:
+		b.WriteString("()")
+	case string:
+		b.WriteString(strconv.Quote(t))
+	case int64:
+		b.WriteString(strconv.FormatInt(t, 10))
+	case float64:
+		b.WriteString(balStringFloat(t))
+	case bool:
+		b.WriteString(strconv.FormatBool(t))
+	case *decimal.Decimal:
+		b.WriteString(t.FormatBallerina())
+		b.WriteByte('d')
+	case *List:
+		if !enterBalString(b, unsafe.Pointer(t), visited) {
+			return
+		}
+		defer delete(visited, uintptr(unsafe.Pointer(t)))
+		b.WriteByte('[')
+		for i := 0; i < t.Len(); i++ {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			writeBalString(b, t.Get(i), visited)
+		}
+		b.WriteByte(']')
+	case *Map:
+		if !enterBalString(b, unsafe.Pointer(t), visited) {
+			return
+		}
+		defer delete(visited, uintptr(unsafe.Pointer(t)))
+		writeBalStringMap(b, t, visited)
+	case *Table:
+		if !enterBalString(b, unsafe.Pointer(t), visited) {
+			return
+		}
+		defer delete(visited, uintptr(unsafe.Pointer(t)))
+		b.WriteString("table key(")
+		b.WriteString(strings.Join(t.KeyFields, ","))
+		b.WriteString(") [")
+		for e := t.head; e != nil; e = e.next {
+			if e != t.head {
+				b.WriteByte(',')
+			}
+			writeBalString(b, e.row, visited)
+		}
+		b.WriteByte(']')
+	case *Error:
+		if !enterBalString(b, unsafe.Pointer(t), visited) {
+			return
+		}
+		defer delete(visited, uintptr(unsafe.Pointer(t)))
+		if t.TypeName != "" {
+			b.WriteString("error ")
+			b.WriteString(t.TypeName)
+			b.WriteString(" (")
+		} else {
+			b.WriteString("error(")
+		}
+		b.WriteString(strconv.Quote(t.Message))
+		if t.Cause != nil {
+			b.WriteByte(',')
+			writeBalString(b, t.Cause, visited)
+		}
+		if t.Detail != nil {
+			for e := t.Detail.head; e != nil; e = e.next {
+				b.WriteByte(',')
+				b.WriteString(e.key)
+				b.WriteByte('=')
+				writeBalString(b, e.value, visited)
+			}
+		}
+		b.WriteByte(')')
+	case XMLValue:
+		b.WriteString("xml`")
+		b.WriteString(t.XMLString())
+		b.WriteByte('`')
+	case *RegExp:
+		b.WriteString("re`")
+		b.WriteString(t.Source)
+		b.WriteByte('`')
+	default:
+		b.WriteString(toString(v, visited, true))
+	}
+}
+
+func writeBalStringMap(b *strings.Builder, m *Map, visited map[uintptr]bool) {
+	b.WriteByte('{')
+	for e := m.head; e != nil; e = e.next {
+		if e != m.head {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.Quote(e.key))
+		b.WriteByte(':')
+		writeBalString(b, e.value, visited)
+	}
+	b.WriteByte('}')
+}
+
+// enterBalString marks ptr as being rendered. It writes "..." and returns
+// false if ptr is already being rendered further up.
+func enterBalString(b *strings.Builder, ptr unsafe.Pointer, visited map[uintptr]bool) bool {
+	if visited[uintptr(ptr)] {
+		b.WriteString("...")
+		return false
+	}
+	visited[uintptr(ptr)] = true
+	return true
+}
+
+func balStringFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "float:NaN"
+	case math.IsInf(f, 1):
+		return "float:Infinity"
+	case math.IsInf(f, -1):
+		return "-float:Infinity"
+	}
+	return FormatFloat(f)
+}
+
+// FromBalString parses s as the `lang.value:toBalString` representation of an
+// anydata value, as `lang.value:fromBalString` does. Lists and mappings are
+// created with the types listTy and mapTy. Only simple values, lists and
+// mappings are supported; an error value is returned for anything else.
+func FromBalString(tc semtypes.Context, s string, listTy, mapTy semtypes.SemType) BalValue {
+	p := &balStringParser{tc: tc, s: s, listTy: listTy, mapTy: mapTy}
+	v, ok := p.value()
+	if p.skipSpace(); ok && p.pos == len(p.s) {
+		return v
+	}
+	return NewErrorWithMessage(fmt.Sprintf("cannot parse '%s' as a Ballerina value: unexpected input at index %d", s, p.pos))
+}
+
+type balStringParser struct {
+	tc            semtypes.Context
+	s             string
+	pos           int
+	listTy, mapTy semtypes.SemType
+}
+
+func (p *balStringParser) value() (BalValue, bool) {
+	p.skipSpace()
+	switch {
+	case p.consume("()"):
+		return nil, true
+	case p.consume("true"):
+		return true, true
+	case p.consume("false"):
+		return false, true
+	case p.consume("float:NaN"):
+		return math.NaN(), true
+	case p.consume("float:Infinity"):
+		return math.Inf(1), true
+	case p.consume("-float:Infinity"):
+		return math.Inf(-1), true
+	case p.peek() == '"':
+		return p.quoted()
+	case p.consume("["):
+		return p.list()
+	case p.consume("{"):
+		return p.mapping()
+	default:
+		return p.number()
+	}
+}
+
+func (p *balStringParser) list() (BalValue, bool) {
+	var items []BalValue
+	for p.skipSpace(); !p.consume("]"); {
+		if len(items) > 0 && !p.consume(",") {
+			return nil, false
+		}
+		item, ok := p.value()
+		if !ok {
+			return nil, false
+		}
+		items = append(items, item)
+		p.skipSpace()
+	}
+	filler, _ := FillerFactoryFor(p.tc, semtypes.ListProj(p.tc, p.listTy, semtypes.INT))
+	return NewList(p.listTy, semtypes.ToListAtomicType(p.tc, p.listTy), false, filler, 0, items), true
+}
+
+func (p *balStringParser) mapping() (BalValue, bool) {
+	var entries []MapEntry
+	for p.skipSpace(); !p.consume("}"); {
+		if len(entries) > 0 && !p.consume(",") {
+			return nil, false
+		}
+		p.skipSpace()
+		key, ok := p.quoted()
+		if !ok {
+			return nil, false
+		}
+		if p.skipSpace(); !p.consume(":") {
+			return nil, false
+		}
+		value, ok := p.value()
+		if !ok {
+			return nil, false
+		}
+		entries = append(entries, MapEntry{Key: key.(string), Value: value})
+		p.skipSpace()
+	}
+	return NewMap(p.mapTy, semtypes.ToMappingAtomicType(p.tc, p.mapTy), false, entries), true
+}
+
+func (p *balStringParser) quoted() (BalValue, bool) {
+	if p.peek() != '"' {
+		return nil, false
+	}
+	end := p.pos + 1
+	for end < len(p.s) && p.s[end] != '"' {
+		if p.s[end] == '\\' {
+			end++
+		}
+		end++
+	}
+	if end >= len(p.s) {
+		return nil, false
+	}
+	str, err := strconv.Unquote(p.s[p.pos : end+1])
+	if err != nil {
+		return nil, false
+	}
+	p.pos = end + 1
+	return str, true
+}
+
+func (p *balStringParser) number() (BalValue, bool) {
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte("+-.0123456789eE", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+	lit := p.s[start:p.pos]
+	if lit == "" {
+		return nil, false
+	}
+	if p.consume("d") || p.consume("D") {
+		d, err := decimal.FromLiteral(lit)
+		return d, err == nil
+	}
+	if p.consume("f") || p.consume("F") || strings.ContainsAny(lit, ".eE") {
+		f, err := strconv.ParseFloat(lit, 64)
+		return f, err == nil
+	}
+	n, err := strconv.ParseInt(lit, 10, 64)
+	return n, err == nil
+}
+
+func (p *balStringParser) peek() byte {
+	if p.pos < len(p.s) {
+		return p.s[p.pos]
+	}
+	return 0
+}
+
+func (p *balStringParser) consume(token string) bool {
+	if strings.HasPrefix(p.s[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+func (p *balStringParser) skipSpace() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package values
+
+import (
+	"unsafe"
+
+	"ballerina-lang-go/semtypes"
+)
+
+// Clone returns a deep copy of v, as `lang.value:clone` does. The copy has
+// the same inherent types as v; readonly members are shared rather than
+// copied. Shared and cyclic references in v are preserved in the copy.
+func Clone(tc semtypes.Context, v BalValue) BalValue {
+	return clone(tc, v, make(map[unsafe.Pointer]BalValue))
+}
+
+func clone(tc semtypes.Context, v BalValue, cloned map[unsafe.Pointer]BalValue) BalValue {
+	switch t := v.(type) {
+	case *List:
+		if t.isReadonly {
+			return t
+		}
+		if c, ok := cloned[unsafe.Pointer(t)]; ok {
+			return c
+		}
+		c := &List{Type: t.Type, atomic: t.atomic, filler: t.filler}
+		cloned[unsafe.Pointer(t)] = c
+		c.elems = make([]BalValue, len(t.elems))
+		for i, elem := range t.elems {
+			c.elems[i] = clone(tc, elem, cloned)
+		}
+		return c
+	case *Map:
+		if t.isReadonly {
+			return t
+		}
+		if c, ok := cloned[unsafe.Pointer(t)]; ok {
+			return c
+		}
+		c := NewMap(t.Type, t.atomic, false, nil)
+		cloned[unsafe.Pointer(t)] = c
+		for e := t.head; e != nil; e = e.next {
+			c.putUnchecked(e.key, clone(tc, e.value, cloned))
+		}
+		return c
+	case *Table:
+		if t.isReadonly {
+			return t
+		}
+		if c, ok := cloned[unsafe.Pointer(t)]; ok {
+			return c
+		}
+		c := NewTable(t.Type, t.RowType, t.KeyFields, false, nil)
+		cloned[unsafe.Pointer(t)] = c
+		for e := t.head; e != nil; e = e.next {
+			c.addUnchecked(clone(tc, e.row, cloned).(*Map))
+		}
+		return c
+	case XMLValue:
+		return cloneXML(tc, t, false, cloned)
+	default:
+		return v
+	}
+}
+
+// CloneReadOnly returns a deep copy of v that is readonly, as
+// `lang.value:cloneReadOnly` does. Values that are already readonly are
+// returned as is. Shared and cyclic references in v are preserved in the copy.
+func CloneReadOnly(tc semtypes.Context, v BalValue) BalValue {
+	return cloneReadOnly(tc, v, make(map[unsafe.Pointer]BalValue))
+}
+
+func cloneReadOnly(tc semtypes.Context, v BalValue, cloned map[unsafe.Pointer]BalValue) BalValue {
+	switch t := v.(type) {
+	case *List:
+		if t.isReadonly {
+			return t
+		}
+		if c, ok := cloned[unsafe.Pointer(t)]; ok {
+			return c
+		}
+		ty := semtypes.Intersect(t.Type, semtypes.VAL_READONLY)
+		c := &List{Type: ty, atomic: readonlyListAtomic(tc, ty, t.atomic), isReadonly: true}
+		cloned[unsafe.Pointer(t)] = c
+		c.elems = make([]BalValue, len(t.elems))
+		for i, elem := range t.elems {
+			c.elems[i] = cloneReadOnly(tc, elem, cloned)
+		}
+		return c
+	case *Map:
+		if t.isReadonly {
+			return t
+		}
+		if c, ok := cloned[unsafe.Pointer(t)]; ok {
+			return c
+		}
+		ty := semtypes.Intersect(t.Type, semtypes.VAL_READONLY)
+		c := NewMap(ty, readonlyMappingAtomic(tc, ty, t.atomic), true, nil)
+		cloned[unsafe.Pointer(t)] = c
+		for e := t.head; e != nil; e = e.next {
+			c.putUnchecked(e.key, cloneReadOnly(tc, e.value, cloned))
+		}
+		return c
+	case *Table:
+		if t.isReadonly {
+			return t
+		}
+		if c, ok := cloned[unsafe.Pointer(t)]; ok {
+			return c
+		}
+		ty := semtypes.Intersect(t.Type, semtypes.VAL_READONLY)
+		rowTy := semtypes.Intersect(t.RowType, semtypes.VAL_READONLY)
+		c := NewTable(ty, rowTy, t.KeyFields, true, nil)
+		cloned[unsafe.Pointer(t)] = c
+		for e := t.head; e != nil; e = e.next {
+			c.addUnchecked(cloneReadOnly(tc, e.row, cloned).(*Map))
+		}
+		return c
+	case XMLValue:
+		return cloneXML(tc, t, true, cloned)
+	default:
+		// Simple values, errors, functions, typedescs and readonly objects
+		// are immutable.
+		return v
+	}
+}
+
+// cloneXML copies the mutable parts of x; the copy is readonly when readonly
+// is set.
+func cloneXML(tc semtypes.Context, x XMLValue, readonly bool, cloned map[unsafe.Pointer]BalValue) XMLValue {
+	if x.Readonly() {
+		return x
+	}
+	cloneMap := func(m *Map) *Map {
+		if readonly {
+			return cloneReadOnly(tc, m, cloned).(*Map)
+		}
+		return clone(tc, m, cloned).(*Map)
+	}
+	switch t := x.(type) {
+	case *XMLElement:
+		var attrs, namespaces *Map
+		if t.Attributes != nil {
+			attrs = cloneMap(t.Attributes)
+		}
+		if t.Namespaces != nil {
+			namespaces = cloneMap(t.Namespaces)
+		}
+		var children XMLValue
+		if t.Children != nil {
+			children = cloneXML(tc, t.Children, readonly, cloned)
+		}
+		return NewXMLElement(t.Name, attrs, namespaces, children, readonly)
+	case *XMLSequence:
+		children := make([]XMLValue, len(t.Children))
+		for i, child := range t.Children {
+			children[i] = cloneXML(tc, child, readonly, cloned)
+		}
+		return NewXMLConcatSequence(children...)
+	case *XMLProcessingInstruction:
+		return NewXMLProcessingInstruction(t.Target, t.Data, readonly)
+	case *XMLComment:
+		return NewXMLComment(t.Body, readonly)
+	default:
+		return x
+	}
+}
+
+func readonlyListAtomic(tc semtypes.Context, ty semtypes.SemType, fallback *semtypes.ListAtomicType) *semtypes.ListAtomicType {
+	if atomic := semtypes.ToListAtomicType(tc, ty); atomic != nil {
+		return atomic
+	}
+	return fallback
+}
+
+func readonlyMappingAtomic(tc semtypes.Context, ty semtypes.SemType, fallback *semtypes.MappingAtomicType) *semtypes.MappingAtomicType {
+	if atomic := semtypes.ToMappingAtomicType(tc, ty); atomic != nil {
+		return atomic
+	}
+	return fallback
+}
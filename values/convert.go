@@ -0,0 +1,232 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package values
+
+import (
+	"fmt"
+	"math"
+	"unsafe"
+
+	"ballerina-lang-go/decimal"
+	"ballerina-lang-go/semtypes"
+)
+
+// numericConversions lists, in the order they are attempted, the conversions
+// to each numeric basic type applied when the shape of a number does not
+// belong to the target type.
+var numericConversions = []struct {
+	basic   semtypes.SemType
+	convert func(BalValue) (BalValue, bool)
+}{
+	{semtypes.INT, numberToInt},
+	{semtypes.FLOAT, numberToFloat},
+	{semtypes.DECIMAL, numberToDecimal},
+}
+
+// CloneWithType returns a copy of v whose inherent type belongs to t, as
+// `lang.value:cloneWithType` does. The copy has the shape of v except that
+// numbers are converted to another numeric type where the shape alone does not
+// fit t. Structured members take their inherent types from t. An error value
+// is returned when no such copy exists, including when v is cyclic. Default
+// values of record fields are not part of t, so a field with a default value
+// that v lacks makes the conversion fail.
+func CloneWithType(tc semtypes.Context, v BalValue, t semtypes.SemType) BalValue {
+	if c, ok := convertToType(tc, v, t, make(map[unsafe.Pointer]bool)); ok {
+		return c
+	}
+	return NewErrorWithMessage(fmt.Sprintf("'%s' value cannot be converted to the target type", valueKindName(v)))
+}
+
+// EnsureType returns v if it belongs to t, as `lang.value:ensureType` does.
+// A number that does not belong to t is converted as by a type cast. Unlike a
+// cast, a value that cannot be converted results in an error value being
+// returned rather than a panic.
+func EnsureType(tc semtypes.Context, v BalValue, t semtypes.SemType) BalValue {
+	if semtypes.IsSubtype(tc, SemTypeForValue(v), t) {
+		return v
+	}
+	if c, ok := convertNumber(tc, v, t); ok {
+		return c
+	}
+	return NewErrorWithMessage(fmt.Sprintf("incompatible types: '%s' value cannot be cast to the target type", valueKindName(v)))
+}
+
+func convertToType(tc semtypes.Context, v BalValue, t semtypes.SemType, visiting map[unsafe.Pointer]bool) (BalValue, bool) {
+	switch v := v.(type) {
+	case *List:
+		if visiting[unsafe.Pointer(v)] {
+			return nil, false
+		}
+		visiting[unsafe.Pointer(v)] = true
+		defer delete(visiting, unsafe.Pointer(v))
+		for _, alt := range semtypes.ListAlternatives(tc, semtypes.Intersect(t, semtypes.LIST)) {
+			if c, ok := convertList(tc, v, alt.SemType, visiting); ok {
+				return c, true
+			}
+		}
+		return nil, false
+	case *Map:
+		if visiting[unsafe.Pointer(v)] {
+			return nil, false
+		}
+		visiting[unsafe.Pointer(v)] = true
+		defer delete(visiting, unsafe.Pointer(v))
+		for _, alt := range semtypes.MappingAlternatives(tc, semtypes.Intersect(t, semtypes.MAPPING)) {
+			if c, ok := convertMap(tc, v, alt.SemType, visiting); ok {
+				return c, true
+			}
+		}
+		return nil, false
+	}
+	if semtypes.IsSubtype(tc, SemTypeForValue(v), t) {
+		return Clone(tc, v), true
+	}
+	return convertNumber(tc, v, t)
+}
+
+// convertList builds a list of type ty from the members of l, converting each
+// member to the member type ty declares for its index.
+func convertList(tc semtypes.Context, l *List, ty semtypes.SemType, visiting map[unsafe.Pointer]bool) (BalValue, bool) {
+	atomic := semtypes.ToListAtomicType(tc, ty)
+	if atomic == nil || l.Len() < atomic.Members.FixedLength {
+		return nil, false
+	}
+	items := make([]BalValue, l.Len())
+	for i := range items {
+		memberTy := atomic.MemberAtInnerVal(i)
+		if semtypes.IsNever(memberTy) {
+			return nil, false
+		}
+		item, ok := convertToType(tc, l.Get(i), memberTy, visiting)
+		if !ok {
+			return nil, false
+		}
+		items[i] = item
+	}
+	filler, _ := FillerFactoryFor(tc, atomic.Rest())
+	return NewList(ty, atomic, semtypes.IsSubtype(tc, ty, semtypes.VAL_READONLY), filler, 0, items), true
+}
+
+// convertMap builds a mapping of type ty from the fields of m, converting each
+// field to the type ty declares for it. Fields ty does not allow and required
+// fields of ty that m lacks make the conversion fail.
+func convertMap(tc semtypes.Context, m *Map, ty semtypes.SemType, visiting map[unsafe.Pointer]bool) (BalValue, bool) {
+	atomic := semtypes.ToMappingAtomicType(tc, ty)
+	if atomic == nil {
+		return nil, false
+	}
+	entries := make([]MapEntry, 0, m.Len())
+	for e := m.head; e != nil; e = e.next {
+		fieldTy := atomic.FieldInnerVal(e.key)
+		if semtypes.IsNever(fieldTy) {
+			return nil, false
+		}
+		value, ok := convertToType(tc, e.value, fieldTy, visiting)
+		if !ok {
+			return nil, false
+		}
+		entries = append(entries, MapEntry{Key: e.key, Value: value})
+	}
+	for _, name := range atomic.Names {
+		if _, ok := m.data[name]; !ok && !atomic.IsOptional(tc, name) {
+			return nil, false
+		}
+	}
+	return NewMap(ty, atomic, semtypes.IsSubtype(tc, ty, semtypes.VAL_READONLY), entries), true
+}
+
+// convertNumber converts a number to the first numeric basic type of t that
+// the converted value belongs to.
+func convertNumber(tc semtypes.Context, v BalValue, t semtypes.SemType) (BalValue, bool) {
+	for _, conv := range numericConversions {
+		if semtypes.IsEmpty(tc, semtypes.Intersect(t, conv.basic)) {
+			continue
+		}
+		c, ok := conv.convert(v)
+		if ok && semtypes.IsSubtype(tc, SemTypeForValue(c), t) {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+func numberToInt(v BalValue) (BalValue, bool) {
+	switch v := v.(type) {
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) || v < float64(math.MinInt64) || v >= float64(math.MaxInt64) {
+			return nil, false
+		}
+		return int64(math.RoundToEven(v)), true
+	case *decimal.Decimal:
+		n, ok, err := v.Int64()
+		return n, ok && err == nil
+	}
+	return nil, false
+}
+
+func numberToFloat(v BalValue) (BalValue, bool) {
+	switch v := v.(type) {
+	case int64:
+		return float64(v), true
+	case *decimal.Decimal:
+		return v.Float64(), true
+	}
+	return nil, false
+}
+
+func numberToDecimal(v BalValue) (BalValue, bool) {
+	switch v := v.(type) {
+	case int64:
+		return decimal.FromInt64(v), true
+	case float64:
+		d, err := decimal.FromFloat64(v)
+		return d, err == nil
+	}
+	return nil, false
+}
+
+// valueKindName names the basic type of v for error messages. Types are not
+// rendered in full, since doing so may need type definitions that cannot be
+// created at runtime.
+func valueKindName(v BalValue) string {
+	switch v.(type) {
+	case nil:
+		return "()"
+	case bool:
+		return "boolean"
+	case int64:
+		return "int"
+	case float64:
+		return "float"
+	case *decimal.Decimal:
+		return "decimal"
+	case string:
+		return "string"
+	case *List:
+		return "list"
+	case *Map:
+		return "mapping"
+	case *Table:
+		return "table"
+	case *Error:
+		return "error"
+	case XMLValue:
+		return "xml"
+	default:
+		return "value"
+	}
+}
@@ -65,12 +65,21 @@ func deepEqual(v1, v2 BalValue, visited map[refPair]struct{}) bool {
 			return false
 		}
 		return mapDeepEqual(a, b, visited)
+	case *Table:
+		b, ok := v2.(*Table)
+		if !ok {
+			return false
+		}
+		return tableDeepEqual(a, b, visited)
 	case XMLValue:
 		b, ok := v2.(XMLValue)
 		if !ok {
 			return false
 		}
 		return xmlDeepEqual(a, b, visited)
+	case *RegExp:
+		b, ok := v2.(*RegExp)
+		return ok && a.Source == b.Source
 	default:
 		return v1 == v2
 	}
@@ -136,6 +145,25 @@ func mapDeepEqual(a, b *Map, visited map[refPair]struct{}) bool {
 	return true
 }
 
+func tableDeepEqual(a, b *Table, visited map[refPair]struct{}) bool {
+	if a == b {
+		return true
+	}
+	if a.Len() != b.Len() {
+		return false
+	}
+	visited, cycle := markVisited(unsafe.Pointer(a), unsafe.Pointer(b), visited)
+	if cycle {
+		return true
+	}
+	for ea, eb := a.head, b.head; ea != nil; ea, eb = ea.next, eb.next {
+		if !mapDeepEqual(ea.row, eb.row, visited) {
+			return false
+		}
+	}
+	return true
+}
+
 func xmlDeepEqual(a, b XMLValue, visited map[refPair]struct{}) bool {
 	if a == b {
 		return true
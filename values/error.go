@@ -29,6 +29,39 @@ type Error struct {
 	Cause    BalValue
 	Detail   *Map
 	TypeName string
+	// StackTrace is the call stack of the strand when the error was created,
+	// innermost frame first. It is nil until the runtime captures it.
+	StackTrace []StackFrame
+}
+
+// StackFrame is an entry of the call stack captured by an error value.
+type StackFrame struct {
+	// FunctionKey is the lookup key of the called function, such as
+	// "org/module:name".
+	FunctionKey string
+	// FilePath is empty when the location of the frame is unknown.
+	FilePath string
+	// Line is the 1-based line of the frame's current position.
+	Line int
+}
+
+// CallableName returns the name of the function of the frame, without its
+// module.
+func (f StackFrame) CallableName() string {
+	if idx := strings.LastIndex(f.FunctionKey, ":"); idx != -1 {
+		return f.FunctionKey[idx+1:]
+	}
+	return f.FunctionKey
+}
+
+// ModuleName returns the module of the function of the frame, or "" for a
+// function of an anonymous single-file module.
+func (f StackFrame) ModuleName() string {
+	idx := strings.LastIndex(f.FunctionKey, ":")
+	if idx == -1 || strings.HasPrefix(f.FunctionKey, "$anon/") {
+		return ""
+	}
+	return f.FunctionKey[:idx]
 }
 
 func NewError(t semtypes.SemType, message string, cause BalValue, typeName string, detail *Map) *Error {
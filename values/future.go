@@ -0,0 +1,49 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package values
+
+import "ballerina-lang-go/semtypes"
+
+// Future is the runtime representation of a future<T> value. It is completed
+// with the value delivered on the channel of the strand it belongs to; the
+// strand is expected to send exactly one value, which is then cached so that
+// the future can be waited on more than once.
+type Future struct {
+	Type   semtypes.SemType
+	done   chan struct{}
+	result BalValue
+}
+
+func NewFuture(typ semtypes.SemType, strand <-chan BalValue) *Future {
+	f := &Future{Type: typ, done: make(chan struct{})}
+	go func() {
+		f.result = <-strand
+		close(f.done)
+	}()
+	return f
+}
+
+// Done returns a channel that is closed once the future has completed.
+func (f *Future) Done() <-chan struct{} {
+	return f.done
+}
+
+// Result blocks until the future has completed and returns its value.
+func (f *Future) Result() BalValue {
+	<-f.done
+	return f.result
+}
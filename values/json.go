@@ -19,6 +19,12 @@ package values
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unsafe"
 
 	"ballerina-lang-go/decimal"
 	"ballerina-lang-go/semtypes"
@@ -103,3 +109,239 @@ func GoToBalValue(tc semtypes.Context, v any, jsonListTy, jsonMapTy semtypes.Sem
 		return nil
 	}
 }
+
+// ToJSON converts an anydata value to json, as `lang.value:toJson` does. A new
+// copy is made of every structured value: lists become jsonListTy values and
+// mappings become jsonMapTy values. A table becomes a list of its rows and an
+// xml value becomes its string form. It panics if v is cyclic.
+func ToJSON(tc semtypes.Context, v BalValue, jsonListTy, jsonMapTy semtypes.SemType) BalValue {
+	return toJSON(tc, v, jsonListTy, jsonMapTy, make(map[unsafe.Pointer]bool))
+}
+
+func toJSON(tc semtypes.Context, v BalValue, jsonListTy, jsonMapTy semtypes.SemType, visiting map[unsafe.Pointer]bool) BalValue {
+	enter := func(ptr unsafe.Pointer) {
+		if visiting[ptr] {
+			panic(NewErrorWithMessage("cyclic value referenced"))
+		}
+		visiting[ptr] = true
+	}
+	switch t := v.(type) {
+	case *List:
+		enter(unsafe.Pointer(t))
+		defer delete(visiting, unsafe.Pointer(t))
+		items := make([]BalValue, t.Len())
+		for i := range items {
+			items[i] = toJSON(tc, t.Get(i), jsonListTy, jsonMapTy, visiting)
+		}
+		return NewList(jsonListTy, semtypes.ToListAtomicType(tc, jsonListTy), false, nil, 0, items)
+	case *Map:
+		enter(unsafe.Pointer(t))
+		defer delete(visiting, unsafe.Pointer(t))
+		entries := make([]MapEntry, 0, t.Len())
+		for e := t.head; e != nil; e = e.next {
+			entries = append(entries, MapEntry{Key: e.key, Value: toJSON(tc, e.value, jsonListTy, jsonMapTy, visiting)})
+		}
+		return NewMap(jsonMapTy, semtypes.ToMappingAtomicType(tc, jsonMapTy), false, entries)
+	case *Table:
+		enter(unsafe.Pointer(t))
+		defer delete(visiting, unsafe.Pointer(t))
+		items := make([]BalValue, 0, t.size)
+		for e := t.head; e != nil; e = e.next {
+			items = append(items, toJSON(tc, e.row, jsonListTy, jsonMapTy, visiting))
+		}
+		return NewList(jsonListTy, semtypes.ToListAtomicType(tc, jsonListTy), false, nil, 0, items)
+	case XMLValue:
+		return String(t, make(map[uintptr]bool))
+	default:
+		return v
+	}
+}
+
+// ToJSONString serializes a json value as `lang.value:toJsonString` does.
+// Mapping members keep their insertion order.
+func ToJSONString(v BalValue) string {
+	var b strings.Builder
+	writeJSON(&b, v)
+	return b.String()
+}
+
+func writeJSON(b *strings.Builder, v BalValue) {
+	switch t := v.(type) {
+	case nil:
+		b.WriteString("null")
+	case string:
+		writeJSONQuoted(b, t)
+	case float64:
+		b.WriteString(FormatFloat(t))
+	case *decimal.Decimal:
+		b.WriteString(t.String())
+	case *List:
+		b.WriteByte('[')
+		for i := range t.Len() {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeJSON(b, t.Get(i))
+		}
+		b.WriteByte(']')
+	case *Map:
+		b.WriteByte('{')
+		for e := t.head; e != nil; e = e.next {
+			if e != t.head {
+				b.WriteString(", ")
+			}
+			writeJSONQuoted(b, e.key)
+			b.WriteByte(':')
+			writeJSON(b, e.value)
+		}
+		b.WriteByte('}')
+	default:
+		b.WriteString(String(v, make(map[uintptr]bool)))
+	}
+}
+
+func writeJSONQuoted(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+}
+
+// ParseJSON parses str as JSON, as `lang.value:fromJsonString` does. Object
+// members keep their order in str. A number is an int when it is
+// syntactically an integer within the int range, a float -0.0 when it is a
+// negative zero and a decimal otherwise. An error value is returned when str
+// is not valid JSON.
+func ParseJSON(tc semtypes.Context, str string, jsonListTy, jsonMapTy semtypes.SemType) BalValue {
+	dec := json.NewDecoder(strings.NewReader(str))
+	dec.UseNumber()
+	v, err := parseJSONValue(tc, dec, jsonListTy, jsonMapTy)
+	if err == nil {
+		if _, trailing := dec.Token(); trailing != io.EOF {
+			err = fmt.Errorf("invalid character after top-level value")
+		}
+	}
+	if err != nil {
+		return NewErrorWithMessage("failed to parse JSON: " + err.Error())
+	}
+	return v
+}
+
+func parseJSONValue(tc semtypes.Context, dec *json.Decoder, jsonListTy, jsonMapTy semtypes.SemType) (BalValue, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok := tok.(type) {
+	case json.Delim:
+		if tok == '[' {
+			var items []BalValue
+			for dec.More() {
+				item, err := parseJSONValue(tc, dec, jsonListTy, jsonMapTy)
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+			return NewList(jsonListTy, semtypes.ToListAtomicType(tc, jsonListTy), false, nil, 0, items), nil
+		}
+		var entries []MapEntry
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := parseJSONValue(tc, dec, jsonListTy, jsonMapTy)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, MapEntry{Key: key.(string), Value: value})
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return NewMap(jsonMapTy, semtypes.ToMappingAtomicType(tc, jsonMapTy), false, entries), nil
+	case json.Number:
+		return parseJSONNumber(string(tok))
+	default:
+		return tok, nil
+	}
+}
+
+func parseJSONNumber(s string) (BalValue, error) {
+	if !strings.ContainsAny(s, ".eE") {
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			if n == 0 && s[0] == '-' {
+				return math.Copysign(0, -1), nil
+			}
+			return n, nil
+		}
+	}
+	d, err := decimal.FromLiteral(s)
+	if err != nil {
+		return nil, fmt.Errorf("number %s out of range", s)
+	}
+	if d.Cmp(decimal.FromInt64(0)) == 0 && s[0] == '-' {
+		return math.Copysign(0, -1), nil
+	}
+	return d, nil
+}
+
+// MergeJSON merges j2 into j1, as `lang.value:mergeJson` does. When either is
+// nil the other is returned. When both are mappings, each field of j2 is
+// merged into the field of j1 with the same key, which is updated in place,
+// and j1 is returned. In every other case an error value is returned.
+func MergeJSON(tc semtypes.Context, j1, j2 BalValue) BalValue {
+	if j1 == nil {
+		return j2
+	}
+	if j2 == nil {
+		return j1
+	}
+	m1, ok1 := j1.(*Map)
+	m2, ok2 := j2.(*Map)
+	if !ok1 || !ok2 {
+		return NewErrorWithMessage("cannot merge JSON values that are not both JSON objects")
+	}
+	if m1 == m2 {
+		return m1
+	}
+	if m1.isReadonly {
+		return NewErrorWithMessage("cannot merge into a readonly JSON object")
+	}
+	for e := m2.head; e != nil; e = e.next {
+		merged := e.value
+		if existing, ok := m1.Get(e.key); ok {
+			merged = MergeJSON(tc, existing, e.value)
+			if err, isErr := merged.(*Error); isErr {
+				return NewErrorWithMessage(fmt.Sprintf("cannot merge field '%s': %s", e.key, err.Message))
+			}
+		}
+		m1.Put(tc, e.key, merged)
+	}
+	return m1
+}
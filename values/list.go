@@ -18,7 +18,9 @@ package values
 
 import (
 	"ballerina-lang-go/semtypes"
+	"fmt"
 	"math"
+	"slices"
 	"strings"
 	"unsafe"
 )
@@ -126,6 +128,64 @@ func (l *List) Append(tc semtypes.Context, vs ...BalValue) {
 	l.elems = append(l.elems, vs...)
 }
 
+// Remove removes the member at idx and returns it, moving the members after
+// it down by one. Panics if the list is readonly, idx is out of range or the
+// inherent type does not allow the shorter list.
+func (l *List) Remove(tc semtypes.Context, idx int) BalValue {
+	l.checkMutable()
+	if idx < 0 || idx >= len(l.elems) {
+		panic(NewErrorWithMessage(fmt.Sprintf("invalid array index: %d", idx)))
+	}
+	l.checkLength(len(l.elems) - 1)
+	for i := idx + 1; i < len(l.elems); i++ {
+		l.checkMemberType(tc, i-1, l.elems[i])
+	}
+	removed := l.elems[idx]
+	copy(l.elems[idx:], l.elems[idx+1:])
+	l.elems[len(l.elems)-1] = nil
+	l.elems = l.elems[:len(l.elems)-1]
+	return removed
+}
+
+// Insert adds values at idx, moving the members from idx up. Each member is
+// checked against the inherent member type at its eventual index.
+func (l *List) Insert(tc semtypes.Context, idx int, vs ...BalValue) {
+	l.checkMutable()
+	if idx < 0 || idx > len(l.elems) {
+		panic(NewErrorWithMessage(fmt.Sprintf("invalid array index: %d", idx)))
+	}
+	for i, v := range vs {
+		l.checkMemberType(tc, idx+i, v)
+	}
+	for i := idx; i < len(l.elems); i++ {
+		l.checkMemberType(tc, i+len(vs), l.elems[i])
+	}
+	l.elems = slices.Insert(l.elems, idx, vs...)
+}
+
+// SetLength truncates the list or grows it with filler values to length.
+// Panics if the list is readonly or the inherent type does not allow length.
+func (l *List) SetLength(length int) {
+	l.checkMutable()
+	if length < 0 {
+		panic(NewErrorWithMessage(fmt.Sprintf("invalid array length: %d", length)))
+	}
+	if length > len(l.elems) {
+		l.FillingGet(length - 1)
+		return
+	}
+	l.checkLength(length)
+	clear(l.elems[length:])
+	l.elems = l.elems[:length]
+}
+
+// checkLength panics if the inherent type requires more than length members.
+func (l *List) checkLength(length int) {
+	if length < l.atomic.Members.FixedLength {
+		panic(NewErrorWithMessage("inherent type violation"))
+	}
+}
+
 func (l *List) checkMutable() {
 	if l.isReadonly {
 		panic(NewErrorWithMessage("inherent type violation: cannot mutate readonly value"))
@@ -0,0 +1,125 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package values
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"ballerina-lang-go/common/bregexp"
+)
+
+// RegExp is a value of the regexp basic type. Source is the pattern as written
+// in Ballerina; matching is done with the RE2 translation of it. RegExp values
+// are immutable, so values with the same source are shared.
+type RegExp struct {
+	Source string
+	re     *regexp.Regexp
+
+	anchoredOnce sync.Once
+	anchored     *regexp.Regexp
+	fullOnce     sync.Once
+	full         *regexp.Regexp
+}
+
+// regExpCacheLimit bounds the number of patterns kept in regExpCache. Patterns
+// parsed once the cache is full are not shared.
+const regExpCacheLimit = 1024
+
+var (
+	regExpCache     sync.Map // string -> *RegExp
+	regExpCacheSize atomic.Int64
+)
+
+// NewRegExp parses source as a Ballerina regular expression. The returned
+// error is a Ballerina error value describing the syntax error.
+func NewRegExp(source string) (*RegExp, *Error) {
+	if cached, ok := regExpCache.Load(source); ok {
+		return cached.(*RegExp), nil
+	}
+	r, err := compileRegExp(source)
+	if err != nil {
+		return nil, err
+	}
+	if regExpCacheSize.Load() >= regExpCacheLimit {
+		return r, nil
+	}
+	value, loaded := regExpCache.LoadOrStore(source, r)
+	if !loaded {
+		regExpCacheSize.Add(1)
+	}
+	return value.(*RegExp), nil
+}
+
+// NewRegExpFromTemplate builds the regular expression of a regexp template
+// from its literal strings and the string values of its insertions. Each
+// insertion must be a regular expression by itself, and is inserted as a
+// non-capturing group so that, as bregexp.Check assumes, it is an atom.
+// Patterns built from insertions are not cached.
+func NewRegExpFromTemplate(strs []string, insertions []string) (*RegExp, *Error) {
+	if len(insertions) == 0 {
+		return NewRegExp(strs[0])
+	}
+	var sb strings.Builder
+	for i, ins := range insertions {
+		if _, err := bregexp.Translate(ins); err != nil {
+			return nil, NewErrorWithMessage("invalid insertion in regular expression: " + err.Error())
+		}
+		sb.WriteString(strs[i])
+		sb.WriteString("(?:")
+		sb.WriteString(ins)
+		sb.WriteString(")")
+	}
+	sb.WriteString(strs[len(insertions)])
+	return compileRegExp(sb.String())
+}
+
+func compileRegExp(source string) (*RegExp, *Error) {
+	translated, err := bregexp.Translate(source)
+	if err != nil {
+		return nil, NewErrorWithMessage("invalid regular expression: " + err.Error())
+	}
+	re, err := regexp.Compile(translated)
+	if err != nil {
+		return nil, NewErrorWithMessage("unsupported regular expression: " + err.Error())
+	}
+	return &RegExp{Source: source, re: re}, nil
+}
+
+// Regexp returns the compiled pattern.
+func (r *RegExp) Regexp() *regexp.Regexp {
+	return r.re
+}
+
+// Anchored returns the pattern compiled so that it only matches at the start
+// of the input.
+func (r *RegExp) Anchored() *regexp.Regexp {
+	r.anchoredOnce.Do(func() {
+		r.anchored = regexp.MustCompile(`\A(?:` + r.re.String() + `)`)
+	})
+	return r.anchored
+}
+
+// Full returns the pattern compiled so that it only matches the whole input.
+func (r *RegExp) Full() *regexp.Regexp {
+	r.fullOnce.Do(func() {
+		r.full = regexp.MustCompile(`\A(?:` + r.re.String() + `)\z`)
+	})
+	return r.full
+}
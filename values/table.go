@@ -0,0 +1,344 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package values
+
+import (
+	"hash/fnv"
+	"math"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"ballerina-lang-go/decimal"
+	"ballerina-lang-go/semtypes"
+)
+
+type tableEntry struct {
+	key        []BalValue
+	row        *Map
+	prev, next *tableEntry
+}
+
+// Table is a table value. Rows are kept in insertion order; when the table has
+// a key specifier, rows are also indexed by the values of their key fields.
+type Table struct {
+	Type       semtypes.SemType
+	RowType    semtypes.SemType
+	KeyFields  []string
+	isReadonly bool
+
+	size       int
+	index      map[uint64][]*tableEntry
+	head, tail *tableEntry
+}
+
+// NewTable constructs a table seeded with rows, in the order given, without
+// applying any inherent type or readonly checks on them. keyFields is empty
+// for a keyless table. Panics if two rows have the same key.
+func NewTable(ty, rowTy semtypes.SemType, keyFields []string, isReadonly bool, rows []*Map) *Table {
+	t := &Table{
+		Type:       ty,
+		RowType:    rowTy,
+		KeyFields:  keyFields,
+		isReadonly: isReadonly,
+		index:      make(map[uint64][]*tableEntry),
+	}
+	for _, row := range rows {
+		t.addUnchecked(row)
+	}
+	return t
+}
+
+// HasKeySpecifier reports whether rows of the table are identified by key fields.
+func (t *Table) HasKeySpecifier() bool {
+	return len(t.KeyFields) > 0
+}
+
+// Add appends row to the table. Panics if the table is readonly, row does not
+// belong to the row type, or a row with the same key already exists.
+func (t *Table) Add(tc semtypes.Context, row *Map) {
+	t.checkMutable()
+	t.checkRowType(tc, row)
+	t.addUnchecked(row)
+}
+
+func (t *Table) addUnchecked(row *Map) {
+	key := t.rowKey(row)
+	if key != nil && t.lookup(key) != nil {
+		panic(NewErrorWithMessage("a value found for key '" + keyString(key) + "'"))
+	}
+	t.insert(key, row)
+}
+
+// Put adds row to the table, replacing the row with the same key if there is
+// one. The replacing row takes the position of the replaced row.
+func (t *Table) Put(tc semtypes.Context, row *Map) {
+	t.checkMutable()
+	t.checkRowType(tc, row)
+	key := t.rowKey(row)
+	if key != nil {
+		if e := t.lookup(key); e != nil {
+			e.row = row
+			return
+		}
+	}
+	t.insert(key, row)
+}
+
+// HasRowKey reports whether the table has a row with the same key as row.
+func (t *Table) HasRowKey(row *Map) bool {
+	return t.lookup(t.rowKey(row)) != nil
+}
+
+// Get returns the row with the given key. For a multi-field key, key must be
+// a list of the key field values in key specifier order.
+func (t *Table) Get(key BalValue) (*Map, bool) {
+	if e := t.lookup(t.keyFromValue(key)); e != nil {
+		return e.row, true
+	}
+	return nil, false
+}
+
+// Remove removes and returns the row with the given key. Panics if the table
+// is readonly.
+func (t *Table) Remove(key BalValue) (*Map, bool) {
+	t.checkMutable()
+	keyValues := t.keyFromValue(key)
+	e := t.lookup(keyValues)
+	if e == nil {
+		return nil, false
+	}
+	t.unlinkEntry(e)
+	h := hashKey(keyValues)
+	bucket := t.index[h]
+	for i, each := range bucket {
+		if each == e {
+			bucket = append(bucket[:i], bucket[i+1:]...)
+			break
+		}
+	}
+	if len(bucket) == 0 {
+		delete(t.index, h)
+	} else {
+		t.index[h] = bucket
+	}
+	t.size--
+	return e.row, true
+}
+
+// RemoveAll removes every row. Panics if the table is readonly.
+func (t *Table) RemoveAll() {
+	t.checkMutable()
+	t.head, t.tail = nil, nil
+	t.index = make(map[uint64][]*tableEntry)
+	t.size = 0
+}
+
+func (t *Table) Len() int {
+	return t.size
+}
+
+// Rows returns the rows in insertion order.
+func (t *Table) Rows() []*Map {
+	rows := make([]*Map, 0, t.size)
+	for e := t.head; e != nil; e = e.next {
+		rows = append(rows, e.row)
+	}
+	return rows
+}
+
+// Keys returns the key of each row in insertion order. Each key holds one
+// value per key field.
+func (t *Table) Keys() [][]BalValue {
+	keys := make([][]BalValue, 0, t.size)
+	for e := t.head; e != nil; e = e.next {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+func (t *Table) insert(key []BalValue, row *Map) {
+	e := &tableEntry{key: key, row: row}
+	if key != nil {
+		h := hashKey(key)
+		t.index[h] = append(t.index[h], e)
+	}
+	t.appendEntry(e)
+	t.size++
+}
+
+func (t *Table) lookup(key []BalValue) *tableEntry {
+	if key == nil {
+		return nil
+	}
+	for _, e := range t.index[hashKey(key)] {
+		if keyEqual(e.key, key) {
+			return e
+		}
+	}
+	return nil
+}
+
+func (t *Table) rowKey(row *Map) []BalValue {
+	if !t.HasKeySpecifier() {
+		return nil
+	}
+	key := make([]BalValue, len(t.KeyFields))
+	for i, name := range t.KeyFields {
+		key[i], _ = row.Get(name)
+	}
+	return key
+}
+
+func (t *Table) keyFromValue(key BalValue) []BalValue {
+	if !t.HasKeySpecifier() {
+		return nil
+	}
+	if len(t.KeyFields) == 1 {
+		return []BalValue{key}
+	}
+	list := key.(*List)
+	values := make([]BalValue, list.Len())
+	for i := range values {
+		values[i] = list.Get(i)
+	}
+	return values
+}
+
+func (t *Table) checkMutable() {
+	if t.isReadonly {
+		panic(NewErrorWithMessage("inherent type violation: cannot mutate readonly value"))
+	}
+}
+
+func (t *Table) checkRowType(tc semtypes.Context, row *Map) {
+	if !semtypes.IsSubtype(tc, row.Type, t.RowType) {
+		panic(NewErrorWithMessage("inherent type violation"))
+	}
+}
+
+func (t *Table) appendEntry(e *tableEntry) {
+	if t.tail == nil {
+		t.head, t.tail = e, e
+		return
+	}
+	e.prev = t.tail
+	t.tail.next = e
+	t.tail = e
+}
+
+func (t *Table) unlinkEntry(e *tableEntry) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		t.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		t.tail = e.prev
+	}
+	e.prev, e.next = nil, nil
+}
+
+func (t *Table) String(visited map[uintptr]bool) string {
+	ptr := uintptr(unsafe.Pointer(t))
+	if visited[ptr] {
+		return "[...]"
+	}
+	visited[ptr] = true
+	defer delete(visited, ptr)
+
+	var b strings.Builder
+	b.WriteByte('[')
+	for e := t.head; e != nil; e = e.next {
+		if e != t.head {
+			b.WriteByte(',')
+		}
+		b.WriteString(e.row.String(visited))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+func keyEqual(k1, k2 []BalValue) bool {
+	if len(k1) != len(k2) {
+		return false
+	}
+	for i := range k1 {
+		if !DeepEquals(k1[i], k2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func keyString(key []BalValue) string {
+	parts := make([]string, len(key))
+	for i, v := range key {
+		parts[i] = toString(v, make(map[uintptr]bool), true)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// hashKey hashes key values consistently with DeepEquals: values that are
+// deep-equal always hash the same. Values that only compare equal via
+// DeepEquals normalization (such as decimals with different precision) share
+// a coarse hash and are told apart by keyEqual.
+func hashKey(key []BalValue) uint64 {
+	h := fnv.New64a()
+	var b strings.Builder
+	for _, v := range key {
+		writeKeyHash(&b, v)
+	}
+	_, _ = h.Write([]byte(b.String()))
+	return h.Sum64()
+}
+
+func writeKeyHash(b *strings.Builder, v BalValue) {
+	switch v := v.(type) {
+	case nil:
+		b.WriteString("nil;")
+	case bool:
+		b.WriteString("bool:" + strconv.FormatBool(v) + ";")
+	case int64:
+		b.WriteString("int:" + strconv.FormatInt(v, 10) + ";")
+	case float64:
+		switch {
+		case math.IsNaN(v):
+			b.WriteString("float:NaN;")
+		case v == 0:
+			b.WriteString("float:0;")
+		default:
+			b.WriteString("float:" + strconv.FormatFloat(v, 'g', -1, 64) + ";")
+		}
+	case string:
+		b.WriteString("string:" + strconv.Itoa(len(v)) + ":" + v + ";")
+	case *decimal.Decimal:
+		b.WriteString("decimal;")
+	case *List:
+		b.WriteString("list[")
+		for i := range v.Len() {
+			writeKeyHash(b, v.Get(i))
+		}
+		b.WriteString("];")
+	case *Map:
+		b.WriteString("map:" + strconv.Itoa(v.Len()) + ";")
+	default:
+		b.WriteString("other;")
+	}
+}
@@ -37,6 +37,9 @@ type Function struct {
 // around a semtype.
 type TypeDesc struct {
 	Type semtypes.SemType
+	// Annotations maps the `org/module:name` key of each annotation attached to
+	// the type definition to its value. It is nil when there are none.
+	Annotations *Map
 }
 
 // FillerFactory produces a fresh filler value each time it is invoked.
@@ -133,6 +136,8 @@ func SemTypeForValue(v BalValue) semtypes.SemType {
 		return v.Type
 	case *Map:
 		return v.Type
+	case *Table:
+		return v.Type
 	case *Error:
 		return v.Type
 	case *Function:
@@ -141,10 +146,14 @@ func SemTypeForValue(v BalValue) semtypes.SemType {
 		return v.Type
 	case *Stream:
 		return v.Type
+	case *Future:
+		return v.Type
 	case XMLValue:
 		return v.Type()
 	case *TypeDesc:
 		return semtypes.TYPEDESC
+	case *RegExp:
+		return semtypes.REGEXP
 	default:
 		return semtypes.ANY
 	}
@@ -178,6 +187,8 @@ func toString(v BalValue, visited map[uintptr]bool, isDirect bool) string {
 		return t.String(visited)
 	case *Map:
 		return t.String(visited)
+	case *Table:
+		return t.String(visited)
 	case *Error:
 		return t.String(visited)
 	case *Function:
@@ -186,10 +197,14 @@ func toString(v BalValue, visited map[uintptr]bool, isDirect bool) string {
 		return "object"
 	case *Stream:
 		return "stream"
+	case *Future:
+		return "future"
 	case *TypeDesc:
 		return "typedesc"
 	case XMLValue:
 		return t.XMLString()
+	case *RegExp:
+		return t.Source
 	default:
 		return "<unsupported>"
 	}
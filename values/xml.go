@@ -256,3 +256,33 @@ func NewXMLConcatSequence(items ...XMLValue) *XMLSequence {
 	ty, isReadonly := xmlSequenceType(items)
 	return &XMLSequence{Children: items, semType: ty, isReadonly: isReadonly}
 }
+
+// XMLItems returns the items of x, flattening nested sequences and merging adjacent text items into new ones.
+func XMLItems(x XMLValue) []XMLValue {
+	var items []XMLValue
+	var appendItems func(x XMLValue)
+	appendItems = func(x XMLValue) {
+		switch v := x.(type) {
+		case nil:
+		case *XMLSequence:
+			for _, child := range v.Children {
+				appendItems(child)
+			}
+		case *XMLText:
+			if v.Body == "" {
+				return
+			}
+			if last := len(items) - 1; last >= 0 {
+				if prev, ok := items[last].(*XMLText); ok {
+					items[last] = NewXMLText(prev.Body + v.Body)
+					return
+				}
+			}
+			items = append(items, v)
+		default:
+			items = append(items, v)
+		}
+	}
+	appendItems(x)
+	return items
+}